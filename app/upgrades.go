@@ -8,6 +8,7 @@ import (
 
 	authz "github.com/cosmos/cosmos-sdk/x/authz"
 
+	v10 "github.com/Stride-Labs/stride/v9/app/upgrades/v10"
 	v2 "github.com/Stride-Labs/stride/v9/app/upgrades/v2"
 	v3 "github.com/Stride-Labs/stride/v9/app/upgrades/v3"
 	v4 "github.com/Stride-Labs/stride/v9/app/upgrades/v4"
@@ -104,6 +105,12 @@ func (app *StrideApp) setupUpgradeHandlers() {
 		v9.CreateUpgradeHandler(app.mm, app.configurator, app.ClaimKeeper),
 	)
 
+	// v10 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v10.UpgradeName,
+		v10.CreateUpgradeHandler(app.mm, app.configurator, app.StakeibcKeeper, app.GetSubspace(stakeibctypes.ModuleName)),
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(fmt.Errorf("Failed to read upgrade info from disk: %w", err))
@@ -0,0 +1,5 @@
+package v10
+
+var (
+	UpgradeName = "v10"
+)
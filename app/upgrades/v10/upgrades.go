@@ -0,0 +1,77 @@
+package v10
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v10
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	stakeibcKeeper stakeibckeeper.Keeper,
+	stakeibcParamSubspace paramstypes.Subspace,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting upgrade v10...")
+
+		AddStakeibcParams(ctx, stakeibcParamSubspace)
+		AddHostZoneStrideCommissions(ctx, stakeibcKeeper)
+		AddStTokenDenomMetadata(ctx, stakeibcKeeper)
+
+		ctx.Logger().Info("Running module migrations...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// Initializes the stakeibc params that were added in v10 with their default value
+// Unlike re-initializing the full param set (as in v5), this leaves the existing params untouched
+func AddStakeibcParams(ctx sdk.Context, stakeibcParamSubspace paramstypes.Subspace) {
+	ctx.Logger().Info("Adding new stakeibc params...")
+
+	defaultParams := stakeibctypes.DefaultParams()
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeyRedemptionRateHistorySize, defaultParams.RedemptionRateHistorySize)
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeyRedemptionRateTwapEpochs, defaultParams.RedemptionRateTwapEpochs)
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeyInstantRedemptionBufferPercent, defaultParams.InstantRedemptionBufferPercent)
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeyInstantRedemptionFee, defaultParams.InstantRedemptionFee)
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeyMaxAutoClaimsPerEpoch, defaultParams.MaxAutoClaimsPerEpoch)
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeySafetySlashConfirmationWindow, defaultParams.SafetySlashConfirmationWindow)
+	stakeibcParamSubspace.Set(ctx, stakeibctypes.KeySunsetClaimPeriodDays, defaultParams.SunsetClaimPeriodDays)
+}
+
+// Sets the stride commission on each host zone that does not have one yet
+// The commission is carried over from the module-wide StrideCommission param
+func AddHostZoneStrideCommissions(ctx sdk.Context, stakeibcKeeper stakeibckeeper.Keeper) {
+	ctx.Logger().Info("Adding stride commission to host zones...")
+
+	for _, hostZone := range stakeibcKeeper.GetAllHostZone(ctx) {
+		if hostZone.StrideCommission != nil {
+			continue
+		}
+		strideCommission := stakeibcKeeper.GetDefaultStrideCommission(ctx)
+		hostZone.StrideCommission = &strideCommission
+		stakeibcKeeper.SetHostZone(ctx, hostZone)
+	}
+}
+
+// Registers the bank metadata of each host zone's stToken that does not have metadata yet
+// The display name, symbol and exponent are derived from the host denom (and can later be updated by governance)
+func AddStTokenDenomMetadata(ctx sdk.Context, stakeibcKeeper stakeibckeeper.Keeper) {
+	ctx.Logger().Info("Adding stToken denom metadata...")
+
+	for _, hostZone := range stakeibcKeeper.GetAllHostZone(ctx) {
+		if _, found := stakeibcKeeper.GetStTokenMetadata(ctx, hostZone.HostDenom); found {
+			continue
+		}
+		if err := stakeibcKeeper.SetStTokenMetadata(ctx, hostZone.HostDenom, "", "", 0); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Unable to add stToken metadata for %s: %s", hostZone.ChainId, err.Error()))
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package v10_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	v10 "github.com/Stride-Labs/stride/v9/app/upgrades/v10"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.AppTestHelper
+}
+
+func (s *UpgradeTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+func (s *UpgradeTestSuite) TestUpgrade() {
+	dummyUpgradeHeight := int64(5)
+
+	s.ConfirmUpgradeSucceededs("v10", dummyUpgradeHeight)
+	s.CheckStakeibcParamsAfterUpgrade()
+}
+
+func (s *UpgradeTestSuite) TestAddStakeibcParams() {
+	// Modify an existing param to confirm it's not overwritten
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.StrideCommission = 5
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	v10.AddStakeibcParams(s.Ctx, s.App.GetSubspace(stakeibctypes.ModuleName))
+
+	s.CheckStakeibcParamsAfterUpgrade()
+	s.Require().Equal(uint64(5), s.App.StakeibcKeeper.GetParams(s.Ctx).StrideCommission, "existing params should be unchanged")
+}
+
+func (s *UpgradeTestSuite) TestAddHostZoneStrideCommissions() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.StrideCommission = 5
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Store one host zone without a commission and one with a commission already set
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: "chain-0"})
+	existingCommission := sdk.MustNewDecFromStr("0.075")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: "chain-1", StrideCommission: &existingCommission})
+
+	v10.AddHostZoneStrideCommissions(s.Ctx, s.App.StakeibcKeeper)
+
+	hostZone0, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, "chain-0")
+	s.Require().True(found)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.05").String(), hostZone0.StrideCommission.String(), "commission populated from param")
+
+	hostZone1, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, "chain-1")
+	s.Require().True(found)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.075").String(), hostZone1.StrideCommission.String(), "existing commission unchanged")
+}
+
+func (s *UpgradeTestSuite) TestAddStTokenDenomMetadata() {
+	// Store one host zone without metadata and one with metadata already registered
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: "chain-0", HostDenom: "uatom"})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: "chain-1", HostDenom: "aevmos"})
+	existingMetadata := stakeibctypes.NewStTokenMetadata("aevmos", "Stride Staked Evmos", "stEvmos", 18)
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, existingMetadata)
+
+	v10.AddStTokenDenomMetadata(s.Ctx, s.App.StakeibcKeeper)
+
+	metadata0, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, "stuatom")
+	s.Require().True(found, "metadata added for stuatom")
+	s.Require().Equal(stakeibctypes.NewStTokenMetadata("uatom", "Stride Staked ATOM", "stATOM", 6), metadata0, "stuatom metadata")
+
+	metadata1, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, "staevmos")
+	s.Require().True(found, "metadata found for staevmos")
+	s.Require().Equal(existingMetadata, metadata1, "existing metadata unchanged")
+}
+
+func (s *UpgradeTestSuite) CheckStakeibcParamsAfterUpgrade() {
+	defaultParams := stakeibctypes.DefaultParams()
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	s.Require().Equal(defaultParams.RedemptionRateHistorySize, params.RedemptionRateHistorySize, "redemption rate history size")
+	s.Require().Equal(defaultParams.RedemptionRateTwapEpochs, params.RedemptionRateTwapEpochs, "redemption rate twap epochs")
+	s.Require().Equal(defaultParams.InstantRedemptionBufferPercent, params.InstantRedemptionBufferPercent, "instant redemption buffer percent")
+	s.Require().Equal(defaultParams.InstantRedemptionFee, params.InstantRedemptionFee, "instant redemption fee")
+	s.Require().Equal(uint64(50), params.MaxAutoClaimsPerEpoch, "max auto claims per epoch")
+	s.Require().Equal(defaultParams.SafetySlashConfirmationWindow, params.SafetySlashConfirmationWindow, "safety slash confirmation window")
+	s.Require().Equal(defaultParams.SunsetClaimPeriodDays, params.SunsetClaimPeriodDays, "sunset claim period days")
+}
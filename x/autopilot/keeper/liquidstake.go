@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -57,14 +58,22 @@ func (k Keeper) TryLiquidStaking(
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid stride_address (%s) in autopilot memo", strideAddress)
 	}
 
-	return k.RunLiquidStake(ctx, strideAddress, token)
+	minStTokenOut, err := packetMetadata.GetMinStTokenOut()
+	if err != nil {
+		return err
+	}
+
+	return k.RunLiquidStake(ctx, strideAddress, token, minStTokenOut)
 }
 
-func (k Keeper) RunLiquidStake(ctx sdk.Context, addr sdk.AccAddress, token sdk.Coin) error {
+// Liquid stakes the token on behalf of the address
+// If minStTokenOut is specified (non-nil), the liquid stake fails if fewer stTokens would be minted
+func (k Keeper) RunLiquidStake(ctx sdk.Context, addr sdk.AccAddress, token sdk.Coin, minStTokenOut sdkmath.Int) error {
 	msg := &stakeibctypes.MsgLiquidStake{
-		Creator:   addr.String(),
-		Amount:    token.Amount,
-		HostDenom: token.Denom,
+		Creator:       addr.String(),
+		Amount:        token.Amount,
+		HostDenom:     token.Denom,
+		MinStTokenOut: minStTokenOut,
 	}
 
 	if err := msg.ValidateBasic(); err != nil {
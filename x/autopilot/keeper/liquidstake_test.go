@@ -32,6 +32,16 @@ func getStakeibcPacketMetadata(address, action string) string {
 		}`, address, action)
 }
 
+func getStakeibcPacketMetadataWithMinStTokenOut(address, action, minStTokenOut string) string {
+	return fmt.Sprintf(`
+		{
+			"autopilot": {
+				"receiver": "%[1]s",
+				"stakeibc": { "stride_address": "%[1]s", "action": "%[2]s", "min_st_token_out": "%[3]s" } 
+			}
+		}`, address, action, minStTokenOut)
+}
+
 func (suite *KeeperTestSuite) TestLiquidStakeOnRecvPacket() {
 	now := time.Now()
 
@@ -163,6 +173,34 @@ func (suite *KeeperTestSuite) TestLiquidStakeOnRecvPacket() {
 			expSuccess:     false,
 			expLiquidStake: false,
 		},
+		{ // all okay with min stToken out met
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    "uatom",
+				Amount:   "1000000",
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getStakeibcPacketMetadataWithMinStTokenOut(addr1.String(), "LiquidStake", "1000000"),
+			},
+			destChannel:    "channel-0",
+			recvDenom:      atomIbcDenom,
+			expSuccess:     true,
+			expLiquidStake: true,
+		},
+		{ // min stToken out not met
+			forwardingActive: true,
+			packetData: transfertypes.FungibleTokenPacketData{
+				Denom:    "uatom",
+				Amount:   "1000000",
+				Sender:   "cosmos16plylpsgxechajltx9yeseqexzdzut9g8vla4k",
+				Receiver: addr1.String(),
+				Memo:     getStakeibcPacketMetadataWithMinStTokenOut(addr1.String(), "LiquidStake", "1000001"),
+			},
+			destChannel:    "channel-0",
+			recvDenom:      atomIbcDenom,
+			expSuccess:     false,
+			expLiquidStake: false,
+		},
 		{ // invalid stride address (memo)
 			forwardingActive: true,
 			packetData: transfertypes.FungibleTokenPacketData{
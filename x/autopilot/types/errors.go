@@ -14,4 +14,5 @@ var (
 	ErrInvalidReceiverAddress    = errorsmod.Register(ModuleName, 1506, "receiver address must be specified when using autopilot")
 	ErrPacketForwardingInactive  = errorsmod.Register(ModuleName, 1507, "autopilot packet forwarding is disabled")
 	ErrInvalidMemoSize           = errorsmod.Register(ModuleName, 1508, "the memo or receiver field exceeded the max allowable size")
+	ErrInvalidMinStTokenOut      = errorsmod.Register(ModuleName, 1509, "invalid min stToken out (must be a non-negative integer)")
 )
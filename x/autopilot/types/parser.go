@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -29,6 +30,8 @@ type ModuleRoutingInfo interface {
 type StakeibcPacketMetadata struct {
 	Action        string `json:"action"`
 	StrideAddress string `json:"stride_address"`
+	// Optional minimum number of stTokens to receive from the liquid stake
+	MinStTokenOut string `json:"min_st_token_out,omitempty"`
 }
 
 // Packet metadata info specific to Claim (e.g. airdrops for non-118 coins)
@@ -46,10 +49,25 @@ func (m StakeibcPacketMetadata) Validate() error {
 	if m.Action != "LiquidStake" {
 		return errorsmod.Wrapf(ErrUnsupportedStakeibcAction, "action %s is not supported", m.Action)
 	}
+	if _, err := m.GetMinStTokenOut(); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// Returns the optional minimum number of stTokens to receive, or a nil Int if it was not specified
+func (m StakeibcPacketMetadata) GetMinStTokenOut() (sdkmath.Int, error) {
+	if m.MinStTokenOut == "" {
+		return sdkmath.Int{}, nil
+	}
+	minStTokenOut, ok := sdkmath.NewIntFromString(m.MinStTokenOut)
+	if !ok || minStTokenOut.IsNegative() {
+		return sdkmath.Int{}, errorsmod.Wrapf(ErrInvalidMinStTokenOut, "min_st_token_out: %s", m.MinStTokenOut)
+	}
+	return minStTokenOut, nil
+}
+
 // Validate claim packet metadata includes the stride address
 func (m ClaimPacketMetadata) Validate() error {
 	_, err := sdk.AccAddressFromBech32(m.StrideAddress)
@@ -203,6 +203,32 @@ func TestValidateStakeibcPacketMetadata(t *testing.T) {
 			},
 			expectedErr: "unsupported stakeibc action",
 		},
+		{
+			name: "valid min stToken out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        validAction,
+				MinStTokenOut: "1000",
+			},
+		},
+		{
+			name: "invalid min stToken out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        validAction,
+				MinStTokenOut: "not_a_number",
+			},
+			expectedErr: "invalid min stToken out",
+		},
+		{
+			name: "negative min stToken out",
+			metadata: &types.StakeibcPacketMetadata{
+				StrideAddress: validAddress,
+				Action:        validAction,
+				MinStTokenOut: "-1",
+			},
+			expectedErr: "invalid min stToken out",
+		},
 	}
 
 	for _, tc := range testCases {
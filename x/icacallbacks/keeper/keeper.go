@@ -14,6 +14,7 @@ import (
 
 	ibckeeper "github.com/cosmos/ibc-go/v5/modules/core/keeper"
 
+	"github.com/Stride-Labs/stride/v9/utils"
 	"github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
 	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
 
@@ -95,16 +96,16 @@ func (k Keeper) GetCallbackDataFromPacket(ctx sdk.Context, modulePacket channelt
 	return &callbackData, true
 }
 
-func (k Keeper) GetICACallbackHandlerFromPacket(ctx sdk.Context, modulePacket channeltypes.Packet) (*types.ICACallbackHandler, error) {
+func (k Keeper) GetICACallbackHandlerFromPacket(ctx sdk.Context, modulePacket channeltypes.Packet, callbackId string) (*types.ICACallbackHandler, error) {
 	module, _, err := k.IBCKeeper.ChannelKeeper.LookupModuleByChannel(ctx, modulePacket.GetSourcePort(), modulePacket.GetSourceChannel())
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("error LookupModuleByChannel for portID: %s, channelID: %s, sequence: %d", modulePacket.GetSourcePort(), modulePacket.GetSourceChannel(), modulePacket.Sequence))
 		return nil, err
 	}
-	// redirect transfer callbacks to the records module
+	// redirect transfer callbacks to the module that registered the callback (the records module by default)
 	// is there a better way to do this?
 	if module == "transfer" {
-		module = recordstypes.ModuleName
+		module = k.getTransferCallbackModule(callbackId)
 	}
 	// fetch the callback function
 	callbackHandler, err := k.GetICACallbackHandler(module)
@@ -114,13 +115,27 @@ func (k Keeper) GetICACallbackHandlerFromPacket(ctx sdk.Context, modulePacket ch
 	return &callbackHandler, nil
 }
 
+// Transfer callbacks are handled by the records module, unless the callback ID was registered
+// by a different module (e.g. stakeibc's LSM token transfers)
+func (k Keeper) getTransferCallbackModule(callbackId string) string {
+	if recordsHandler, found := k.icacallbacks[recordstypes.ModuleName]; !found || recordsHandler.HasICACallback(callbackId) {
+		return recordstypes.ModuleName
+	}
+	for _, module := range utils.StringMapKeys(k.icacallbacks) {
+		if k.icacallbacks[module].HasICACallback(callbackId) {
+			return module
+		}
+	}
+	return recordstypes.ModuleName
+}
+
 func (k Keeper) CallRegisteredICACallback(ctx sdk.Context, modulePacket channeltypes.Packet, ackResponse *types.AcknowledgementResponse) error {
 	callbackDataKey := types.PacketID(modulePacket.GetSourcePort(), modulePacket.GetSourceChannel(), modulePacket.Sequence)
 	callbackData, found := k.GetCallbackDataFromPacket(ctx, modulePacket, callbackDataKey)
 	if !found {
 		return nil
 	}
-	callbackHandler, err := k.GetICACallbackHandlerFromPacket(ctx, modulePacket)
+	callbackHandler, err := k.GetICACallbackHandlerFromPacket(ctx, modulePacket, callbackData.CallbackId)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("GetICACallbackHandlerFromPacket %s", err.Error()))
 		return err
@@ -30,6 +30,10 @@ const (
 	STAKING_STORE_QUERY_WITH_PROOF = "store/staking/key"
 	// The bank store is key'd by the account address
 	BANK_STORE_QUERY_WITH_PROOF = "store/bank/key"
+	// The slashing store is key'd by the validator's consensus address
+	SLASHING_STORE_QUERY_WITH_PROOF = "store/slashing/key"
+	// The params store is key'd by the module's subspace and the param key
+	PARAMS_STORE_QUERY_WITH_PROOF = "store/params/key"
 )
 
 var (
@@ -20,6 +20,7 @@ func createNUserRedemptionRecord(keeper *keeper.Keeper, ctx sdk.Context, n int)
 	for i := range items {
 		items[i].Id = strconv.Itoa(i)
 		items[i].Amount = sdkmath.NewInt(int64(i))
+		items[i].StTokenAmount = sdkmath.NewInt(int64(i))
 		keeper.SetUserRedemptionRecord(ctx, items[i])
 	}
 	return items
@@ -133,6 +133,8 @@ type UserRedemptionRecord struct {
 	HostZoneId     string                                 `protobuf:"bytes,6,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
 	EpochNumber    uint64                                 `protobuf:"varint,7,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
 	ClaimIsPending bool                                   `protobuf:"varint,8,opt,name=claim_is_pending,json=claimIsPending,proto3" json:"claim_is_pending,omitempty"`
+	// number of stTokens escrowed for the redemption
+	StTokenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=st_token_amount,json=stTokenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st_token_amount"`
 }
 
 func (m *UserRedemptionRecord) Reset()         { *m = UserRedemptionRecord{} }
@@ -688,67 +690,67 @@ func init() { proto.RegisterFile("stride/records/genesis.proto", fileDescriptor_
 
 var fileDescriptor_98cfd0253c8b6797 = []byte{
 	// 978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3d, 0x6f, 0xdb, 0x46,
-	0x18, 0x16, 0x45, 0x8a, 0x92, 0x5f, 0xdb, 0x8a, 0x7c, 0x56, 0x6c, 0xda, 0x49, 0x64, 0x85, 0x68,
-	0x0b, 0x2d, 0x91, 0x52, 0xb7, 0x28, 0xd0, 0xa2, 0x40, 0x2b, 0x59, 0x8a, 0x4d, 0x43, 0x91, 0xd5,
-	0x93, 0xd4, 0x14, 0x19, 0x4a, 0x50, 0xe2, 0x41, 0x22, 0x1c, 0xf2, 0x04, 0xde, 0xc9, 0x68, 0xbb,
-	0xf4, 0x2f, 0x74, 0xe8, 0xd0, 0xb1, 0x4b, 0xd1, 0xbf, 0x92, 0x31, 0x63, 0xd1, 0x21, 0x28, 0xec,
-	0xa1, 0x3f, 0xa2, 0x4b, 0xc1, 0x23, 0x4d, 0x53, 0x1f, 0x49, 0x00, 0x23, 0x93, 0xc4, 0xf7, 0xfb,
-	0xde, 0xe7, 0xe1, 0x73, 0x84, 0xfb, 0x8c, 0xfb, 0x8e, 0x4d, 0x6a, 0x3e, 0x19, 0x51, 0xdf, 0x66,
-	0xb5, 0x31, 0xf1, 0x08, 0x73, 0x58, 0x75, 0xea, 0x53, 0x4e, 0x51, 0x3e, 0xf4, 0x56, 0x23, 0xef,
-	0x7e, 0x71, 0x4c, 0xc7, 0x54, 0xb8, 0x6a, 0xc1, 0xbf, 0x30, 0x4a, 0xff, 0x33, 0x0d, 0xc5, 0x01,
-	0x23, 0x3e, 0x26, 0x36, 0x71, 0xa7, 0xdc, 0xa1, 0x1e, 0x16, 0xf1, 0x28, 0x0f, 0x69, 0xc7, 0xd6,
-	0xa4, 0xb2, 0x54, 0x59, 0xc3, 0x69, 0xc7, 0x46, 0x3b, 0xa0, 0x32, 0xe2, 0xd9, 0xc4, 0xd7, 0xd2,
-	0xc2, 0x16, 0x3d, 0xa1, 0x7d, 0xc8, 0xf9, 0x64, 0x44, 0x9c, 0x0b, 0xe2, 0x6b, 0xb2, 0xf0, 0xc4,
-	0xcf, 0xe8, 0x09, 0xa8, 0x96, 0x4b, 0x67, 0x1e, 0xd7, 0x94, 0xc0, 0xd3, 0xa8, 0xbe, 0x7c, 0x7d,
-	0x90, 0xfa, 0xfb, 0xf5, 0xc1, 0x47, 0x63, 0x87, 0x4f, 0x66, 0xc3, 0xea, 0x88, 0xba, 0xb5, 0x11,
-	0x65, 0x2e, 0x65, 0xd1, 0xcf, 0x23, 0x66, 0x9f, 0xd7, 0xf8, 0x8f, 0x53, 0xc2, 0xaa, 0x86, 0xc7,
-	0x71, 0x94, 0x8d, 0x8a, 0x90, 0xb1, 0x89, 0x47, 0x5d, 0x2d, 0x23, 0x1a, 0x84, 0x0f, 0xa8, 0x0c,
-	0x1b, 0x13, 0xca, 0xb8, 0xf9, 0x13, 0xf5, 0x88, 0xe9, 0xd8, 0x9a, 0x2a, 0x9c, 0x10, 0xd8, 0x9e,
-	0x53, 0x8f, 0x18, 0x36, 0x7a, 0x08, 0x1b, 0x64, 0x4a, 0x47, 0x13, 0xd3, 0x9b, 0xb9, 0x43, 0xe2,
-	0x6b, 0xd9, 0xb2, 0x54, 0x51, 0xf0, 0xba, 0xb0, 0x75, 0x84, 0x09, 0x55, 0xa0, 0x30, 0x7a, 0x61,
-	0x39, 0xae, 0xe9, 0x30, 0x73, 0x4a, 0x3c, 0xdb, 0xf1, 0xc6, 0x5a, 0xae, 0x2c, 0x55, 0x72, 0x38,
-	0x2f, 0xec, 0x06, 0xeb, 0x86, 0x56, 0x3d, 0x0f, 0x6a, 0xd7, 0xf2, 0x2d, 0x97, 0x7d, 0xa1, 0xfc,
-	0xf6, 0xfb, 0x41, 0x4a, 0xef, 0xc2, 0x56, 0xb8, 0x2a, 0xd6, 0xb5, 0x46, 0xe7, 0x84, 0x37, 0x2d,
-	0x6e, 0xa1, 0x8f, 0x21, 0xeb, 0x51, 0xd3, 0xb6, 0xb8, 0x25, 0x56, 0xb7, 0x7e, 0xb8, 0x53, 0x9d,
-	0x87, 0xa1, 0xda, 0xa1, 0x41, 0xe0, 0x49, 0x0a, 0xab, 0x9e, 0xf8, 0xd7, 0xc8, 0x81, 0x3a, 0x15,
-	0x05, 0xf4, 0x1c, 0xa8, 0xa1, 0x57, 0xff, 0x57, 0x86, 0xcd, 0x26, 0x99, 0x52, 0xe6, 0xf0, 0x25,
-	0x38, 0x14, 0x01, 0xc7, 0xcd, 0x6a, 0xd3, 0xef, 0x67, 0xb5, 0xf2, 0xdb, 0x56, 0xab, 0x2c, 0xad,
-	0xf6, 0x4b, 0x50, 0x19, 0xb7, 0xf8, 0x8c, 0x89, 0xb5, 0xe7, 0x0f, 0x3f, 0x58, 0x3c, 0xe7, 0xdc,
-	0xf8, 0xd5, 0x9e, 0x88, 0xc5, 0x51, 0x0e, 0x7a, 0x0c, 0x45, 0x3b, 0xf4, 0x9b, 0x2b, 0x00, 0x42,
-	0x91, 0xaf, 0x95, 0xc0, 0x29, 0xe8, 0x47, 0x67, 0xfe, 0x88, 0x08, 0x74, 0xde, 0xdd, 0x4f, 0xc4,
-	0xe2, 0x28, 0x47, 0x9f, 0x80, 0x1a, 0x4e, 0x80, 0x10, 0xe4, 0xfb, 0xb8, 0xde, 0xe9, 0x3d, 0x69,
-	0x61, 0xf3, 0x9b, 0x41, 0x6b, 0xd0, 0x2a, 0xa4, 0x90, 0x06, 0xc5, 0xd8, 0x66, 0x74, 0xcc, 0x2e,
-	0x3e, 0x3b, 0xc6, 0xad, 0x5e, 0xaf, 0x90, 0x46, 0x45, 0x28, 0x34, 0x5b, 0xed, 0xd6, 0x71, 0xbd,
-	0x6f, 0x9c, 0x75, 0xa2, 0x78, 0x09, 0xed, 0xc3, 0x4e, 0xc2, 0x9a, 0xcc, 0x90, 0xf5, 0x0a, 0xa8,
-	0x61, 0x6f, 0x04, 0xa0, 0xf6, 0xfa, 0xd8, 0x68, 0x06, 0x1d, 0x10, 0xe4, 0x9f, 0x19, 0xfd, 0x93,
-	0x26, 0xae, 0x3f, 0xab, 0xb7, 0x4d, 0xe3, 0xa8, 0x5e, 0x90, 0x4e, 0x95, 0x5c, 0xa6, 0xa0, 0xea,
-	0x7f, 0x28, 0xb0, 0x75, 0x12, 0xad, 0x75, 0xe0, 0x0d, 0xa9, 0xe0, 0x1a, 0xfa, 0x16, 0xee, 0x30,
-	0x6e, 0x72, 0x7a, 0x4e, 0x3c, 0x33, 0x82, 0x59, 0xba, 0x15, 0xcc, 0x9b, 0x8c, 0xf7, 0x83, 0x2a,
-	0xf5, 0x10, 0xed, 0xef, 0x61, 0xdb, 0xb3, 0xb8, 0x73, 0x41, 0xe6, 0x6b, 0xdf, 0x8e, 0x42, 0x5b,
-	0x61, 0xa9, 0x64, 0xfd, 0xdb, 0xb2, 0xe9, 0x43, 0xc8, 0xcf, 0xae, 0x0f, 0x6f, 0x72, 0xc7, 0x25,
-	0xe2, 0x4d, 0x57, 0xf0, 0x66, 0x6c, 0xed, 0x3b, 0x2e, 0x41, 0x5f, 0x2f, 0x90, 0xae, 0xb2, 0x48,
-	0x82, 0xa5, 0x4d, 0x2e, 0x12, 0xef, 0x33, 0xd8, 0x9d, 0x31, 0xe2, 0x9b, 0x7e, 0x2c, 0x77, 0x66,
-	0x94, 0xab, 0x65, 0xcb, 0x72, 0x65, 0x0d, 0xdf, 0x9d, 0xad, 0x10, 0x43, 0xa6, 0xff, 0x1c, 0x13,
-	0x68, 0x1b, 0xee, 0x0c, 0x3a, 0x8d, 0xb3, 0x4e, 0xd3, 0xe8, 0x1c, 0xc7, 0x0c, 0xda, 0x83, 0xbb,
-	0x37, 0xc6, 0x39, 0x42, 0xa0, 0x5d, 0xd8, 0x6e, 0x7d, 0x67, 0xf4, 0xcd, 0x05, 0xd6, 0x49, 0xe8,
-	0x01, 0xec, 0xcd, 0x3b, 0x92, 0x79, 0x0a, 0xda, 0x84, 0xb5, 0xa3, 0x76, 0xdd, 0x78, 0x5a, 0x6f,
-	0xb4, 0x5b, 0x85, 0xb4, 0xfe, 0xab, 0x04, 0x45, 0xf1, 0x3e, 0xc4, 0x47, 0x8b, 0x84, 0x61, 0x51,
-	0xe3, 0xa4, 0x65, 0x8d, 0xeb, 0x41, 0xf1, 0x66, 0xff, 0xf1, 0x46, 0x99, 0x26, 0x97, 0xe5, 0xca,
-	0xfa, 0xe1, 0xc3, 0x77, 0x2e, 0x11, 0xa3, 0xc9, 0xa2, 0x89, 0x9d, 0x2a, 0xb9, 0x74, 0x41, 0xd6,
-	0xff, 0x93, 0x61, 0xe3, 0x38, 0xbc, 0x76, 0x82, 0xfd, 0x10, 0xf4, 0x69, 0xa0, 0x66, 0x81, 0x4a,
-	0xbe, 0x49, 0xff, 0x42, 0x0d, 0x6d, 0x28, 0x01, 0xd9, 0x70, 0x14, 0x8b, 0x76, 0x21, 0x3b, 0xa5,
-	0x3e, 0x0f, 0xc8, 0x11, 0xdd, 0x2e, 0xc1, 0xa3, 0x61, 0x23, 0x07, 0xee, 0xad, 0xc6, 0xcb, 0x7c,
-	0xe1, 0x30, 0x1e, 0x9d, 0x60, 0x49, 0x0b, 0x56, 0x5d, 0x68, 0x51, 0x47, 0x6d, 0x15, 0xbe, 0x6d,
-	0x87, 0x71, 0xf4, 0x15, 0xdc, 0x7f, 0x43, 0xab, 0x51, 0x7c, 0x85, 0x29, 0x78, 0x6f, 0x55, 0xfe,
-	0x91, 0x20, 0xbf, 0x03, 0xf7, 0x42, 0x24, 0x6e, 0xa8, 0x9c, 0x9c, 0x35, 0xb3, 0x7a, 0xd6, 0x55,
-	0xa0, 0x5e, 0xcf, 0x4a, 0x56, 0xf8, 0xc4, 0xac, 0x3d, 0xd8, 0xbe, 0xd6, 0xcf, 0x64, 0x8b, 0xac,
-	0x68, 0xf1, 0xe0, 0xad, 0xd2, 0x18, 0xd5, 0xde, 0xb2, 0x93, 0x46, 0x51, 0x34, 0x21, 0xca, 0x73,
-	0x07, 0xcf, 0xcd, 0x89, 0x72, 0xe2, 0xc4, 0x87, 0x19, 0x90, 0x9f, 0xb2, 0x71, 0xe3, 0xf4, 0xe5,
-	0x65, 0x49, 0x7a, 0x75, 0x59, 0x92, 0xfe, 0xb9, 0x2c, 0x49, 0xbf, 0x5c, 0x95, 0x52, 0xaf, 0xae,
-	0x4a, 0xa9, 0xbf, 0xae, 0x4a, 0xa9, 0xe7, 0x8f, 0x13, 0x52, 0xd2, 0x13, 0x43, 0x3d, 0x6a, 0x5b,
-	0x43, 0x56, 0x8b, 0x3e, 0x5c, 0x2e, 0x3e, 0xaf, 0xfd, 0x10, 0x7f, 0xbd, 0x08, 0x61, 0x19, 0xaa,
-	0xe2, 0xb3, 0xe4, 0x93, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x44, 0x7c, 0x58, 0x75, 0xdc, 0x08,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x63, 0xc7, 0x49, 0xdf, 0x6d, 0xb3, 0xe9, 0x34, 0xdb, 0xba, 0xdd, 0xdd, 0x34, 0x6b,
+	0x01, 0xca, 0x65, 0x93, 0xa5, 0x20, 0x24, 0x10, 0x12, 0x24, 0x4d, 0xb6, 0x75, 0x95, 0x4d, 0xc3,
+	0x24, 0x61, 0xd1, 0x1e, 0xb0, 0x9c, 0x78, 0x94, 0x58, 0x5d, 0x7b, 0x22, 0xcf, 0xa4, 0x02, 0x2e,
+	0xfc, 0x05, 0x0e, 0x1c, 0x38, 0x72, 0xe1, 0xbf, 0xec, 0x71, 0x8f, 0x88, 0xc3, 0x0a, 0xb5, 0x07,
+	0x7e, 0x04, 0x07, 0x90, 0xc7, 0xae, 0xeb, 0x7c, 0x74, 0x41, 0xd5, 0x9e, 0x9a, 0x79, 0xbf, 0xdf,
+	0x79, 0x9e, 0x3e, 0x63, 0x78, 0xc0, 0xb8, 0xef, 0xd8, 0xa4, 0xe6, 0x93, 0x11, 0xf5, 0x6d, 0x56,
+	0x1b, 0x13, 0x8f, 0x30, 0x87, 0x55, 0xa7, 0x3e, 0xe5, 0x14, 0xe5, 0x43, 0x6f, 0x35, 0xf2, 0xee,
+	0x15, 0xc7, 0x74, 0x4c, 0x85, 0xab, 0x16, 0xfc, 0x0a, 0xa3, 0xf4, 0x7f, 0xd2, 0x50, 0x1c, 0x30,
+	0xe2, 0x63, 0x62, 0x13, 0x77, 0xca, 0x1d, 0xea, 0x61, 0x11, 0x8f, 0xf2, 0x90, 0x76, 0x6c, 0x4d,
+	0x2a, 0x4b, 0x95, 0x35, 0x9c, 0x76, 0x6c, 0xb4, 0x0d, 0x2a, 0x23, 0x9e, 0x4d, 0x7c, 0x2d, 0x2d,
+	0x6c, 0xd1, 0x09, 0xed, 0x41, 0xce, 0x27, 0x23, 0xe2, 0x9c, 0x13, 0x5f, 0x93, 0x85, 0x27, 0x3e,
+	0xa3, 0xa7, 0xa0, 0x5a, 0x2e, 0x9d, 0x79, 0x5c, 0x53, 0x02, 0x4f, 0xa3, 0xfa, 0xea, 0xcd, 0x7e,
+	0xea, 0x8f, 0x37, 0xfb, 0x1f, 0x8c, 0x1d, 0x3e, 0x99, 0x0d, 0xab, 0x23, 0xea, 0xd6, 0x46, 0x94,
+	0xb9, 0x94, 0x45, 0x7f, 0x1e, 0x33, 0xfb, 0xac, 0xc6, 0xbf, 0x9f, 0x12, 0x56, 0x35, 0x3c, 0x8e,
+	0xa3, 0x6c, 0x54, 0x84, 0x8c, 0x4d, 0x3c, 0xea, 0x6a, 0x19, 0xd1, 0x20, 0x3c, 0xa0, 0x32, 0xac,
+	0x4f, 0x28, 0xe3, 0xe6, 0x0f, 0xd4, 0x23, 0xa6, 0x63, 0x6b, 0xaa, 0x70, 0x42, 0x60, 0x7b, 0x41,
+	0x3d, 0x62, 0xd8, 0xe8, 0x11, 0xac, 0x93, 0x29, 0x1d, 0x4d, 0x4c, 0x6f, 0xe6, 0x0e, 0x89, 0xaf,
+	0x65, 0xcb, 0x52, 0x45, 0xc1, 0x77, 0x84, 0xad, 0x23, 0x4c, 0xa8, 0x02, 0x85, 0xd1, 0x4b, 0xcb,
+	0x71, 0x4d, 0x87, 0x99, 0x53, 0xe2, 0xd9, 0x8e, 0x37, 0xd6, 0x72, 0x65, 0xa9, 0x92, 0xc3, 0x79,
+	0x61, 0x37, 0x58, 0x37, 0xb4, 0xa2, 0xaf, 0xe1, 0x2e, 0xe3, 0x26, 0xa7, 0x67, 0xc4, 0x33, 0xa3,
+	0xad, 0xd6, 0x6e, 0xb5, 0xd5, 0x06, 0xe3, 0xfd, 0xa0, 0x4a, 0x5d, 0x14, 0xd1, 0xf3, 0xa0, 0x76,
+	0x2d, 0xdf, 0x72, 0xd9, 0x67, 0xca, 0x2f, 0xbf, 0xee, 0xa7, 0xf4, 0x2e, 0x6c, 0x86, 0x10, 0xb0,
+	0xae, 0x35, 0x3a, 0x23, 0xbc, 0x69, 0x71, 0x0b, 0x7d, 0x08, 0x59, 0x8f, 0x9a, 0xb6, 0xc5, 0x2d,
+	0x01, 0xc9, 0x9d, 0x83, 0xed, 0xea, 0x3c, 0xbc, 0xd5, 0x0e, 0x0d, 0x02, 0x8f, 0x53, 0x58, 0xf5,
+	0xc4, 0xaf, 0x46, 0x0e, 0xd4, 0xa9, 0x28, 0xa0, 0xe7, 0x40, 0x0d, 0xbd, 0xfa, 0x5f, 0x32, 0x6c,
+	0x34, 0xc9, 0x94, 0x32, 0x87, 0x2f, 0xc1, 0xac, 0x08, 0x98, 0xaf, 0x21, 0x4b, 0xbf, 0x1b, 0xc8,
+	0xe4, 0xb7, 0x41, 0xa6, 0x2c, 0x41, 0xf6, 0x39, 0xa8, 0x8c, 0x5b, 0x7c, 0xc6, 0x04, 0x9c, 0xf9,
+	0x83, 0xf7, 0x16, 0xf7, 0x9c, 0x1b, 0xbf, 0xda, 0x13, 0xb1, 0x38, 0xca, 0x41, 0x4f, 0xa0, 0x68,
+	0x87, 0x7e, 0x73, 0x05, 0xf0, 0x28, 0xf2, 0xb5, 0x12, 0xf8, 0x07, 0xfd, 0xe8, 0xcc, 0x1f, 0x11,
+	0x2d, 0xf7, 0xbf, 0xfa, 0x89, 0x58, 0x1c, 0xe5, 0xe8, 0x13, 0x50, 0xc3, 0x09, 0x10, 0x82, 0x7c,
+	0x1f, 0xd7, 0x3b, 0xbd, 0xa7, 0x2d, 0x6c, 0x7e, 0x35, 0x68, 0x0d, 0x5a, 0x85, 0x14, 0xd2, 0xa0,
+	0x18, 0xdb, 0x8c, 0x8e, 0xd9, 0xc5, 0xa7, 0x47, 0xb8, 0xd5, 0xeb, 0x15, 0xd2, 0xa8, 0x08, 0x85,
+	0x66, 0xab, 0xdd, 0x3a, 0xaa, 0xf7, 0x8d, 0xd3, 0x4e, 0x14, 0x2f, 0xa1, 0x3d, 0xd8, 0x4e, 0x58,
+	0x93, 0x19, 0xb2, 0x5e, 0x01, 0x35, 0xec, 0x8d, 0x00, 0xd4, 0x5e, 0x1f, 0x1b, 0xcd, 0xa0, 0x03,
+	0x82, 0xfc, 0x73, 0xa3, 0x7f, 0xdc, 0xc4, 0xf5, 0xe7, 0xf5, 0xb6, 0x69, 0x1c, 0xd6, 0x0b, 0xd2,
+	0x89, 0x92, 0xcb, 0x14, 0x54, 0xfd, 0x37, 0x05, 0x36, 0x8f, 0xa3, 0x6b, 0x1d, 0x78, 0x43, 0x7a,
+	0x23, 0x87, 0xa5, 0x77, 0xc0, 0x61, 0xf4, 0x2d, 0x6c, 0x79, 0x16, 0x77, 0xce, 0xc9, 0x7c, 0xed,
+	0xdb, 0x51, 0x68, 0x33, 0x2c, 0x95, 0xac, 0x7f, 0x5b, 0x36, 0xbd, 0x0f, 0xf9, 0xd9, 0xd5, 0xf2,
+	0x26, 0x77, 0x5c, 0x22, 0x14, 0x44, 0xc1, 0x1b, 0xb1, 0xb5, 0xef, 0xb8, 0x04, 0x7d, 0xb9, 0x40,
+	0xba, 0xca, 0x22, 0x09, 0x96, 0x6e, 0x72, 0x91, 0x78, 0x9f, 0xc0, 0xce, 0x8c, 0x11, 0xdf, 0xf4,
+	0x63, 0x19, 0x35, 0xa3, 0x5c, 0x2d, 0x5b, 0x96, 0x2b, 0x6b, 0xf8, 0xde, 0x6c, 0x85, 0xc8, 0x32,
+	0xfd, 0xc7, 0x98, 0x40, 0x5b, 0x70, 0x77, 0xd0, 0x69, 0x9c, 0x76, 0x9a, 0x46, 0xe7, 0x28, 0x66,
+	0xd0, 0x2e, 0xdc, 0xbb, 0x36, 0xce, 0x11, 0x02, 0xed, 0xc0, 0x56, 0xeb, 0x1b, 0xa3, 0x6f, 0x2e,
+	0xb0, 0x4e, 0x42, 0x0f, 0x61, 0x77, 0xde, 0x91, 0xcc, 0x53, 0xd0, 0x06, 0xac, 0x1d, 0xb6, 0xeb,
+	0xc6, 0xb3, 0x7a, 0xa3, 0xdd, 0x2a, 0xa4, 0xf5, 0x9f, 0x25, 0x28, 0x8a, 0xff, 0x87, 0x78, 0xb5,
+	0x48, 0x18, 0x16, 0xb5, 0x53, 0x5a, 0xd6, 0xce, 0x1e, 0x14, 0xaf, 0xef, 0x3f, 0xbe, 0x51, 0xa6,
+	0xc9, 0x65, 0xb9, 0x72, 0xe7, 0xe0, 0xd1, 0x7f, 0x5e, 0x22, 0x46, 0x93, 0x45, 0x13, 0x3b, 0x51,
+	0x72, 0xe9, 0x82, 0xac, 0xff, 0x2d, 0xc3, 0xfa, 0x51, 0xf8, 0x9c, 0x05, 0xf7, 0x43, 0xd0, 0xc7,
+	0x81, 0x9a, 0x05, 0x2a, 0x79, 0x93, 0xfe, 0x85, 0x1a, 0xda, 0x50, 0x02, 0xb2, 0xe1, 0x28, 0x16,
+	0xed, 0x40, 0x76, 0x4a, 0x7d, 0x1e, 0x90, 0x23, 0x7a, 0xb5, 0x82, 0xa3, 0x61, 0x23, 0x07, 0xee,
+	0xaf, 0xc6, 0xcb, 0x7c, 0xe9, 0x30, 0x1e, 0x6d, 0xb0, 0xa4, 0x05, 0xab, 0x1e, 0xca, 0xa8, 0xa3,
+	0xb6, 0x0a, 0xdf, 0xb6, 0xc3, 0x38, 0xfa, 0x02, 0x1e, 0xdc, 0xd0, 0x6a, 0x14, 0x3f, 0x8d, 0x0a,
+	0xde, 0x5d, 0x95, 0x7f, 0x28, 0xc8, 0xef, 0xc0, 0xfd, 0x10, 0x89, 0x6b, 0x2a, 0x27, 0x67, 0xcd,
+	0xac, 0x9e, 0x75, 0x15, 0xa8, 0x57, 0xb3, 0x92, 0x15, 0x3e, 0x31, 0x6b, 0x0f, 0xb6, 0xae, 0xf4,
+	0x33, 0xd9, 0x22, 0x2b, 0x5a, 0x3c, 0x7c, 0xab, 0x34, 0x46, 0xb5, 0x37, 0xed, 0xa4, 0x51, 0x14,
+	0x4d, 0x88, 0xf2, 0xdc, 0xe2, 0xb9, 0x39, 0x51, 0x4e, 0x6c, 0x7c, 0x90, 0x01, 0xf9, 0x19, 0x1b,
+	0x37, 0x4e, 0x5e, 0x5d, 0x94, 0xa4, 0xd7, 0x17, 0x25, 0xe9, 0xcf, 0x8b, 0x92, 0xf4, 0xd3, 0x65,
+	0x29, 0xf5, 0xfa, 0xb2, 0x94, 0xfa, 0xfd, 0xb2, 0x94, 0x7a, 0xf1, 0x24, 0x21, 0x25, 0x3d, 0x31,
+	0xd4, 0xe3, 0xb6, 0x35, 0x64, 0xb5, 0xe8, 0x83, 0xe8, 0xfc, 0xd3, 0xda, 0x77, 0xf1, 0x57, 0x91,
+	0x10, 0x96, 0xa1, 0x2a, 0x3e, 0x77, 0x3e, 0xfa, 0x77, 0x00, 0x7d, 0x7b, 0xd0, 0xc2, 0x34, 0x09,
 	0x00, 0x00,
 }
 
@@ -814,6 +816,16 @@ func (m *UserRedemptionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.StTokenAmount.Size()
+		i -= size
+		if _, err := m.StTokenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	if m.ClaimIsPending {
 		i--
 		if m.ClaimIsPending {
@@ -1298,6 +1310,8 @@ func (m *UserRedemptionRecord) Size() (n int) {
 	if m.ClaimIsPending {
 		n += 2
 	}
+	l = m.StTokenAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1732,6 +1746,40 @@ func (m *UserRedemptionRecord) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ClaimIsPending = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
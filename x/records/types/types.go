@@ -5,3 +5,8 @@ import "fmt"
 func UserRedemptionRecordKeyFormatter(chainId string, epochNumber uint64, sender string) string {
 	return fmt.Sprintf("%s.%d.%s", chainId, epochNumber, sender) // {chain_id}.{epoch}.{sender}
 }
+
+// Used for a redemption that is rolled into an epoch in which the sender already has a record with a different receiver
+func UserRedemptionRecordReceiverKeyFormatter(chainId string, epochNumber uint64, sender string, receiver string) string {
+	return fmt.Sprintf("%s.%d.%s.%s", chainId, epochNumber, sender, receiver) // {chain_id}.{epoch}.{sender}.{receiver}
+}
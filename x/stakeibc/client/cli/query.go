@@ -33,6 +33,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListEpochTracker())
 	cmd.AddCommand(CmdShowEpochTracker())
 	cmd.AddCommand(CmdNextPacketSequence())
+	cmd.AddCommand(CmdShowRedemptionRateHistory())
+	cmd.AddCommand(CmdShowFeeRecipients())
+	cmd.AddCommand(CmdShowUnconfirmedSlashes())
+	cmd.AddCommand(CmdShowValidatorWeights())
+	cmd.AddCommand(CmdShowRebalancePlan())
+	cmd.AddCommand(CmdShowSunsetProgress())
+	cmd.AddCommand(CmdShowNextScheduledRuns())
+	cmd.AddCommand(CmdShowICARetries())
+	cmd.AddCommand(CmdShowDirectedDelegations())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
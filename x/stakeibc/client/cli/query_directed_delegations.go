@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdShowDirectedDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "directed-delegations [chain-id]",
+		Short: "shows the liquid staked amounts directed to each of a host zone's validators",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDirectedDelegationsRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.DirectedDelegations(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdShowICARetries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-retries [chain-id]",
+		Short: "shows the ICA operations queued for a retry on a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryICARetriesRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.ICARetries(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
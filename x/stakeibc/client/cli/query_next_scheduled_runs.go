@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdShowNextScheduledRuns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-scheduled-runs [chain-id]",
+		Short: "shows the next scheduled run of each epochly task for a host zone",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNextScheduledRunsRequest{
+				ChainId: args[0],
+			}
+
+			res, err := queryClient.NextScheduledRuns(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdShowRebalancePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance-plan [chain-id] [optional-max-rebalancings]",
+		Short: "shows the redelegations that would be submitted to rebalance a host zone's validators",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRebalancePlanRequest{
+				ChainId: args[0],
+			}
+			if len(args) == 2 {
+				maxRebalancings, err := strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+				req.MaxRebalancings = maxRebalancings
+			}
+
+			res, err := queryClient.RebalancePlan(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
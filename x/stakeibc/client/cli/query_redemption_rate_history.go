@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+const FlagTwapEpochs = "twap-epochs"
+
+func CmdShowRedemptionRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-rate-history [chain-id]",
+		Short: "shows a host zone's redemption rate history and time-weighted redemption rate",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainId := args[0]
+			twapEpochs, err := cmd.Flags().GetUint64(FlagTwapEpochs)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRedemptionRateHistoryRequest{
+				ChainId:    chainId,
+				TwapEpochs: twapEpochs,
+			}
+
+			res, err := queryClient.RedemptionRateHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagTwapEpochs, 0, "Number of stride epochs to average the redemption rate over (defaults to the full history)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
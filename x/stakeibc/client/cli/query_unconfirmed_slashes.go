@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdShowUnconfirmedSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unconfirmed-slashes [optional-chain-id]",
+		Short: "shows validator delegation discrepancies that are pending confirmation or quarantined",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnconfirmedSlashesRequest{}
+			if len(args) == 1 {
+				req.ChainId = args[0]
+			}
+
+			res, err := queryClient.UnconfirmedSlashes(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -35,6 +35,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRestoreInterchainAccount())
 	cmd.AddCommand(CmdUpdateValidatorSharesExchRate())
 	cmd.AddCommand(CmdClearBalance())
+	cmd.AddCommand(CmdResumeHostZone())
+	cmd.AddCommand(CmdCancelRedemption())
+	cmd.AddCommand(CmdUpdateHostZone())
+	cmd.AddCommand(CmdUpdateFeeRecipients())
+	cmd.AddCommand(CmdSetValidatorWeightPolicy())
+	cmd.AddCommand(CmdSetUnbondingStrategy())
+	cmd.AddCommand(CmdSunsetHostZone())
+	cmd.AddCommand(CmdAbortHostZoneSunset())
+	cmd.AddCommand(CmdResolveICARetry())
+	cmd.AddCommand(CmdLSMLiquidStake())
+	cmd.AddCommand(CmdResolveUnconfirmedSlash())
 
 	return cmd
 }
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/spf13/cobra"
 
@@ -17,6 +18,11 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagMinStTokenOut = "min-st-token-out"
+	FlagValidator     = "validator"
+)
+
 func CmdLiquidStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquid-stake [amount] [hostDenom]",
@@ -34,11 +40,21 @@ func CmdLiquidStake() *cobra.Command {
 				return err
 			}
 
+			minStTokenOut, err := parseOptionalIntFlag(cmd, FlagMinStTokenOut)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgLiquidStake(
 				clientCtx.GetFromAddress().String(),
 				argAmount,
 				argHostDenom,
 			)
+			msg.MinStTokenOut = minStTokenOut
+			msg.ValidatorAddress, err = cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -46,7 +62,25 @@ func CmdLiquidStake() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMinStTokenOut, "", "minimum number of stTokens to receive, otherwise the liquid stake fails")
+	cmd.Flags().String(FlagValidator, "", "validator in the host zone's active set that the liquid stake should be delegated to")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// Parses an optional integer flag, returning a nil Int if the flag was not provided
+func parseOptionalIntFlag(cmd *cobra.Command, flagName string) (sdkmath.Int, error) {
+	flagValue, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	if flagValue == "" {
+		return sdkmath.Int{}, nil
+	}
+	value, found := sdk.NewIntFromString(flagValue)
+	if !found {
+		return sdkmath.Int{}, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "can not convert %s flag (%s) to int", flagName, flagValue)
+	}
+	return value, nil
+}
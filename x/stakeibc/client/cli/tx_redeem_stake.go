@@ -17,6 +17,11 @@ import (
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagInstant      = "instant"
+	FlagMinNativeOut = "min-native-out"
+)
+
 func CmdRedeemStake() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "redeem-stake [amount] [hostZoneID] [receiver]",
@@ -36,12 +41,24 @@ func CmdRedeemStake() *cobra.Command {
 
 			argReceiver := args[2]
 
+			instant, err := cmd.Flags().GetBool(FlagInstant)
+			if err != nil {
+				return err
+			}
+
+			minNativeOut, err := parseOptionalIntFlag(cmd, FlagMinNativeOut)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgRedeemStake(
 				clientCtx.GetFromAddress().String(),
 				argAmount,
 				hostZoneID,
 				argReceiver,
 			)
+			msg.Instant = instant
+			msg.MinNativeOut = minNativeOut
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -49,6 +66,8 @@ func CmdRedeemStake() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagInstant, false, "redeem immediately from the host zone's instant redemption buffer (for a fee), falling back to the unbonding queue")
+	cmd.Flags().String(FlagMinNativeOut, "", "minimum number of native tokens to receive, otherwise the redemption fails")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -15,6 +15,10 @@ import (
 const (
 	FlagMinRedemptionRate = "min-redemption-rate"
 	FlagMaxRedemptionRate = "max-redemption-rate"
+
+	FlagStTokenDisplayName = "st-token-display-name"
+	FlagStTokenSymbol      = "st-token-symbol"
+	FlagStTokenExponent    = "st-token-exponent"
 )
 
 var _ = strconv.Itoa(0)
@@ -77,6 +81,16 @@ func CmdRegisterHostZone() *cobra.Command {
 				maxRedemptionRate,
 			)
 
+			if msg.StTokenDisplayName, err = cmd.Flags().GetString(FlagStTokenDisplayName); err != nil {
+				return err
+			}
+			if msg.StTokenSymbol, err = cmd.Flags().GetString(FlagStTokenSymbol); err != nil {
+				return err
+			}
+			if msg.StTokenExponent, err = cmd.Flags().GetUint32(FlagStTokenExponent); err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -88,6 +102,9 @@ func CmdRegisterHostZone() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagMinRedemptionRate, "", "minimum redemption rate")
 	cmd.Flags().String(FlagMaxRedemptionRate, "", "maximum redemption rate")
+	cmd.Flags().String(FlagStTokenDisplayName, "", "display name of the stToken (e.g. \"Stride Staked ATOM\"), derived from the host denom if omitted")
+	cmd.Flags().String(FlagStTokenSymbol, "", "symbol of the stToken (e.g. stATOM), derived from the host denom if omitted")
+	cmd.Flags().Uint32(FlagStTokenExponent, 0, "number of decimals of the stToken's display unit, derived from the host denom if omitted")
 
 	return cmd
 }
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdResolveICARetry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-ica-retry [chain-id] [delegate|undelegate|reinvest] [record-id] [force|drop]",
+		Short: "Broadcast message resolve-ica-retry",
+		Long: "Resolves an operation in the ICA retry queue. With force, the operation's tx is submitted immediately, " +
+			"even if it's in the dead letter state. With drop, the operation is removed from the queue. " +
+			"The record ID is the deposit record ID for delegations, and 0 for undelegations and reinvestments",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			operation, ok := types.ICARetry_Operation_value[strings.ToUpper(args[1])]
+			if !ok {
+				return fmt.Errorf("invalid operation %s, must be either delegate, undelegate or reinvest", args[1])
+			}
+			recordId, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+			action, ok := types.ICARetryAction_value[strings.ToUpper(args[3])+"_RETRY"]
+			if !ok {
+				return fmt.Errorf("invalid action %s, must be either force or drop", args[3])
+			}
+
+			msg := types.NewMsgResolveICARetry(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.ICARetry_Operation(operation),
+				recordId,
+				types.ICARetryAction(action),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
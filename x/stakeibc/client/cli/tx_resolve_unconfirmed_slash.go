@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdResolveUnconfirmedSlash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resolve-unconfirmed-slash [chain-id] [validator-address] [confirm|dismiss]",
+		Short: "Broadcast message resolve-unconfirmed-slash",
+		Long: "Resolves a quarantined slash. With confirm, the slash is applied to the validator's delegation and weight " +
+			"(as long as the delegation hasn't changed since the slash was detected). With dismiss, the slash is removed " +
+			"without modifying the host zone's records",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			action, ok := types.UnconfirmedSlashAction_value[strings.ToUpper(args[2])+"_SLASH"]
+			if !ok {
+				return fmt.Errorf("invalid action %s, must be either confirm or dismiss", args[2])
+			}
+
+			msg := types.NewMsgResolveUnconfirmedSlash(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				types.UnconfirmedSlashAction(action),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func CmdSetUnbondingStrategy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-unbonding-strategy [chain-id] [target-weight|rebalance]",
+		Short: "Broadcast message set-unbonding-strategy",
+		Long: "Sets how a host zone's unbondings are split across validators. With target-weight, each validator " +
+			"unbonds in proportion to its weight. With rebalance, validators above their target delegation unbond first",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			strategy, ok := types.UnbondingStrategy_value[strings.ToUpper(strings.ReplaceAll(args[1], "-", "_"))]
+			if !ok {
+				return fmt.Errorf("invalid unbonding strategy %s, must be either target-weight or rebalance", args[1])
+			}
+
+			msg := types.NewMsgSetUnbondingStrategy(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.UnbondingStrategy(strategy),
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Parse a JSON with a validator weight policy in the format
+// {
+//	  "max_commission_rate": "0.10",
+//	  "max_voting_power_share": "0.05",
+//	  "max_jailed_count": "2",
+//	  "max_missed_blocks": "500",
+//	  "max_weight_change": "10"
+// }
+func parseValidatorWeightPolicyFile(clientCtx client.Context, policyFile string) (*types.ValidatorWeightPolicy, error) {
+	fileContents, err := os.ReadFile(policyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy types.ValidatorWeightPolicy
+	if err = clientCtx.Codec.UnmarshalJSON(fileContents, &policy); err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func CmdSetValidatorWeightPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-validator-weight-policy [chain-id] [optional-policy-file]",
+		Short: "Broadcast message set-validator-weight-policy",
+		Long: "Sets the policy used to automatically derive a host zone's validator weights from queried validator data. " +
+			"If no policy file is provided, the policy is removed and weights must be set manually",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var policy *types.ValidatorWeightPolicy
+			if len(args) == 2 {
+				policy, err = parseValidatorWeightPolicyFile(clientCtx, args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetValidatorWeightPolicy(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				policy,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
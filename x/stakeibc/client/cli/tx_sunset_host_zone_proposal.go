@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func parseSunsetProposalFile(cdc codec.JSONCodec, proposalFile string, proposal codec.ProtoMarshaler) error {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return err
+	}
+	return cdc.UnmarshalJSON(contents, proposal)
+}
+
+// Submits a sunset proposal, using the deposit from the flags if specified
+func submitSunsetProposal(cmd *cobra.Command, clientCtx client.Context, proposal govtypes.Content, proposalDeposit string) error {
+	depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+
+	// if deposit from flags is not empty, it overrides the deposit from proposal
+	if depositFromFlags != "" {
+		proposalDeposit = depositFromFlags
+	}
+	deposit, err := sdk.ParseCoinsNormalized(proposalDeposit)
+	if err != nil {
+		return err
+	}
+
+	strideDenom, err := sdk.GetBaseDenom()
+	if err != nil {
+		return err
+	}
+
+	if len(deposit) != 1 || deposit.GetDenomByIndex(0) != strideDenom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "Deposit token denom must be %s", strideDenom)
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func CmdSunsetHostZoneProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sunset-host-zone [proposal-file]",
+		Short: "Submit a sunset-host-zone proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a sunset-host-zone proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-legacy-proposal sunset-host-zone <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "description": "Proposal to wind down the GAIA host zone",
+    "chain_id": "GAIA",
+    "deposit": "64000000ustrd"
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.SunsetHostZoneProposal
+			if err := parseSunsetProposalFile(clientCtx.Codec, args[0], &proposal); err != nil {
+				return err
+			}
+			proposal.Title = fmt.Sprintf("Sunset host zone %s", proposal.ChainId)
+
+			return submitSunsetProposal(cmd, clientCtx, &proposal, proposal.Deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func CmdAbortHostZoneSunsetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abort-host-zone-sunset [proposal-file]",
+		Short: "Submit an abort-host-zone-sunset proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an abort-host-zone-sunset proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. A sunset can only be aborted while it's unbonding.
+
+Example:
+$ %s tx gov submit-legacy-proposal abort-host-zone-sunset <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "description": "Proposal to abort the GAIA host zone sunset",
+    "chain_id": "GAIA",
+    "deposit": "64000000ustrd"
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.AbortHostZoneSunsetProposal
+			if err := parseSunsetProposalFile(clientCtx.Codec, args[0], &proposal); err != nil {
+				return err
+			}
+			proposal.Title = fmt.Sprintf("Abort host zone %s sunset", proposal.ChainId)
+
+			return submitSunsetProposal(cmd, clientCtx, &proposal, proposal.Deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func CmdResumeHostZoneProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-host-zone [proposal-file]",
+		Short: "Submit a resume-host-zone proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a resume-host-zone proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. A host zone can only be resumed once its redemption rate is back within bounds.
+
+Example:
+$ %s tx gov submit-legacy-proposal resume-host-zone <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "description": "Proposal to resume the halted GAIA host zone",
+    "chain_id": "GAIA",
+    "deposit": "64000000ustrd"
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposal types.ResumeHostZoneProposal
+			if err := parseSunsetProposalFile(clientCtx.Codec, args[0], &proposal); err != nil {
+				return err
+			}
+			proposal.Title = fmt.Sprintf("Resume host zone %s", proposal.ChainId)
+
+			return submitSunsetProposal(cmd, clientCtx, &proposal, proposal.Deposit)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
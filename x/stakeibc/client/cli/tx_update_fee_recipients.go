@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Parse a JSON with a list of fee recipients in the format
+// {
+//	  "fee_recipients": [
+//	     {"name": "fee_collector", "type": "MODULE_ACCOUNT", "address": "fee_collector", "weight": "80"},
+//	     {"name": "community_pool", "type": "COMMUNITY_POOL", "weight": "10"},
+//	     {"name": "treasury", "type": "ACCOUNT", "address": "strideXXX", "weight": "10"}
+//    ]
+// }
+func parseUpdateFeeRecipientsFile(clientCtx client.Context, feeRecipientsFile string) (feeRecipients []types.FeeRecipient, err error) {
+	fileContents, err := os.ReadFile(feeRecipientsFile)
+	if err != nil {
+		return feeRecipients, err
+	}
+
+	var msg types.MsgUpdateFeeRecipients
+	if err = clientCtx.Codec.UnmarshalJSON(fileContents, &msg); err != nil {
+		return feeRecipients, err
+	}
+
+	return msg.FeeRecipients, nil
+}
+
+func CmdUpdateFeeRecipients() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-fee-recipients [fee-recipients-file]",
+		Short: "Broadcast message update-fee-recipients",
+		Long:  "Replaces the list of weighted recipients that protocol revenue is distributed to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeRecipients, err := parseUpdateFeeRecipientsFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateFeeRecipients(
+				clientCtx.GetFromAddress().String(),
+				feeRecipients,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
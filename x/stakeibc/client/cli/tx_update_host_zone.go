@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+const (
+	FlagStrideCommission   = "stride-commission"
+	FlagUnbondingFrequency = "unbonding-frequency"
+	FlagTransferChannelId  = "transfer-channel-id"
+	FlagBech32Prefix       = "bech32-prefix"
+
+	FlagDepositInterval        = "deposit-interval"
+	FlagDelegateInterval       = "delegate-interval"
+	FlagReinvestInterval       = "reinvest-interval"
+	FlagRedemptionRateInterval = "redemption-rate-interval"
+)
+
+// Parses an optional decimal flag, returning nil if the flag was not specified
+func parseOptionalDecFlag(flagSet *pflag.FlagSet, flag string) (*sdk.Dec, error) {
+	decStr, err := flagSet.GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	if decStr == "" {
+		return nil, nil
+	}
+	dec, err := sdk.NewDecFromStr(decStr)
+	if err != nil {
+		return nil, err
+	}
+	return &dec, nil
+}
+
+// Parses the epoch interval flags, returning nil if none of them were specified
+func parseEpochIntervalFlags(flagSet *pflag.FlagSet) (*types.EpochIntervals, error) {
+	intervalFlags := []string{FlagDepositInterval, FlagDelegateInterval, FlagReinvestInterval, FlagRedemptionRateInterval}
+	intervals := make([]uint64, len(intervalFlags))
+
+	anyChanged := false
+	for i, flag := range intervalFlags {
+		interval, err := flagSet.GetUint64(flag)
+		if err != nil {
+			return nil, err
+		}
+		intervals[i] = interval
+		anyChanged = anyChanged || flagSet.Changed(flag)
+	}
+	if !anyChanged {
+		return nil, nil
+	}
+
+	return &types.EpochIntervals{
+		DepositInterval:        intervals[0],
+		DelegateInterval:       intervals[1],
+		ReinvestInterval:       intervals[2],
+		RedemptionRateInterval: intervals[3],
+	}, nil
+}
+
+func CmdUpdateHostZone() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-host-zone [chain-id]",
+		Short: "Broadcast message update-host-zone",
+		Long: "Updates the configurable fields of a host zone, only the fields passed as flags are modified. " +
+			"If any of the epoch interval flags are passed, they replace all of the host zone's interval overrides " +
+			"(intervals that are omitted or set to 0 fall back to the module params). " +
+			"The message is signed by the governance module account, so it must be generated with --generate-only " +
+			"and included in a governance proposal (or submitted with update-host-zone-proposal instead)",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateHostZone(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				argChainId,
+			)
+
+			if msg.StrideCommission, err = parseOptionalDecFlag(cmd.Flags(), FlagStrideCommission); err != nil {
+				return err
+			}
+			if msg.MinRedemptionRate, err = parseOptionalDecFlag(cmd.Flags(), FlagMinRedemptionRate); err != nil {
+				return err
+			}
+			if msg.MaxRedemptionRate, err = parseOptionalDecFlag(cmd.Flags(), FlagMaxRedemptionRate); err != nil {
+				return err
+			}
+			if msg.UnbondingFrequency, err = cmd.Flags().GetUint64(FlagUnbondingFrequency); err != nil {
+				return err
+			}
+			if msg.TransferChannelId, err = cmd.Flags().GetString(FlagTransferChannelId); err != nil {
+				return err
+			}
+			if msg.Bech32Prefix, err = cmd.Flags().GetString(FlagBech32Prefix); err != nil {
+				return err
+			}
+			if msg.EpochIntervals, err = parseEpochIntervalFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			if msg.StTokenDisplayName, err = cmd.Flags().GetString(FlagStTokenDisplayName); err != nil {
+				return err
+			}
+			if msg.StTokenSymbol, err = cmd.Flags().GetString(FlagStTokenSymbol); err != nil {
+				return err
+			}
+			if msg.StTokenExponent, err = cmd.Flags().GetUint32(FlagStTokenExponent); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStrideCommission, "", "fraction of staking rewards taken as the stride commission (e.g. 0.1 for 10%)")
+	cmd.Flags().Uint64(FlagUnbondingFrequency, 0, "number of day epochs between each unbonding")
+	cmd.Flags().String(FlagMinRedemptionRate, "", "lower bound of the redemption rate safety check")
+	cmd.Flags().String(FlagMaxRedemptionRate, "", "upper bound of the redemption rate safety check")
+	cmd.Flags().String(FlagTransferChannelId, "", "transfer channel to the host zone")
+	cmd.Flags().String(FlagBech32Prefix, "", "bech32 prefix of addresses on the host zone")
+	cmd.Flags().Uint64(FlagDepositInterval, 0, "number of stride epochs between each deposit transfer")
+	cmd.Flags().Uint64(FlagDelegateInterval, 0, "number of stride epochs between each delegation")
+	cmd.Flags().Uint64(FlagReinvestInterval, 0, "number of stride epochs between each reinvestment")
+	cmd.Flags().Uint64(FlagRedemptionRateInterval, 0, "number of stride epochs between each redemption rate update")
+	cmd.Flags().String(FlagStTokenDisplayName, "", "display name of the stToken in its bank metadata")
+	cmd.Flags().String(FlagStTokenSymbol, "", "symbol of the stToken in its bank metadata")
+	cmd.Flags().Uint32(FlagStTokenExponent, 0, "number of decimals of the stToken's display unit in its bank metadata")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/spf13/cobra"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func parseUpdateHostZoneProposalFile(cdc codec.JSONCodec, proposalFile string) (proposal types.UpdateHostZoneProposal, err error) {
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	proposal.Title = fmt.Sprintf("Update host zone %s", proposal.ChainId)
+
+	return proposal, nil
+}
+
+func CmdUpdateHostZoneProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-host-zone [proposal-file]",
+		Short: "Submit an update-host-zone proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update-host-zone proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Only the fields included in the file are updated.
+If epoch_intervals is included, it replaces all of the host zone's interval overrides (omitted intervals fall back to the module params).
+The st_token_display_name, st_token_symbol and st_token_exponent fields update the stToken's bank metadata.
+
+Example:
+$ %s tx gov submit-legacy-proposal update-host-zone <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+{
+    "description": "Proposal to widen the GAIA redemption rate bounds and unbond every 4 days",
+    "chain_id": "GAIA",
+    "unbonding_frequency": "4",
+    "min_redemption_rate": "0.9",
+    "max_redemption_rate": "1.5",
+    "epoch_intervals": {
+        "delegate_interval": "2",
+        "redemption_rate_interval": "4"
+    },
+    "st_token_symbol": "stATOM",
+    "st_token_exponent": 6,
+    "deposit": "64000000ustrd"
+}
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := parseUpdateHostZoneProposalFile(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositFromFlags, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			// if deposit from flags is not empty, it overrides the deposit from proposal
+			if depositFromFlags != "" {
+				proposal.Deposit = depositFromFlags
+			}
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			strideDenom, err := sdk.GetBaseDenom()
+			if err != nil {
+				return err
+			}
+
+			if len(deposit) != 1 || deposit.GetDenomByIndex(0) != strideDenom {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "Deposit token denom must be %s", strideDenom)
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
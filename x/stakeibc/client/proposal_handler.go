@@ -7,5 +7,10 @@ import (
 )
 
 var (
-	AddValidatorsProposalHandler = govclient.NewProposalHandler(cli.CmdAddValidatorsProposal)
+	AddValidatorsProposalHandler       = govclient.NewProposalHandler(cli.CmdAddValidatorsProposal)
+	UpdateHostZoneProposalHandler      = govclient.NewProposalHandler(cli.CmdUpdateHostZoneProposal)
+	SunsetHostZoneProposalHandler      = govclient.NewProposalHandler(cli.CmdSunsetHostZoneProposal)
+	AbortHostZoneSunsetProposalHandler = govclient.NewProposalHandler(cli.CmdAbortHostZoneSunsetProposal)
+	UpdateFeeRecipientsProposalHandler = govclient.NewProposalHandler(cli.CmdUpdateFeeRecipientsProposal)
+	ResumeHostZoneProposalHandler      = govclient.NewProposalHandler(cli.CmdResumeHostZoneProposal)
 )
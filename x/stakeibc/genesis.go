@@ -16,6 +16,30 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, epochTracker := range genState.EpochTrackerList {
 		k.SetEpochTracker(ctx, epochTracker)
 	}
+	for _, redemptionRateRecord := range genState.RedemptionRateRecords {
+		k.SetRedemptionRateRecord(ctx, redemptionRateRecord)
+	}
+	for _, feeRecipient := range genState.FeeRecipients {
+		k.SetFeeRecipient(ctx, feeRecipient)
+	}
+	for _, feeRecipientRevenue := range genState.FeeRecipientRevenue {
+		k.SetFeeRecipientRevenue(ctx, feeRecipientRevenue)
+	}
+	for _, unconfirmedSlash := range genState.UnconfirmedSlashes {
+		k.SetUnconfirmedSlash(ctx, unconfirmedSlash)
+	}
+	for _, pendingRedelegation := range genState.PendingRedelegations {
+		k.SetPendingRedelegation(ctx, pendingRedelegation)
+	}
+	for _, icaRecovery := range genState.IcaRecoveries {
+		k.SetICARecovery(ctx, icaRecovery)
+	}
+	for _, icaRetry := range genState.IcaRetries {
+		k.SetICARetry(ctx, icaRetry)
+	}
+	for _, lsmTokenDeposit := range genState.LsmTokenDeposits {
+		k.SetLSMTokenDeposit(ctx, lsmTokenDeposit)
+	}
 
 	k.SetParams(ctx, genState.Params)
 }
@@ -27,6 +51,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 	genesis.HostZoneList = k.GetAllHostZone(ctx)
 	genesis.EpochTrackerList = k.GetAllEpochTracker(ctx)
+	genesis.RedemptionRateRecords = k.GetRedemptionRateRecordsForAllHostZones(ctx)
+	genesis.FeeRecipients = k.GetAllFeeRecipients(ctx)
+	genesis.FeeRecipientRevenue = k.GetAllFeeRecipientRevenue(ctx)
+	genesis.UnconfirmedSlashes = k.GetAllUnconfirmedSlashes(ctx)
+	genesis.PendingRedelegations = k.GetAllPendingRedelegations(ctx)
+	genesis.IcaRecoveries = k.GetAllICARecoveries(ctx)
+	genesis.IcaRetries = k.GetAllICARetries(ctx)
+	genesis.LsmTokenDeposits = k.GetAllLSMTokenDeposits(ctx)
 
 	return genesis
 }
@@ -3,6 +3,8 @@ package stakeibc_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/Stride-Labs/stride/v9/testutil/keeper"
@@ -18,6 +20,38 @@ func TestGenesis(t *testing.T) {
 		EpochTrackerList: []types.EpochTracker{
 			{EpochIdentifier: "stride_epoch"},
 		},
+		RedemptionRateRecords: []types.RedemptionRateRecord{
+			{ChainId: "chain-0", EpochNumber: 1, RedemptionRate: sdk.NewDec(1)},
+			{ChainId: "chain-1", EpochNumber: 1, RedemptionRate: sdk.MustNewDecFromStr("1.1")},
+		},
+		FeeRecipients: []types.FeeRecipient{
+			{Name: "community", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 1},
+		},
+		FeeRecipientRevenue: []types.FeeRecipientRevenue{
+			{Name: "community", TotalRevenue: sdk.NewCoins(sdk.NewInt64Coin("ustrd", 10))},
+		},
+		UnconfirmedSlashes: []types.UnconfirmedSlash{
+			{ChainId: "chain-0", ValidatorAddress: "val1", DelegationAmt: sdkmath.NewInt(100), QueriedDelegationAmt: sdkmath.NewInt(90)},
+		},
+		PendingRedelegations: []types.PendingRedelegation{
+			{ChainId: "chain-0", SrcValidator: "val1", DstValidator: "val2", Amount: sdkmath.NewInt(10), CompletionTime: 100},
+		},
+		IcaRecoveries: []types.ICARecovery{
+			{ChainId: "chain-0", AccountType: types.ICAAccountType_DELEGATION, ClosedChannelId: "channel-1", Attempts: 1},
+		},
+		IcaRetries: []types.ICARetry{
+			{ChainId: "chain-0", Operation: types.ICARetry_DELEGATE, RecordId: 1, Attempts: 1},
+		},
+		LsmTokenDeposits: []types.LSMTokenDeposit{
+			{
+				ChainId:       "chain-0",
+				Denom:         "val1/1",
+				StakerAddress: "staker",
+				Amount:        sdkmath.NewInt(10),
+				NativeAmount:  sdkmath.NewInt(10),
+				StTokenAmount: sdkmath.NewInt(9),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -26,6 +60,15 @@ func TestGenesis(t *testing.T) {
 	got := stakeibc.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
 
+	require.Equal(t, genesisState.RedemptionRateRecords, got.RedemptionRateRecords)
+	require.Equal(t, genesisState.FeeRecipients, got.FeeRecipients)
+	require.Equal(t, genesisState.FeeRecipientRevenue, got.FeeRecipientRevenue)
+	require.Equal(t, genesisState.UnconfirmedSlashes, got.UnconfirmedSlashes)
+	require.Equal(t, genesisState.PendingRedelegations, got.PendingRedelegations)
+	require.Equal(t, genesisState.IcaRecoveries, got.IcaRecoveries)
+	require.Equal(t, genesisState.IcaRetries, got.IcaRetries)
+	require.Equal(t, genesisState.LsmTokenDeposits, got.LsmTokenDeposits)
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
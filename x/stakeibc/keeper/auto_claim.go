@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Returns the user redemption records for a host zone that are ready to be claimed
+// (i.e. the host zone unbonding is CLAIMABLE and the claim is not already pending), up to the specified limit
+// The records are selected starting from the offset (wrapping around to the first record), so that records
+// whose claims keep failing are not re-selected every epoch ahead of the remaining records
+func (k Keeper) GetClaimableRedemptionRecords(
+	ctx sdk.Context,
+	chainId string,
+	epochUnbondingRecords []recordstypes.EpochUnbondingRecord,
+	limit uint64,
+	offset uint64,
+) (userRedemptionRecords []recordstypes.UserRedemptionRecord) {
+	claimableRecords := []recordstypes.UserRedemptionRecord{}
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+			if hostZoneUnbonding.HostZoneId != chainId || hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_CLAIMABLE {
+				continue
+			}
+
+			for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+				userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId)
+				if !found || userRedemptionRecord.ClaimIsPending || !userRedemptionRecord.Amount.IsPositive() {
+					continue
+				}
+				claimableRecords = append(claimableRecords, userRedemptionRecord)
+			}
+		}
+	}
+
+	numClaimable := uint64(len(claimableRecords))
+	if numClaimable == 0 {
+		return userRedemptionRecords
+	}
+	for i := uint64(0); i < numClaimable && i < limit; i++ {
+		userRedemptionRecords = append(userRedemptionRecords, claimableRecords[(offset+i)%numClaimable])
+	}
+	return userRedemptionRecords
+}
+
+// Claims unbonded tokens on behalf of users by submitting a bank send from the redemption account for each claimable record
+// Each claim is submitted as its own ICA tx so that a rejected send only fails the claim for that record
+// The records are marked as pending and are cleaned up in the ClaimCallback
+func (k Keeper) AutoClaimUndelegatedTokensForHostZone(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	epochUnbondingRecords []recordstypes.EpochUnbondingRecord,
+	maxClaims uint64,
+	offset uint64,
+) (numClaimed int, err error) {
+	userRedemptionRecords := k.GetClaimableRedemptionRecords(ctx, hostZone.ChainId, epochUnbondingRecords, maxClaims, offset)
+	if len(userRedemptionRecords) == 0 {
+		return 0, nil
+	}
+
+	redemptionAccount, found := k.GetRedemptionAccount(ctx, hostZone)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", hostZone.ChainId)
+	}
+
+	for _, userRedemptionRecord := range userRedemptionRecords {
+		msgs := []sdk.Msg{
+			&bankTypes.MsgSend{
+				FromAddress: redemptionAccount.Address,
+				ToAddress:   userRedemptionRecord.Receiver,
+				Amount:      sdk.NewCoins(sdk.NewCoin(userRedemptionRecord.Denom, userRedemptionRecord.Amount)),
+			},
+		}
+
+		claimCallback := types.ClaimCallback{
+			UserRedemptionRecordId: userRedemptionRecord.Id,
+			ChainId:                hostZone.ChainId,
+			EpochNumber:            userRedemptionRecord.EpochNumber,
+		}
+		marshalledCallbackArgs, err := k.MarshalClaimCallbackArgs(ctx, claimCallback)
+		if err != nil {
+			return numClaimed, errorsmod.Wrap(err, "unable to marshal claim callback args")
+		}
+
+		_, err = k.SubmitTxsDayEpoch(ctx, hostZone.ConnectionId, msgs, *redemptionAccount, ICACallbackID_Claim, marshalledCallbackArgs)
+		if err != nil {
+			return numClaimed, errorsmod.Wrapf(err, "unable to submit ICA claim tx for %s", userRedemptionRecord.Id)
+		}
+
+		// Set claimIsPending to true, so that the record can't be double claimed
+		userRedemptionRecord.ClaimIsPending = true
+		k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+		numClaimed++
+	}
+
+	return numClaimed, nil
+}
+
+// Automatically claims unbonded tokens for each host zone, bounded by MaxAutoClaimsPerEpoch
+// The selection window advances by MaxAutoClaimsPerEpoch each day epoch so that every claimable record
+// is eventually attempted, even if some of the claims fail
+// Users can still claim manually with MsgClaimUndelegatedTokens
+func (k Keeper) AutoClaimAllUndelegatedTokens(ctx sdk.Context) {
+	maxClaims := k.GetParam(ctx, types.KeyMaxAutoClaimsPerEpoch)
+	if maxClaims == 0 {
+		return
+	}
+
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to automatically claim unbonded tokens, epoch tracker (%s) not found", epochtypes.DAY_EPOCH))
+		return
+	}
+	offset := dayEpochTracker.EpochNumber * maxClaims
+
+	k.Logger(ctx).Info("Automatically claiming unbonded tokens...")
+	epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		numClaimed, err := k.AutoClaimUndelegatedTokensForHostZone(ctx, hostZone, epochUnbondingRecords, maxClaims, offset)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to automatically claim unbonded tokens for %s, err: %s", hostZone.ChainId, err.Error()))
+			continue
+		}
+		if numClaimed > 0 {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitted automatic claims for %d redemption records", numClaimed))
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icacallbacktypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type AutoClaimTestCase struct {
+	redemptionPortId      string
+	redemptionChannelId   string
+	claimableRecordIds    []string
+	nonClaimableRecordIds []string
+}
+
+func (s *KeeperTestSuite) SetupAutoClaim() AutoClaimTestCase {
+	redemptionIcaOwner := "GAIA.REDEMPTION"
+	redemptionChannelId := s.CreateICAChannel(redemptionIcaOwner)
+
+	hostZone := stakeibctypes.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: ibctesting.FirstConnectionID,
+		RedemptionAccount: &stakeibctypes.ICAAccount{
+			Address: s.IcaAddresses[redemptionIcaOwner],
+			Target:  stakeibctypes.ICAAccountType_REDEMPTION,
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        3,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+	})
+
+	// Epoch 1 and 2 are claimable, epoch 3 is still unbonding
+	// Within epoch 1, one of the records already has a pending claim
+	tc := AutoClaimTestCase{
+		redemptionPortId:    icatypes.PortPrefix + redemptionIcaOwner,
+		redemptionChannelId: redemptionChannelId,
+	}
+	statuses := []recordtypes.HostZoneUnbonding_Status{
+		recordtypes.HostZoneUnbonding_CLAIMABLE,
+		recordtypes.HostZoneUnbonding_CLAIMABLE,
+		recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+	}
+	for i, status := range statuses {
+		epochNumber := uint64(i + 1)
+		recordIds := []string{}
+		for _, sender := range []string{"sender1", "sender2"} {
+			recordId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, sender)
+			claimIsPending := epochNumber == 1 && sender == "sender2"
+			s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+				Id:             recordId,
+				HostZoneId:     HostChainId,
+				EpochNumber:    epochNumber,
+				Sender:         sender,
+				Receiver:       fmt.Sprintf("cosmos_%s", sender),
+				Denom:          "uatom",
+				Amount:         sdkmath.NewInt(1000),
+				ClaimIsPending: claimIsPending,
+			})
+			recordIds = append(recordIds, recordId)
+
+			if status == recordtypes.HostZoneUnbonding_CLAIMABLE && !claimIsPending {
+				tc.claimableRecordIds = append(tc.claimableRecordIds, recordId)
+			} else {
+				tc.nonClaimableRecordIds = append(tc.nonClaimableRecordIds, recordId)
+			}
+		}
+
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+			EpochNumber: epochNumber,
+			HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+				HostZoneId:            HostChainId,
+				Status:                status,
+				UserRedemptionRecords: recordIds,
+				NativeTokenAmount:     sdkmath.NewInt(2000),
+			}},
+		})
+	}
+
+	return tc
+}
+
+func (s *KeeperTestSuite) checkClaimIsPending(recordIds []string, expectedPending bool) {
+	for _, recordId := range recordIds {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "record %s found", recordId)
+		s.Require().Equal(expectedPending, record.ClaimIsPending, "record %s claim is pending", recordId)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetClaimableRedemptionRecords() {
+	tc := s.SetupAutoClaim()
+	epochUnbondingRecords := s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx)
+
+	getRecordIds := func(records []recordtypes.UserRedemptionRecord) (recordIds []string) {
+		for _, record := range records {
+			recordIds = append(recordIds, record.Id)
+		}
+		return recordIds
+	}
+
+	records := s.App.StakeibcKeeper.GetClaimableRedemptionRecords(s.Ctx, HostChainId, epochUnbondingRecords, 10, 0)
+	s.Require().Equal(tc.claimableRecordIds, getRecordIds(records), "claimable records")
+
+	// With a limit, only the records starting from the offset should be returned
+	records = s.App.StakeibcKeeper.GetClaimableRedemptionRecords(s.Ctx, HostChainId, epochUnbondingRecords, 2, 0)
+	s.Require().Equal(tc.claimableRecordIds[:2], getRecordIds(records), "records with limit")
+
+	// The offset should wrap around to the first record
+	records = s.App.StakeibcKeeper.GetClaimableRedemptionRecords(s.Ctx, HostChainId, epochUnbondingRecords, 2, 2)
+	s.Require().Equal([]string{tc.claimableRecordIds[2], tc.claimableRecordIds[0]}, getRecordIds(records), "records with offset")
+
+	// The same record should never be returned twice, even if the limit exceeds the number of records
+	records = s.App.StakeibcKeeper.GetClaimableRedemptionRecords(s.Ctx, HostChainId, epochUnbondingRecords, 10, 4)
+	s.Require().ElementsMatch(tc.claimableRecordIds, getRecordIds(records), "records with large offset")
+
+	// Other host zones should have no records
+	records = s.App.StakeibcKeeper.GetClaimableRedemptionRecords(s.Ctx, "OSMO", epochUnbondingRecords, 10, 0)
+	s.Require().Empty(records, "no records for other host zone")
+}
+
+func (s *KeeperTestSuite) setMaxAutoClaimsPerEpoch(maxClaims uint64) {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.MaxAutoClaimsPerEpoch = maxClaims
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) setDayEpochNumber(epochNumber uint64) {
+	dayEpochTracker, found := s.App.StakeibcKeeper.GetEpochTracker(s.Ctx, epochtypes.DAY_EPOCH)
+	s.Require().True(found, "day epoch tracker found")
+	dayEpochTracker.EpochNumber = epochNumber
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, dayEpochTracker)
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllUndelegatedTokens_Successful() {
+	tc := s.SetupAutoClaim()
+	s.setMaxAutoClaimsPerEpoch(50)
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found before ICA")
+
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+
+	// An ICA tx should be submitted for each claimable record
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found after ICA")
+	s.Require().Equal(startSequence+uint64(len(tc.claimableRecordIds)), endSequence, "one ICA tx submitted per record")
+
+	// Each callback should identify a single record
+	callbackRecordIds := []string{}
+	for _, callbackData := range s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx) {
+		s.Require().Equal(stakeibckeeper.ICACallbackID_Claim, callbackData.CallbackId, "callback id")
+		claimCallback, err := s.App.StakeibcKeeper.UnmarshalClaimCallbackArgs(s.Ctx, callbackData.CallbackArgs)
+		s.Require().NoError(err, "unmarshal claim callback")
+		callbackRecordIds = append(callbackRecordIds, claimCallback.UserRedemptionRecordId)
+	}
+	s.Require().ElementsMatch(tc.claimableRecordIds, callbackRecordIds, "callback record ids")
+
+	// All claimable records should now be pending, and the rest should be untouched
+	s.checkClaimIsPending(tc.claimableRecordIds, true)
+	s.checkClaimIsPending(tc.nonClaimableRecordIds[1:], false)
+
+	// Running again should not re-submit any claims
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	finalSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.redemptionPortId, tc.redemptionChannelId)
+	s.Require().True(found, "sequence number not found after second run")
+	s.Require().Equal(endSequence, finalSequence, "no additional ICA tx")
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllUndelegatedTokens_BoundedPerEpoch() {
+	tc := s.SetupAutoClaim()
+
+	s.setMaxAutoClaimsPerEpoch(2)
+	s.setDayEpochNumber(0)
+
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	s.checkClaimIsPending(tc.claimableRecordIds[:2], true)
+	s.checkClaimIsPending(tc.claimableRecordIds[2:], false)
+
+	// The remaining record should be claimed in the next epoch
+	s.setDayEpochNumber(1)
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	s.checkClaimIsPending(tc.claimableRecordIds, true)
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllUndelegatedTokens_FailedClaimsDoNotBlockOthers() {
+	tc := s.SetupAutoClaim()
+	s.setMaxAutoClaimsPerEpoch(2)
+	s.setDayEpochNumber(0)
+
+	// Claim the first two records, and then simulate the claim failing
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	s.checkClaimIsPending(tc.claimableRecordIds[:2], true)
+	for _, recordId := range tc.claimableRecordIds[:2] {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "record %s found", recordId)
+		record.ClaimIsPending = false
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+	}
+
+	// In the next epoch, the window should advance to the record that has not been attempted yet
+	s.setDayEpochNumber(1)
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	s.checkClaimIsPending(tc.claimableRecordIds[2:], true)
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllUndelegatedTokens_FailedClaimOnlyRevertsItsRecord() {
+	tc := s.SetupAutoClaim()
+	s.setMaxAutoClaimsPerEpoch(50)
+
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	s.checkClaimIsPending(tc.claimableRecordIds, true)
+
+	// Fail the claim for the first record and acknowledge the rest
+	failedRecordId := tc.claimableRecordIds[0]
+	for _, callbackData := range s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx) {
+		claimCallback, err := s.App.StakeibcKeeper.UnmarshalClaimCallbackArgs(s.Ctx, callbackData.CallbackArgs)
+		s.Require().NoError(err, "unmarshal claim callback")
+
+		status := icacallbacktypes.AckResponseStatus_SUCCESS
+		if claimCallback.UserRedemptionRecordId == failedRecordId {
+			status = icacallbacktypes.AckResponseStatus_FAILURE
+		}
+		ackResponse := &icacallbacktypes.AcknowledgementResponse{Status: status}
+		err = stakeibckeeper.ClaimCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, ackResponse, callbackData.CallbackArgs)
+		s.Require().NoError(err, "claim callback for %s", claimCallback.UserRedemptionRecordId)
+	}
+
+	// Only the failed record should remain, and it should be claimable again
+	s.checkClaimIsPending([]string{failedRecordId}, false)
+	for _, recordId := range tc.claimableRecordIds[1:] {
+		_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().False(found, "record %s should have been removed", recordId)
+	}
+}
+
+func (s *KeeperTestSuite) TestAutoClaimAllUndelegatedTokens_Disabled() {
+	tc := s.SetupAutoClaim()
+
+	s.setMaxAutoClaimsPerEpoch(0)
+
+	s.App.StakeibcKeeper.AutoClaimAllUndelegatedTokens(s.Ctx)
+	s.checkClaimIsPending(tc.claimableRecordIds, false)
+}
+
+func (s *KeeperTestSuite) TestAutoClaimUndelegatedTokensForHostZone_NoRedemptionAccount() {
+	s.SetupAutoClaim()
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found)
+	hostZone.RedemptionAccount = nil
+
+	epochUnbondingRecords := s.App.RecordsKeeper.GetAllEpochUnbondingRecord(s.Ctx)
+	_, err := s.App.StakeibcKeeper.AutoClaimUndelegatedTokensForHostZone(s.Ctx, hostZone, epochUnbondingRecords, 10, 0)
+	s.Require().ErrorContains(err, "no redemption account found for GAIA")
+}
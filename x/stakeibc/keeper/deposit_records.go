@@ -41,7 +41,8 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 	transferDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		isTransferRecord := record.Status == recordstypes.DepositRecord_TRANSFER_QUEUE
 		isBeforeCurrentEpoch := record.DepositEpochNumber < epochNumber
-		return isTransferRecord && isBeforeCurrentEpoch
+		isDue := k.IsHostZoneIntervalDueByChainId(ctx, record.HostZoneId, types.KeyDepositInterval, epochNumber)
+		return isTransferRecord && isBeforeCurrentEpoch && isDue
 	})
 
 	ibcTransferTimeoutNanos := k.GetParam(ctx, types.KeyIBCTransferTimeoutNanos)
@@ -76,6 +77,18 @@ func (k Keeper) TransferExistingDepositsToHostZones(ctx sdk.Context, epochNumber
 		}
 		delegateAddress := delegateAccount.Address
 
+		// Hold back a portion of the deposit to top up the instant redemption buffer
+		// The updated record and host zone are stored before the transfer so that they remain consistent if the transfer fails
+		if holdBackAmount := k.HoldBackInstantRedemptionBuffer(ctx, &hostZone, &depositRecord); holdBackAmount.IsPositive() {
+			k.SetHostZone(ctx, hostZone)
+			if depositRecord.Amount.IsZero() {
+				k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Deposit record fully held back for instant redemptions - Removing."))
+				k.RecordsKeeper.RemoveDepositRecord(ctx, depositRecord.Id)
+				continue
+			}
+			k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		}
+
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Transferring %v%s", depositRecord.Amount, hostZone.HostDenom))
 		transferCoin := sdk.NewCoin(hostZone.IbcDenom, depositRecord.Amount)
 
@@ -108,7 +121,9 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 	stakeDepositRecords := utils.FilterDepositRecords(depositRecords, func(record recordstypes.DepositRecord) (condition bool) {
 		isStakeRecord := record.Status == recordstypes.DepositRecord_DELEGATION_QUEUE
 		isBeforeCurrentEpoch := record.DepositEpochNumber < epochNumber
-		return isStakeRecord && isBeforeCurrentEpoch
+		isDue := k.IsHostZoneIntervalDueByChainId(ctx, record.HostZoneId, types.KeyDelegateInterval, epochNumber)
+		isQueuedForRetry := k.HasICARetry(ctx, record.HostZoneId, types.ICARetry_DELEGATE, record.Id)
+		return isStakeRecord && isBeforeCurrentEpoch && isDue && !isQueuedForRetry
 	})
 
 	if len(stakeDepositRecords) == 0 {
@@ -143,12 +158,21 @@ func (k Keeper) StakeExistingDepositsOnHostZones(ctx sdk.Context, epochNumber ui
 			continue
 		}
 
+		// Deposits of a sunset host zone are added to the sunset claimable balance instead of being staked
+		if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+			if err := k.SweepSunsetDeposit(ctx, hostZone, depositRecord); err != nil {
+				k.Logger(ctx).Error(fmt.Sprintf("Unable to sweep deposit record %d on %s for sunset | err: %s", depositRecord.Id, hostZone.ChainId, err.Error()))
+			}
+			continue
+		}
+
 		k.Logger(ctx).Info(utils.LogWithHostZone(depositRecord.HostZoneId, "Staking %v%s", depositRecord.Amount, hostZone.HostDenom))
 		stakeAmount := sdk.NewCoin(hostZone.HostDenom, depositRecord.Amount)
 
 		err := k.DelegateOnHost(ctx, hostZone, stakeAmount, depositRecord)
 		if err != nil {
 			k.Logger(ctx).Error(fmt.Sprintf("Did not stake %s on %s | err: %s", stakeAmount.String(), hostZone.ChainId, err.Error()))
+			k.RecordFailedICAOperation(ctx, hostZone.ChainId, types.ICARetry_DELEGATE, depositRecord.Id, err)
 			continue
 		}
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Successfully submitted stake"))
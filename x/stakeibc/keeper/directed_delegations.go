@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Records a liquid stake that the user directed to a specific validator
+// The validator must be in the host zone's active set (i.e. not jailed or tombstoned, and with a non-zero weight)
+// The amount is added to both the validator's directed total and its undelegated directed amount,
+// so that it's delegated to the validator when the deposit is staked
+func (k Keeper) AddDirectedLiquidStake(hostZone *types.HostZone, validatorAddress string, amount sdkmath.Int) error {
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, validatorAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s is not registered on host zone %s", validatorAddress, hostZone.ChainId)
+	}
+	if validator.GetActiveWeight() == 0 {
+		return errorsmod.Wrapf(types.ErrValidatorNotActive, "validator %s is not in the active set of host zone %s", validatorAddress, hostZone.ChainId)
+	}
+
+	validator.DirectedDelegationAmt = validator.GetDirectedDelegationAmt().Add(amount)
+	validator.UndelegatedDirectedAmt = validator.GetUndelegatedDirectedAmt().Add(amount)
+	hostZone.Validators[valIndex] = &validator
+
+	return nil
+}
+
+// Reduces a validator's undelegated directed amount after a delegation to the validator succeeds
+func (k Keeper) ConsumeUndelegatedDirectedAmt(hostZone *types.HostZone, validatorAddress string, delegatedAmount sdkmath.Int) {
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, validatorAddress)
+	if !found {
+		return
+	}
+
+	undelegatedAmt := validator.GetUndelegatedDirectedAmt()
+	validator.UndelegatedDirectedAmt = undelegatedAmt.Sub(sdkmath.MinInt(undelegatedAmt, delegatedAmount))
+	hostZone.Validators[valIndex] = &validator
+}
+
+// Reduces each validator's directed amount after redeemed tokens are undelegated
+// Since stTokens are fungible, a redemption can't be attributed to a specific directed liquid stake,
+// so each directed amount is reduced in proportion to the redeemed share of the host zone's staked balance
+func (k Keeper) ReduceDirectedDelegations(ctx sdk.Context, chainId string, redeemedAmount sdkmath.Int) {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found || !hostZone.StakedBal.IsPositive() {
+		return
+	}
+
+	updated := false
+	for i, validator := range hostZone.Validators {
+		directedAmt := validator.GetDirectedDelegationAmt()
+		if directedAmt.IsZero() {
+			continue
+		}
+
+		reduction := sdkmath.MinInt(directedAmt, directedAmt.Mul(redeemedAmount).Quo(hostZone.StakedBal))
+		validator.DirectedDelegationAmt = directedAmt.Sub(reduction)
+		validator.UndelegatedDirectedAmt = sdkmath.MinInt(validator.GetUndelegatedDirectedAmt(), validator.DirectedDelegationAmt)
+		hostZone.Validators[i] = validator
+		updated = true
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Reduced directed delegation to %s by %v after redemption", validator.Address, reduction))
+	}
+
+	if updated {
+		k.SetHostZone(ctx, hostZone)
+	}
+}
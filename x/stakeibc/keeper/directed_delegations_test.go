@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestAddDirectedLiquidStake() {
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 1},
+			{Address: "val2", Weight: 1, DirectedDelegationAmt: sdkmath.NewInt(100), UndelegatedDirectedAmt: sdkmath.NewInt(40)},
+			{Address: "val3", Weight: 0},
+			{Address: "val4", Weight: 1, Jailed: true},
+		},
+	}
+
+	// Directing to a validator without a previous directed amount
+	err := s.App.StakeibcKeeper.AddDirectedLiquidStake(&hostZone, "val1", sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected for val1")
+	s.Require().Equal(sdkmath.NewInt(10), hostZone.Validators[0].DirectedDelegationAmt, "val1 directed amount")
+	s.Require().Equal(sdkmath.NewInt(10), hostZone.Validators[0].UndelegatedDirectedAmt, "val1 undelegated amount")
+
+	// Directing to a validator that already has a directed amount
+	err = s.App.StakeibcKeeper.AddDirectedLiquidStake(&hostZone, "val2", sdkmath.NewInt(10))
+	s.Require().NoError(err, "no error expected for val2")
+	s.Require().Equal(sdkmath.NewInt(110), hostZone.Validators[1].DirectedDelegationAmt, "val2 directed amount")
+	s.Require().Equal(sdkmath.NewInt(50), hostZone.Validators[1].UndelegatedDirectedAmt, "val2 undelegated amount")
+
+	// Zero-weight and jailed validators are not in the active set
+	err = s.App.StakeibcKeeper.AddDirectedLiquidStake(&hostZone, "val3", sdkmath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrValidatorNotActive, "zero weight validator")
+	err = s.App.StakeibcKeeper.AddDirectedLiquidStake(&hostZone, "val4", sdkmath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrValidatorNotActive, "jailed validator")
+
+	// Unknown validator
+	err = s.App.StakeibcKeeper.AddDirectedLiquidStake(&hostZone, "val5", sdkmath.NewInt(10))
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound, "unknown validator")
+}
+
+func (s *KeeperTestSuite) TestConsumeUndelegatedDirectedAmt() {
+	hostZone := types.HostZone{
+		Validators: []*types.Validator{
+			{Address: "val1", DirectedDelegationAmt: sdkmath.NewInt(100), UndelegatedDirectedAmt: sdkmath.NewInt(40)},
+			{Address: "val2", DirectedDelegationAmt: sdkmath.NewInt(100), UndelegatedDirectedAmt: sdkmath.NewInt(40)},
+		},
+	}
+
+	// Delegation smaller than the undelegated amount
+	s.App.StakeibcKeeper.ConsumeUndelegatedDirectedAmt(&hostZone, "val1", sdkmath.NewInt(15))
+	s.Require().Equal(sdkmath.NewInt(25), hostZone.Validators[0].UndelegatedDirectedAmt, "val1 undelegated amount")
+
+	// Delegation larger than the undelegated amount (floored at zero)
+	s.App.StakeibcKeeper.ConsumeUndelegatedDirectedAmt(&hostZone, "val2", sdkmath.NewInt(60))
+	s.Require().True(hostZone.Validators[1].UndelegatedDirectedAmt.IsZero(), "val2 undelegated amount")
+
+	// The directed totals should not change
+	s.Require().Equal(sdkmath.NewInt(100), hostZone.Validators[0].DirectedDelegationAmt, "val1 directed amount")
+	s.Require().Equal(sdkmath.NewInt(100), hostZone.Validators[1].DirectedDelegationAmt, "val2 directed amount")
+}
+
+func (s *KeeperTestSuite) TestReduceDirectedDelegations() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:   HostChainId,
+		StakedBal: sdkmath.NewInt(1000),
+		Validators: []*types.Validator{
+			{Address: "val1", DirectedDelegationAmt: sdkmath.NewInt(200), UndelegatedDirectedAmt: sdkmath.NewInt(190)},
+			{Address: "val2", DirectedDelegationAmt: sdkmath.NewInt(100), UndelegatedDirectedAmt: sdkmath.NewInt(10)},
+			{Address: "val3"},
+		},
+	})
+
+	// Redeeming 10% of the staked balance should reduce each directed amount by 10%
+	s.App.StakeibcKeeper.ReduceDirectedDelegations(s.Ctx, HostChainId, sdkmath.NewInt(100))
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+
+	expectedDirected := []sdkmath.Int{sdkmath.NewInt(180), sdkmath.NewInt(90), sdkmath.ZeroInt()}
+	expectedUndelegated := []sdkmath.Int{sdkmath.NewInt(180), sdkmath.NewInt(10), sdkmath.ZeroInt()}
+	for i, validator := range hostZone.Validators {
+		s.Require().Equal(expectedDirected[i], validator.GetDirectedDelegationAmt(), "directed amount for %s", validator.Address)
+		s.Require().Equal(expectedUndelegated[i], validator.GetUndelegatedDirectedAmt(), "undelegated amount for %s", validator.Address)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetTargetValAmtsForHostZone_Directed() {
+	hostZone := types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", Weight: 1, DirectedDelegationAmt: sdkmath.NewInt(300), UndelegatedDirectedAmt: sdkmath.NewInt(100)},
+			{Address: "val2", Weight: 1},
+			{Address: "val3", Weight: 2, DirectedDelegationAmt: sdkmath.NewInt(100)},
+			{Address: "val4", Weight: 1, DirectedDelegationAmt: sdkmath.NewInt(500), Jailed: true},
+		},
+	}
+
+	// The directed amounts (400, excluding the jailed validator) are added on top of the weighted split of the remaining 800
+	targets, err := s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx, hostZone, sdkmath.NewInt(1200))
+	s.Require().NoError(err, "no error expected when directed amounts are below the total")
+	s.Require().Equal(sdkmath.NewInt(500), targets["val1"], "val1 target")
+	s.Require().Equal(sdkmath.NewInt(200), targets["val2"], "val2 target")
+	s.Require().Equal(sdkmath.NewInt(500), targets["val3"], "val3 target")
+	s.Require().Equal(sdkmath.ZeroInt(), targets["val4"], "val4 target")
+
+	// If the directed amounts exceed the total, it's split in proportion to the directed amounts
+	targets, err = s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx, hostZone, sdkmath.NewInt(201))
+	s.Require().NoError(err, "no error expected when directed amounts are above the total")
+	s.Require().Equal(sdkmath.NewInt(151), targets["val1"], "val1 target")
+	s.Require().Equal(sdkmath.ZeroInt(), targets["val2"], "val2 target")
+	s.Require().Equal(sdkmath.NewInt(50), targets["val3"], "val3 target")
+	s.Require().Equal(sdkmath.ZeroInt(), targets["val4"], "val4 target")
+
+	// New delegations only use the directed amounts that have not yet been delegated
+	targets, err = s.App.StakeibcKeeper.GetDelegationValAmtsForHostZone(s.Ctx, hostZone, sdkmath.NewInt(500))
+	s.Require().NoError(err, "no error expected for delegation split")
+	s.Require().Equal(sdkmath.NewInt(200), targets["val1"], "val1 delegation")
+	s.Require().Equal(sdkmath.NewInt(100), targets["val2"], "val2 delegation")
+	s.Require().Equal(sdkmath.NewInt(200), targets["val3"], "val3 delegation")
+	s.Require().Equal(sdkmath.ZeroInt(), targets["val4"], "val4 delegation")
+}
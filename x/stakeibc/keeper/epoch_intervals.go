@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Returns the number of stride epochs between each run of a task for the given host zone
+// The host zone's override is used if set, otherwise the interval falls back to the module-wide param
+func (k Keeper) GetHostZoneInterval(ctx sdk.Context, hostZone types.HostZone, intervalKey []byte) uint64 {
+	if interval := hostZone.EpochIntervals.GetInterval(intervalKey); interval != 0 {
+		return interval
+	}
+	return k.GetParam(ctx, intervalKey)
+}
+
+// Returns true if the task with the given interval should run for the host zone in this stride epoch
+func (k Keeper) IsHostZoneIntervalDue(ctx sdk.Context, hostZone types.HostZone, intervalKey []byte, epochNumber uint64) bool {
+	return epochNumber%k.GetHostZoneInterval(ctx, hostZone, intervalKey) == 0
+}
+
+// Same as IsHostZoneIntervalDue, but looks up the host zone from its chain ID
+// Deposit records for unknown host zones are considered due, so that they're handled (and logged) by the caller
+func (k Keeper) IsHostZoneIntervalDueByChainId(ctx sdk.Context, chainId string, intervalKey []byte, epochNumber uint64) bool {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return true
+	}
+	return k.IsHostZoneIntervalDue(ctx, hostZone, intervalKey, epochNumber)
+}
+
+// Builds the next scheduled run of a task, given the epoch's tracker and the task's interval
+// The epoch tracker holds the epoch that most recently started, so the next run is the
+// following epoch number that's a multiple of the interval
+func getNextScheduledRun(task string, epochTracker types.EpochTracker, interval uint64, overridden bool) types.ScheduledRun {
+	nextEpochNumber := (epochTracker.EpochNumber/interval + 1) * interval
+	epochsUntilRun := nextEpochNumber - epochTracker.EpochNumber - 1
+	return types.ScheduledRun{
+		Task:            task,
+		EpochIdentifier: epochTracker.EpochIdentifier,
+		Interval:        interval,
+		Overridden:      overridden,
+		NextEpochNumber: nextEpochNumber,
+		NextRunTime:     epochTracker.NextEpochStartTime + epochsUntilRun*epochTracker.Duration,
+	}
+}
+
+// Returns the next scheduled run of each epochly task for a host zone
+func (k Keeper) GetNextScheduledRuns(ctx sdk.Context, hostZone types.HostZone) ([]types.ScheduledRun, error) {
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return nil, types.ErrEpochNotFound.Wrapf("epoch tracker (%s) not found", epochstypes.STRIDE_EPOCH)
+	}
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.DAY_EPOCH)
+	if !found {
+		return nil, types.ErrEpochNotFound.Wrapf("epoch tracker (%s) not found", epochstypes.DAY_EPOCH)
+	}
+
+	strideEpochTasks := []struct {
+		task        string
+		intervalKey []byte
+	}{
+		{task: types.ScheduledTaskDeposit, intervalKey: types.KeyDepositInterval},
+		{task: types.ScheduledTaskDelegate, intervalKey: types.KeyDelegateInterval},
+		{task: types.ScheduledTaskReinvest, intervalKey: types.KeyReinvestInterval},
+		{task: types.ScheduledTaskRedemptionRate, intervalKey: types.KeyRedemptionRateInterval},
+	}
+
+	scheduledRuns := []types.ScheduledRun{}
+	for _, strideEpochTask := range strideEpochTasks {
+		interval := k.GetHostZoneInterval(ctx, hostZone, strideEpochTask.intervalKey)
+		overridden := hostZone.EpochIntervals.GetInterval(strideEpochTask.intervalKey) != 0
+		scheduledRuns = append(scheduledRuns, getNextScheduledRun(strideEpochTask.task, strideEpochTracker, interval, overridden))
+	}
+
+	// Unbondings are scheduled in the day epoch from the host zone's unbonding frequency
+	if hostZone.UnbondingFrequency != 0 {
+		scheduledRuns = append(scheduledRuns, getNextScheduledRun(types.ScheduledTaskUnbonding, dayEpochTracker, hostZone.UnbondingFrequency, true))
+	}
+
+	return scheduledRuns, nil
+}
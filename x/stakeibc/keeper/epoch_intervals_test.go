@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetHostZoneInterval() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.DepositInterval = 2
+	params.DelegateInterval = 3
+	params.ReinvestInterval = 4
+	params.RedemptionRateInterval = 5
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Without overrides, the intervals should fall back to the params
+	hostZone := stakeibctypes.HostZone{ChainId: HostChainId}
+	s.Require().Equal(uint64(2), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyDepositInterval), "deposit interval")
+	s.Require().Equal(uint64(3), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyDelegateInterval), "delegate interval")
+	s.Require().Equal(uint64(4), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyReinvestInterval), "reinvest interval")
+	s.Require().Equal(uint64(5), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyRedemptionRateInterval), "rr interval")
+
+	// With overrides, zero intervals should still fall back to the params
+	hostZone.EpochIntervals = &stakeibctypes.EpochIntervals{
+		DepositInterval:        10,
+		RedemptionRateInterval: 1,
+	}
+	s.Require().Equal(uint64(10), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyDepositInterval), "deposit interval")
+	s.Require().Equal(uint64(3), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyDelegateInterval), "delegate interval")
+	s.Require().Equal(uint64(4), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyReinvestInterval), "reinvest interval")
+	s.Require().Equal(uint64(1), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyRedemptionRateInterval), "rr interval")
+
+	// The task should only be due on multiples of the interval
+	s.Require().True(s.App.StakeibcKeeper.IsHostZoneIntervalDue(s.Ctx, hostZone, stakeibctypes.KeyDepositInterval, 20), "deposit due at epoch 20")
+	s.Require().False(s.App.StakeibcKeeper.IsHostZoneIntervalDue(s.Ctx, hostZone, stakeibctypes.KeyDepositInterval, 21), "deposit not due at epoch 21")
+	s.Require().True(s.App.StakeibcKeeper.IsHostZoneIntervalDue(s.Ctx, hostZone, stakeibctypes.KeyRedemptionRateInterval, 21), "rr due at epoch 21")
+}
+
+func (s *KeeperTestSuite) TestTransferDepositRecords_IntervalNotDue() {
+	tc := s.SetupDepositRecords()
+
+	// Override the deposit interval so that the current epoch (2) is skipped
+	hostZone := tc.hostZone
+	hostZone.EpochIntervals = &stakeibctypes.EpochIntervals{DepositInterval: 3}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.TransferChannel.PortID, tc.TransferChannel.ChannelID)
+	s.Require().True(found, "sequence number not found before transfer")
+
+	s.App.StakeibcKeeper.TransferExistingDepositsToHostZones(s.Ctx, tc.epochNumber, tc.initialDepositRecords.GetAllRecords())
+
+	// No transfers should have been sent and all records should remain
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.TransferChannel.PortID, tc.TransferChannel.ChannelID)
+	s.Require().True(found, "sequence number not found after transfer")
+	s.Require().Equal(startSequence, endSequence, "tx sequence number after transfer")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "number of callbacks")
+	s.Require().Len(s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx), len(tc.initialDepositRecords.GetAllRecords()), "number of deposit records")
+
+	// Once the interval is due, the transfers should go through
+	tc.epochNumber = 3
+	s.CheckStateAfterTransferringDepositRecords(tc, 0)
+}
+
+func (s *KeeperTestSuite) TestStakeDepositRecords_IntervalNotDue() {
+	tc := s.SetupDepositRecords()
+
+	// Override the delegate interval so that the current epoch (2) is skipped
+	hostZone := tc.hostZone
+	hostZone.EpochIntervals = &stakeibctypes.EpochIntervals{DelegateInterval: 3}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.DelegationChannel.PortID, tc.DelegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found before delegation")
+
+	s.App.StakeibcKeeper.StakeExistingDepositsOnHostZones(s.Ctx, tc.epochNumber, tc.initialDepositRecords.GetAllRecords())
+
+	// No delegations should have been sent
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.DelegationChannel.PortID, tc.DelegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found after delegation")
+	s.Require().Equal(startSequence, endSequence, "tx sequence number after delegation")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "number of callbacks")
+}
+
+func (s *KeeperTestSuite) TestUpdateRedemptionRates_IntervalNotDue() {
+	initialRedemptionRate := sdk.NewDec(1)
+	tc := s.SetupUpdateRedemptionRates(sdkmath.NewInt(5), sdkmath.NewInt(3), sdkmath.NewInt(3), sdkmath.NewInt(10), initialRedemptionRate)
+
+	hostZone := tc.hostZone
+	hostZone.EpochIntervals = &stakeibctypes.EpochIntervals{RedemptionRateInterval: 2}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// On an odd epoch, the redemption rate should not be updated
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{EpochIdentifier: epochtypes.STRIDE_EPOCH, EpochNumber: 3})
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, tc.allRecords)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(initialRedemptionRate, hostZone.RedemptionRate, "redemption rate should not be updated on epoch 3")
+
+	// On an even epoch, the redemption rate should be updated to (5 + 3 + 3) / 10
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{EpochIdentifier: epochtypes.STRIDE_EPOCH, EpochNumber: 4})
+	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, tc.allRecords)
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.1"), hostZone.RedemptionRate, "redemption rate should be updated on epoch 4")
+}
+
+func (s *KeeperTestSuite) SetupNextScheduledRuns() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.DepositInterval = 1
+	params.DelegateInterval = 1
+	params.ReinvestInterval = 2
+	params.RedemptionRateInterval = 3
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:            HostChainId,
+		UnbondingFrequency: 3,
+		EpochIntervals:     &stakeibctypes.EpochIntervals{DelegateInterval: 4},
+	})
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        5,
+		NextEpochStartTime: 1000,
+		Duration:           100,
+	})
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.DAY_EPOCH,
+		EpochNumber:        7,
+		NextEpochStartTime: 50_000,
+		Duration:           10_000,
+	})
+}
+
+func (s *KeeperTestSuite) TestGetNextScheduledRuns() {
+	s.SetupNextScheduledRuns()
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+
+	scheduledRuns, err := s.App.StakeibcKeeper.GetNextScheduledRuns(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when getting scheduled runs")
+
+	expectedScheduledRuns := []stakeibctypes.ScheduledRun{
+		{Task: stakeibctypes.ScheduledTaskDeposit, EpochIdentifier: epochtypes.STRIDE_EPOCH, Interval: 1, Overridden: false, NextEpochNumber: 6, NextRunTime: 1000},
+		{Task: stakeibctypes.ScheduledTaskDelegate, EpochIdentifier: epochtypes.STRIDE_EPOCH, Interval: 4, Overridden: true, NextEpochNumber: 8, NextRunTime: 1200},
+		{Task: stakeibctypes.ScheduledTaskReinvest, EpochIdentifier: epochtypes.STRIDE_EPOCH, Interval: 2, Overridden: false, NextEpochNumber: 6, NextRunTime: 1000},
+		{Task: stakeibctypes.ScheduledTaskRedemptionRate, EpochIdentifier: epochtypes.STRIDE_EPOCH, Interval: 3, Overridden: false, NextEpochNumber: 6, NextRunTime: 1000},
+		{Task: stakeibctypes.ScheduledTaskUnbonding, EpochIdentifier: epochtypes.DAY_EPOCH, Interval: 3, Overridden: true, NextEpochNumber: 9, NextRunTime: 60_000},
+	}
+	s.Require().Equal(expectedScheduledRuns, scheduledRuns, "scheduled runs")
+}
+
+func (s *KeeperTestSuite) TestGetNextScheduledRuns_MissingEpochTracker() {
+	hostZone := stakeibctypes.HostZone{ChainId: HostChainId}
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{EpochIdentifier: epochtypes.STRIDE_EPOCH, EpochNumber: 1})
+
+	_, err := s.App.StakeibcKeeper.GetNextScheduledRuns(s.Ctx, hostZone)
+	s.Require().ErrorContains(err, "epoch tracker (day) not found")
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetFeeRecipient set a specific feeRecipient in the store from its name
+func (k Keeper) SetFeeRecipient(ctx sdk.Context, feeRecipient types.FeeRecipient) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeRecipientKeyPrefix))
+	b := k.cdc.MustMarshal(&feeRecipient)
+	store.Set([]byte(feeRecipient.Name), b)
+}
+
+// RemoveFeeRecipient removes a feeRecipient from the store
+func (k Keeper) RemoveFeeRecipient(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeRecipientKeyPrefix))
+	store.Delete([]byte(name))
+}
+
+// GetAllFeeRecipients returns all feeRecipients, sorted by name
+func (k Keeper) GetAllFeeRecipients(ctx sdk.Context) (list []types.FeeRecipient) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeRecipientKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeRecipient
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Replaces the full set of fee recipients
+func (k Keeper) SetFeeRecipients(ctx sdk.Context, feeRecipients []types.FeeRecipient) {
+	for _, feeRecipient := range k.GetAllFeeRecipients(ctx) {
+		k.RemoveFeeRecipient(ctx, feeRecipient.Name)
+	}
+	for _, feeRecipient := range feeRecipients {
+		k.SetFeeRecipient(ctx, feeRecipient)
+	}
+}
+
+// Validates that each module account recipient exists and replaces the full set of fee recipients
+// Used by both MsgUpdateFeeRecipients and UpdateFeeRecipientsProposal
+func (k Keeper) ReplaceFeeRecipients(ctx sdk.Context, feeRecipients []types.FeeRecipient) error {
+	// Confirm each module account exists, otherwise the send would fail during distribution
+	for _, recipient := range feeRecipients {
+		if recipient.Type == types.FeeRecipientType_MODULE_ACCOUNT && k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+			return errorsmod.Wrapf(types.ErrInvalidFeeRecipient, "module account %s does not exist", recipient.Address)
+		}
+	}
+
+	k.SetFeeRecipients(ctx, feeRecipients)
+	k.Logger(ctx).Info(fmt.Sprintf("Updated fee recipients: %v", feeRecipients))
+
+	return nil
+}
+
+// Returns the fee recipients that protocol revenue should be distributed to
+// If none have been configured, all revenue goes to the fee collector
+func (k Keeper) GetActiveFeeRecipients(ctx sdk.Context) []types.FeeRecipient {
+	feeRecipients := k.GetAllFeeRecipients(ctx)
+	if len(feeRecipients) == 0 {
+		return []types.FeeRecipient{{
+			Name:    authtypes.FeeCollectorName,
+			Type:    types.FeeRecipientType_MODULE_ACCOUNT,
+			Address: authtypes.FeeCollectorName,
+			Weight:  1,
+		}}
+	}
+	return feeRecipients
+}
+
+// SetFeeRecipientRevenue set a specific feeRecipientRevenue in the store from its name
+func (k Keeper) SetFeeRecipientRevenue(ctx sdk.Context, feeRecipientRevenue types.FeeRecipientRevenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeRecipientRevenueKeyPrefix))
+	b := k.cdc.MustMarshal(&feeRecipientRevenue)
+	store.Set([]byte(feeRecipientRevenue.Name), b)
+}
+
+// GetFeeRecipientRevenue returns a feeRecipientRevenue from its name
+func (k Keeper) GetFeeRecipientRevenue(ctx sdk.Context, name string) (val types.FeeRecipientRevenue, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeRecipientRevenueKeyPrefix))
+
+	b := store.Get([]byte(name))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllFeeRecipientRevenue returns the cumulative revenue of every current or past fee recipient, sorted by name
+func (k Keeper) GetAllFeeRecipientRevenue(ctx sdk.Context) (list []types.FeeRecipientRevenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FeeRecipientRevenueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeeRecipientRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Adds to the cumulative revenue of a fee recipient
+func (k Keeper) AddFeeRecipientRevenue(ctx sdk.Context, name string, revenue sdk.Coins) {
+	feeRecipientRevenue, found := k.GetFeeRecipientRevenue(ctx, name)
+	if !found {
+		feeRecipientRevenue = types.FeeRecipientRevenue{Name: name}
+	}
+	feeRecipientRevenue.TotalRevenue = feeRecipientRevenue.TotalRevenue.Add(revenue...)
+	k.SetFeeRecipientRevenue(ctx, feeRecipientRevenue)
+}
+
+// Splits the tokens between the fee recipients proportionally to their weights
+// Each share is rounded down, so any remainder is left behind for the next distribution
+func GetFeeRecipientShares(feeRecipients []types.FeeRecipient, tokens sdk.Coins) []sdk.Coins {
+	totalWeight := sdkmath.ZeroInt()
+	for _, recipient := range feeRecipients {
+		totalWeight = totalWeight.Add(sdkmath.NewIntFromUint64(recipient.Weight))
+	}
+
+	shares := make([]sdk.Coins, len(feeRecipients))
+	for i, recipient := range feeRecipients {
+		share := sdk.NewCoins()
+		if totalWeight.IsPositive() {
+			for _, token := range tokens {
+				amount := token.Amount.Mul(sdkmath.NewIntFromUint64(recipient.Weight)).Quo(totalWeight)
+				share = share.Add(sdk.NewCoin(token.Denom, amount))
+			}
+		}
+		shares[i] = share
+	}
+	return shares
+}
+
+// Sends tokens from the reward collector to a fee recipient
+func (k Keeper) SendToFeeRecipient(ctx sdk.Context, recipient types.FeeRecipient, tokens sdk.Coins) error {
+	switch recipient.Type {
+	case types.FeeRecipientType_ACCOUNT:
+		address, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardCollectorName, address, tokens)
+	case types.FeeRecipientType_MODULE_ACCOUNT:
+		if k.accountKeeper.GetModuleAddress(recipient.Address) == nil {
+			return errorsmod.Wrapf(types.ErrInvalidFeeRecipient, "module account %s does not exist", recipient.Address)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardCollectorName, recipient.Address, tokens)
+	case types.FeeRecipientType_COMMUNITY_POOL:
+		rewardCollectorAddress := k.accountKeeper.GetModuleAccount(ctx, types.RewardCollectorName).GetAddress()
+		return k.DistributionKeeper.FundCommunityPool(ctx, tokens, rewardCollectorAddress)
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidFeeRecipient, "invalid type for fee recipient %s", recipient.Name)
+	}
+}
+
+// Distributes tokens from the reward collector to each fee recipient
+// If a send fails, that recipient's share is left in the reward collector and the remaining recipients are still paid
+func (k Keeper) DistributeToFeeRecipients(ctx sdk.Context, tokens sdk.Coins) {
+	feeRecipients := k.GetActiveFeeRecipients(ctx)
+	shares := GetFeeRecipientShares(feeRecipients, tokens)
+
+	for i, recipient := range feeRecipients {
+		share := shares[i]
+		if share.IsZero() {
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.SendToFeeRecipient(cacheCtx, recipient, share); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to send %v to fee recipient %s: %s", share, recipient.Name, err.Error()))
+			continue
+		}
+		write()
+
+		k.AddFeeRecipientRevenue(ctx, recipient.Name, share)
+
+		k.Logger(ctx).Info(fmt.Sprintf("Sent %v from %s to fee recipient %s", share, types.RewardCollectorName, recipient.Name))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFeeDistribution,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyFeeRecipient, recipient.Name),
+				sdk.NewAttribute(types.AttributeKeyRecipientAddress, recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, share.String()),
+			),
+		)
+	}
+}
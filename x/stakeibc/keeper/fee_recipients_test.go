@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupFeeRecipients() []stakeibctypes.FeeRecipient {
+	s.SetupTestRewardAllocation()
+
+	feeRecipients := []stakeibctypes.FeeRecipient{
+		{Name: "fee_collector", Type: stakeibctypes.FeeRecipientType_MODULE_ACCOUNT, Address: authtypes.FeeCollectorName, Weight: 6},
+		{Name: "community_pool", Type: stakeibctypes.FeeRecipientType_COMMUNITY_POOL, Weight: 3},
+		{Name: "treasury", Type: stakeibctypes.FeeRecipientType_ACCOUNT, Address: s.TestAccs[0].String(), Weight: 1},
+	}
+	s.App.StakeibcKeeper.SetFeeRecipients(s.Ctx, feeRecipients)
+
+	return feeRecipients
+}
+
+func (s *KeeperTestSuite) TestGetFeeRecipientShares() {
+	feeRecipients := []stakeibctypes.FeeRecipient{
+		{Name: "A", Weight: 1},
+		{Name: "B", Weight: 2},
+	}
+	tokens := sdk.NewCoins(sdk.NewInt64Coin(StAtom, 100), sdk.NewInt64Coin(StOsmo, 30))
+
+	shares := stakeibckeeper.GetFeeRecipientShares(feeRecipients, tokens)
+	s.Require().Len(shares, 2)
+	s.CompareCoins(sdk.NewInt64Coin(StAtom, 33), sdk.NewCoin(StAtom, shares[0].AmountOf(StAtom)), "recipient A stAtom")
+	s.CompareCoins(sdk.NewInt64Coin(StOsmo, 10), sdk.NewCoin(StOsmo, shares[0].AmountOf(StOsmo)), "recipient A stOsmo")
+	s.CompareCoins(sdk.NewInt64Coin(StAtom, 66), sdk.NewCoin(StAtom, shares[1].AmountOf(StAtom)), "recipient B stAtom")
+	s.CompareCoins(sdk.NewInt64Coin(StOsmo, 20), sdk.NewCoin(StOsmo, shares[1].AmountOf(StOsmo)), "recipient B stOsmo")
+}
+
+func (s *KeeperTestSuite) TestGetActiveFeeRecipients_DefaultsToFeeCollector() {
+	feeRecipients := s.App.StakeibcKeeper.GetActiveFeeRecipients(s.Ctx)
+	s.Require().Len(feeRecipients, 1)
+	s.Require().Equal(stakeibctypes.FeeRecipientType_MODULE_ACCOUNT, feeRecipients[0].Type)
+	s.Require().Equal(authtypes.FeeCollectorName, feeRecipients[0].Address)
+}
+
+func (s *KeeperTestSuite) TestSweepRewardCollToFeeRecipients_MultipleRecipients() {
+	s.SetupFeeRecipients()
+
+	// Fund the reward collector with an amount that doesn't divide evenly, to leave behind a remainder
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StAtom, sdkmath.NewInt(1001)))
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StOsmo, sdkmath.NewInt(1000)))
+
+	err := s.App.StakeibcKeeper.SweepStTokensFromRewardCollToFeeColl(s.Ctx)
+	s.Require().NoError(err)
+
+	// Fee collector gets 60%, community pool gets 30%, treasury gets 10%
+	s.checkModuleAccountBalance(authtypes.FeeCollectorName, StAtom, sdkmath.NewInt(600))
+	s.checkModuleAccountBalance(authtypes.FeeCollectorName, StOsmo, sdkmath.NewInt(600))
+
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(sdk.NewDec(300), communityPool.AmountOf(StAtom), "community pool stAtom")
+	s.Require().Equal(sdk.NewDec(300), communityPool.AmountOf(StOsmo), "community pool stOsmo")
+
+	s.CompareCoins(sdk.NewInt64Coin(StAtom, 100), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], StAtom), "treasury stAtom")
+	s.CompareCoins(sdk.NewInt64Coin(StOsmo, 100), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], StOsmo), "treasury stOsmo")
+
+	// The rounding remainder should stay in the reward collector
+	s.checkModuleAccountBalance(stakeibctypes.RewardCollectorName, StAtom, sdkmath.NewInt(1))
+	s.checkModuleAccountBalance(stakeibctypes.RewardCollectorName, StOsmo, sdkmath.ZeroInt())
+
+	// Check the cumulative revenue of each recipient
+	expectedRevenue := map[string]sdk.Coins{
+		"fee_collector":  sdk.NewCoins(sdk.NewInt64Coin(StAtom, 600), sdk.NewInt64Coin(StOsmo, 600)),
+		"community_pool": sdk.NewCoins(sdk.NewInt64Coin(StAtom, 300), sdk.NewInt64Coin(StOsmo, 300)),
+		"treasury":       sdk.NewCoins(sdk.NewInt64Coin(StAtom, 100), sdk.NewInt64Coin(StOsmo, 100)),
+	}
+	for name, expected := range expectedRevenue {
+		revenue, found := s.App.StakeibcKeeper.GetFeeRecipientRevenue(s.Ctx, name)
+		s.Require().True(found, "revenue for %s should have been found", name)
+		s.Require().Equal(expected.String(), revenue.TotalRevenue.String(), "revenue for %s", name)
+	}
+
+	// Check that an event was emitted for each recipient
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeFeeDistribution {
+			numEvents++
+		}
+	}
+	s.Require().Equal(3, numEvents, "number of fee distribution events")
+}
+
+func (s *KeeperTestSuite) TestSweepRewardCollToFeeRecipients_CumulativeRevenue() {
+	s.SetupFeeRecipients()
+
+	// Sweep twice, the revenue should accumulate across both
+	for i := 0; i < 2; i++ {
+		s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StAtom, sdkmath.NewInt(1000)))
+		err := s.App.StakeibcKeeper.SweepStTokensFromRewardCollToFeeColl(s.Ctx)
+		s.Require().NoError(err)
+	}
+
+	revenue, found := s.App.StakeibcKeeper.GetFeeRecipientRevenue(s.Ctx, "treasury")
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(StAtom, 200)).String(), revenue.TotalRevenue.String(), "treasury revenue")
+
+	// Revenue from a removed recipient should still be retained
+	s.App.StakeibcKeeper.SetFeeRecipients(s.Ctx, []stakeibctypes.FeeRecipient{})
+	_, found = s.App.StakeibcKeeper.GetFeeRecipientRevenue(s.Ctx, "treasury")
+	s.Require().True(found, "revenue should be retained after the recipient is removed")
+}
+
+func (s *KeeperTestSuite) TestSweepRewardCollToFeeRecipients_FailedSend() {
+	s.SetupTestRewardAllocation()
+
+	// Register a recipient with a module account that doesn't exist, the send to that recipient will fail
+	s.App.StakeibcKeeper.SetFeeRecipients(s.Ctx, []stakeibctypes.FeeRecipient{
+		{Name: "fee_collector", Type: stakeibctypes.FeeRecipientType_MODULE_ACCOUNT, Address: authtypes.FeeCollectorName, Weight: 1},
+		{Name: "missing", Type: stakeibctypes.FeeRecipientType_MODULE_ACCOUNT, Address: "missing_module", Weight: 1},
+	})
+
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StAtom, sdkmath.NewInt(1000)))
+	err := s.App.StakeibcKeeper.SweepStTokensFromRewardCollToFeeColl(s.Ctx)
+	s.Require().NoError(err)
+
+	// The fee collector should still be paid, and the failed share should remain in the reward collector
+	s.checkModuleAccountBalance(authtypes.FeeCollectorName, StAtom, sdkmath.NewInt(500))
+	s.checkModuleAccountBalance(stakeibctypes.RewardCollectorName, StAtom, sdkmath.NewInt(500))
+
+	_, found := s.App.StakeibcKeeper.GetFeeRecipientRevenue(s.Ctx, "missing")
+	s.Require().False(found, "no revenue should be recorded for the failed recipient")
+}
+
+func (s *KeeperTestSuite) TestFeeRecipientsQuery() {
+	feeRecipients := s.SetupFeeRecipients()
+
+	s.FundModuleAccount(stakeibctypes.RewardCollectorName, sdk.NewCoin(StAtom, sdkmath.NewInt(1000)))
+	err := s.App.StakeibcKeeper.SweepStTokensFromRewardCollToFeeColl(s.Ctx)
+	s.Require().NoError(err)
+
+	response, err := s.App.StakeibcKeeper.FeeRecipients(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.QueryFeeRecipientsRequest{})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(feeRecipients, response.FeeRecipients, "fee recipients")
+	s.Require().Len(response.Revenue, 3, "number of revenue entries")
+
+	_, err = s.App.StakeibcKeeper.FeeRecipients(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().ErrorContains(err, "invalid request")
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
@@ -15,3 +16,43 @@ func (k Keeper) AddValidatorsProposal(ctx sdk.Context, msg *types.AddValidatorsP
 
 	return nil
 }
+
+func (k Keeper) UpdateHostZoneProposal(ctx sdk.Context, proposal *types.UpdateHostZoneProposal) error {
+	return k.UpdateHostZoneConfig(ctx, proposal.GetHostZoneUpdate())
+}
+
+// Returns the host zone targeted by a sunset proposal, which must exist and must not be halted
+func (k Keeper) getSunsetProposalHostZone(ctx sdk.Context, chainId string) (types.HostZone, error) {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return hostZone, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", chainId)
+	}
+	if hostZone.Halted {
+		return hostZone, errorsmod.Wrapf(types.ErrHaltedHostZone, "host zone %s is halted", chainId)
+	}
+	return hostZone, nil
+}
+
+func (k Keeper) SunsetHostZoneProposal(ctx sdk.Context, proposal *types.SunsetHostZoneProposal) error {
+	hostZone, err := k.getSunsetProposalHostZone(ctx, proposal.ChainId)
+	if err != nil {
+		return err
+	}
+	return k.StartHostZoneSunset(ctx, hostZone)
+}
+
+func (k Keeper) AbortHostZoneSunsetProposal(ctx sdk.Context, proposal *types.AbortHostZoneSunsetProposal) error {
+	hostZone, err := k.getSunsetProposalHostZone(ctx, proposal.ChainId)
+	if err != nil {
+		return err
+	}
+	return k.StartHostZoneSunsetAbort(ctx, hostZone)
+}
+
+func (k Keeper) UpdateFeeRecipientsProposal(ctx sdk.Context, proposal *types.UpdateFeeRecipientsProposal) error {
+	return k.ReplaceFeeRecipients(ctx, proposal.FeeRecipients)
+}
+
+func (k Keeper) ResumeHostZoneProposal(ctx sdk.Context, proposal *types.ResumeHostZoneProposal) error {
+	return k.ResumeHaltedHostZone(ctx, proposal.ChainId)
+}
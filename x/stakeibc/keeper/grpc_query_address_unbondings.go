@@ -62,8 +62,14 @@ func (k Keeper) AddressUnbondings(c context.Context, req *types.QueryAddressUnbo
 						}
 						daysUntilUnbonding := hostZone.UnbondingFrequency - (currentDay % hostZone.UnbondingFrequency)
 						unbondingStartTime := dayEpochTracker.NextEpochStartTime + ((daysUntilUnbonding - 1) * nanosecondsInDay)
-						unbondingDurationEstimate := (hostZone.UnbondingFrequency - 1) * 7
-						unbondingTime = unbondingStartTime + (unbondingDurationEstimate * nanosecondsInDay)
+						// Use the host's unbonding period if it's been queried, otherwise fall back to an estimate
+						// based on the unbonding frequency
+						if hostZone.HostStakingParams != nil && hostZone.HostStakingParams.UnbondingPeriod != 0 {
+							unbondingTime = unbondingStartTime + hostZone.HostStakingParams.UnbondingPeriod
+						} else {
+							unbondingDurationEstimate := (hostZone.UnbondingFrequency - 1) * 7
+							unbondingTime = unbondingStartTime + (unbondingDurationEstimate * nanosecondsInDay)
+						}
 					}
 					unbondingTime = unbondingTime + nanosecondsInDay
 					unbondingTimeStr := time.Unix(0, int64(unbondingTime)).UTC().String()
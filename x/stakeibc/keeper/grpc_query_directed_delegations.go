@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) DirectedDelegations(c context.Context, req *types.QueryDirectedDelegationsRequest) (*types.QueryDirectedDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "host zone not found")
+	}
+
+	directedDelegations := []types.DirectedDelegation{}
+	totalDirectedAmount := sdkmath.ZeroInt()
+	for _, validator := range hostZone.Validators {
+		directedDelegations = append(directedDelegations, types.DirectedDelegation{
+			ValidatorAddress:  validator.Address,
+			DirectedAmount:    validator.GetDirectedDelegationAmt(),
+			UndelegatedAmount: validator.GetUndelegatedDirectedAmt(),
+		})
+		totalDirectedAmount = totalDirectedAmount.Add(validator.GetDirectedDelegationAmt())
+	}
+
+	return &types.QueryDirectedDelegationsResponse{
+		DirectedDelegations: directedDelegations,
+		TotalDirectedAmount: totalDirectedAmount,
+	}, nil
+}
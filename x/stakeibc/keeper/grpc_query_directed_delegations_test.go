@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestDirectedDelegationsQuery() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId: HostChainId,
+		Validators: []*types.Validator{
+			{Address: "val1", DirectedDelegationAmt: sdkmath.NewInt(100), UndelegatedDirectedAmt: sdkmath.NewInt(40)},
+			{Address: "val2"},
+			{Address: "val3", DirectedDelegationAmt: sdkmath.NewInt(50), UndelegatedDirectedAmt: sdkmath.ZeroInt()},
+		},
+	})
+
+	expectedDelegations := []types.DirectedDelegation{
+		{ValidatorAddress: "val1", DirectedAmount: sdkmath.NewInt(100), UndelegatedAmount: sdkmath.NewInt(40)},
+		{ValidatorAddress: "val2", DirectedAmount: sdkmath.ZeroInt(), UndelegatedAmount: sdkmath.ZeroInt()},
+		{ValidatorAddress: "val3", DirectedAmount: sdkmath.NewInt(50), UndelegatedAmount: sdkmath.ZeroInt()},
+	}
+
+	resp, err := s.App.StakeibcKeeper.DirectedDelegations(sdk.WrapSDKContext(s.Ctx), &types.QueryDirectedDelegationsRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedDelegations, resp.DirectedDelegations, "directed delegations")
+	s.Require().Equal(sdkmath.NewInt(150), resp.TotalDirectedAmount, "total directed amount")
+}
+
+func (s *KeeperTestSuite) TestDirectedDelegationsQuery_InvalidRequest() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.DirectedDelegations(ctx, nil)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = s.App.StakeibcKeeper.DirectedDelegations(ctx, &types.QueryDirectedDelegationsRequest{ChainId: "fake_host_zone"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "host zone not found"))
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) FeeRecipients(c context.Context, req *types.QueryFeeRecipientsRequest) (*types.QueryFeeRecipientsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeRecipientsResponse{
+		FeeRecipients: k.GetActiveFeeRecipients(ctx),
+		Revenue:       k.GetAllFeeRecipientRevenue(ctx),
+	}, nil
+}
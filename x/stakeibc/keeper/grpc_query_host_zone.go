@@ -31,6 +31,8 @@ func (k Keeper) HostZoneAll(c context.Context, req *types.QueryAllHostZoneReques
 			return err
 		}
 
+		strideCommission := k.GetStrideCommission(ctx, hostZone)
+		hostZone.StrideCommission = &strideCommission
 		hostZones = append(hostZones, hostZone)
 		return nil
 	})
@@ -51,6 +53,8 @@ func (k Keeper) HostZone(c context.Context, req *types.QueryGetHostZoneRequest)
 	if !found {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
+	strideCommission := k.GetStrideCommission(ctx, hostZone)
+	hostZone.StrideCommission = &strideCommission
 
 	return &types.QueryGetHostZoneResponse{HostZone: hostZone}, nil
 }
@@ -119,3 +119,28 @@ func TestHostZoneQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func (s *KeeperTestSuite) TestHostZoneQuery_StrideCommissionFallback() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.StrideCommission = 7
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// A host zone without a commission should report the param value
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+
+	expectedCommission := sdk.NewDecWithPrec(7, 2)
+	response, err := s.App.StakeibcKeeper.HostZone(sdk.WrapSDKContext(s.Ctx), &types.QueryGetHostZoneRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().NotNil(response.HostZone.StrideCommission)
+	s.Require().Equal(expectedCommission.String(), response.HostZone.StrideCommission.String(), "single host zone commission")
+
+	allResponse, err := s.App.StakeibcKeeper.HostZoneAll(sdk.WrapSDKContext(s.Ctx), &types.QueryAllHostZoneRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(allResponse.HostZone, 1)
+	s.Require().Equal(expectedCommission.String(), allResponse.HostZone[0].StrideCommission.String(), "all host zones commission")
+
+	// The stored host zone should be unchanged
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found)
+	s.Require().Nil(hostZone.StrideCommission, "stored commission should remain unset")
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) ICARetries(c context.Context, req *types.QueryICARetriesRequest) (*types.QueryICARetriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "host zone not found")
+	}
+
+	return &types.QueryICARetriesResponse{IcaRetries: k.GetAllHostZoneICARetries(ctx, req.ChainId)}, nil
+}
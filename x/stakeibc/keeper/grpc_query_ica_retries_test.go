@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestICARetriesQuery() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+
+	expectedRetries := []types.ICARetry{
+		{ChainId: HostChainId, Operation: types.ICARetry_DELEGATE, RecordId: 1, Attempts: 1},
+		{ChainId: HostChainId, Operation: types.ICARetry_DELEGATE, RecordId: 2, Attempts: 6, Status: types.ICARetry_DEAD_LETTER},
+		{ChainId: HostChainId, Operation: types.ICARetry_UNDELEGATE, Attempts: 2},
+	}
+	for _, icaRetry := range expectedRetries {
+		s.App.StakeibcKeeper.SetICARetry(s.Ctx, icaRetry)
+	}
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, types.ICARetry{ChainId: "different_host_zone", Operation: types.ICARetry_REINVEST})
+
+	resp, err := s.App.StakeibcKeeper.ICARetries(sdk.WrapSDKContext(s.Ctx), &types.QueryICARetriesRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedRetries, resp.IcaRetries, "ica retries")
+}
+
+func (s *KeeperTestSuite) TestICARetriesQuery_InvalidRequest() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.ICARetries(ctx, nil)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = s.App.StakeibcKeeper.ICARetries(ctx, &types.QueryICARetriesRequest{ChainId: "fake_host_zone"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "host zone not found"))
+}
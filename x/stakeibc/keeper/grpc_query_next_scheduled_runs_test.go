@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestNextScheduledRunsQuery() {
+	s.SetupNextScheduledRuns()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	resp, err := s.App.StakeibcKeeper.NextScheduledRuns(ctx, &types.QueryNextScheduledRunsRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Len(resp.ScheduledRuns, 5, "number of scheduled runs")
+	s.Require().Equal(types.ScheduledTaskDelegate, resp.ScheduledRuns[1].Task, "delegate task")
+	s.Require().Equal(uint64(8), resp.ScheduledRuns[1].NextEpochNumber, "delegate next epoch")
+}
+
+func (s *KeeperTestSuite) TestNextScheduledRunsQuery_InvalidRequest() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.NextScheduledRuns(ctx, nil)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = s.App.StakeibcKeeper.NextScheduledRuns(ctx, &types.QueryNextScheduledRunsRequest{ChainId: "fake_host_zone"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "host zone not found"))
+}
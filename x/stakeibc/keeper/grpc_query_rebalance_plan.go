@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) RebalancePlan(c context.Context, req *types.QueryRebalancePlanRequest) (*types.QueryRebalancePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "host zone not found")
+	}
+
+	rebalancings, err := k.GetRebalancePlan(ctx, hostZone, req.MaxRebalancings)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryRebalancePlanResponse{
+		Rebalancings:         rebalancings,
+		PendingRedelegations: k.GetActivePendingRedelegations(ctx, req.ChainId),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestRebalancePlanQuery() {
+	s.SetupRebalancePlan()
+	s.AddPendingRedelegation("stride_VAL2", "stride_VAL3", s.Ctx.BlockTime().Add(time.Hour))
+	s.AddPendingRedelegation("stride_VAL2", "stride_VAL4", s.Ctx.BlockTime().Add(-time.Hour))
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	resp, err := s.App.StakeibcKeeper.RebalancePlan(ctx, &types.QueryRebalancePlanRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Rebalancings, 3, "val3 should be excluded as a source")
+	s.Require().Len(resp.PendingRedelegations, 1, "only unmatured redelegations should be returned")
+
+	// The query should not modify state
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(int64(100), hostZone.Validators[0].DelegationAmt.Int64(), "val1 delegation")
+
+	// With a limit on the number of rebalancings
+	resp, err = s.App.StakeibcKeeper.RebalancePlan(ctx, &types.QueryRebalancePlanRequest{ChainId: HostChainId, MaxRebalancings: 1})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Rebalancings, 1, "limited rebalancings")
+}
+
+func (s *KeeperTestSuite) TestRebalancePlanQuery_InvalidRequest() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.RebalancePlan(ctx, nil)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = s.App.StakeibcKeeper.RebalancePlan(ctx, &types.QueryRebalancePlanRequest{ChainId: "fake_host_zone"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "host zone not found"))
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) RedemptionRateHistory(c context.Context, req *types.QueryRedemptionRateHistoryRequest) (*types.QueryRedemptionRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "host zone not found")
+	}
+
+	records := k.GetAllRedemptionRateRecords(ctx, req.ChainId)
+	if len(records) == 0 {
+		return &types.QueryRedemptionRateHistoryResponse{TimeWeightedRedemptionRate: sdk.ZeroDec()}, nil
+	}
+
+	// Default to averaging over the full history
+	twapEpochs := req.TwapEpochs
+	if twapEpochs == 0 {
+		twapEpochs = k.GetParam(ctx, types.KeyRedemptionRateHistorySize)
+	}
+	twap, err := k.GetTimeWeightedRedemptionRate(ctx, req.ChainId, twapEpochs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionRateHistoryResponse{
+		RedemptionRateRecords:      records,
+		TimeWeightedRedemptionRate: twap,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) UnconfirmedSlashes(c context.Context, req *types.QueryUnconfirmedSlashesRequest) (*types.QueryUnconfirmedSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.ChainId == "" {
+		return &types.QueryUnconfirmedSlashesResponse{UnconfirmedSlashes: k.GetAllUnconfirmedSlashes(ctx)}, nil
+	}
+
+	if _, found := k.GetHostZone(ctx, req.ChainId); !found {
+		return nil, status.Error(codes.NotFound, "host zone not found")
+	}
+	return &types.QueryUnconfirmedSlashesResponse{UnconfirmedSlashes: k.GetAllHostZoneUnconfirmedSlashes(ctx, req.ChainId)}, nil
+}
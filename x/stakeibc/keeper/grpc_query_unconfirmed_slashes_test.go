@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestUnconfirmedSlashesQuery() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: "chain-1"})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: "chain-2"})
+
+	unconfirmedSlashes := []types.UnconfirmedSlash{
+		{ChainId: "chain-1", ValidatorAddress: "val1", Status: types.UnconfirmedSlash_PENDING_CONFIRMATION},
+		{ChainId: "chain-1", ValidatorAddress: "val2", Status: types.UnconfirmedSlash_QUARANTINED},
+		{ChainId: "chain-2", ValidatorAddress: "val1", Status: types.UnconfirmedSlash_QUARANTINED},
+	}
+	for _, unconfirmedSlash := range unconfirmedSlashes {
+		unconfirmedSlash.DelegationAmt = sdkmath.NewInt(1000)
+		unconfirmedSlash.QueriedDelegationAmt = sdkmath.NewInt(950)
+		s.App.StakeibcKeeper.SetUnconfirmedSlash(s.Ctx, unconfirmedSlash)
+	}
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	// Without a chain ID, all unconfirmed slashes should be returned
+	resp, err := s.App.StakeibcKeeper.UnconfirmedSlashes(ctx, &types.QueryUnconfirmedSlashesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.UnconfirmedSlashes, 3, "all unconfirmed slashes")
+
+	// Filtered by host zone
+	resp, err = s.App.StakeibcKeeper.UnconfirmedSlashes(ctx, &types.QueryUnconfirmedSlashesRequest{ChainId: "chain-1"})
+	s.Require().NoError(err)
+	s.Require().Len(resp.UnconfirmedSlashes, 2, "chain-1 unconfirmed slashes")
+	for _, unconfirmedSlash := range resp.UnconfirmedSlashes {
+		s.Require().Equal("chain-1", unconfirmedSlash.ChainId, "chain ID")
+	}
+
+	// Unregistered host zone
+	_, err = s.App.StakeibcKeeper.UnconfirmedSlashes(ctx, &types.QueryUnconfirmedSlashesRequest{ChainId: "chain-3"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "host zone not found"))
+
+	// Nil request
+	_, err = s.App.StakeibcKeeper.UnconfirmedSlashes(ctx, nil)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (k Keeper) ValidatorWeights(c context.Context, req *types.QueryValidatorWeightsRequest) (*types.QueryValidatorWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostZone, found := k.GetHostZone(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "host zone not found")
+	}
+
+	// Without a policy, the weights are set manually and there's nothing to explain
+	if hostZone.ValidatorWeightPolicy == nil {
+		return &types.QueryValidatorWeightsResponse{Explanations: []types.ValidatorWeightExplanation{}}, nil
+	}
+
+	explanations, err := k.ComputeValidatorWeights(ctx, hostZone)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryValidatorWeightsResponse{
+		Policy:       hostZone.ValidatorWeightPolicy,
+		Explanations: explanations,
+	}, nil
+}
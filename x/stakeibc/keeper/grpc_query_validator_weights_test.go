@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorWeightsQuery() {
+	hostZone, expectedWeights := s.SetupValidatorWeightPolicy()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	resp, err := s.App.StakeibcKeeper.ValidatorWeights(ctx, &types.QueryValidatorWeightsRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Equal(*hostZone.ValidatorWeightPolicy, *resp.Policy, "policy")
+	s.Require().Len(resp.Explanations, len(hostZone.Validators), "one explanation per validator")
+	for _, explanation := range resp.Explanations {
+		s.Require().Equal(expectedWeights[explanation.ValidatorAddress].next, explanation.NextWeight, "%s next weight", explanation.ValidatorAddress)
+	}
+
+	// The query should not modify the weights
+	hostZoneAfter, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(50), hostZoneAfter.Validators[0].Weight, "val1 weight")
+}
+
+func (s *KeeperTestSuite) TestValidatorWeightsQuery_NoPolicy() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{
+		ChainId:    HostChainId,
+		Validators: []*types.Validator{{Address: "val1", Weight: 10}},
+	})
+
+	resp, err := s.App.StakeibcKeeper.ValidatorWeights(sdk.WrapSDKContext(s.Ctx), &types.QueryValidatorWeightsRequest{ChainId: HostChainId})
+	s.Require().NoError(err)
+	s.Require().Nil(resp.Policy, "policy")
+	s.Require().Empty(resp.Explanations, "explanations")
+}
+
+func (s *KeeperTestSuite) TestValidatorWeightsQuery_InvalidRequest() {
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.StakeibcKeeper.ValidatorWeights(ctx, nil)
+	s.Require().ErrorIs(err, status.Error(codes.InvalidArgument, "invalid request"))
+
+	_, err = s.App.StakeibcKeeper.ValidatorWeights(ctx, &types.QueryValidatorWeightsRequest{ChainId: "fake_host_zone"})
+	s.Require().ErrorIs(err, status.Error(codes.NotFound, "host zone not found"))
+}
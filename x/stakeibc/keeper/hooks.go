@@ -27,19 +27,26 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		k.InitiateAllHostZoneUnbondings(ctx, epochNumber)
 		// Check previous epochs to see if unbondings finished, and sweep the tokens if so
 		k.SweepAllUnbondedTokens(ctx)
+		// Claim any unbonded tokens that have landed in the redemption account on behalf of users
+		k.AutoClaimAllUndelegatedTokens(ctx)
 		// Cleanup any records that are no longer needed
 		k.CleanupEpochUnbondingRecords(ctx, epochNumber)
 		// Create an empty unbonding record for this epoch
 		k.CreateEpochUnbondingRecord(ctx, epochNumber)
+		// Advance any host zones that are being sunset
+		k.ProcessAllHostZoneSunsets(ctx, epochNumber)
+		// Recompute validator weights on host zones with a weight policy
+		k.UpdateAllValidatorWeights(ctx)
+		// Remove any redelegations that have matured on the host
+		k.CleanupCompletedRedelegations(ctx)
+		// Refresh each host's staking params
+		k.QueryAllHostStakingParams(ctx)
 	}
 
 	// Stride Epoch - Process Deposits and Delegations
 	if epochInfo.Identifier == epochstypes.STRIDE_EPOCH {
-		// Get cadence intervals
-		redemptionRateInterval := k.GetParam(ctx, types.KeyRedemptionRateInterval)
-		depositInterval := k.GetParam(ctx, types.KeyDepositInterval)
-		delegationInterval := k.GetParam(ctx, types.KeyDelegateInterval)
-		reinvestInterval := k.GetParam(ctx, types.KeyReinvestInterval)
+		// Re-register any interchain accounts whose channel was closed, and queue up their in-flight records again
+		k.RecoverAllClosedICAs(ctx)
 
 		// Create a new deposit record for each host zone and the grab all deposit records
 		k.CreateDepositRecordsForEpoch(ctx, epochNumber)
@@ -48,25 +55,30 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochInfo epochstypes.EpochInf
 		// TODO: move this to an external function that anyone can call, so that we don't have to call it every epoch
 		k.SetWithdrawalAddress(ctx)
 
+		// Each of the following tasks only runs for the host zones whose cadence interval
+		// (either the host zone's override or the module param) divides the epoch number
+
 		// Update the redemption rate
-		if epochNumber%redemptionRateInterval == 0 {
-			k.UpdateRedemptionRates(ctx, depositRecords)
-		}
+		k.UpdateRedemptionRates(ctx, depositRecords)
 
 		// Transfer deposited funds from the controller account to the delegation account on the host zone
-		if epochNumber%depositInterval == 0 {
-			k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
-		}
+		k.TransferExistingDepositsToHostZones(ctx, epochNumber, depositRecords)
 
 		// Delegate tokens from the delegation account
-		if epochNumber%delegationInterval == 0 {
-			k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
-		}
+		k.StakeExistingDepositsOnHostZones(ctx, epochNumber, depositRecords)
 
 		// Reinvest staking rewards
-		if epochNumber%reinvestInterval == 0 { // allow a few blocks from UpdateUndelegatedBal to avoid conflicts
-			k.ReinvestRewards(ctx)
-		}
+		k.ReinvestRewards(ctx)
+
+		// Retry any delegations, undelegations or reinvestments that previously failed
+		k.ProcessICARetries(ctx)
+
+		// Progress any LSM liquid stakes that are not waiting on a callback
+		k.ProcessLSMTokenDeposits(ctx, epochNumber)
+
+		// Redelegate out of any validators that were jailed or tombstoned, and refresh each validator's status
+		k.RedelegateFromAllInactiveValidators(ctx)
+		k.QueryAllValidatorStatuses(ctx)
 	}
 	if epochInfo.Identifier == epochstypes.MINT_EPOCH {
 		k.AllocateHostZoneReward(ctx)
@@ -142,8 +154,24 @@ func (k Keeper) SetWithdrawalAddress(ctx sdk.Context) {
 func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordstypes.DepositRecord) {
 	k.Logger(ctx).Info("Updating Redemption Rates...")
 
+	// The redemption rate history is keyed by the stride epoch number
+	strideEpochTracker, strideEpochFound := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !strideEpochFound {
+		k.Logger(ctx).Error("Unable to record redemption rate history: stride epoch tracker not found")
+	}
+
 	// Update the redemption rate for each host zone
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		// Once a host zone is being sunset, its funds leave the tracked balances as they're unbonded
+		// so the redemption rate is frozen (and the sunset redemption rate is used instead)
+		if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+			continue
+		}
+
+		// Skip host zones that aren't scheduled to update their redemption rate this epoch
+		if strideEpochFound && !k.IsHostZoneIntervalDue(ctx, hostZone, types.KeyRedemptionRateInterval, strideEpochTracker.EpochNumber) {
+			continue
+		}
 
 		// Gather redemption rate components
 		stSupply := k.bankKeeper.GetSupply(ctx, types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)).Amount
@@ -154,19 +182,28 @@ func (k Keeper) UpdateRedemptionRates(ctx sdk.Context, depositRecords []recordst
 		undelegatedBalance := k.GetUndelegatedBalance(hostZone, depositRecords)
 		stakedBalance := hostZone.StakedBal
 		moduleAcctBalance := k.GetModuleAccountBalance(hostZone, depositRecords)
+		instantRedemptionBuffer := GetInstantRedemptionBuffer(hostZone)
 
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
-			"Redemption Rate Components - Undelegated Balance: %v, Staked Balance: %v, Module Account Balance: %v, stToken Supply: %v",
-			undelegatedBalance, stakedBalance, moduleAcctBalance, stSupply))
+			"Redemption Rate Components - Undelegated Balance: %v, Staked Balance: %v, Module Account Balance: %v, Instant Redemption Buffer: %v, stToken Supply: %v",
+			undelegatedBalance, stakedBalance, moduleAcctBalance, instantRedemptionBuffer, stSupply))
 
 		// Calculate the redemption rate
-		redemptionRate := (sdk.NewDecFromInt(undelegatedBalance).Add(sdk.NewDecFromInt(stakedBalance)).Add(sdk.NewDecFromInt(moduleAcctBalance))).Quo(sdk.NewDecFromInt(stSupply))
+		redemptionRate := (sdk.NewDecFromInt(undelegatedBalance).
+			Add(sdk.NewDecFromInt(stakedBalance)).
+			Add(sdk.NewDecFromInt(moduleAcctBalance)).
+			Add(sdk.NewDecFromInt(instantRedemptionBuffer))).
+			Quo(sdk.NewDecFromInt(stSupply))
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "New Redemption Rate: %v (vs Prev Rate: %v)", redemptionRate, hostZone.RedemptionRate))
 
 		// Update the host zone
 		hostZone.LastRedemptionRate = hostZone.RedemptionRate
 		hostZone.RedemptionRate = redemptionRate
 		k.SetHostZone(ctx, hostZone)
+
+		if strideEpochFound {
+			k.AddRedemptionRateToHistory(ctx, hostZone.ChainId, strideEpochTracker.EpochNumber, redemptionRate)
+		}
 	}
 }
 
@@ -203,7 +240,26 @@ func (k Keeper) GetModuleAccountBalance(hostZone types.HostZone, depositRecords
 func (k Keeper) ReinvestRewards(ctx sdk.Context) {
 	k.Logger(ctx).Info("Reinvesting tokens...")
 
+	strideEpochTracker, strideEpochFound := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		// Skip host zones that aren't scheduled to reinvest this epoch
+		if strideEpochFound && !k.IsHostZoneIntervalDue(ctx, hostZone, types.KeyReinvestInterval, strideEpochTracker.EpochNumber) {
+			continue
+		}
+
+		// Sunset host zones don't reinvest, their rewards are instead added to the sunset claimable balance,
+		// which can't change while it's being swept or restaked
+		if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE && !CanAddToSunsetClaimableBalance(hostZone) {
+			continue
+		}
+
+		// If a previous reinvestment failed to submit, it's handled by the retry queue instead
+		if k.HasICARetry(ctx, hostZone.ChainId, types.ICARetry_REINVEST, 0) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Reinvestment is queued for a retry"))
+			continue
+		}
+
 		// only process host zones once withdrawal accounts are registered
 		withdrawalAccount := hostZone.WithdrawalAccount
 		if withdrawalAccount == nil || withdrawalAccount.Address == "" {
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v5/modules/light-clients/07-tendermint/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Submits ICQs to refresh the staking params and total bonded tokens of each host zone
+func (k Keeper) QueryAllHostStakingParams(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if err := k.QueryHostStakingParamsIcq(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to submit host staking params ICQ, err: %s", err.Error()))
+		}
+		if err := k.QueryHostBondedPoolIcq(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to submit host bonded pool ICQ, err: %s", err.Error()))
+		}
+	}
+}
+
+// Returns the host's unbonding period from the light client on the given connection
+// This is available synchronously, unlike the staking params ICQ, so it can be used to validate the host zone at registration
+func (k Keeper) GetHostUnbondingPeriod(ctx sdk.Context, connectionId string) (time.Duration, error) {
+	conn, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, connectionId)
+	if !found {
+		return 0, fmt.Errorf("invalid connection id, %s not found", connectionId)
+	}
+	clientState, found := k.IBCKeeper.ClientKeeper.GetClientState(ctx, conn.ClientId)
+	if !found {
+		return 0, fmt.Errorf("client id %s not found for connection %s", conn.ClientId, connectionId)
+	}
+	client, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return 0, fmt.Errorf("invalid client state for client %s on connection %s", conn.ClientId, connectionId)
+	}
+	return client.UnbondingPeriod, nil
+}
+
+// Checks the host zone's configuration against the staking params queried from the host, flagging any mismatch
+// The unbonding frequency must be high enough that the number of concurrent unbondings stays within the
+// host's max unbonding entries, and the host denom must match the host's bond denom
+// Since the params are queried asynchronously and can change on the host after registration, a mismatch
+// is surfaced through an event rather than rejected
+func (k Keeper) CheckHostStakingParams(ctx sdk.Context, hostZone types.HostZone) {
+	hostStakingParams := hostZone.HostStakingParams
+	if hostStakingParams == nil {
+		return
+	}
+
+	mismatches := []string{}
+	if hostStakingParams.UnbondingPeriod != 0 {
+		if err := hostStakingParams.ValidateUnbondingFrequency(hostZone.UnbondingFrequency); err != nil {
+			mismatches = append(mismatches, err.Error())
+		}
+	}
+	if hostStakingParams.BondDenom != "" && hostStakingParams.BondDenom != hostZone.HostDenom {
+		mismatches = append(mismatches, fmt.Sprintf("host denom %s does not match the host's bond denom %s", hostZone.HostDenom, hostStakingParams.BondDenom))
+	}
+
+	for _, mismatch := range mismatches {
+		k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Host staking params mismatch: %s", mismatch))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHostParamsMismatch,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+				sdk.NewAttribute(types.AttributeKeyMismatchReason, mismatch),
+			),
+		)
+	}
+}
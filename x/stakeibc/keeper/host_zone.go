@@ -61,10 +61,12 @@ func (k Keeper) GetHostZoneFromTransferChannelID(ctx sdk.Context, channelID stri
 	return types.HostZone{}, false
 }
 
-// RemoveHostZone removes a hostZone from the store
+// RemoveHostZone removes a hostZone and its redemption rate history from the store
 func (k Keeper) RemoveHostZone(ctx sdk.Context, chain_id string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.HostZoneKey))
 	store.Delete([]byte(chain_id))
+
+	k.RemoveRedemptionRateHistory(ctx, chain_id)
 }
 
 // GetAllHostZone returns all hostZone
@@ -165,10 +167,13 @@ func (k Keeper) AddValidatorToHostZone(ctx sdk.Context, chainId string, validato
 
 	// Finally, add the validator to the host
 	hostZone.Validators = append(hostZone.Validators, &types.Validator{
-		Name:          validator.Name,
-		Address:       validator.Address,
-		Weight:        valWeight,
-		DelegationAmt: sdkmath.ZeroInt(),
+		Name:                   validator.Name,
+		Address:                validator.Address,
+		Weight:                 valWeight,
+		DelegationAmt:          sdkmath.ZeroInt(),
+		Tokens:                 sdkmath.ZeroInt(),
+		DirectedDelegationAmt:  sdkmath.ZeroInt(),
+		UndelegatedDirectedAmt: sdkmath.ZeroInt(),
 	})
 
 	k.SetHostZone(ctx, hostZone)
@@ -212,6 +217,16 @@ func GetValidatorFromAddress(validators []*types.Validator, address string) (val
 	return types.Validator{}, 0, false
 }
 
+// Get a validator and its index from a list of validators, by consensus address
+func GetValidatorFromConsensusAddress(validators []*types.Validator, consensusAddress string) (val types.Validator, index int64, found bool) {
+	for i, v := range validators {
+		if v.ConsensusAddress == consensusAddress {
+			return *v, int64(i), true
+		}
+	}
+	return types.Validator{}, 0, false
+}
+
 // GetHostZoneFromIBCDenom returns a HostZone from a IBCDenom
 func (k Keeper) GetHostZoneFromIBCDenom(ctx sdk.Context, denom string) (*types.HostZone, error) {
 	var matchZone types.HostZone
@@ -267,3 +282,18 @@ func (k Keeper) GetRedemptionAccount(ctx sdk.Context, hostZone types.HostZone) (
 	}
 	return hostZone.RedemptionAccount, true
 }
+
+// Returns the fraction of staking rewards taken as the stride commission for a host zone
+// Host zones without a commission override fall back to the (percentage) StrideCommission param
+func (k Keeper) GetStrideCommission(ctx sdk.Context, hostZone types.HostZone) sdk.Dec {
+	if hostZone.StrideCommission != nil && !hostZone.StrideCommission.IsNil() {
+		return *hostZone.StrideCommission
+	}
+	return k.GetDefaultStrideCommission(ctx)
+}
+
+// Converts the StrideCommission param from a whole percentage to a fraction
+func (k Keeper) GetDefaultStrideCommission(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	return sdk.NewDecFromInt(sdkmath.NewIntFromUint64(params.StrideCommission)).Quo(sdk.NewDec(100))
+}
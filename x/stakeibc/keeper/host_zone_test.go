@@ -24,6 +24,11 @@ func createNHostZone(keeper *keeper.Keeper, ctx sdk.Context, n int) []types.Host
 		items[i].MinRedemptionRate = sdk.NewDecWithPrec(5, 1)
 		items[i].MaxRedemptionRate = sdk.NewDecWithPrec(15, 1)
 		items[i].StakedBal = sdkmath.ZeroInt()
+		items[i].InstantRedemptionBuffer = sdkmath.ZeroInt()
+		items[i].SunsetRedemptionRate = sdk.ZeroDec()
+		items[i].SunsetClaimableBalance = sdkmath.ZeroInt()
+		strideCommission := sdk.NewDecWithPrec(1, 1)
+		items[i].StrideCommission = &strideCommission
 		keeper.SetHostZone(ctx, items[i])
 	}
 	return items
@@ -179,3 +184,18 @@ func (s *KeeperTestSuite) TestGetHostZoneFromTransferChannelID() {
 	_, found := s.App.StakeibcKeeper.GetHostZoneFromTransferChannelID(s.Ctx, "fake_channel")
 	s.Require().False(found, "fake channel should not be found")
 }
+
+func (s *KeeperTestSuite) TestGetStrideCommission() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.StrideCommission = 10
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Without an override, the commission falls back to the param
+	hostZone := types.HostZone{ChainId: HostChainId}
+	s.Require().Equal(sdk.NewDecWithPrec(1, 1).String(), s.App.StakeibcKeeper.GetStrideCommission(s.Ctx, hostZone).String(), "fallback commission")
+
+	// With an override, the host zone's commission is used
+	strideCommission := sdk.MustNewDecFromStr("0.075")
+	hostZone.StrideCommission = &strideCommission
+	s.Require().Equal("0.075000000000000000", s.App.StakeibcKeeper.GetStrideCommission(s.Ctx, hostZone).String(), "host zone commission")
+}
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochstypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetICARecovery set a specific icaRecovery in the store from its index
+func (k Keeper) SetICARecovery(ctx sdk.Context, icaRecovery types.ICARecovery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	b := k.cdc.MustMarshal(&icaRecovery)
+	store.Set(types.ICARecoveryKey(icaRecovery.ChainId, icaRecovery.AccountType), b)
+}
+
+// GetICARecovery returns an icaRecovery from its index
+func (k Keeper) GetICARecovery(ctx sdk.Context, chainId string, accountType types.ICAAccountType) (val types.ICARecovery, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+
+	b := store.Get(types.ICARecoveryKey(chainId, accountType))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveICARecovery removes an icaRecovery from the store
+func (k Keeper) RemoveICARecovery(ctx sdk.Context, chainId string, accountType types.ICAAccountType) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	store.Delete(types.ICARecoveryKey(chainId, accountType))
+}
+
+// GetAllICARecoveries returns all icaRecoveries
+func (k Keeper) GetAllICARecoveries(ctx sdk.Context) (list []types.ICARecovery) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARecoveryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ICARecovery
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Emits an event for an interchain account recovery
+func (k Keeper) emitICARecoveryEvent(ctx sdk.Context, icaRecovery types.ICARecovery, recoveryStatus string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeICARecovery,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, icaRecovery.ChainId),
+			sdk.NewAttribute(types.AttributeKeyICAAccountType, icaRecovery.AccountType.String()),
+			sdk.NewAttribute(types.AttributeKeyICAChannelId, icaRecovery.ClosedChannelId),
+			sdk.NewAttribute(types.AttributeKeyICAAttempts, fmt.Sprintf("%d", icaRecovery.Attempts)),
+			sdk.NewAttribute(types.AttributeKeyICARecovery, recoveryStatus),
+		),
+	)
+}
+
+// Registers a new channel for an existing interchain account on the host zone
+// This can only be called once the account's previous channel has been closed
+func (k Keeper) ReregisterInterchainAccount(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	// Get ConnectionEnd (for counterparty connection)
+	connectionEnd, found := k.IBCKeeper.ConnectionKeeper.GetConnection(ctx, hostZone.ConnectionId)
+	if !found {
+		errMsg := fmt.Sprintf("invalid connection id from host %s, %s not found", hostZone.ChainId, hostZone.ConnectionId)
+		k.Logger(ctx).Error(errMsg)
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, errMsg)
+	}
+	counterpartyConnection := connectionEnd.Counterparty
+
+	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+		Version:                icatypes.Version,
+		ControllerConnectionId: hostZone.ConnectionId,
+		HostConnectionId:       counterpartyConnection.ConnectionId,
+		Encoding:               icatypes.EncodingProtobuf,
+		TxType:                 icatypes.TxTypeSDKMultiMsg,
+	}))
+
+	owner := types.FormatICAAccountOwner(hostZone.ChainId, accountType)
+	if err := k.ICAControllerKeeper.RegisterInterchainAccount(ctx, hostZone.ConnectionId, owner, appVersion); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to register %s account : %s", accountType.String(), err))
+		return err
+	}
+
+	return nil
+}
+
+// Reverts the records that were in flight on an interchain account's closed channel back to their queued
+// state so that they're picked up again once the account is restored
+//   - Delegation: DELEGATION_IN_PROGRESS deposits, and UNBONDING_IN_PROGRESS and EXIT_TRANSFER_IN_PROGRESS unbondings
+//   - Redemption: pending claims on user redemption records
+func (k Keeper) ResetInFlightICARecords(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType) error {
+	switch accountType {
+	case types.ICAAccountType_DELEGATION:
+		return k.resetInFlightDelegationRecords(ctx, hostZone)
+	case types.ICAAccountType_REDEMPTION:
+		k.resetInFlightClaims(ctx, hostZone)
+	}
+	return nil
+}
+
+// Reverts the deposit and unbonding records that were in flight on the delegation ICA
+func (k Keeper) resetInFlightDelegationRecords(ctx sdk.Context, hostZone types.HostZone) error {
+	// revert DELEGATION_IN_PROGRESS records for the closed ICA channel (so that they can be staked)
+	depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+	for _, depositRecord := range depositRecords {
+		// only revert records for the select host zone
+		if depositRecord.HostZoneId == hostZone.ChainId && depositRecord.Status == recordtypes.DepositRecord_DELEGATION_IN_PROGRESS {
+			depositRecord.Status = recordtypes.DepositRecord_DELEGATION_QUEUE
+			k.Logger(ctx).Info(fmt.Sprintf("Setting DepositRecord %d to status DepositRecord_DELEGATION_QUEUE", depositRecord.Id))
+			k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		}
+	}
+
+	// revert epoch unbonding records for the closed ICA channel
+	epochUnbondingRecords := k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx)
+	epochNumberForPendingUnbondingRecords := []uint64{}
+	epochNumberForPendingTransferRecords := []uint64{}
+	for _, epochUnbondingRecord := range epochUnbondingRecords {
+		// only revert records for the select host zone
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if !found {
+			k.Logger(ctx).Info(fmt.Sprintf("No HostZoneUnbonding found for chainId: %s, epoch: %d", hostZone.ChainId, epochUnbondingRecord.EpochNumber))
+			continue
+		}
+
+		// Revert UNBONDING_IN_PROGRESS and EXIT_TRANSFER_IN_PROGRESS records
+		if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS {
+			k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d is stuck in status %s",
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS.String(),
+			))
+			epochNumberForPendingUnbondingRecords = append(epochNumberForPendingUnbondingRecords, epochUnbondingRecord.EpochNumber)
+
+		} else if hostZoneUnbonding.Status == recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS {
+			k.Logger(ctx).Info(fmt.Sprintf("HostZoneUnbonding for %s at EpochNumber %d to in status %s",
+				hostZone.ChainId, epochUnbondingRecord.EpochNumber, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_IN_PROGRESS.String(),
+			))
+			epochNumberForPendingTransferRecords = append(epochNumberForPendingTransferRecords, epochUnbondingRecord.EpochNumber)
+		}
+	}
+	// Revert UNBONDING_IN_PROGRESS records to UNBONDING_QUEUE
+	err := k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone.ChainId, epochNumberForPendingUnbondingRecords, recordtypes.HostZoneUnbonding_UNBONDING_QUEUE)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to update host zone unbonding record status to %s for chainId: %s and epochUnbondingRecordIds: %v, err: %s",
+			recordtypes.HostZoneUnbonding_UNBONDING_QUEUE.String(), hostZone.ChainId, epochNumberForPendingUnbondingRecords, err)
+		k.Logger(ctx).Error(errMsg)
+		return err
+	}
+
+	// Revert EXIT_TRANSFER_IN_PROGRESS records to EXIT_TRANSFER_QUEUE
+	err = k.RecordsKeeper.SetHostZoneUnbondings(ctx, hostZone.ChainId, epochNumberForPendingTransferRecords, recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to update host zone unbonding record status to %s for chainId: %s and epochUnbondingRecordIds: %v, err: %s",
+			recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE.String(), hostZone.ChainId, epochNumberForPendingTransferRecords, err)
+		k.Logger(ctx).Error(errMsg)
+		return err
+	}
+
+	return nil
+}
+
+// Clears the pending flag on user redemption records whose claim was in flight on the redemption ICA,
+// so that the claim can be retried
+func (k Keeper) resetInFlightClaims(ctx sdk.Context, hostZone types.HostZone) {
+	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		if userRedemptionRecord.HostZoneId == hostZone.ChainId && userRedemptionRecord.ClaimIsPending {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Resetting pending claim for UserRedemptionRecord %s", userRedemptionRecord.Id))
+			userRedemptionRecord.ClaimIsPending = false
+			k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+		}
+	}
+}
+
+// Checks the controller channel of each host zone's interchain accounts, and automatically re-registers
+// any account whose channel was closed (e.g. after a packet timeout on the ordered channel)
+// Re-registrations are retried with an exponential backoff (in stride epochs) until the new channel opens
+func (k Keeper) RecoverAllClosedICAs(ctx sdk.Context) {
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		k.Logger(ctx).Error("Unable to recover closed ICAs: stride epoch tracker not found")
+		return
+	}
+
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		for _, accountType := range types.HostZoneICAAccountTypes {
+			if err := k.RecoverClosedICA(ctx, hostZone, accountType, strideEpochTracker.EpochNumber); err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+					"Unable to recover %s ICA: %s", accountType.String(), err.Error()))
+			}
+		}
+	}
+}
+
+// Checks the controller channel of one of the host zone's interchain accounts
+//   - If the channel is open, any existing recovery is completed
+//   - If the channel is closed, in-flight records are reset and the account is re-registered
+//     if the backoff since the last attempt has elapsed
+func (k Keeper) RecoverClosedICA(ctx sdk.Context, hostZone types.HostZone, accountType types.ICAAccountType, epochNumber uint64) error {
+	owner := types.FormatICAAccountOwner(hostZone.ChainId, accountType)
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	// Accounts without an active channel have never been opened, so there's nothing to recover
+	channelID, found := k.ICAControllerKeeper.GetActiveChannelID(ctx, hostZone.ConnectionId, portID)
+	if !found {
+		return nil
+	}
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", portID, channelID)
+	}
+
+	icaRecovery, recoveryInProgress := k.GetICARecovery(ctx, hostZone.ChainId, accountType)
+
+	// Once the new channel is open, the recovery is complete
+	if channel.State == channeltypes.OPEN {
+		if recoveryInProgress {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "%s ICA recovered on %s", accountType.String(), channelID))
+			k.RemoveICARecovery(ctx, hostZone.ChainId, accountType)
+			k.emitICARecoveryEvent(ctx, icaRecovery, types.AttributeValueICARecovered)
+		}
+		return nil
+	}
+	if channel.State != channeltypes.CLOSED {
+		return nil
+	}
+
+	if !recoveryInProgress {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "%s ICA channel %s is closed", accountType.String(), channelID))
+		icaRecovery = types.ICARecovery{
+			ChainId:          hostZone.ChainId,
+			AccountType:      accountType,
+			ClosedChannelId:  channelID,
+			DetectedEpoch:    epochNumber,
+			NextAttemptEpoch: epochNumber,
+		}
+	}
+
+	// Wait for the backoff to elapse before registering another channel
+	if epochNumber < icaRecovery.NextAttemptEpoch {
+		return nil
+	}
+
+	// Nothing can be acknowledged on the closed channel, so any records that were in flight are queued up again
+	if err := k.ResetInFlightICARecords(ctx, hostZone, accountType); err != nil {
+		return err
+	}
+
+	// Even if the registration fails, the attempt counts towards the backoff so that it's not retried every epoch
+	icaRecovery.ClosedChannelId = channelID
+	icaRecovery.Attempts++
+	icaRecovery.NextAttemptEpoch = epochNumber + types.GetICARecoveryBackoff(icaRecovery.Attempts)
+	k.SetICARecovery(ctx, icaRecovery)
+
+	// The registration is attempted in a cached context so that a failure doesn't leave a partially opened channel
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.ReregisterInterchainAccount(cacheCtx, hostZone, accountType); err != nil {
+		return err
+	}
+	writeCache()
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Re-registered %s ICA (attempt %d), next attempt in epoch %d",
+		accountType.String(), icaRecovery.Attempts, icaRecovery.NextAttemptEpoch))
+	k.emitICARecoveryEvent(ctx, icaRecovery, types.AttributeValueICAReregistered)
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type ICARecoveryTestCase struct {
+	restoreTestCase RestoreInterchainAccountTestCase
+	portID          string
+	channelID       string
+}
+
+func (s *KeeperTestSuite) SetupICARecovery() ICARecoveryTestCase {
+	tc := s.SetupRestoreInterchainAccount()
+
+	owner := types.FormatICAAccountOwner(HostChainId, types.ICAAccountType_DELEGATION)
+	channelID := s.CreateICAChannel(owner)
+	portID := icatypes.PortPrefix + owner
+
+	s.SetICARecoveryEpoch(10)
+
+	return ICARecoveryTestCase{
+		restoreTestCase: tc,
+		portID:          portID,
+		channelID:       channelID,
+	}
+}
+
+func (s *KeeperTestSuite) SetICARecoveryEpoch(epochNumber uint64) {
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     epochNumber,
+	})
+}
+
+func (s *KeeperTestSuite) SetICAChannelState(portID, channelID string, state channeltypes.State) {
+	channel, found := s.App.IBCKeeper.ChannelKeeper.GetChannel(s.Ctx, portID, channelID)
+	s.Require().True(found, "channel found")
+	channel.State = state
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, portID, channelID, channel)
+}
+
+func (s *KeeperTestSuite) CountICARecoveryEvents(recoveryStatus string) int {
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeICARecovery {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == types.AttributeKeyICARecovery && string(attribute.Value) == recoveryStatus {
+				numEvents++
+			}
+		}
+	}
+	return numEvents
+}
+
+func (s *KeeperTestSuite) TestGetICARecoveryBackoff() {
+	expectedBackoffs := map[uint64]uint64{1: 1, 2: 2, 3: 4, 4: 8, 7: 64, 8: 64, 100: 64}
+	for attempts, expectedBackoff := range expectedBackoffs {
+		s.Require().Equal(expectedBackoff, types.GetICARecoveryBackoff(attempts), "backoff after %d attempts", attempts)
+	}
+}
+
+func (s *KeeperTestSuite) TestRecoverAllClosedICAs_ChannelOpen() {
+	tc := s.SetupICARecovery()
+
+	s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+
+	// Nothing should change while the channel is open
+	s.Require().Len(s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx), 2, "no channel should be created")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARecoveries(s.Ctx), 0, "no recoveries")
+	s.VerifyDepositRecordsStatus(tc.restoreTestCase.depositRecordStatusUpdates, false)
+	s.VerifyHostZoneUnbondingStatus(tc.restoreTestCase.unbondingRecordStatusUpdate, false)
+}
+
+func (s *KeeperTestSuite) TestRecoverAllClosedICAs_ChannelClosed() {
+	tc := s.SetupICARecovery()
+	s.SetICAChannelState(tc.portID, tc.channelID, channeltypes.CLOSED)
+
+	s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+
+	// A new channel should be opened and the in-flight records should be reverted
+	s.Require().Len(s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx), 3, "a new channel should be created")
+	s.VerifyDepositRecordsStatus(tc.restoreTestCase.depositRecordStatusUpdates, true)
+	s.VerifyHostZoneUnbondingStatus(tc.restoreTestCase.unbondingRecordStatusUpdate, true)
+
+	icaRecovery, found := s.App.StakeibcKeeper.GetICARecovery(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery should be stored")
+	s.Require().Equal(types.ICARecovery{
+		ChainId:          HostChainId,
+		AccountType:      types.ICAAccountType_DELEGATION,
+		ClosedChannelId:  tc.channelID,
+		DetectedEpoch:    10,
+		Attempts:         1,
+		NextAttemptEpoch: 11,
+	}, icaRecovery, "ica recovery")
+	s.Require().Equal(1, s.CountICARecoveryEvents(types.AttributeValueICAReregistered), "number of re-registration events")
+}
+
+func (s *KeeperTestSuite) TestRecoverAllClosedICAs_Backoff() {
+	tc := s.SetupICARecovery()
+	s.SetICAChannelState(tc.portID, tc.channelID, channeltypes.CLOSED)
+
+	// First attempt in epoch 10
+	s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+	s.Require().Len(s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx), 3, "channels after first attempt")
+
+	// The new channel never opens, so the account should be registered again in epoch 11 (after 1 epoch)
+	// and then in epoch 13 (after 2 epochs)
+	expectedChannels := map[uint64]int{10: 3, 11: 4, 12: 4, 13: 5}
+	for _, epochNumber := range []uint64{10, 11, 12, 13} {
+		s.SetICARecoveryEpoch(epochNumber)
+		s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+		s.Require().Len(s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx), expectedChannels[epochNumber],
+			"number of channels in epoch %d", epochNumber)
+	}
+
+	icaRecovery, found := s.App.StakeibcKeeper.GetICARecovery(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery should be stored")
+	s.Require().Equal(uint64(3), icaRecovery.Attempts, "attempts")
+	s.Require().Equal(uint64(17), icaRecovery.NextAttemptEpoch, "next attempt epoch")
+}
+
+func (s *KeeperTestSuite) TestRecoverAllClosedICAs_Recovered() {
+	tc := s.SetupICARecovery()
+	s.SetICAChannelState(tc.portID, tc.channelID, channeltypes.CLOSED)
+
+	s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+	_, found := s.App.StakeibcKeeper.GetICARecovery(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().True(found, "recovery should be stored")
+
+	// Once the active channel is open again, the recovery should be removed
+	s.SetICAChannelState(tc.portID, tc.channelID, channeltypes.OPEN)
+	s.SetICARecoveryEpoch(11)
+	s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+
+	_, found = s.App.StakeibcKeeper.GetICARecovery(s.Ctx, HostChainId, types.ICAAccountType_DELEGATION)
+	s.Require().False(found, "recovery should be removed")
+	s.Require().Len(s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx), 3, "no additional channel should be created")
+	s.Require().Equal(1, s.CountICARecoveryEvents(types.AttributeValueICARecovered), "number of recovered events")
+}
+
+func (s *KeeperTestSuite) TestRecoverAllClosedICAs_HaltedHostZone() {
+	tc := s.SetupICARecovery()
+	s.SetICAChannelState(tc.portID, tc.channelID, channeltypes.CLOSED)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.RecoverAllClosedICAs(s.Ctx)
+
+	s.Require().Len(s.App.IBCKeeper.ChannelKeeper.GetAllChannels(s.Ctx), 2, "no channel should be created")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARecoveries(s.Ctx), 0, "no recoveries")
+}
+
+func (s *KeeperTestSuite) TestResetInFlightICARecords_Redemption() {
+	hostZone := types.HostZone{ChainId: HostChainId}
+	userRedemptionRecords := []recordtypes.UserRedemptionRecord{
+		{Id: "pending", HostZoneId: HostChainId, ClaimIsPending: true, Amount: sdkmath.NewInt(1)},
+		{Id: "not-pending", HostZoneId: HostChainId, ClaimIsPending: false, Amount: sdkmath.NewInt(1)},
+		{Id: "different-host", HostZoneId: "different_host_zone", ClaimIsPending: true, Amount: sdkmath.NewInt(1)},
+	}
+	for _, userRedemptionRecord := range userRedemptionRecords {
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, userRedemptionRecord)
+	}
+
+	err := s.App.StakeibcKeeper.ResetInFlightICARecords(s.Ctx, hostZone, types.ICAAccountType_REDEMPTION)
+	s.Require().NoError(err, "no error expected when resetting records")
+
+	expectedPending := map[string]bool{"pending": false, "not-pending": false, "different-host": true}
+	for id, expected := range expectedPending {
+		userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, id)
+		s.Require().True(found, "user redemption record %s found", id)
+		s.Require().Equal(expected, userRedemptionRecord.ClaimIsPending, "claim is pending for %s", id)
+	}
+}
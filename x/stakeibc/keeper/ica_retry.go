@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochstypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetICARetry set a specific icaRetry in the store from its index
+func (k Keeper) SetICARetry(ctx sdk.Context, icaRetry types.ICARetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARetryKeyPrefix))
+	b := k.cdc.MustMarshal(&icaRetry)
+	store.Set(types.ICARetryKey(icaRetry.ChainId, icaRetry.Operation, icaRetry.RecordId), b)
+}
+
+// GetICARetry returns an icaRetry from its index
+func (k Keeper) GetICARetry(ctx sdk.Context, chainId string, operation types.ICARetry_Operation, recordId uint64) (val types.ICARetry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARetryKeyPrefix))
+
+	b := store.Get(types.ICARetryKey(chainId, operation, recordId))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveICARetry removes an icaRetry from the store
+func (k Keeper) RemoveICARetry(ctx sdk.Context, chainId string, operation types.ICARetry_Operation, recordId uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARetryKeyPrefix))
+	store.Delete(types.ICARetryKey(chainId, operation, recordId))
+}
+
+// GetAllICARetries returns all icaRetries
+func (k Keeper) GetAllICARetries(ctx sdk.Context) (list []types.ICARetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARetryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ICARetry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllHostZoneICARetries returns all of a host zone's icaRetries
+func (k Keeper) GetAllHostZoneICARetries(ctx sdk.Context, chainId string) (list []types.ICARetry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ICARetryKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.HostZoneKeyPrefix(chainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ICARetry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Returns true if the operation is in the retry queue, in which case it's skipped by the regular epochly flow
+func (k Keeper) HasICARetry(ctx sdk.Context, chainId string, operation types.ICARetry_Operation, recordId uint64) bool {
+	_, found := k.GetICARetry(ctx, chainId, operation, recordId)
+	return found
+}
+
+// Records a failed attempt of an ICA operation, adding it to the retry queue if it's not already there
+// The next attempt is scheduled after an exponential backoff, and after too many attempts, the operation
+// is moved to the dead letter state
+func (k Keeper) RecordFailedICAOperation(ctx sdk.Context, chainId string, operation types.ICARetry_Operation, recordId uint64, failure error) {
+	var epochNumber uint64
+	if strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH); found {
+		epochNumber = strideEpochTracker.EpochNumber
+	}
+
+	icaRetry, found := k.GetICARetry(ctx, chainId, operation, recordId)
+	if !found {
+		icaRetry = types.ICARetry{
+			ChainId:   chainId,
+			Operation: operation,
+			RecordId:  recordId,
+			Status:    types.ICARetry_PENDING,
+		}
+	}
+
+	icaRetry.Attempts++
+	icaRetry.LastError = failure.Error()
+	icaRetry.NextAttemptEpoch = epochNumber + types.GetICARetryBackoff(icaRetry.Attempts)
+
+	if icaRetry.Attempts >= types.ICARetryMaxAttempts {
+		icaRetry.Status = types.ICARetry_DEAD_LETTER
+		k.Logger(ctx).Error(utils.LogWithHostZone(chainId, "%s operation (record %d) failed %d times and will no longer be retried: %s",
+			operation.String(), recordId, icaRetry.Attempts, failure.Error()))
+	} else {
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "%s operation (record %d) failed (attempt %d), retrying in epoch %d: %s",
+			operation.String(), recordId, icaRetry.Attempts, icaRetry.NextAttemptEpoch, failure.Error()))
+	}
+
+	k.SetICARetry(ctx, icaRetry)
+}
+
+// Submits the ICA tx for an operation in the retry queue
+// Returns true if the operation no longer needs to be retried, either because the tx was submitted
+// or because there's nothing left to submit
+func (k Keeper) SubmitICARetry(ctx sdk.Context, hostZone types.HostZone, icaRetry types.ICARetry) (completed bool, err error) {
+	switch icaRetry.Operation {
+	case types.ICARetry_DELEGATE:
+		depositRecord, found := k.RecordsKeeper.GetDepositRecord(ctx, icaRetry.RecordId)
+		if !found || depositRecord.Status == recordstypes.DepositRecord_TRANSFER_QUEUE ||
+			depositRecord.Status == recordstypes.DepositRecord_TRANSFER_IN_PROGRESS {
+			return true, nil
+		}
+		// If the delegation timed out, the record can't be staked again until the channel is restored
+		if depositRecord.Status == recordstypes.DepositRecord_DELEGATION_IN_PROGRESS {
+			return false, errorsmod.Wrapf(types.ErrICATxFailed, "deposit record %d is still in progress", depositRecord.Id)
+		}
+		// Deposits of a sunset host zone are swept instead of staked, which is handled with the rest of the deposits
+		if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+			return true, nil
+		}
+		stakeAmount := sdk.NewCoin(hostZone.HostDenom, depositRecord.Amount)
+		if err := k.DelegateOnHost(ctx, hostZone, stakeAmount, depositRecord); err != nil {
+			return false, err
+		}
+		return true, nil
+
+	case types.ICARetry_UNDELEGATE:
+		// Undelegations are only retried from the day epoch on the host's unbonding days (in InitiateAllHostZoneUnbondings),
+		// since outside of that window, the current day's unbonding record is still accumulating redemptions
+		// The operation is left in the queue so that it's picked up at the next unbonding day
+		return false, nil
+
+	case types.ICARetry_REINVEST:
+		// The reinvestment tx is submitted from the withdrawal balance callback, which removes the retry
+		// once the tx is submitted (or records another failed attempt)
+		if err := k.UpdateWithdrawalBalance(ctx, hostZone); err != nil {
+			return false, err
+		}
+		return false, nil
+
+	default:
+		return false, errorsmod.Wrapf(types.ErrICATxFailed, "unknown retry operation %s", icaRetry.Operation.String())
+	}
+}
+
+// Submits an operation from the retry queue and updates the queue with the result
+func (k Keeper) processICARetry(ctx sdk.Context, hostZone types.HostZone, icaRetry types.ICARetry, epochNumber uint64) error {
+	// The tx is submitted in a cached context so that a failed attempt doesn't leave partial state behind
+	cacheCtx, writeCache := ctx.CacheContext()
+	completed, err := k.SubmitICARetry(cacheCtx, hostZone, icaRetry)
+	if err != nil {
+		k.RecordFailedICAOperation(ctx, icaRetry.ChainId, icaRetry.Operation, icaRetry.RecordId, err)
+		return err
+	}
+	writeCache()
+
+	if completed {
+		k.Logger(ctx).Info(utils.LogWithHostZone(icaRetry.ChainId, "Retried %s operation (record %d) after %d failed attempts",
+			icaRetry.Operation.String(), icaRetry.RecordId, icaRetry.Attempts))
+		k.RemoveICARetry(ctx, icaRetry.ChainId, icaRetry.Operation, icaRetry.RecordId)
+		return nil
+	}
+
+	// If the operation's result is still pending, wait for the backoff before trying again
+	icaRetry.NextAttemptEpoch = epochNumber + types.GetICARetryBackoff(icaRetry.Attempts)
+	k.SetICARetry(ctx, icaRetry)
+
+	return nil
+}
+
+// Retries each operation in the retry queue whose backoff has elapsed
+// Operations in the dead letter state are only retried when forced by an admin
+// Undelegations are skipped, as they're retried by the regular unbonding flow on the host's next unbonding day
+func (k Keeper) ProcessICARetries(ctx sdk.Context) {
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		k.Logger(ctx).Error("Unable to process ICA retries: stride epoch tracker not found")
+		return
+	}
+	epochNumber := strideEpochTracker.EpochNumber
+
+	for _, icaRetry := range k.GetAllICARetries(ctx) {
+		if icaRetry.Status == types.ICARetry_DEAD_LETTER || epochNumber < icaRetry.NextAttemptEpoch {
+			continue
+		}
+
+		hostZone, found := k.GetHostZone(ctx, icaRetry.ChainId)
+		if !found {
+			k.RemoveICARetry(ctx, icaRetry.ChainId, icaRetry.Operation, icaRetry.RecordId)
+			continue
+		}
+		// Undelegations are retried from the day epoch instead
+		if hostZone.Halted || icaRetry.Operation == types.ICARetry_UNDELEGATE {
+			continue
+		}
+
+		if err := k.processICARetry(ctx, hostZone, icaRetry, epochNumber); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to retry %s operation (record %d) on %s: %s",
+				icaRetry.Operation.String(), icaRetry.RecordId, icaRetry.ChainId, err.Error()))
+		}
+	}
+}
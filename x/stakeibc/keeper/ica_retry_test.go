@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"errors"
+
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestGetICARetryBackoff() {
+	expectedBackoffs := map[uint64]uint64{1: 1, 2: 2, 3: 4, 4: 8, 5: 16, 6: 16, 100: 16}
+	for attempts, expectedBackoff := range expectedBackoffs {
+		s.Require().Equal(expectedBackoff, types.GetICARetryBackoff(attempts), "backoff after %d attempts", attempts)
+	}
+}
+
+func (s *KeeperTestSuite) TestRecordFailedICAOperation() {
+	s.SetICARecoveryEpoch(10)
+
+	// Each failure should increment the attempts and push back the next attempt
+	expectedNextAttemptEpochs := []uint64{11, 12, 14, 18, 26}
+	for i, expectedNextAttemptEpoch := range expectedNextAttemptEpochs {
+		s.App.StakeibcKeeper.RecordFailedICAOperation(s.Ctx, HostChainId, types.ICARetry_DELEGATE, 1, errors.New("failed"))
+
+		icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, types.ICARetry_DELEGATE, 1)
+		s.Require().True(found, "retry should be stored after attempt %d", i+1)
+		s.Require().Equal(types.ICARetry{
+			ChainId:          HostChainId,
+			Operation:        types.ICARetry_DELEGATE,
+			RecordId:         1,
+			Status:           types.ICARetry_PENDING,
+			Attempts:         uint64(i + 1),
+			NextAttemptEpoch: expectedNextAttemptEpoch,
+			LastError:        "failed",
+		}, icaRetry, "ica retry after attempt %d", i+1)
+	}
+
+	// After the max number of attempts, the operation should be moved to the dead letter state
+	s.App.StakeibcKeeper.RecordFailedICAOperation(s.Ctx, HostChainId, types.ICARetry_DELEGATE, 1, errors.New("failed again"))
+
+	icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, types.ICARetry_DELEGATE, 1)
+	s.Require().True(found, "retry should be stored")
+	s.Require().Equal(types.ICARetryMaxAttempts, icaRetry.Attempts, "attempts")
+	s.Require().Equal(types.ICARetry_DEAD_LETTER, icaRetry.Status, "status")
+	s.Require().Equal("failed again", icaRetry.LastError, "last error")
+
+	// Retries from other host zones and operations should not be affected
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARetries(s.Ctx), 1, "number of retries")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllHostZoneICARetries(s.Ctx, "different_host_zone"), 0, "retries on different host")
+}
+
+func (s *KeeperTestSuite) TestProcessICARetries_Delegate() {
+	tc := s.SetupDepositRecords()
+	depositRecord := tc.initialDepositRecords.recordsToBeStaked[0]
+
+	// Queue the first record for a retry
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, types.ICARetry{
+		ChainId:          HostChainId,
+		Operation:        types.ICARetry_DELEGATE,
+		RecordId:         depositRecord.Id,
+		Attempts:         1,
+		NextAttemptEpoch: tc.epochNumber,
+	})
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.DelegationChannel.PortID, tc.DelegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found before retry")
+
+	s.App.StakeibcKeeper.ProcessICARetries(s.Ctx)
+
+	// The delegation should be submitted and the retry should be removed
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.DelegationChannel.PortID, tc.DelegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found after retry")
+	s.Require().Equal(startSequence+1, endSequence, "tx sequence number after retry")
+
+	updatedRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().True(found, "deposit record found")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, updatedRecord.Status, "deposit record status")
+
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARetries(s.Ctx), 0, "retry should be removed")
+}
+
+func (s *KeeperTestSuite) TestProcessICARetries_Failed() {
+	tc := s.SetupDepositRecords()
+	depositRecord := tc.initialDepositRecords.recordsToBeStaked[0]
+
+	// Remove the delegation account so that the retry fails
+	hostZone := tc.hostZone
+	hostZone.DelegationAccount = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, types.ICARetry{
+		ChainId:          HostChainId,
+		Operation:        types.ICARetry_DELEGATE,
+		RecordId:         depositRecord.Id,
+		Attempts:         1,
+		NextAttemptEpoch: tc.epochNumber,
+	})
+
+	s.App.StakeibcKeeper.ProcessICARetries(s.Ctx)
+
+	// The failed attempt should be recorded, and the deposit record should be left untouched
+	icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, types.ICARetry_DELEGATE, depositRecord.Id)
+	s.Require().True(found, "retry should still be stored")
+	s.Require().Equal(uint64(2), icaRetry.Attempts, "attempts")
+	s.Require().Equal(tc.epochNumber+2, icaRetry.NextAttemptEpoch, "next attempt epoch")
+	s.Require().Contains(icaRetry.LastError, "Invalid delegation account", "last error")
+
+	updatedRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().True(found, "deposit record found")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE, updatedRecord.Status, "deposit record status")
+}
+
+func (s *KeeperTestSuite) TestProcessICARetries_Skipped() {
+	tc := s.SetupDepositRecords()
+	recordsToBeStaked := tc.initialDepositRecords.recordsToBeStaked
+
+	// Neither the dead letter retry nor the retry that isn't due yet should be submitted
+	icaRetries := []types.ICARetry{
+		{
+			ChainId:          HostChainId,
+			Operation:        types.ICARetry_DELEGATE,
+			RecordId:         recordsToBeStaked[0].Id,
+			Status:           types.ICARetry_DEAD_LETTER,
+			Attempts:         types.ICARetryMaxAttempts,
+			NextAttemptEpoch: tc.epochNumber,
+		},
+		{
+			ChainId:          HostChainId,
+			Operation:        types.ICARetry_DELEGATE,
+			RecordId:         recordsToBeStaked[1].Id,
+			Attempts:         2,
+			NextAttemptEpoch: tc.epochNumber + 1,
+		},
+	}
+	for _, icaRetry := range icaRetries {
+		s.App.StakeibcKeeper.SetICARetry(s.Ctx, icaRetry)
+	}
+
+	s.App.StakeibcKeeper.ProcessICARetries(s.Ctx)
+
+	s.Require().ElementsMatch(icaRetries, s.App.StakeibcKeeper.GetAllICARetries(s.Ctx), "retries should be unchanged")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+}
+
+func (s *KeeperTestSuite) TestProcessICARetries_NothingToRetry() {
+	s.SetupDepositRecords()
+
+	// The first retry's record no longer exists, and the second retry's host zone no longer exists
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, types.ICARetry{ChainId: HostChainId, Operation: types.ICARetry_DELEGATE, RecordId: 1000})
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, types.ICARetry{ChainId: "fake_host_zone", Operation: types.ICARetry_UNDELEGATE})
+
+	s.App.StakeibcKeeper.ProcessICARetries(s.Ctx)
+
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARetries(s.Ctx), 0, "retries should be removed")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+}
+
+func (s *KeeperTestSuite) TestStakeDepositRecords_SkipsQueuedRetries() {
+	tc := s.SetupDepositRecords()
+	recordsToBeStaked := tc.initialDepositRecords.recordsToBeStaked
+
+	// Queue the last record for a retry, it should be skipped by the regular flow
+	lastRecord := recordsToBeStaked[len(recordsToBeStaked)-1]
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, types.ICARetry{
+		ChainId:          HostChainId,
+		Operation:        types.ICARetry_DELEGATE,
+		RecordId:         lastRecord.Id,
+		NextAttemptEpoch: tc.epochNumber + 1,
+	})
+
+	numSkipped := 1
+	s.CheckStateAfterStakingDepositRecords(tc, numSkipped)
+}
+
+func (s *KeeperTestSuite) TestStakeDepositRecords_QueuesFailedDelegations() {
+	tc := s.SetupDepositRecords()
+
+	// Zero out the validator weights so that each delegation fails
+	hostZone := tc.hostZone
+	for _, validator := range hostZone.Validators {
+		validator.Weight = 0
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.StakeibcKeeper.StakeExistingDepositsOnHostZones(s.Ctx, tc.epochNumber, tc.initialDepositRecords.GetAllRecords())
+
+	// Each failed delegation should be added to the retry queue
+	for _, depositRecord := range tc.initialDepositRecords.recordsToBeStaked {
+		icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, types.ICARetry_DELEGATE, depositRecord.Id)
+		s.Require().True(found, "retry should be queued for record %d", depositRecord.Id)
+		s.Require().Equal(uint64(1), icaRetry.Attempts, "attempts for record %d", depositRecord.Id)
+		s.Require().Equal(tc.epochNumber+1, icaRetry.NextAttemptEpoch, "next attempt for record %d", depositRecord.Id)
+		s.Require().Contains(icaRetry.LastError, types.ErrNoValidatorWeights.Error(), "last error for record %d", depositRecord.Id)
+	}
+}
@@ -8,12 +8,19 @@ import (
 )
 
 const (
-	ICACallbackID_Delegate   = "delegate"
-	ICACallbackID_Claim      = "claim"
-	ICACallbackID_Undelegate = "undelegate"
-	ICACallbackID_Reinvest   = "reinvest"
-	ICACallbackID_Redemption = "redemption"
-	ICACallbackID_Rebalance  = "rebalance"
+	ICACallbackID_Delegate      = "delegate"
+	ICACallbackID_Claim         = "claim"
+	ICACallbackID_Undelegate    = "undelegate"
+	ICACallbackID_Reinvest      = "reinvest"
+	ICACallbackID_Redemption    = "redemption"
+	ICACallbackID_Rebalance     = "rebalance"
+	ICACallbackID_LSMTransfer   = "lsmtransfer"
+	ICACallbackID_LSMRedeem     = "lsmredeem"
+	ICACallbackID_LSMReturn     = "lsmreturn"
+	ICACallbackID_SunsetSweep   = "sunsetsweep"
+	ICACallbackID_SunsetRestake = "sunsetrestake"
+	ICACallbackID_SunsetDeposit = "sunsetdeposit"
+	ICACallbackID_SunsetRewards = "sunsetrewards"
 )
 
 // ICACallbacks wrapper struct for stakeibc keeper
@@ -51,6 +58,13 @@ func (c ICACallbacks) RegisterICACallbacks() icacallbackstypes.ICACallbackHandle
 		AddICACallback(ICACallbackID_Undelegate, ICACallback(UndelegateCallback)).
 		AddICACallback(ICACallbackID_Reinvest, ICACallback(ReinvestCallback)).
 		AddICACallback(ICACallbackID_Redemption, ICACallback(RedemptionCallback)).
-		AddICACallback(ICACallbackID_Rebalance, ICACallback(RebalanceCallback))
+		AddICACallback(ICACallbackID_Rebalance, ICACallback(RebalanceCallback)).
+		AddICACallback(ICACallbackID_LSMTransfer, ICACallback(LSMTransferCallback)).
+		AddICACallback(ICACallbackID_LSMRedeem, ICACallback(LSMRedeemCallback)).
+		AddICACallback(ICACallbackID_LSMReturn, ICACallback(LSMReturnCallback)).
+		AddICACallback(ICACallbackID_SunsetSweep, ICACallback(SunsetSweepCallback)).
+		AddICACallback(ICACallbackID_SunsetRestake, ICACallback(SunsetRestakeCallback)).
+		AddICACallback(ICACallbackID_SunsetDeposit, ICACallback(SunsetDepositCallback)).
+		AddICACallback(ICACallbackID_SunsetRewards, ICACallback(SunsetRewardsCallback))
 	return a.(ICACallbacks)
 }
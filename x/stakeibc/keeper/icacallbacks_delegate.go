@@ -67,10 +67,14 @@ func DelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack
 	}
 
 	// Check for timeout (ack nil)
-	// No need to reset the deposit record status since it will get reverted when the channel is restored
+	// No need to reset the deposit record status since it will get reverted when the closed channel is
+	// automatically restored in the next stride epoch (see RecoverAllClosedICAs)
+	// The delegation is added to the retry queue so that it's staked again once the record is reverted
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
 		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Delegate,
 			icacallbackstypes.AckResponseStatus_TIMEOUT, packet))
+		k.RecordFailedICAOperation(ctx, chainId, types.ICARetry_DELEGATE, recordId,
+			errorsmod.Wrapf(types.ErrICATxFailed, "delegation of deposit record %d timed out", recordId))
 		return nil
 	}
 
@@ -96,6 +100,7 @@ func DelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ack
 		if !success {
 			return errorsmod.Wrapf(types.ErrValidatorDelegationChg, "Failed to add delegation to validator")
 		}
+		k.ConsumeUndelegatedDirectedAmt(&hostZone, splitDelegation.Validator, splitDelegation.Amount)
 		k.SetHostZone(ctx, hostZone)
 	}
 
@@ -159,6 +159,11 @@ func (s *KeeperTestSuite) TestDelegateCallback_DelegateCallbackTimeout() {
 	err := stakeibckeeper.DelegateCallback(s.App.StakeibcKeeper, s.Ctx, invalidArgs.packet, invalidArgs.ackResponse, invalidArgs.args)
 	s.Require().NoError(err)
 	s.checkDelegateStateIfCallbackFailed(tc)
+
+	// The delegation should be queued for a retry
+	icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, stakeibc.ICARetry_DELEGATE, tc.initialState.depositRecord.Id)
+	s.Require().True(found, "delegation retry should be queued")
+	s.Require().Contains(icaRetry.LastError, "timed out", "last error")
 }
 
 func (s *KeeperTestSuite) TestDelegateCallback_DelegateCallbackErrorOnHost() {
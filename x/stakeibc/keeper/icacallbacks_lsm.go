@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	icacallbackstypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Deserializes the LSM callback args and looks up the associated host zone and deposit
+func (k Keeper) getLSMCallbackDeposit(ctx sdk.Context, args []byte) (hostZone types.HostZone, deposit types.LSMTokenDeposit, err error) {
+	lsmCallback, err := k.UnmarshalLSMLiquidStakeCallbackArgs(ctx, args)
+	if err != nil {
+		return hostZone, deposit, errorsmod.Wrapf(types.ErrUnmarshalFailure, fmt.Sprintf("Unable to unmarshal LSM callback args: %s", err.Error()))
+	}
+	if lsmCallback.Deposit == nil {
+		return hostZone, deposit, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "LSM callback args are missing the deposit")
+	}
+	chainId := lsmCallback.Deposit.ChainId
+
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return hostZone, deposit, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "host zone not found %s", chainId)
+	}
+	deposit, found = k.GetLSMTokenDeposit(ctx, chainId, lsmCallback.Deposit.Denom, lsmCallback.Deposit.StakerAddress)
+	if !found {
+		return hostZone, deposit, errorsmod.Wrapf(types.ErrLSMTokenDepositNotFound,
+			"LSM token deposit not found (%s, %s)", lsmCallback.Deposit.Denom, lsmCallback.Deposit.StakerAddress)
+	}
+	return hostZone, deposit, nil
+}
+
+// ICA Callback after transferring LSM tokens to the delegation account on the host
+//   If successful:
+//      * Submits the ICA to redeem the LSM tokens for a native delegation
+//        (if the redemption can't be submitted, it will be retried at the next epoch)
+//   If timeout/failure:
+//      * The transfer module returns the tokens to the host zone account, so the deposit is queued to be
+//        refunded to the staker at the next epoch
+//        (the callback runs before the transfer module returns the tokens, so they can't be refunded here)
+func LSMTransferCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, deposit, err := k.getLSMCallbackDeposit(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_LSMTransfer,
+		"Starting LSM transfer callback for %s from %s", deposit.Denom, deposit.StakerAddress))
+
+	if ackResponse.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMTransfer, ackResponse.Status, packet))
+		deposit.Status = types.LSMTokenDeposit_REFUND_QUEUE
+		k.SetLSMTokenDeposit(ctx, deposit)
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMTransfer,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	// Queue the redemption and attempt to submit it right away
+	deposit.Status = types.LSMTokenDeposit_DETOKENIZATION_QUEUE
+	k.SetLSMTokenDeposit(ctx, deposit)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.DetokenizeLSMTokenDeposit(cacheCtx, hostZone, deposit); err != nil {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_LSMTransfer,
+			"Unable to submit LSM token redemption, it will be retried next epoch: %s", err.Error()))
+		return nil
+	}
+	writeCache()
+
+	return nil
+}
+
+// ICA Callback after redeeming LSM tokens for a native delegation
+//   If successful:
+//      * Mints stTokens to the staker and records the delegation on the validator
+//   If timeout:
+//      * Re-queues the redemption so that it's submitted again at the next epoch
+//   If failure:
+//      * Returns the LSM tokens from the delegation account to the staker
+//        (if the transfer can't be submitted, it will be retried at the next epoch)
+func LSMRedeemCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, deposit, err := k.getLSMCallbackDeposit(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_LSMRedeem,
+		"Starting LSM redeem callback for %s from %s", deposit.Denom, deposit.StakerAddress))
+
+	// Check for timeout (ack nil)
+	// The tokens are still in the delegation account, so the redemption can be submitted again
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMRedeem,
+			icacallbackstypes.AckResponseStatus_TIMEOUT, packet))
+		deposit.Status = types.LSMTokenDeposit_DETOKENIZATION_QUEUE
+		k.SetLSMTokenDeposit(ctx, deposit)
+		return nil
+	}
+
+	// Check for a failed transaction (ack error)
+	// The liquid stake is rolled back by returning the tokens to the staker
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMRedeem,
+			icacallbackstypes.AckResponseStatus_FAILURE, packet))
+		deposit.Status = types.LSMTokenDeposit_RETURN_QUEUE
+		k.SetLSMTokenDeposit(ctx, deposit)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ReturnLSMTokenDeposit(cacheCtx, hostZone, deposit); err != nil {
+			k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_LSMRedeem,
+				"Unable to return LSM tokens, it will be retried next epoch: %s", err.Error()))
+			return nil
+		}
+		writeCache()
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMRedeem,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	return k.FinishLSMLiquidStake(ctx, hostZone, deposit)
+}
+
+// ICA Callback after returning LSM tokens from the delegation account to the staker
+//   If successful:
+//      * Removes the deposit, completing the rollback of the liquid stake
+//   If timeout/failure:
+//      * The tokens are still in the delegation account, so the return is re-queued for the next epoch
+func LSMReturnCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, deposit, err := k.getLSMCallbackDeposit(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_LSMReturn,
+		"Starting LSM return callback for %s to %s", deposit.Denom, deposit.StakerAddress))
+
+	if ackResponse.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMReturn, ackResponse.Status, packet))
+		deposit.Status = types.LSMTokenDeposit_RETURN_QUEUE
+		k.SetLSMTokenDeposit(ctx, deposit)
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_LSMReturn,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	k.RemoveLSMTokenDeposit(ctx, deposit.ChainId, deposit.Denom, deposit.StakerAddress)
+	k.emitLSMLiquidStakeEvent(ctx, deposit, types.AttributeValueLSMRolledBack)
+
+	return nil
+}
@@ -37,6 +37,7 @@ func (k Keeper) UnmarshalRebalanceCallbackArgs(ctx sdk.Context, rebalanceCallbac
 // ICA Callback after rebalance validators on a host zone
 //   If successful:
 //      * Updates relevant validator delegations on the host zone struct
+//      * Records each redelegation as pending until its completion time
 //   If timeout/failure:
 //      * Does nothing
 func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
@@ -48,6 +49,9 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	chainId := rebalanceCallback.HostZoneId
 	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Rebalance, "Starting rebalance callback"))
 
+	// The redelegations are no longer in flight, regardless of the outcome
+	k.RemoveInFlightRedelegations(ctx, chainId, rebalanceCallback.Rebalancings)
+
 	// Check for timeout (ack nil)
 	// No action is necessary on a timeout
 	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
@@ -100,5 +104,11 @@ func RebalanceCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ac
 	}
 	k.SetHostZone(ctx, hostZone)
 
+	// Track the redelegations until they mature on the host, so that future rebalances respect the host's limits
+	if err := k.RecordPendingRedelegations(ctx, chainId, rebalanceCallback.Rebalancings, ackResponse.MsgResponses); err != nil {
+		k.Logger(ctx).Error(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_Rebalance,
+			"Unable to record pending redelegations, err: %s", err.Error()))
+	}
+
 	return nil
 }
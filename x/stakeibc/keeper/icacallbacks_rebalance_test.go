@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
 	_ "github.com/stretchr/testify/suite"
@@ -31,7 +33,11 @@ func (s *KeeperTestSuite) SetupRebalanceCallback() RebalanceCallbackTestCase {
 	rebalanceValidatorsTestCase := s.SetupRebalanceValidators()
 
 	packet := channeltypes.Packet{}
-	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	completionTime := s.Ctx.BlockTime().Add(time.Hour)
+	ackResponse := icacallbacktypes.AcknowledgementResponse{
+		Status:       icacallbacktypes.AckResponseStatus_SUCCESS,
+		MsgResponses: [][]byte{s.CreateRedelegateResponse(completionTime), s.CreateRedelegateResponse(completionTime)},
+	}
 	callbackArgs := types.RebalanceCallback{
 		HostZoneId: HostChainId,
 		Rebalancings: []*types.Rebalancing{
@@ -80,6 +86,13 @@ func (s *KeeperTestSuite) TestRebalanceCallback_Successful() {
 	s.Require().Equal(sdkmath.NewInt(96), validators[2].DelegationAmt, "validator 3 stake")
 	s.Require().Equal(sdkmath.NewInt(387), validators[3].DelegationAmt, "validator 4 stake")
 	s.Require().Equal(sdkmath.NewInt(400), validators[4].DelegationAmt, "validator 5 stake")
+
+	// Each redelegation should be tracked until it matures
+	pendingRedelegations := s.App.StakeibcKeeper.GetAllHostZonePendingRedelegations(s.Ctx, HostChainId)
+	s.Require().Len(pendingRedelegations, 2, "number of pending redelegations")
+	for _, pendingRedelegation := range pendingRedelegations {
+		s.Require().Equal("stride_VAL1", pendingRedelegation.DstValidator, "pending redelegation destination")
+	}
 }
 
 func (s *KeeperTestSuite) checkDelegationStateIfCallbackFailed() {
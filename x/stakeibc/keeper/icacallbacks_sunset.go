@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Marshalls sunset callback arguments
+func (k Keeper) MarshalSunsetCallbackArgs(ctx sdk.Context, sunsetCallback types.SunsetCallback) ([]byte, error) {
+	out, err := proto.Marshal(&sunsetCallback)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("MarshalSunsetCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return out, nil
+}
+
+// Unmarshalls sunset callback arguments into a SunsetCallback struct
+func (k Keeper) UnmarshalSunsetCallbackArgs(ctx sdk.Context, sunsetCallback []byte) (*types.SunsetCallback, error) {
+	unmarshalledSunsetCallback := types.SunsetCallback{}
+	if err := proto.Unmarshal(sunsetCallback, &unmarshalledSunsetCallback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UnmarshalSunsetCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return &unmarshalledSunsetCallback, nil
+}
+
+// Deserializes the sunset callback args and looks up the associated host zone
+func (k Keeper) getSunsetCallbackHostZone(ctx sdk.Context, args []byte) (hostZone types.HostZone, sunsetCallback *types.SunsetCallback, err error) {
+	sunsetCallback, err = k.UnmarshalSunsetCallbackArgs(ctx, args)
+	if err != nil {
+		return hostZone, nil, errorsmod.Wrapf(types.ErrUnmarshalFailure, fmt.Sprintf("Unable to unmarshal sunset callback args: %s", err.Error()))
+	}
+	hostZone, found := k.GetHostZone(ctx, sunsetCallback.HostZoneId)
+	if !found {
+		return hostZone, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "host zone not found %s", sunsetCallback.HostZoneId)
+	}
+	return hostZone, sunsetCallback, nil
+}
+
+// ICA Callback after sweeping the leftover sunset funds to the sunset collector
+//   If successful:
+//      * Completes the sunset and halts the host zone
+//   If timeout/failure:
+//      * The funds are still in the redemption account, so the sunset is moved back to the claimable phase
+//        and the sweep is retried in the next day epoch
+func SunsetSweepCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, sunsetCallback, err := k.getSunsetCallbackHostZone(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_SunsetSweep,
+		"Starting sunset sweep callback for %v", sunsetCallback.Amount))
+
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_SWEEPING {
+		return errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is not sweeping its sunset leftovers (status: %s)",
+			chainId, hostZone.SunsetStatus)
+	}
+
+	if ackResponse.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetSweep, ackResponse.Status, packet))
+		hostZone.SunsetStatus = types.SunsetStatus_SUNSET_CLAIMABLE
+		k.SetHostZone(ctx, hostZone)
+		k.emitSunsetEvent(ctx, hostZone)
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetSweep,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	k.FinalizeHostZoneSunset(ctx, hostZone)
+
+	return nil
+}
+
+// ICA Callback after sending the unbonded funds of an aborted sunset back to the delegation account
+//   If successful:
+//      * Queues the funds for delegation and returns the host zone to normal operation
+//   If timeout/failure:
+//      * The funds are still in the redemption account, so the send is retried in the next day epoch
+func SunsetRestakeCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, sunsetCallback, err := k.getSunsetCallbackHostZone(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_SunsetRestake,
+		"Starting sunset restake callback for %v", sunsetCallback.Amount))
+
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_ABORT_RESTAKING {
+		return errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is not restaking an aborted sunset (status: %s)",
+			chainId, hostZone.SunsetStatus)
+	}
+
+	if ackResponse.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetRestake, ackResponse.Status, packet))
+		hostZone.SunsetStatus = types.SunsetStatus_SUNSET_ABORTING
+		k.SetHostZone(ctx, hostZone)
+		k.emitSunsetEvent(ctx, hostZone)
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetRestake,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.STRIDE_EPOCH)
+	}
+
+	// The funds are now in the delegation account, so they're staked with the next batch of deposits
+	k.RecordsKeeper.AppendDepositRecord(ctx, recordstypes.DepositRecord{
+		Amount:             sunsetCallback.Amount.Amount,
+		Denom:              sunsetCallback.Amount.Denom,
+		HostZoneId:         chainId,
+		Status:             recordstypes.DepositRecord_DELEGATION_QUEUE,
+		DepositEpochNumber: strideEpochTracker.EpochNumber,
+	})
+
+	hostZone.SunsetClaimableBalance = sdkmath.ZeroInt()
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_NONE
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+
+	return nil
+}
+
+// ICA Callback after sweeping a pending deposit of a sunset host zone from the delegation account to the redemption account
+//   If successful:
+//      * Removes the deposit record and adds the deposit to the sunset claimable balance
+//   If timeout:
+//      * Does nothing, the deposit record is reverted when the closed channel is restored (see RecoverAllClosedICAs)
+//   If failure:
+//      * Reverts the deposit record status, so that the sweep is retried in the next stride epoch
+func SunsetDepositCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, sunsetCallback, err := k.getSunsetCallbackHostZone(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	recordId := sunsetCallback.DepositRecordId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_SunsetDeposit,
+		"Starting sunset deposit callback for Deposit Record: %d", recordId))
+
+	depositRecord, found := k.RecordsKeeper.GetDepositRecord(ctx, recordId)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "deposit record not found %d", recordId)
+	}
+
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_TIMEOUT {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetDeposit, ackResponse.Status, packet))
+		return nil
+	}
+
+	if ackResponse.Status == icacallbackstypes.AckResponseStatus_FAILURE {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetDeposit, ackResponse.Status, packet))
+		depositRecord.Status = recordstypes.DepositRecord_DELEGATION_QUEUE
+		k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetDeposit,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	k.RecordsKeeper.RemoveDepositRecord(ctx, recordId)
+	hostZone.SunsetClaimableBalance = GetSunsetClaimableBalance(hostZone).Add(sunsetCallback.Amount.Amount)
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
+
+// ICA Callback after sending the rewards of a sunset host zone to the redemption account (instead of reinvesting them)
+//   If successful:
+//      * Adds the rewards to the sunset claimable balance and queries the fee account so that the commission is sent to stride
+//   If timeout/failure:
+//      * Does nothing, the rewards are still in the withdrawal account and are picked up in the next reinvestment
+func SunsetRewardsCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, ackResponse *icacallbackstypes.AcknowledgementResponse, args []byte) error {
+	hostZone, sunsetCallback, err := k.getSunsetCallbackHostZone(ctx, args)
+	if err != nil {
+		return err
+	}
+	chainId := hostZone.ChainId
+	k.Logger(ctx).Info(utils.LogICACallbackWithHostZone(chainId, ICACallbackID_SunsetRewards,
+		"Starting sunset rewards callback for %v", sunsetCallback.Amount))
+
+	if ackResponse.Status != icacallbackstypes.AckResponseStatus_SUCCESS {
+		k.Logger(ctx).Error(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetRewards, ackResponse.Status, packet))
+		return nil
+	}
+
+	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_SunsetRewards,
+		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
+
+	hostZone.SunsetClaimableBalance = GetSunsetClaimableBalance(hostZone).Add(sunsetCallback.Amount.Amount)
+	k.SetHostZone(ctx, hostZone)
+
+	return k.UpdateFeeAccountBalance(ctx, hostZone)
+}
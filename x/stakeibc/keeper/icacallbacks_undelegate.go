@@ -43,6 +43,7 @@ func (k Keeper) UnmarshalUndelegateCallbackArgs(ctx sdk.Context, undelegateCallb
 // ICA Callback after undelegating
 //   If successful:
 //      * Updates epoch unbonding record status
+//      * Reduces the liquid stakes directed to each validator by the unbonded share
 // 		* Records delegation changes on the host zone and validators,
 //      * Burns stTokens
 //   If timeout:
@@ -84,6 +85,15 @@ func UndelegateCallback(k Keeper, ctx sdk.Context, packet channeltypes.Packet, a
 	k.Logger(ctx).Info(utils.LogICACallbackStatusWithHostZone(chainId, ICACallbackID_Undelegate,
 		icacallbackstypes.AckResponseStatus_SUCCESS, packet))
 
+	// The directed amounts are reduced once the tokens are unbonded (rather than when the redemption is requested),
+	// so that cancelled, rolled over or failed redemptions leave them unchanged
+	// This must be done before the staked balance is decremented, since the reduction is a share of the staked balance
+	totalUndelegated := sdkmath.ZeroInt()
+	for _, undelegation := range undelegateCallback.SplitDelegations {
+		totalUndelegated = totalUndelegated.Add(undelegation.Amount)
+	}
+	k.ReduceDirectedDelegations(ctx, chainId, totalUndelegated)
+
 	// Update delegation balances
 	hostZone, found := k.GetHostZone(ctx, undelegateCallback.HostZoneId)
 	if !found {
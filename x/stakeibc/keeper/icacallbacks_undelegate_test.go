@@ -176,6 +176,38 @@ func (s *KeeperTestSuite) TestUndelegateCallback_Successful() {
 	s.Require().Equal(tc.balanceToUnstake, initialState.zoneAccountBalance.Sub(s.App.BankKeeper.GetBalance(s.Ctx, zoneAccount, StAtom).Amount), "tokens are burned")
 }
 
+func (s *KeeperTestSuite) TestUndelegateCallback_ReducesDirectedDelegations() {
+	tc := s.SetupUndelegateCallback()
+
+	// Direct liquid stakes to val1
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	hostZone.Validators[0].DirectedDelegationAmt = sdkmath.NewInt(100_000)
+	hostZone.Validators[0].UndelegatedDirectedAmt = sdkmath.ZeroInt()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// A failed undelegation should leave the directed amount unchanged
+	failedArgs := tc.validArgs
+	failedArgs.ackResponse = &icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.UndelegateCallback(s.App.StakeibcKeeper, s.Ctx, failedArgs.packet, failedArgs.ackResponse, failedArgs.args)
+	s.Require().NoError(err, "undelegate callback succeeds with error on host")
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdkmath.NewInt(100_000), hostZone.Validators[0].DirectedDelegationAmt, "val1 directed amount after failure")
+
+	// Once the undelegation succeeds, the directed amount should be reduced
+	validArgs := tc.validArgs
+	err = stakeibckeeper.UndelegateCallback(s.App.StakeibcKeeper, s.Ctx, validArgs.packet, validArgs.ackResponse, validArgs.args)
+	s.Require().NoError(err, "undelegate callback succeeds")
+
+	// Unbonding 30% of the staked balance should reduce the directed amount by 30%
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdkmath.NewInt(70_000), hostZone.Validators[0].DirectedDelegationAmt, "val1 directed amount after success")
+	s.Require().Equal(tc.initialState.stakedBal.Sub(tc.balanceToUnstake), hostZone.StakedBal, "stakedBal has decreased on the host zone")
+}
+
 func (s *KeeperTestSuite) checkStateIfUndelegateCallbackFailed(tc UndelegateCallbackTestCase) {
 	initialState := tc.initialState
 
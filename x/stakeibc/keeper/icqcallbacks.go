@@ -11,6 +11,12 @@ const (
 	ICQCallbackID_FeeBalance        = "feebalance"
 	ICQCallbackID_Delegation        = "delegation"
 	ICQCallbackID_Validator         = "validator"
+	ICQCallbackID_SigningInfo       = "signinginfo"
+	ICQCallbackID_ValidatorStatus   = "validatorstatus"
+	ICQCallbackID_ValidatorUptime   = "validatoruptime"
+	ICQCallbackID_HostStakingParam  = "hoststakingparam"
+	ICQCallbackID_LSMValidator      = "lsmvalidator"
+	ICQCallbackID_HostBondedPool    = "hostbondedpool"
 )
 
 // ICQCallbacks wrapper struct for stakeibc keeper
@@ -46,5 +52,11 @@ func (c ICQCallbacks) RegisterICQCallbacks() icqtypes.QueryCallbacks {
 		AddICQCallback(ICQCallbackID_WithdrawalBalance, ICQCallback(WithdrawalBalanceCallback)).
 		AddICQCallback(ICQCallbackID_FeeBalance, ICQCallback(FeeBalanceCallback)).
 		AddICQCallback(ICQCallbackID_Delegation, ICQCallback(DelegatorSharesCallback)).
-		AddICQCallback(ICQCallbackID_Validator, ICQCallback(ValidatorExchangeRateCallback))
+		AddICQCallback(ICQCallbackID_Validator, ICQCallback(ValidatorExchangeRateCallback)).
+		AddICQCallback(ICQCallbackID_SigningInfo, ICQCallback(SigningInfoCallback)).
+		AddICQCallback(ICQCallbackID_ValidatorStatus, ICQCallback(ValidatorStatusCallback)).
+		AddICQCallback(ICQCallbackID_ValidatorUptime, ICQCallback(ValidatorUptimeCallback)).
+		AddICQCallback(ICQCallbackID_HostStakingParam, ICQCallback(HostStakingParamCallback)).
+		AddICQCallback(ICQCallbackID_LSMValidator, ICQCallback(LSMValidatorCallback)).
+		AddICQCallback(ICQCallbackID_HostBondedPool, ICQCallback(HostBondedPoolCallback))
 }
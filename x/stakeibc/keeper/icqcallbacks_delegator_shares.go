@@ -107,15 +107,14 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Validator (%s) tokens returned from query is greater than the DelegationAmt", validator.Address)
 	}
 
-	// TODO(TESTS-171) add some safety checks here (e.g. we could query the slashing module to confirm the decr in tokens was due to slash)
-	// update our records of the total stakedbal and of the validator's delegation amt
-	// NOTE:  we assume any decrease in delegation amt that's not tracked via records is a slash
+	// NOTE: any decrease in delegation amt that's not tracked via records is treated as a potential slash
+	// Before updating our records, the slash must be confirmed against the validator's signing info on the host
 
 	// Get slash percentage
 	slashAmount := validator.DelegationAmt.Sub(delegatedTokens)
 	slashPct := sdk.NewDecFromInt(slashAmount).Quo(sdk.NewDecFromInt(validator.DelegationAmt))
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Delegation,
-		"Validator was slashed! Validator: %s, Delegator: %s, Delegation in State: %v, Delegation from ICQ %v, Slash Amount: %v, Slash Pct: %v",
+		"Potential slash detected! Validator: %s, Delegator: %s, Delegation in State: %v, Delegation from ICQ %v, Slash Amount: %v, Slash Pct: %v",
 		validator.Address, queriedDelgation.DelegatorAddress, validator.DelegationAmt, delegatedTokens, slashAmount, slashPct))
 
 	// Abort if the slash was greater than the safety threshold
@@ -129,23 +128,26 @@ func DelegatorSharesCallback(k Keeper, ctx sdk.Context, args []byte, query icqty
 			"Validator slashed but ABORTING update, slash (%v) is greater than safety threshold (%v)", slashPct, slashThresholdDecimal)
 	}
 
-	// Update the validator weight and delegation reflect to reflect the slash
-	weight, err := cast.ToInt64E(validator.Weight)
-	if err != nil {
-		return errorsmod.Wrapf(types.ErrIntCast, "unable to convert validator weight to int64, err: %s", err.Error())
+	// Record the discrepancy and submit an ICQ for the validator's signing info to confirm the slash
+	// If the validator's consensus address is not known, the slash cannot be confirmed and is quarantined
+	unconfirmedSlash := types.UnconfirmedSlash{
+		ChainId:              chainId,
+		ValidatorAddress:     validator.Address,
+		DelegationAmt:        validator.DelegationAmt,
+		QueriedDelegationAmt: delegatedTokens,
+		EpochNumber:          strideEpochTracker.EpochNumber,
+		DetectionTime:        uint64(ctx.BlockTime().UnixNano()),
+		Status:               types.UnconfirmedSlash_PENDING_CONFIRMATION,
 	}
-	weightAdjustment := sdk.NewDecFromInt(delegatedTokens).Quo(sdk.NewDecFromInt(validator.DelegationAmt))
-
-	validator.Weight = sdk.NewDec(weight).Mul(weightAdjustment).TruncateInt().Uint64()
-	validator.DelegationAmt = validator.DelegationAmt.Sub(slashAmount)
-
-	// Update the validator on the host zone
-	hostZone.StakedBal = hostZone.StakedBal.Sub(slashAmount)
-	hostZone.Validators[valIndex] = &validator
-	k.SetHostZone(ctx, hostZone)
+	if validator.ConsensusAddress == "" {
+		k.QuarantineSlash(ctx, unconfirmedSlash, "validator consensus address unknown")
+		return nil
+	}
+	k.SetUnconfirmedSlash(ctx, unconfirmedSlash)
 
-	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Delegation,
-		"Delegation updated to: %v, Weight updated to: %v", validator.DelegationAmt, validator.Weight))
+	if err := k.QuerySigningInfoIcq(ctx, hostZone, validator.ConsensusAddress, ICQCallbackID_SigningInfo); err != nil {
+		return errorsmod.Wrapf(types.ErrICQFailed, "Failed to query signing info, err: %s", err.Error())
+	}
 
 	return nil
 }
@@ -1,11 +1,11 @@
 package keeper_test
 
 import (
-	"math"
-
 	sdkmath "cosmossdk.io/math"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
@@ -34,6 +34,16 @@ type DelegatorSharesICQCallbackTestCase struct {
 	expectedSlashAmount      sdkmath.Int
 	expectedWeight           uint64
 	exchangeRate             sdk.Dec
+	consensusAddress         string
+}
+
+// Returns a valid bech32 consensus address for the host zone
+func (s *KeeperTestSuite) CreateConsensusAddress(seed string) string {
+	addressBz := make([]byte, 20)
+	copy(addressBz, seed)
+	consensusAddress, err := bech32.ConvertAndEncode("cosmosvalcons", addressBz)
+	s.Require().NoError(err, "no error expected when encoding consensus address")
+	return consensusAddress
 }
 
 // Mocks the query response that's returned from an ICQ for the number of shares for a given validator/delegator pair
@@ -52,6 +62,7 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 	s.CreateTransferChannel(HostChainId)
 
 	valAddress := "valoper2"
+	consensusAddress := s.CreateConsensusAddress("valcons2")
 	valIndexQueried := 1
 	tokensBeforeSlash := sdkmath.NewInt(1000)
 	internalExchangeRate := sdk.NewDec(1).Quo(sdk.NewDec(2)) // 0.5
@@ -73,8 +84,9 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 
 	currentEpoch := uint64(1)
 	hostZone := stakeibctypes.HostZone{
-		ChainId:   HostChainId,
-		StakedBal: stakedBal,
+		ChainId:      HostChainId,
+		ConnectionId: ibctesting.FirstConnectionID,
+		StakedBal:    stakedBal,
 		Validators: []*stakeibctypes.Validator{
 			// This validator isn't being queried
 			{
@@ -91,8 +103,9 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 					InternalTokensToSharesRate: internalExchangeRate,
 					EpochNumber:                currentEpoch,
 				},
-				DelegationAmt: tokensBeforeSlash,
-				Weight:        weightBeforeSlash,
+				DelegationAmt:    tokensBeforeSlash,
+				Weight:           weightBeforeSlash,
+				ConsensusAddress: consensusAddress,
 			},
 		},
 	}
@@ -128,6 +141,7 @@ func (s *KeeperTestSuite) SetupDelegatorSharesICQCallback() DelegatorSharesICQCa
 		expectedSlashAmount:      expectedSlashAmount,
 		expectedWeight:           expectedWeightAfterSlash,
 		exchangeRate:             internalExchangeRate,
+		consensusAddress:         consensusAddress,
 	}
 }
 
@@ -138,15 +152,42 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_Successful() {
 	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
 	s.Require().NoError(err, "delegator shares callback error")
 
-	// Confirm the staked balance was decreased on the host
-	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.initialState.hostZone.ChainId)
-	s.Require().True(found, "host zone found")
-	s.Require().Equal(tc.expectedSlashAmount.Int64(), tc.initialState.hostZone.StakedBal.Sub(hostZone.StakedBal).Int64(), "staked bal slash")
+	// The slash should not be applied until it's confirmed
+	s.checkStateIfValidatorNotSlashed(tc)
 
-	// Confirm the validator's weight and delegation amount were reduced
-	validator := hostZone.Validators[tc.valIndexQueried]
-	s.Require().Equal(tc.expectedWeight, validator.Weight, "validator weight")
-	s.Require().Equal(tc.expectedDelegationAmount.Int64(), validator.DelegationAmt.Int64(), "validator delegation amount")
+	// Confirm the discrepancy was recorded as pending confirmation
+	initialValidator := tc.initialState.hostZone.Validators[tc.valIndexQueried]
+	unconfirmedSlash, found := s.App.StakeibcKeeper.GetUnconfirmedSlash(s.Ctx, HostChainId, initialValidator.Address)
+	s.Require().True(found, "unconfirmed slash found")
+	s.Require().Equal(stakeibctypes.UnconfirmedSlash_PENDING_CONFIRMATION, unconfirmedSlash.Status, "unconfirmed slash status")
+	s.Require().Equal(initialValidator.DelegationAmt.Int64(), unconfirmedSlash.DelegationAmt.Int64(), "unconfirmed slash delegation amount")
+	s.Require().Equal(tc.expectedDelegationAmount.Int64(), unconfirmedSlash.QueriedDelegationAmt.Int64(), "unconfirmed slash queried delegation amount")
+	s.Require().Equal(tc.initialState.strideEpochTracker.EpochNumber, unconfirmedSlash.EpochNumber, "unconfirmed slash epoch number")
+
+	// Confirm the signing info ICQ was submitted
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "one query submitted")
+	s.Require().Equal(stakeibckeeper.ICQCallbackID_SigningInfo, queries[0].CallbackId, "query callback ID")
+	s.Require().Equal(icqtypes.SLASHING_STORE_QUERY_WITH_PROOF, queries[0].QueryType, "query type")
+}
+
+func (s *KeeperTestSuite) TestDelegatorSharesCallback_NoConsensusAddress() {
+	tc := s.SetupDelegatorSharesICQCallback()
+
+	// Remove the validator's consensus address so the slash can't be confirmed
+	hostZone := tc.initialState.hostZone
+	validator := *hostZone.Validators[tc.valIndexQueried]
+	validator.ConsensusAddress = ""
+	hostZone.Validators[tc.valIndexQueried] = &validator
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err, "delegator shares callback error")
+
+	// The slash should be quarantined without modifying state or submitting a query
+	s.checkStateIfValidatorNotSlashed(tc)
+	s.checkSlashQuarantined(validator.Address)
+	s.Require().Empty(s.App.InterchainqueryKeeper.AllQueries(s.Ctx), "no queries submitted")
 }
 
 func (s *KeeperTestSuite) checkStateIfValidatorNotSlashed(tc DelegatorSharesICQCallbackTestCase) {
@@ -160,6 +201,20 @@ func (s *KeeperTestSuite) checkStateIfValidatorNotSlashed(tc DelegatorSharesICQC
 	s.Require().Equal(initialValidator.DelegationAmt, finalValidator.DelegationAmt, "validator delegation amount should not have updated")
 }
 
+func (s *KeeperTestSuite) checkSlashQuarantined(validatorAddress string) {
+	unconfirmedSlash, found := s.App.StakeibcKeeper.GetUnconfirmedSlash(s.Ctx, HostChainId, validatorAddress)
+	s.Require().True(found, "unconfirmed slash found")
+	s.Require().Equal(stakeibctypes.UnconfirmedSlash_QUARANTINED, unconfirmedSlash.Status, "unconfirmed slash status")
+
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeSlashQuarantined {
+			numEvents++
+		}
+	}
+	s.Require().Equal(1, numEvents, "number of slash quarantine events")
+}
+
 func (s *KeeperTestSuite) TestDelegatorSharesCallback_HostZoneNotFound() {
 	tc := s.SetupDelegatorSharesICQCallback()
 
@@ -269,22 +324,6 @@ func (s *KeeperTestSuite) TestDelegatorSharesCallback_InvalidNumTokens() {
 	s.Require().EqualError(err, expectedErrMsg)
 }
 
-func (s *KeeperTestSuite) TestDelegatorSharesCallback_WeightOverfow() {
-	tc := s.SetupDelegatorSharesICQCallback()
-
-	// Update the validator weight to max int so it overflows when casted
-	hostZone := tc.initialState.hostZone
-	validator := hostZone.Validators[tc.valIndexQueried]
-	validator.Weight = math.MaxUint64
-	hostZone.Validators[tc.valIndexQueried] = validator
-	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
-
-	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
-	expectedErrMsg := `unable to convert validator weight to int64, err: overflow: `
-	expectedErrMsg += `unable to cast \d+ of type uint64 to int64: unable to cast to safe cast int`
-	s.Require().Regexp(expectedErrMsg, err.Error())
-}
-
 func (s *KeeperTestSuite) TestDelegatorSharesCallback_SlashGtTenPercent() {
 	tc := s.SetupDelegatorSharesICQCallback()
 
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	icqkeeper "github.com/Stride-Labs/stride/v9/x/interchainquery/keeper"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// HostBondedPoolCallback is a callback handler for the host bonded pool query.
+//
+// The query response returns the balance of the host's bonded pool, which is the total
+// number of tokens bonded on the host. It's stored on the host zone's HostStakingParams
+// and used as the denominator of each validator's voting power share
+func HostBondedPoolCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_HostBondedPool,
+		"Starting host bonded pool callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response args to determine the balance
+	bondedTokens, err := icqkeeper.UnmarshalAmountFromBalanceQuery(k.cdc, args)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to determine balance from query response")
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_HostBondedPool,
+		"Query response - Bonded Tokens: %v %s", bondedTokens, hostZone.HostDenom))
+
+	if hostZone.HostStakingParams == nil {
+		hostZone.HostStakingParams = &types.HostStakingParams{}
+	}
+	hostZone.HostStakingParams.BondedTokens = &bondedTokens
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
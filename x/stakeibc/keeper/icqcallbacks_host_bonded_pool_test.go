@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestHostBondedPoolCallback_Successful() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:   HostChainId,
+		HostDenom: Atom,
+		HostStakingParams: &stakeibctypes.HostStakingParams{
+			BondDenom: Atom,
+		},
+	})
+
+	query := icqtypes.Query{ChainId: HostChainId}
+	err := stakeibckeeper.HostBondedPoolCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateBalanceQueryResponse(5000, Atom), query)
+	s.Require().NoError(err, "no error expected during callback")
+
+	// The bonded tokens should be stored alongside the existing staking params
+	hostStakingParams := s.getHostStakingParams()
+	s.Require().NotNil(hostStakingParams.BondedTokens, "bonded tokens set")
+	s.Require().Equal(sdkmath.NewInt(5000), *hostStakingParams.BondedTokens, "bonded tokens")
+	s.Require().Equal(Atom, hostStakingParams.BondDenom, "bond denom")
+}
+
+func (s *KeeperTestSuite) TestHostBondedPoolCallback_HostZoneNotFound() {
+	query := icqtypes.Query{ChainId: HostChainId}
+	err := stakeibckeeper.HostBondedPoolCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateBalanceQueryResponse(5000, Atom), query)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID")
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// HostStakingParamCallback is a callback handler for the host staking param queries.
+//
+// Each query returns a single staking or slashing param from the host's params store (encoded as amino JSON),
+// which is stored on the host zone's HostStakingParams. Once the params are known, they're
+// checked against the host zone's configuration
+func HostStakingParamCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_HostStakingParam,
+		"Starting host staking param callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// An empty response indicates the param is not set on the host
+	if len(args) == 0 {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "host staking param %s not found", query.Request)
+	}
+
+	hostStakingParams := hostZone.HostStakingParams
+	if hostStakingParams == nil {
+		hostStakingParams = &types.HostStakingParams{}
+	}
+
+	// Params are stored with the legacy amino JSON encoding
+	amino := codec.NewLegacyAmino()
+	switch {
+	case bytes.Equal(query.Request, types.GetHostStakingParamKey(stakingtypes.KeyUnbondingTime)):
+		var unbondingTime time.Duration
+		if err := amino.UnmarshalJSON(args, &unbondingTime); err != nil {
+			return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal unbonding time, err: %s", err.Error())
+		}
+		hostStakingParams.UnbondingPeriod = uint64(unbondingTime)
+
+	case bytes.Equal(query.Request, types.GetHostStakingParamKey(stakingtypes.KeyMaxValidators)):
+		var maxValidators uint32
+		if err := amino.UnmarshalJSON(args, &maxValidators); err != nil {
+			return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal max validators, err: %s", err.Error())
+		}
+		hostStakingParams.MaxValidators = maxValidators
+
+	case bytes.Equal(query.Request, types.GetHostStakingParamKey(stakingtypes.KeyBondDenom)):
+		var bondDenom string
+		if err := amino.UnmarshalJSON(args, &bondDenom); err != nil {
+			return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal bond denom, err: %s", err.Error())
+		}
+		hostStakingParams.BondDenom = bondDenom
+
+	case bytes.Equal(query.Request, types.GetHostSlashingParamKey(slashingtypes.KeySlashFractionDoubleSign)):
+		var slashFraction sdk.Dec
+		if err := amino.UnmarshalJSON(args, &slashFraction); err != nil {
+			return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal double sign slash fraction, err: %s", err.Error())
+		}
+		hostStakingParams.SlashFractionDoubleSign = &slashFraction
+
+	case bytes.Equal(query.Request, types.GetHostSlashingParamKey(slashingtypes.KeySlashFractionDowntime)):
+		var slashFraction sdk.Dec
+		if err := amino.UnmarshalJSON(args, &slashFraction); err != nil {
+			return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal downtime slash fraction, err: %s", err.Error())
+		}
+		hostStakingParams.SlashFractionDowntime = &slashFraction
+
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unrecognized host staking param query %s", query.Request)
+	}
+
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_HostStakingParam,
+		"Query response - Unbonding Period: %s, Max Validators: %d, Bond Denom: %s, Slash Fractions (Double Sign/Downtime): %v/%v",
+		time.Duration(hostStakingParams.UnbondingPeriod), hostStakingParams.MaxValidators, hostStakingParams.BondDenom,
+		hostStakingParams.SlashFractionDoubleSign, hostStakingParams.SlashFractionDowntime))
+
+	hostZone.HostStakingParams = hostStakingParams
+	k.SetHostZone(ctx, hostZone)
+
+	k.CheckHostStakingParams(ctx, hostZone)
+
+	return nil
+}
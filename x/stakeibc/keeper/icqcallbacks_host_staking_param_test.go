@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Mocks the query response that's returned from an ICQ for a param in the host's params store
+func (s *KeeperTestSuite) CreateHostStakingParamQueryResponse(value interface{}) []byte {
+	return codec.NewLegacyAmino().MustMarshalJSON(value)
+}
+
+func (s *KeeperTestSuite) CreateHostStakingParamQuery(paramKey []byte) icqtypes.Query {
+	return icqtypes.Query{
+		ChainId: HostChainId,
+		Request: stakeibctypes.GetHostStakingParamKey(paramKey),
+	}
+}
+
+func (s *KeeperTestSuite) SetupHostStakingParamCallback() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId:            HostChainId,
+		HostDenom:          Atom,
+		UnbondingFrequency: 4,
+	})
+}
+
+func (s *KeeperTestSuite) getHostStakingParams() stakeibctypes.HostStakingParams {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().NotNil(hostZone.HostStakingParams, "host staking params set")
+	return *hostZone.HostStakingParams
+}
+
+func (s *KeeperTestSuite) countHostParamsMismatchEvents() int {
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeHostParamsMismatch {
+			numEvents++
+		}
+	}
+	return numEvents
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_Successful() {
+	s.SetupHostStakingParamCallback()
+
+	// Unbonding time
+	unbondingTime := time.Hour * 24 * 21
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyUnbondingTime)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(unbondingTime), query)
+	s.Require().NoError(err, "unbonding time callback error")
+	s.Require().Equal(uint64(unbondingTime), s.getHostStakingParams().UnbondingPeriod, "unbonding period")
+
+	// Max validators
+	query = s.CreateHostStakingParamQuery(stakingtypes.KeyMaxValidators)
+	err = stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(uint32(175)), query)
+	s.Require().NoError(err, "max validators callback error")
+	s.Require().Equal(uint32(175), s.getHostStakingParams().MaxValidators, "max validators")
+
+	// Bond denom
+	query = s.CreateHostStakingParamQuery(stakingtypes.KeyBondDenom)
+	err = stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(Atom), query)
+	s.Require().NoError(err, "bond denom callback error")
+
+	// Each param should have been retained as the others were queried
+	expectedParams := stakeibctypes.HostStakingParams{
+		UnbondingPeriod: uint64(unbondingTime),
+		MaxValidators:   175,
+		BondDenom:       Atom,
+	}
+	s.Require().Equal(expectedParams, s.getHostStakingParams(), "host staking params")
+
+	// The params match the host zone, so there should be no mismatch
+	s.Require().Equal(0, s.countHostParamsMismatchEvents(), "number of mismatch events")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_SlashFractions() {
+	s.SetupHostStakingParamCallback()
+
+	doubleSignQuery := icqtypes.Query{
+		ChainId: HostChainId,
+		Request: stakeibctypes.GetHostSlashingParamKey(slashingtypes.KeySlashFractionDoubleSign),
+	}
+	doubleSignFraction := sdk.MustNewDecFromStr("0.05")
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(doubleSignFraction), doubleSignQuery)
+	s.Require().NoError(err, "double sign slash fraction callback error")
+
+	// Until both fractions are known, the max slash fraction can't be determined
+	_, found := s.getHostStakingParams().GetMaxSlashFraction(true)
+	s.Require().False(found, "max slash fraction should not be known")
+
+	downtimeQuery := icqtypes.Query{
+		ChainId: HostChainId,
+		Request: stakeibctypes.GetHostSlashingParamKey(slashingtypes.KeySlashFractionDowntime),
+	}
+	downtimeFraction := sdk.MustNewDecFromStr("0.0001")
+	err = stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(downtimeFraction), downtimeQuery)
+	s.Require().NoError(err, "downtime slash fraction callback error")
+
+	hostStakingParams := s.getHostStakingParams()
+	s.Require().Equal(doubleSignFraction, *hostStakingParams.SlashFractionDoubleSign, "double sign slash fraction")
+	s.Require().Equal(downtimeFraction, *hostStakingParams.SlashFractionDowntime, "downtime slash fraction")
+
+	// A tombstoned validator could have been slashed for both a double sign and downtime
+	maxSlashFraction, found := hostStakingParams.GetMaxSlashFraction(true)
+	s.Require().True(found, "max slash fraction found")
+	s.Require().Equal(sdk.MustNewDecFromStr("0.0501"), maxSlashFraction, "max slash fraction if tombstoned")
+
+	maxSlashFraction, found = hostStakingParams.GetMaxSlashFraction(false)
+	s.Require().True(found, "max slash fraction found")
+	s.Require().Equal(downtimeFraction, maxSlashFraction, "max slash fraction if jailed")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_UnbondingFrequencyMismatch() {
+	s.SetupHostStakingParamCallback()
+
+	// A 28 day unbonding period requires an unbonding frequency of at least 5
+	unbondingTime := time.Hour * 24 * 28
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyUnbondingTime)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(unbondingTime), query)
+	s.Require().NoError(err, "unbonding time callback error")
+
+	// The params should still be stored, but the mismatch should be flagged
+	s.Require().Equal(uint64(unbondingTime), s.getHostStakingParams().UnbondingPeriod, "unbonding period")
+	s.Require().Equal(1, s.countHostParamsMismatchEvents(), "number of mismatch events")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_BondDenomMismatch() {
+	s.SetupHostStakingParamCallback()
+
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyBondDenom)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(Osmo), query)
+	s.Require().NoError(err, "bond denom callback error")
+
+	s.Require().Equal(Osmo, s.getHostStakingParams().BondDenom, "bond denom")
+	s.Require().Equal(1, s.countHostParamsMismatchEvents(), "number of mismatch events")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_HostZoneNotFound() {
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyBondDenom)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(Atom), query)
+	s.Require().ErrorContains(err, "no registered zone for queried chain ID (GAIA)")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_EmptyResponse() {
+	s.SetupHostStakingParamCallback()
+
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyBondDenom)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, []byte{}, query)
+	s.Require().ErrorContains(err, "host staking param staking/BondDenom not found")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_InvalidResponse() {
+	s.SetupHostStakingParamCallback()
+
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyUnbondingTime)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, []byte("invalid"), query)
+	s.Require().ErrorContains(err, "unable to unmarshal unbonding time")
+}
+
+func (s *KeeperTestSuite) TestHostStakingParamCallback_UnrecognizedParam() {
+	s.SetupHostStakingParamCallback()
+
+	query := s.CreateHostStakingParamQuery(stakingtypes.KeyMaxEntries)
+	err := stakeibckeeper.HostStakingParamCallback(s.App.StakeibcKeeper, s.Ctx, s.CreateHostStakingParamQueryResponse(uint32(7)), query)
+	s.Require().ErrorContains(err, "unrecognized host staking param query staking/MaxEntries")
+}
+
+func (s *KeeperTestSuite) TestGetMinUnbondingFrequency() {
+	day := time.Hour * 24
+	testCases := []struct {
+		unbondingPeriod       time.Duration
+		minUnbondingFrequency uint64
+	}{
+		{unbondingPeriod: 0, minUnbondingFrequency: 1},
+		{unbondingPeriod: 6 * day, minUnbondingFrequency: 1},
+		{unbondingPeriod: 7 * day, minUnbondingFrequency: 2},
+		{unbondingPeriod: 14 * day, minUnbondingFrequency: 3},
+		{unbondingPeriod: 21 * day, minUnbondingFrequency: 4},
+		{unbondingPeriod: 21*day + time.Hour, minUnbondingFrequency: 4},
+		{unbondingPeriod: 27 * day, minUnbondingFrequency: 4},
+		{unbondingPeriod: 28 * day, minUnbondingFrequency: 5},
+		{unbondingPeriod: 48 * day, minUnbondingFrequency: 7},
+		{unbondingPeriod: 49 * day, minUnbondingFrequency: 8},
+	}
+	for _, tc := range testCases {
+		s.Require().Equal(tc.minUnbondingFrequency, stakeibctypes.GetMinUnbondingFrequency(tc.unbondingPeriod),
+			"min unbonding frequency for %s", tc.unbondingPeriod)
+	}
+
+	// The minimum frequency should be the lowest that keeps the concurrent unbondings within the max entries
+	numConcurrentUnbondings := func(unbondingDays, frequency uint64) uint64 { return unbondingDays/frequency + 1 }
+	for unbondingDays := uint64(0); unbondingDays <= 100; unbondingDays++ {
+		minFrequency := stakeibctypes.GetMinUnbondingFrequency(time.Duration(unbondingDays) * day)
+		s.Require().LessOrEqual(numConcurrentUnbondings(unbondingDays, minFrequency), uint64(stakeibctypes.MaxUnbondingEntries),
+			"concurrent unbondings for %d days at the min frequency", unbondingDays)
+		if minFrequency > 1 {
+			s.Require().Greater(numConcurrentUnbondings(unbondingDays, minFrequency-1), uint64(stakeibctypes.MaxUnbondingEntries),
+				"concurrent unbondings for %d days below the min frequency", unbondingDays)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// LSMValidatorCallback is a callback handler for the validator queries submitted by LSM liquid stakes
+// The queried exchange rate is used to value each of the validator's LSM token deposits that are awaiting valuation
+// Each deposit is processed independently: if a deposit can't be valued or transferred, it's refunded to the staker
+// without impacting the other deposits
+func LSMValidatorCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_LSMValidator,
+		"Starting LSM validator callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response args into a Validator struct
+	queriedValidator := stakingtypes.Validator{}
+	if err := k.cdc.Unmarshal(args, &queriedValidator); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into Validator type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_LSMValidator, "Query response - Validator: %s, Jailed: %v, Tokens: %v, Shares: %v",
+		queriedValidator.OperatorAddress, queriedValidator.Jailed, queriedValidator.Tokens, queriedValidator.DelegatorShares))
+
+	for _, deposit := range k.GetAllHostZoneLSMTokenDeposits(ctx, chainId) {
+		if deposit.Status != types.LSMTokenDeposit_VALUATION_IN_PROGRESS || deposit.ValidatorAddress != queriedValidator.OperatorAddress {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ValueLSMTokenDeposit(cacheCtx, hostZone, deposit, queriedValidator); err != nil {
+			k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_LSMValidator,
+				"Unable to value LSM tokens %s from %s, refunding: %s", deposit.Denom, deposit.StakerAddress, err.Error()))
+
+			if err := k.RefundLSMTokenDeposit(ctx, hostZone, deposit); err != nil {
+				k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_LSMValidator,
+					"Unable to refund LSM tokens %s to %s: %s", deposit.Denom, deposit.StakerAddress, err.Error()))
+			}
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
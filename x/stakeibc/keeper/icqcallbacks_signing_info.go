@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cast"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SigningInfoCallback is a callback handler for validator signing info queries.
+//
+// When the delegator shares callback detects a decrease in a validator's delegation that
+// isn't tracked by records, the validator's signing info is queried from the host's slashing store.
+// The slash is confirmed if the validator was tombstoned, or was jailed within the confirmation
+// window preceding the detection, and the decrease is within the host's slash fraction for the
+// offense. Confirmed slashes are applied to the host zone's records, while unconfirmed slashes
+// are quarantined without modifying state.
+func SigningInfoCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_SigningInfo,
+		"Starting signing info callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response into a signing info object
+	signingInfo := slashingtypes.ValidatorSigningInfo{}
+	if err := k.cdc.Unmarshal(args, &signingInfo); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into ValidatorSigningInfo type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_SigningInfo,
+		"Query response - Consensus Address: %s, Jailed Until: %v, Tombstoned: %v",
+		signingInfo.Address, signingInfo.JailedUntil, signingInfo.Tombstoned))
+
+	// Grab the validator and the pending slash using the consensus address returned from the query
+	validator, valIndex, found := GetValidatorFromConsensusAddress(hostZone.Validators, signingInfo.Address)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for consensus address (%s)", signingInfo.Address)
+	}
+	unconfirmedSlash, found := k.GetUnconfirmedSlash(ctx, chainId, validator.Address)
+	if !found || unconfirmedSlash.Status != types.UnconfirmedSlash_PENDING_CONFIRMATION {
+		return errorsmod.Wrapf(types.ErrUnconfirmedSlashNotFound, "no pending slash for validator (%s)", validator.Address)
+	}
+
+	// A tombstoned validator can never rejoin the active set, so it's deactivated regardless of whether the slash is confirmed
+	if signingInfo.Tombstoned && !validator.Tombstoned {
+		validator.Tombstoned = true
+		k.DeactivateValidator(ctx, chainId, &validator)
+		hostZone.Validators[valIndex] = &validator
+		k.SetHostZone(ctx, hostZone)
+	}
+
+	// If the delegation changed since the slash was detected, the queried delegation is stale
+	if !validator.DelegationAmt.Equal(unconfirmedSlash.DelegationAmt) {
+		k.QuarantineSlash(ctx, unconfirmedSlash, "validator delegation changed since slash was detected")
+		return nil
+	}
+
+	// The slash is confirmed if the validator was tombstoned or jailed within the confirmation window
+	// Since jailing for downtime sets JailedUntil in the future, any JailedUntil after the start of the window confirms the slash
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "unable to get epoch tracker for epoch (%s)", epochtypes.STRIDE_EPOCH)
+	}
+	confirmationWindowEpochs, err := cast.ToInt64E(k.GetParam(ctx, types.KeySafetySlashConfirmationWindow))
+	if err != nil {
+		return err
+	}
+	epochDuration, err := cast.ToInt64E(strideEpochTracker.Duration)
+	if err != nil {
+		return err
+	}
+	detectionTime, err := cast.ToInt64E(unconfirmedSlash.DetectionTime)
+	if err != nil {
+		return err
+	}
+	confirmationWindowStart := time.Unix(0, detectionTime-(confirmationWindowEpochs*epochDuration))
+
+	if !signingInfo.Tombstoned && !signingInfo.JailedUntil.After(confirmationWindowStart) {
+		k.QuarantineSlash(ctx, unconfirmedSlash, "validator was not jailed or tombstoned on the host")
+		return nil
+	}
+
+	// The decrease in delegation must also be consistent with the host's slash fractions, since jailing and tombstoning
+	// alone don't confirm the size of the slash
+	// The allowance is rounded up by one token to account for the truncation of the delegator's shares on the host
+	hostStakingParams := types.HostStakingParams{}
+	if hostZone.HostStakingParams != nil {
+		hostStakingParams = *hostZone.HostStakingParams
+	}
+	maxSlashFraction, found := hostStakingParams.GetMaxSlashFraction(signingInfo.Tombstoned)
+	if !found {
+		k.QuarantineSlash(ctx, unconfirmedSlash, "host slash fractions unknown")
+		return nil
+	}
+	slashAmount := unconfirmedSlash.DelegationAmt.Sub(unconfirmedSlash.QueriedDelegationAmt)
+	maxSlashAmount := maxSlashFraction.MulInt(unconfirmedSlash.DelegationAmt).Ceil().TruncateInt().AddRaw(1)
+	if slashAmount.GT(maxSlashAmount) {
+		k.QuarantineSlash(ctx, unconfirmedSlash, fmt.Sprintf("delegation decrease exceeds the host's max slash of %v", maxSlashAmount))
+		return nil
+	}
+
+	// Update the validator weight and delegation to reflect the slash
+	return k.ConfirmSlash(ctx, hostZone, valIndex, unconfirmedSlash)
+}
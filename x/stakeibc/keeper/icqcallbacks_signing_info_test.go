@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type SigningInfoICQCallbackTestCase struct {
+	delegatorSharesTestCase DelegatorSharesICQCallbackTestCase
+	validatorAddress        string
+	query                   icqtypes.Query
+}
+
+// Mocks the query response that's returned from an ICQ for a validator's signing info
+func (s *KeeperTestSuite) CreateSigningInfoQueryResponse(consensusAddress string, jailedUntil time.Time, tombstoned bool) []byte {
+	signingInfo := slashingtypes.ValidatorSigningInfo{
+		Address:     consensusAddress,
+		JailedUntil: jailedUntil,
+		Tombstoned:  tombstoned,
+	}
+	return s.App.RecordsKeeper.Cdc.MustMarshal(&signingInfo)
+}
+
+func (s *KeeperTestSuite) SetupSigningInfoICQCallback() SigningInfoICQCallbackTestCase {
+	// Detect the slash from the delegator shares callback, leaving a pending unconfirmed slash
+	tc := s.SetupDelegatorSharesICQCallback()
+	err := stakeibckeeper.DelegatorSharesCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err, "delegator shares callback error")
+
+	validatorAddress := tc.initialState.hostZone.Validators[tc.valIndexQueried].Address
+	_, found := s.App.StakeibcKeeper.GetUnconfirmedSlash(s.Ctx, HostChainId, validatorAddress)
+	s.Require().True(found, "unconfirmed slash should have been created")
+
+	// The detected slash is 5%, which is within the host's slash fraction for either offense
+	s.setHostSlashFractions(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+
+	return SigningInfoICQCallbackTestCase{
+		delegatorSharesTestCase: tc,
+		validatorAddress:        validatorAddress,
+		query:                   icqtypes.Query{ChainId: HostChainId},
+	}
+}
+
+func (s *KeeperTestSuite) setHostSlashFractions(doubleSign sdk.Dec, downtime sdk.Dec) {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	hostZone.HostStakingParams = &stakeibctypes.HostStakingParams{
+		SlashFractionDoubleSign: &doubleSign,
+		SlashFractionDowntime:   &downtime,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+}
+
+func (s *KeeperTestSuite) checkSlashApplied(tc SigningInfoICQCallbackTestCase, expectedWeight uint64) {
+	dtc := tc.delegatorSharesTestCase
+
+	// Confirm the staked balance was decreased on the host
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(dtc.expectedSlashAmount.Int64(), dtc.initialState.hostZone.StakedBal.Sub(hostZone.StakedBal).Int64(), "staked bal slash")
+
+	// Confirm the validator's weight and delegation amount were reduced
+	validator := hostZone.Validators[dtc.valIndexQueried]
+	s.Require().Equal(expectedWeight, validator.Weight, "validator weight")
+	s.Require().Equal(dtc.expectedDelegationAmount.Int64(), validator.DelegationAmt.Int64(), "validator delegation amount")
+
+	// Confirm the unconfirmed slash was removed and an event was emitted
+	_, found = s.App.StakeibcKeeper.GetUnconfirmedSlash(s.Ctx, HostChainId, tc.validatorAddress)
+	s.Require().False(found, "unconfirmed slash should have been removed")
+
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeSlashConfirmed {
+			numEvents++
+		}
+	}
+	s.Require().Equal(1, numEvents, "number of slash confirmed events")
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_Successful_Jailed() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	// Validator was jailed for downtime, so jailed until is shortly after the current time
+	jailedUntil := s.Ctx.BlockTime().Add(time.Minute)
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, jailedUntil, false)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	s.checkSlashApplied(tc, tc.delegatorSharesTestCase.expectedWeight)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_Successful_Tombstoned() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, time.Unix(0, 0), true)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	// The validator should also be deactivated, since it can never rejoin the active set
+	// Its weight is zeroed, and the slashed weight is stored as the deactivated weight
+	s.checkSlashApplied(tc, 0)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	validator := hostZone.Validators[tc.delegatorSharesTestCase.valIndexQueried]
+	s.Require().True(validator.Tombstoned, "validator tombstoned")
+	s.Require().Equal(tc.delegatorSharesTestCase.expectedWeight, validator.DeactivatedWeight, "validator deactivated weight")
+	s.Require().Equal(uint64(0), validator.GetActiveWeight(), "validator active weight")
+	s.checkValidatorDeactivatedEvent(tc.validatorAddress)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_NotJailed() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	// The validator has never been jailed, so the slash should be quarantined
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, time.Unix(0, 0), false)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	s.checkStateIfValidatorNotSlashed(tc.delegatorSharesTestCase)
+	s.checkSlashQuarantined(tc.validatorAddress)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_JailedBeforeConfirmationWindow() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	// The confirmation window is 4 epochs of 10 seconds, so a jailing that ended a minute ago is too old
+	jailedUntil := s.Ctx.BlockTime().Add(-time.Minute)
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, jailedUntil, false)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	s.checkStateIfValidatorNotSlashed(tc.delegatorSharesTestCase)
+	s.checkSlashQuarantined(tc.validatorAddress)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_ExceedsDowntimeSlashFraction() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	// The validator was only jailed for downtime, which can't account for a 5% decrease
+	s.setHostSlashFractions(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.0001"))
+
+	jailedUntil := s.Ctx.BlockTime().Add(time.Minute)
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, jailedUntil, false)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	s.checkStateIfValidatorNotSlashed(tc.delegatorSharesTestCase)
+	s.checkSlashQuarantined(tc.validatorAddress)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_Successful_TombstonedWithinCombinedSlashFraction() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	// A tombstoned validator could have been slashed for both a double sign and downtime
+	s.setHostSlashFractions(sdk.MustNewDecFromStr("0.04"), sdk.MustNewDecFromStr("0.01"))
+
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, time.Unix(0, 0), true)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	// The tombstoned validator is deactivated, so the slashed weight is stored as its deactivated weight
+	s.checkSlashApplied(tc, 0)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	validator := hostZone.Validators[tc.delegatorSharesTestCase.valIndexQueried]
+	s.Require().Equal(tc.delegatorSharesTestCase.expectedWeight, validator.DeactivatedWeight, "validator deactivated weight")
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_HostSlashFractionsUnknown() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	hostZone.HostStakingParams = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	jailedUntil := s.Ctx.BlockTime().Add(time.Minute)
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, jailedUntil, false)
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	s.checkStateIfValidatorNotSlashed(tc.delegatorSharesTestCase)
+	s.checkSlashQuarantined(tc.validatorAddress)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_DelegationChanged() {
+	tc := s.SetupSigningInfoICQCallback()
+	dtc := tc.delegatorSharesTestCase
+
+	// Update the validator's delegation after the slash was detected
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	validator := *hostZone.Validators[dtc.valIndexQueried]
+	validator.DelegationAmt = validator.DelegationAmt.AddRaw(100)
+	hostZone.Validators[dtc.valIndexQueried] = &validator
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	callbackArgs := s.CreateSigningInfoQueryResponse(dtc.consensusAddress, s.Ctx.BlockTime().Add(time.Minute), false)
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+
+	// The validator's delegation and weight should not have been touched
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(validator.DelegationAmt.Int64(), hostZone.Validators[dtc.valIndexQueried].DelegationAmt.Int64(), "validator delegation amount")
+	s.Require().Equal(validator.Weight, hostZone.Validators[dtc.valIndexQueried].Weight, "validator weight")
+
+	s.checkSlashQuarantined(tc.validatorAddress)
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_HostZoneNotFound() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	badQuery := tc.query
+	badQuery.ChainId = "fake_host_zone"
+
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, time.Unix(0, 0), true)
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, badQuery)
+	s.Require().EqualError(err, "no registered zone for queried chain ID (fake_host_zone): host zone not found")
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_InvalidCallbackArgs() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, []byte("random bytes"), tc.query)
+	s.Require().ErrorContains(err, "unable to unmarshal query response into ValidatorSigningInfo type")
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_ValidatorNotFound() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	fakeConsensusAddress := s.CreateConsensusAddress("fake")
+	callbackArgs := s.CreateSigningInfoQueryResponse(fakeConsensusAddress, time.Unix(0, 0), true)
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().ErrorContains(err, "no registered validator for consensus address")
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_NoPendingSlash() {
+	tc := s.SetupSigningInfoICQCallback()
+
+	s.App.StakeibcKeeper.RemoveUnconfirmedSlash(s.Ctx, HostChainId, tc.validatorAddress)
+
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, time.Unix(0, 0), true)
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().EqualError(err, "no pending slash for validator (valoper2): unconfirmed slash not found")
+}
+
+func (s *KeeperTestSuite) TestSigningInfoCallback_WeightOverflow() {
+	tc := s.SetupSigningInfoICQCallback()
+	dtc := tc.delegatorSharesTestCase
+
+	// Update the validator weight to max int so it overflows when casted
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	validator := *hostZone.Validators[dtc.valIndexQueried]
+	validator.Weight = math.MaxUint64
+	hostZone.Validators[dtc.valIndexQueried] = &validator
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	callbackArgs := s.CreateSigningInfoQueryResponse(dtc.consensusAddress, s.Ctx.BlockTime().Add(time.Minute), false)
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	expectedErrMsg := `unable to convert validator weight to int64, err: overflow: `
+	expectedErrMsg += `unable to cast \d+ of type uint64 to int64: unable to cast to safe cast int`
+	s.Require().Regexp(expectedErrMsg, err.Error())
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -80,6 +83,18 @@ func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query
 		InternalTokensToSharesRate: queriedValidator.TokensFromShares(sdk.NewDec(1.0)),
 		EpochNumber:                strideEpochTracker.GetEpochNumber(),
 	}
+
+	// Update the validator's status, deactivating it if it was jailed
+	k.UpdateValidatorStatus(ctx, chainId, &validator, queriedValidator)
+
+	// Store the validator's consensus address so that its signing info can be queried if a slash is detected
+	if consensusAddress, err := k.GetValidatorConsensusAddress(hostZone, queriedValidator); err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_Validator,
+			"Unable to determine consensus address for validator %s, err: %s", validator.Address, err.Error()))
+	} else {
+		validator.ConsensusAddress = consensusAddress
+	}
+
 	hostZone.Validators[valIndex] = &validator
 	k.SetHostZone(ctx, hostZone)
 
@@ -93,3 +108,18 @@ func ValidatorExchangeRateCallback(k Keeper, ctx sdk.Context, args []byte, query
 
 	return nil
 }
+
+// Returns the bech32 encoded consensus address (using the host zone's prefix) of a validator queried from the host
+func (k Keeper) GetValidatorConsensusAddress(hostZone types.HostZone, queriedValidator stakingtypes.Validator) (string, error) {
+	if queriedValidator.ConsensusPubkey == nil {
+		return "", errors.New("validator consensus pubkey is empty")
+	}
+	if err := queriedValidator.UnpackInterfaces(k.cdc); err != nil {
+		return "", err
+	}
+	consensusAddress, err := queriedValidator.GetConsAddr()
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(hostZone.Bech32Prefix+sdk.PrefixValidator+sdk.PrefixConsensus, consensusAddress)
+}
@@ -6,7 +6,10 @@ import (
 	sdkmath "cosmossdk.io/math"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
@@ -62,6 +65,7 @@ func (s *KeeperTestSuite) SetupValidatorICQCallback() ValidatorICQCallbackTestCa
 	hostZone := stakeibctypes.HostZone{
 		ChainId:      HostChainId,
 		ConnectionId: ibctesting.FirstConnectionID,
+		Bech32Prefix: "cosmos",
 		DelegationAccount: &stakeibctypes.ICAAccount{
 			Address: delegatorAddress,
 			Target:  stakeibctypes.ICAAccountType_DELEGATION,
@@ -130,6 +134,34 @@ func (s *KeeperTestSuite) TestValidatorExchangeRateCallback_Successful() {
 		"validator exchange rate updated")
 }
 
+func (s *KeeperTestSuite) TestValidatorExchangeRateCallback_ConsensusAddress() {
+	tc := s.SetupValidatorICQCallback()
+
+	// Include the validator's consensus pubkey in the query response
+	consensusPubKey := ed25519.GenPrivKey().PubKey()
+	consensusPubKeyAny, err := codectypes.NewAnyWithValue(consensusPubKey)
+	s.Require().NoError(err, "no error expected when packing pubkey")
+
+	queriedValidator := stakingtypes.Validator{
+		OperatorAddress: tc.initialState.hostZone.Validators[tc.valIndexQueried].Address,
+		ConsensusPubkey: consensusPubKeyAny,
+		Tokens:          sdkmath.NewInt(1000),
+		DelegatorShares: sdk.NewDec(2000),
+	}
+	callbackArgs := s.App.RecordsKeeper.Cdc.MustMarshal(&queriedValidator)
+
+	err = stakeibckeeper.ValidatorExchangeRateCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err, "validator exchange rate callback error")
+
+	// Confirm the consensus address was stored using the host's prefix
+	expectedConsensusAddress, err := bech32.ConvertAndEncode("cosmosvalcons", consensusPubKey.Address())
+	s.Require().NoError(err, "no error expected when encoding consensus address")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, tc.initialState.hostZone.ChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(expectedConsensusAddress, hostZone.Validators[tc.valIndexQueried].ConsensusAddress, "validator consensus address")
+}
+
 func (s *KeeperTestSuite) TestValidatorExchangeRateCallback_HostZoneNotFound() {
 	tc := s.SetupValidatorICQCallback()
 
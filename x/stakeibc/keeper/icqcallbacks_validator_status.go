@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// ValidatorStatusCallback is a callback handler for the periodic validator status queries.
+//
+// The validator's bond status, jailed flag, commission and tokens are updated from the queried validator record.
+// If the validator was jailed, its weight is zeroed so it's excluded from future delegations,
+// and its delegation is redelegated to the remaining validators at the next stride epoch
+func ValidatorStatusCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorStatus,
+		"Starting validator status callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response args into a Validator struct
+	queriedValidator := stakingtypes.Validator{}
+	if err := k.cdc.Unmarshal(args, &queriedValidator); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into Validator type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_ValidatorStatus, "Query response - Validator: %s, Jailed: %v, Status: %v",
+		queriedValidator.OperatorAddress, queriedValidator.Jailed, queriedValidator.Status))
+
+	// Get the validator from the host zone
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, queriedValidator.OperatorAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for address (%s)", queriedValidator.OperatorAddress)
+	}
+
+	k.UpdateValidatorStatus(ctx, chainId, &validator, queriedValidator)
+
+	// Store the validator's consensus address so that its signing info can be queried
+	if consensusAddress, err := k.GetValidatorConsensusAddress(hostZone, queriedValidator); err != nil {
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_ValidatorStatus,
+			"Unable to determine consensus address for validator %s, err: %s", validator.Address, err.Error()))
+	} else {
+		validator.ConsensusAddress = consensusAddress
+	}
+
+	hostZone.Validators[valIndex] = &validator
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
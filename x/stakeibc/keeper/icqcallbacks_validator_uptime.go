@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// ValidatorUptimeCallback is a callback handler for the periodic validator signing info queries
+// submitted for host zones with a validator weight policy.
+//
+// The validator's missed blocks counter is stored so that it can be used when deriving the validator's weight.
+// If the validator was tombstoned, it is also deactivated
+func ValidatorUptimeCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_ValidatorUptime,
+		"Starting validator uptime callback, QueryId: %vs, QueryType: %s, Connection: %s", query.Id, query.QueryType, query.ConnectionId))
+
+	// Confirm host exists
+	chainId := query.ChainId
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "no registered zone for queried chain ID (%s)", chainId)
+	}
+
+	// Unmarshal the query response into a signing info object
+	signingInfo := slashingtypes.ValidatorSigningInfo{}
+	if err := k.cdc.Unmarshal(args, &signingInfo); err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to unmarshal query response into ValidatorSigningInfo type, err: %s", err.Error())
+	}
+	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_ValidatorUptime,
+		"Query response - Consensus Address: %s, Missed Blocks: %d, Tombstoned: %v",
+		signingInfo.Address, signingInfo.MissedBlocksCounter, signingInfo.Tombstoned))
+
+	validator, valIndex, found := GetValidatorFromConsensusAddress(hostZone.Validators, signingInfo.Address)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "no registered validator for consensus address (%s)", signingInfo.Address)
+	}
+
+	validator.MissedBlocksCounter = 0
+	if signingInfo.MissedBlocksCounter > 0 {
+		validator.MissedBlocksCounter = uint64(signingInfo.MissedBlocksCounter)
+	}
+
+	if signingInfo.Tombstoned && !validator.Tombstoned {
+		validator.Tombstoned = true
+		k.DeactivateValidator(ctx, chainId, &validator)
+	}
+
+	hostZone.Validators[valIndex] = &validator
+	k.SetHostZone(ctx, hostZone)
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupValidatorUptimeCallback() string {
+	consensusAddress := s.CreateConsensusAddress("val2")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId: HostChainId,
+		Validators: []*stakeibctypes.Validator{
+			{Address: "valoper1", Weight: 10, DelegationAmt: sdkmath.NewInt(1000), ConsensusAddress: s.CreateConsensusAddress("val1")},
+			{Address: "valoper2", Weight: 20, DelegationAmt: sdkmath.NewInt(2000), ConsensusAddress: consensusAddress},
+		},
+	})
+	return consensusAddress
+}
+
+func (s *KeeperTestSuite) CreateValidatorUptimeQueryResponse(consensusAddress string, missedBlocks int64, tombstoned bool) []byte {
+	signingInfo := slashingtypes.ValidatorSigningInfo{
+		Address:             consensusAddress,
+		JailedUntil:         time.Unix(0, 0),
+		Tombstoned:          tombstoned,
+		MissedBlocksCounter: missedBlocks,
+	}
+	return s.App.RecordsKeeper.Cdc.MustMarshal(&signingInfo)
+}
+
+func (s *KeeperTestSuite) TestValidatorUptimeCallback_Successful() {
+	consensusAddress := s.SetupValidatorUptimeCallback()
+
+	callbackArgs := s.CreateValidatorUptimeQueryResponse(consensusAddress, 42, false)
+	err := stakeibckeeper.ValidatorUptimeCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "validator uptime callback error")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(42), hostZone.Validators[1].MissedBlocksCounter, "missed blocks")
+	s.Require().Equal(uint64(20), hostZone.Validators[1].Weight, "weight")
+	s.Require().Equal(uint64(0), hostZone.Validators[0].MissedBlocksCounter, "other validator missed blocks")
+}
+
+func (s *KeeperTestSuite) TestValidatorUptimeCallback_Tombstoned() {
+	consensusAddress := s.SetupValidatorUptimeCallback()
+
+	callbackArgs := s.CreateValidatorUptimeQueryResponse(consensusAddress, 0, true)
+	err := stakeibckeeper.ValidatorUptimeCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "validator uptime callback error")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().True(hostZone.Validators[1].Tombstoned, "validator tombstoned")
+	s.Require().Equal(uint64(0), hostZone.Validators[1].Weight, "weight")
+	s.Require().Equal(uint64(20), hostZone.Validators[1].DeactivatedWeight, "deactivated weight")
+	s.checkValidatorDeactivatedEvent("valoper2")
+}
+
+func (s *KeeperTestSuite) TestValidatorUptimeCallback_ValidatorNotFound() {
+	s.SetupValidatorUptimeCallback()
+
+	fakeConsensusAddress := s.CreateConsensusAddress("fake")
+	callbackArgs := s.CreateValidatorUptimeQueryResponse(fakeConsensusAddress, 0, false)
+	err := stakeibckeeper.ValidatorUptimeCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().ErrorContains(err, "no registered validator for consensus address")
+}
+
+func (s *KeeperTestSuite) TestValidatorUptimeCallback_HostZoneNotFound() {
+	consensusAddress := s.SetupValidatorUptimeCallback()
+
+	callbackArgs := s.CreateValidatorUptimeQueryResponse(consensusAddress, 0, false)
+	err := stakeibckeeper.ValidatorUptimeCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: "fake_host_zone"})
+	s.Require().EqualError(err, "no registered zone for queried chain ID (fake_host_zone): host zone not found")
+}
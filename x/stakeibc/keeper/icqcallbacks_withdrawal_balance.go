@@ -9,7 +9,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	"github.com/spf13/cast"
 
 	icqkeeper "github.com/Stride-Labs/stride/v9/x/interchainquery/keeper"
 
@@ -22,6 +21,7 @@ import (
 // The query response will return the withdrawal account balance
 // If the balance is non-zero, ICA MsgSends are submitted to transfer from the withdrawal account
 //  to the delegation account (for reinvestment) and fee account (for commission)
+// If the host zone is being sunset, the rewards are sent to the redemption account instead of the delegation account
 // Note: for now, to get proofs in your ICQs, you need to query the entire store on the host zone! e.g. "store/bank/key"
 func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icqtypes.Query) error {
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(query.ChainId, ICQCallbackID_WithdrawalBalance,
@@ -42,10 +42,11 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance,
 		"Query response - Withdrawal Balance: %v %s", withdrawalBalanceAmount, hostZone.HostDenom))
 
-	// Confirm the balance is greater than zero
+	// Confirm the balance is greater than zero (if not, there's nothing left to reinvest, so any queued retry is removed)
 	if withdrawalBalanceAmount.LTE(sdkmath.ZeroInt()) {
 		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance,
 			"No balance to transfer for address: %v, balance: %v", hostZone.WithdrawalAccount.GetAddress(), withdrawalBalanceAmount))
+		k.RemoveICARetry(ctx, chainId, types.ICARetry_REINVEST, 0)
 		return nil
 	}
 
@@ -64,14 +65,9 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 	}
 
 	// Determine the stride commission rate to the relevant portion can be sent to the fee account
-	params := k.GetParams(ctx)
-	strideCommissionInt, err := cast.ToInt64E(params.StrideCommission)
-	if err != nil {
-		return err
-	}
+	strideCommission := k.GetStrideCommission(ctx, hostZone)
 
 	// check that stride commission is between 0 and 1
-	strideCommission := sdk.NewDec(strideCommissionInt).Quo(sdk.NewDec(100))
 	if strideCommission.LT(sdk.ZeroDec()) || strideCommission.GT(sdk.OneDec()) {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Aborting withdrawal balance callback -- Stride commission must be between 0 and 1!")
 	}
@@ -100,7 +96,24 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance,
 			"Preparing MsgSends of %v from the withdrawal account to the fee account (for commission)", feeCoin.String()))
 	}
-	if reinvestCoin.Amount.GT(sdk.ZeroInt()) {
+
+	// Once a host zone is being sunset, the rewards are sent to the redemption account and added to the
+	// sunset claimable balance instead of being reinvested (see SunsetRewardsCallback)
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+		redemptionAccount, found := k.GetRedemptionAccount(ctx, hostZone)
+		if !found {
+			return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", chainId)
+		}
+		if reinvestCoin.Amount.GT(sdk.ZeroInt()) {
+			msgs = append(msgs, &banktypes.MsgSend{
+				FromAddress: withdrawalAccount.Address,
+				ToAddress:   redemptionAccount.Address,
+				Amount:      sdk.NewCoins(reinvestCoin),
+			})
+			k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance,
+				"Preparing MsgSends of %v from the withdrawal account to the redemption account (for sunset)", reinvestCoin.String()))
+		}
+	} else if reinvestCoin.Amount.GT(sdk.ZeroInt()) {
 		msgs = append(msgs, &banktypes.MsgSend{
 			FromAddress: withdrawalAccount.Address,
 			ToAddress:   delegationAccount.Address,
@@ -111,21 +124,37 @@ func WithdrawalBalanceCallback(k Keeper, ctx sdk.Context, args []byte, query icq
 	}
 
 	// add callback data before calling reinvestment ICA
-	reinvestCallback := types.ReinvestCallback{
-		ReinvestAmount: reinvestCoin,
-		HostZoneId:     hostZone.ChainId,
+	callbackId := ICACallbackID_Reinvest
+	var marshalledCallbackArgs []byte
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+		callbackId = ICACallbackID_SunsetRewards
+		marshalledCallbackArgs, err = k.MarshalSunsetCallbackArgs(ctx, types.SunsetCallback{
+			HostZoneId: hostZone.ChainId,
+			Amount:     reinvestCoin,
+		})
+	} else {
+		reinvestCallback := types.ReinvestCallback{
+			ReinvestAmount: reinvestCoin,
+			HostZoneId:     hostZone.ChainId,
+		}
+		k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance, "Marshalling ReinvestCallback args: %v", reinvestCallback))
+		marshalledCallbackArgs, err = k.MarshalReinvestCallbackArgs(ctx, reinvestCallback)
 	}
-	k.Logger(ctx).Info(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance, "Marshalling ReinvestCallback args: %v", reinvestCallback))
-	marshalledCallbackArgs, err := k.MarshalReinvestCallbackArgs(ctx, reinvestCallback)
 	if err != nil {
 		return err
 	}
 
 	// Send the transaction through SubmitTx
-	_, err = k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *withdrawalAccount, ICACallbackID_Reinvest, marshalledCallbackArgs)
+	// If the tx fails to submit, the reinvestment is added to the retry queue
+	// (the error is not returned, since that would revert the retry)
+	_, err = k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *withdrawalAccount, callbackId, marshalledCallbackArgs)
 	if err != nil {
-		return errorsmod.Wrapf(types.ErrICATxFailed, "Failed to SubmitTxs, Messages: %v, err: %s", msgs, err.Error())
+		err = errorsmod.Wrapf(types.ErrICATxFailed, "Failed to SubmitTxs, Messages: %v, err: %s", msgs, err.Error())
+		k.Logger(ctx).Error(utils.LogICQCallbackWithHostZone(chainId, ICQCallbackID_WithdrawalBalance, err.Error()))
+		k.RecordFailedICAOperation(ctx, chainId, types.ICARetry_REINVEST, 0, err)
+		return nil
 	}
+	k.RemoveICARetry(ctx, chainId, types.ICARetry_REINVEST, 0)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
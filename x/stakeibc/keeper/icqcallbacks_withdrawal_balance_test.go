@@ -142,6 +142,33 @@ func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_Successful() {
 	s.Require().Equal(endSequence, startSequence+1, "sequence number after reinvestment")
 }
 
+func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_HostZoneCommission() {
+	tc := s.SetupWithdrawalBalanceCallbackTest()
+
+	// Override the commission on the host zone (the param is 10%)
+	hostZone := tc.initialState.hostZone
+	strideCommission := sdk.MustNewDecFromStr("0.025")
+	hostZone.StrideCommission = &strideCommission
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	withdrawalPortId := tc.initialState.withdrawalChannel.PortID
+	withdrawalChannelId := tc.initialState.withdrawalChannel.ChannelID
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, withdrawalPortId, withdrawalChannelId)
+	s.Require().True(found, "sequence number not found before reinvestment")
+
+	err := stakeibckeeper.WithdrawalBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err)
+
+	// 2.5% of the 1000 withdrawal balance should go to the fee account, leaving 975 for reinvestment
+	callbackKey := icacallbackstypes.PacketID(withdrawalPortId, withdrawalChannelId, startSequence)
+	callbackData, found := s.App.IcacallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback data was not found for callback key (%s)", callbackKey)
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalReinvestCallbackArgs(s.Ctx, callbackData.CallbackArgs)
+	s.Require().NoError(err, "unmarshalling callback args error for callback key (%s)", callbackKey)
+	s.Require().Equal(sdk.NewCoin(Atom, sdkmath.NewInt(975)), callbackArgs.ReinvestAmount, "reinvestment coin in callback args (%s)", callbackKey)
+}
+
 func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_EmptyCallbackArgs() {
 	tc := s.SetupWithdrawalBalanceCallbackTest()
 
@@ -247,7 +274,25 @@ func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_FailedSubmitTx() {
 	badHostZone.ConnectionId = "connection-X"
 	s.App.StakeibcKeeper.SetHostZone(s.Ctx, badHostZone)
 
+	// The error should not be returned, and instead, the reinvestment should be queued for a retry
 	err := stakeibckeeper.WithdrawalBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
-	s.Require().ErrorContains(err, "Failed to SubmitTxs")
-	s.Require().ErrorContains(err, "invalid connection id, connection-X not found")
+	s.Require().NoError(err, "no error expected when the ICA tx fails")
+
+	icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, stakeibctypes.ICARetry_REINVEST, 0)
+	s.Require().True(found, "reinvest retry should be queued")
+	s.Require().Equal(uint64(1), icaRetry.Attempts, "retry attempts")
+	s.Require().Contains(icaRetry.LastError, "Failed to SubmitTxs")
+	s.Require().Contains(icaRetry.LastError, "invalid connection id, connection-X not found")
+}
+
+func (s *KeeperTestSuite) TestWithdrawalBalanceCallback_RemovesRetry() {
+	tc := s.SetupWithdrawalBalanceCallbackTest()
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, stakeibctypes.ICARetry{ChainId: HostChainId, Operation: stakeibctypes.ICARetry_REINVEST, Attempts: 2})
+
+	// Once the reinvestment is submitted, the retry should be removed
+	err := stakeibckeeper.WithdrawalBalanceCallback(s.App.StakeibcKeeper, s.Ctx, tc.validArgs.callbackArgs, tc.validArgs.query)
+	s.Require().NoError(err)
+
+	_, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, stakeibctypes.ICARetry_REINVEST, 0)
+	s.Require().False(found, "reinvest retry should be removed")
 }
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Returns the host zone's instant redemption buffer
+// Host zones registered before the buffer was introduced will have a nil value
+func GetInstantRedemptionBuffer(hostZone types.HostZone) sdkmath.Int {
+	if hostZone.InstantRedemptionBuffer.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return hostZone.InstantRedemptionBuffer
+}
+
+// Holds back a portion of a TRANSFER_QUEUE deposit record to top up the host zone's instant redemption buffer
+// The buffer targets InstantRedemptionBufferPercent of the host zone's staked balance
+// The held back tokens remain in the host zone's module account on stride and the deposit record amount is reduced accordingly
+// Returns the amount that was held back
+func (k Keeper) HoldBackInstantRedemptionBuffer(ctx sdk.Context, hostZone *types.HostZone, depositRecord *recordstypes.DepositRecord) sdkmath.Int {
+	// Once a host zone is being sunset, all deposits are staked so that they can be unbonded
+	bufferPercent := k.GetParam(ctx, types.KeyInstantRedemptionBufferPercent)
+	if bufferPercent == 0 || !depositRecord.Amount.IsPositive() || hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+		return sdkmath.ZeroInt()
+	}
+
+	currentBuffer := GetInstantRedemptionBuffer(*hostZone)
+	targetBuffer := sdk.NewDecFromInt(hostZone.StakedBal).Mul(sdk.NewDec(int64(bufferPercent)).Quo(sdk.NewDec(100))).TruncateInt()
+	if currentBuffer.GTE(targetBuffer) {
+		return sdkmath.ZeroInt()
+	}
+
+	holdBackAmount := sdkmath.MinInt(targetBuffer.Sub(currentBuffer), depositRecord.Amount)
+
+	depositRecord.Amount = depositRecord.Amount.Sub(holdBackAmount)
+	hostZone.InstantRedemptionBuffer = currentBuffer.Add(holdBackAmount)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Held back %v%s from deposit record %d for the instant redemption buffer (buffer: %v, target: %v)",
+		holdBackAmount, hostZone.HostDenom, depositRecord.Id, hostZone.InstantRedemptionBuffer, targetBuffer))
+
+	return holdBackAmount
+}
+
+// Returns the amount paid out for an instant redemption of the given native amount, and the fee withheld
+func (k Keeper) GetInstantRedemptionPayout(ctx sdk.Context, nativeAmount sdkmath.Int) (payoutAmount sdkmath.Int, feeAmount sdkmath.Int) {
+	feePercent := k.GetParam(ctx, types.KeyInstantRedemptionFee)
+	feeAmount = sdk.NewDecFromInt(nativeAmount).Mul(sdk.NewDec(int64(feePercent)).Quo(sdk.NewDec(100))).TruncateInt()
+	return nativeAmount.Sub(feeAmount), feeAmount
+}
+
+// Attempts to redeem stTokens immediately using the host zone's instant redemption buffer
+// The stTokens are burned and the native tokens (less the instant redemption fee) are sent to the redeemer on stride
+// The fee remains in the buffer, and since the buffer is included in the redemption rate, it accrues to stakers
+// Returns false (with no state changes) if the buffer is too small to cover the redemption
+func (k Keeper) InstantRedeemStake(ctx sdk.Context, hostZone types.HostZone, sender sdk.AccAddress, stTokenAmount sdkmath.Int, nativeAmount sdkmath.Int) (bool, error) {
+	payoutAmount, feeAmount := k.GetInstantRedemptionPayout(ctx, nativeAmount)
+
+	buffer := GetInstantRedemptionBuffer(hostZone)
+	if buffer.LT(payoutAmount) {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+			"Instant redemption buffer (%v) is insufficient to cover redemption of %v%s", buffer, payoutAmount, hostZone.HostDenom))
+		return false, nil
+	}
+
+	zoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+	if err != nil {
+		return false, fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.Address, hostZone.ChainId)
+	}
+
+	// Escrow the stTokens in the host zone account and burn them
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	if err := k.bankKeeper.SendCoins(ctx, sender, zoneAddress, sdk.NewCoins(sdk.NewCoin(stDenom, stTokenAmount))); err != nil {
+		return false, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v%s to zone account. err: %s", stTokenAmount, stDenom, err.Error())
+	}
+	if err := k.BurnTokens(ctx, hostZone, stTokenAmount); err != nil {
+		return false, errorsmod.Wrapf(err, "unable to burn stTokens for instant redemption")
+	}
+
+	// Pay out the native tokens from the buffer
+	payoutCoin := sdk.NewCoin(hostZone.IbcDenom, payoutAmount)
+	if err := k.bankKeeper.SendCoins(ctx, zoneAddress, sender, sdk.NewCoins(payoutCoin)); err != nil {
+		return false, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v from the instant redemption buffer. err: %s", payoutCoin, err.Error())
+	}
+
+	hostZone.InstantRedemptionBuffer = buffer.Sub(payoutAmount)
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Instant redemption of %v%s for %v%s (fee: %v%s)",
+		stTokenAmount, stDenom, payoutAmount, hostZone.HostDenom, feeAmount, hostZone.HostDenom))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInstantRedemption,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, stTokenAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemAmount, payoutAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeAmount, feeAmount.String()),
+		),
+	)
+
+	return true, nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) setInstantRedemptionParams(bufferPercent uint64, fee uint64) {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.InstantRedemptionBufferPercent = bufferPercent
+	params.InstantRedemptionFee = fee
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestHoldBackInstantRedemptionBuffer() {
+	testCases := []struct {
+		name                  string
+		bufferPercent         uint64
+		initialBuffer         sdkmath.Int
+		depositAmount         sdkmath.Int
+		expectedHoldBack      sdkmath.Int
+		expectedDepositAmount sdkmath.Int
+	}{
+		{
+			name:                  "buffer disabled",
+			bufferPercent:         0,
+			initialBuffer:         sdkmath.ZeroInt(),
+			depositAmount:         sdkmath.NewInt(500),
+			expectedHoldBack:      sdkmath.ZeroInt(),
+			expectedDepositAmount: sdkmath.NewInt(500),
+		},
+		{
+			// target is 10% of 1000 = 100
+			name:                  "partial hold back",
+			bufferPercent:         10,
+			initialBuffer:         sdkmath.NewInt(40),
+			depositAmount:         sdkmath.NewInt(500),
+			expectedHoldBack:      sdkmath.NewInt(60),
+			expectedDepositAmount: sdkmath.NewInt(440),
+		},
+		{
+			name:                  "full deposit held back",
+			bufferPercent:         10,
+			initialBuffer:         sdkmath.NewInt(40),
+			depositAmount:         sdkmath.NewInt(50),
+			expectedHoldBack:      sdkmath.NewInt(50),
+			expectedDepositAmount: sdkmath.ZeroInt(),
+		},
+		{
+			name:                  "buffer already full",
+			bufferPercent:         10,
+			initialBuffer:         sdkmath.NewInt(100),
+			depositAmount:         sdkmath.NewInt(500),
+			expectedHoldBack:      sdkmath.ZeroInt(),
+			expectedDepositAmount: sdkmath.NewInt(500),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.setInstantRedemptionParams(tc.bufferPercent, 1)
+
+			hostZone := stakeibctypes.HostZone{
+				ChainId:                 HostChainId,
+				StakedBal:               sdkmath.NewInt(1000),
+				InstantRedemptionBuffer: tc.initialBuffer,
+			}
+			depositRecord := recordtypes.DepositRecord{
+				HostZoneId: HostChainId,
+				Amount:     tc.depositAmount,
+				Status:     recordtypes.DepositRecord_TRANSFER_QUEUE,
+			}
+
+			holdBack := s.App.StakeibcKeeper.HoldBackInstantRedemptionBuffer(s.Ctx, &hostZone, &depositRecord)
+			s.Require().Equal(tc.expectedHoldBack.Int64(), holdBack.Int64(), "held back amount")
+			s.Require().Equal(tc.expectedDepositAmount.Int64(), depositRecord.Amount.Int64(), "deposit record amount")
+			s.Require().Equal(tc.initialBuffer.Add(tc.expectedHoldBack).Int64(), hostZone.InstantRedemptionBuffer.Int64(), "buffer amount")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetInstantRedemptionBuffer_NilBuffer() {
+	buffer := stakeibckeeper.GetInstantRedemptionBuffer(stakeibctypes.HostZone{})
+	s.Require().Equal(sdkmath.ZeroInt(), buffer, "nil buffer should be treated as zero")
+}
+
+func (s *KeeperTestSuite) SetupInstantRedeemStake(buffer sdkmath.Int) RedeemStakeTestCase {
+	tc := s.SetupRedeemStake()
+	s.setInstantRedemptionParams(10, 1)
+
+	tc.hostZone.IbcDenom = "ibc/uatom"
+	tc.hostZone.InstantRedemptionBuffer = buffer
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	tc.validMsg.Instant = true
+	return tc
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_InstantSuccessful() {
+	tc := s.SetupInstantRedeemStake(sdkmath.NewInt(5_000_000))
+	msg := tc.validMsg
+
+	initialStSupply := s.App.BankKeeper.GetSupply(s.Ctx, "stuatom")
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected during instant redemption")
+
+	// 1,000,000 redeemed at RR 1 with a 1% fee => 990,000 paid out
+	expectedPayout := sdkmath.NewInt(990_000)
+
+	// User should have received the native tokens (less the fee) and sent the stTokens
+	expectedUserAtomBalance := tc.user.atomBalance.AddAmount(expectedPayout)
+	actualUserAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, "ibc/uatom")
+	s.CompareCoins(expectedUserAtomBalance, actualUserAtomBalance, "user ibc/uatom balance")
+
+	expectedUserStAtomBalance := tc.user.stAtomBalance.SubAmount(msg.Amount)
+	actualUserStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, "stuatom")
+	s.CompareCoins(expectedUserStAtomBalance, actualUserStAtomBalance, "user stuatom balance")
+
+	// The stTokens should have been burned
+	actualStSupply := s.App.BankKeeper.GetSupply(s.Ctx, "stuatom")
+	s.CompareCoins(initialStSupply.SubAmount(msg.Amount), actualStSupply, "stuatom supply")
+
+	// The buffer should have decreased by the payout amount (the fee remains in the buffer)
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdkmath.NewInt(5_000_000).Sub(expectedPayout), hostZone.InstantRedemptionBuffer, "instant redemption buffer")
+
+	// No redemption record should have been created
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding found")
+	s.Require().Empty(hostZoneUnbonding.UserRedemptionRecords, "no user redemption records")
+	s.Require().Equal(sdkmath.ZeroInt(), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_InstantFallbackToQueue() {
+	// The buffer is too small to cover the redemption
+	tc := s.SetupInstantRedeemStake(sdkmath.NewInt(100))
+	msg := tc.validMsg
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when falling back to the unbonding queue")
+
+	// The buffer should be unchanged
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdkmath.NewInt(100), hostZone.InstantRedemptionBuffer, "instant redemption buffer")
+
+	// The redemption should have been queued
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding found")
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 1, "user redemption record created")
+	s.Require().Equal(msg.Amount, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+	s.Require().Equal(msg.Amount, hostZoneUnbonding.StTokenAmount, "host zone unbonding sttoken amount")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_InstantBelowMinNativeOut() {
+	// The buffer is large enough, but the payout after the 1% fee (990,000) is below the minimum
+	tc := s.SetupInstantRedeemStake(sdkmath.NewInt(5_000_000))
+	msg := tc.validMsg
+	msg.MinNativeOut = sdkmath.NewInt(1_000_000)
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when falling back to the unbonding queue")
+
+	// The buffer should be unchanged and the redemption should have been queued at the full amount
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdkmath.NewInt(5_000_000), hostZone.InstantRedemptionBuffer, "instant redemption buffer")
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding found")
+	s.Require().Len(hostZoneUnbonding.UserRedemptionRecords, 1, "user redemption record created")
+	s.Require().Equal(msg.Amount, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+}
+
+func (s *KeeperTestSuite) TestTransferExistingDepositsToHostZones_HoldsBackBuffer() {
+	s.setInstantRedemptionParams(10, 1)
+
+	// The deposit is fully held back since it's smaller than the buffer target (10% of 1000)
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostDenom:         Atom,
+		IbcDenom:          IbcAtom,
+		StakedBal:         sdkmath.NewInt(1000),
+		TransferChannelId: "channel-0",
+		DelegationAccount: &stakeibctypes.ICAAccount{Address: "cosmos_DELEGATION"},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	depositRecord := recordtypes.DepositRecord{
+		Id:                 1,
+		HostZoneId:         HostChainId,
+		Amount:             sdkmath.NewInt(60),
+		Status:             recordtypes.DepositRecord_TRANSFER_QUEUE,
+		DepositEpochNumber: 1,
+	}
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, depositRecord)
+
+	s.App.StakeibcKeeper.TransferExistingDepositsToHostZones(s.Ctx, 2, []recordtypes.DepositRecord{depositRecord})
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(sdkmath.NewInt(60), hostZone.InstantRedemptionBuffer, "instant redemption buffer")
+
+	_, found = s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().False(found, "fully held back deposit record should be removed")
+}
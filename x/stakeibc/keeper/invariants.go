@@ -1,20 +1,184 @@
 package keeper
 
-// DONTCOVER
-
 import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
 )
 
-// RegisterInvariants registers all governance invariants.
+// The maximum relative difference allowed between the stToken supply (valued at the redemption rate)
+// and the assets tracked by the host zone, to account for rewards and slashes since the last redemption rate update
+// divide by 100, so 5 = 5%
+const RedemptionRateInvariantTolerancePercent = 5
+
+// RegisterInvariants registers all stakeibc invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "staked-balance", StakedBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-weights", ValidatorWeightsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "user-redemption-records", UserRedemptionRecordsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "redemption-rate", RedemptionRateInvariant(k))
 }
 
 // AllInvariants runs all invariants of the stakeibc module
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		// msg, broke := RedemptionRateInvariant(k)(ctx)
-		// note: once we have >1 invariant here, follow the pattern from staking module invariants here: https://github.com/cosmos/cosmos-sdk/blob/v0.46.0/x/staking/keeper/invariants.go
-		return "", false
+		res, stop := StakedBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = ValidatorWeightsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = UserRedemptionRecordsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return RedemptionRateInvariant(k)(ctx)
+	}
+}
+
+// Checks that each host zone's staked balance is equal to the sum of its validator's delegations
+func StakedBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		for _, hostZone := range k.GetAllHostZone(ctx) {
+			stakedBal := sdkmath.ZeroInt()
+			if !hostZone.StakedBal.IsNil() {
+				stakedBal = hostZone.StakedBal
+			}
+
+			totalDelegations := sdkmath.ZeroInt()
+			for _, validator := range hostZone.Validators {
+				if !validator.DelegationAmt.IsNil() {
+					totalDelegations = totalDelegations.Add(validator.DelegationAmt)
+				}
+			}
+
+			if !stakedBal.Equal(totalDelegations) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s staked balance (%v) does not equal the sum of validator delegations (%v)\n",
+					hostZone.ChainId, stakedBal, totalDelegations)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "staked-balance", msg), broken
+	}
+}
+
+// Checks that no host zone has more validators with a non-zero weight than the SafetyNumValidators param
+// The weights are checked against the param when they're set, and a param change that would lower it below
+// a host zone's weighted validators is rejected, so the invariant holds across param changes
+func ValidatorWeightsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		// The params are only read if there are host zones, since the crisis module
+		// checks invariants at genesis before the stakeibc params are initialized
+		hostZones := k.GetAllHostZone(ctx)
+		if len(hostZones) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "validator-weights", msg), broken
+		}
+
+		maxNumValidators := k.GetParam(ctx, types.KeySafetyNumValidators)
+		for _, hostZone := range hostZones {
+			numWeightedValidators := uint64(0)
+			for _, validator := range hostZone.Validators {
+				if validator.HasWeight() {
+					numWeightedValidators++
+				}
+			}
+
+			if numWeightedValidators > maxNumValidators {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s has %d validators with a non-zero weight, exceeding the max of %d\n",
+					hostZone.ChainId, numWeightedValidators, maxNumValidators)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator-weights", msg), broken
+	}
+}
+
+// Checks that every user redemption record is referenced by exactly one host zone unbonding
+func UserRedemptionRecordsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		numReferences := map[string]int{}
+		for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+			for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+				for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+					numReferences[userRedemptionRecordId]++
+				}
+			}
+		}
+
+		for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+			if count := numReferences[userRedemptionRecord.Id]; count != 1 {
+				broken = true
+				msg += fmt.Sprintf("\tuser redemption record %s is referenced by %d host zone unbondings\n", userRedemptionRecord.Id, count)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "user-redemption-records", msg), broken
+	}
+}
+
+// Checks that the stToken supply, valued at the redemption rate, is within tolerance of the
+// assets tracked for each active host zone (the same components used to calculate the redemption rate)
+// Halted host zones are skipped since they've already been flagged for an unsafe redemption rate
+func RedemptionRateInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+
+		depositRecords := k.RecordsKeeper.GetAllDepositRecord(ctx)
+		for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+			// The redemption rate is frozen while a host zone is being sunset
+			if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+				continue
+			}
+			stSupply := k.bankKeeper.GetSupply(ctx, types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)).Amount
+			if stSupply.IsZero() {
+				continue
+			}
+			if hostZone.RedemptionRate.IsNil() {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s has stTokens in circulation but no redemption rate\n", hostZone.ChainId)
+				continue
+			}
+
+			stakedBal := sdkmath.ZeroInt()
+			if !hostZone.StakedBal.IsNil() {
+				stakedBal = hostZone.StakedBal
+			}
+			trackedAssets := sdk.NewDecFromInt(k.GetUndelegatedBalance(hostZone, depositRecords).
+				Add(stakedBal).
+				Add(k.GetModuleAccountBalance(hostZone, depositRecords)).
+				Add(GetInstantRedemptionBuffer(hostZone)))
+
+			stSupplyValue := sdk.NewDecFromInt(stSupply).Mul(hostZone.RedemptionRate)
+			tolerance := sdk.NewDecWithPrec(RedemptionRateInvariantTolerancePercent, 2)
+			maxDifference := stSupplyValue.Mul(tolerance)
+
+			if stSupplyValue.Sub(trackedAssets).Abs().GT(maxDifference) {
+				broken = true
+				msg += fmt.Sprintf("\thost zone %s stToken supply value (%v) is not within %v of the tracked assets (%v)\n",
+					hostZone.ChainId, stSupplyValue, tolerance, trackedAssets)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "redemption-rate", msg), broken
 	}
 }
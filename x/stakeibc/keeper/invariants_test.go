@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Creates a healthy state in which all invariants should hold:
+//   - a host zone with a staked balance of 1000 split across two validators
+//   - 1000 stTokens in circulation at a redemption rate of 1 (which matches the tracked assets)
+//   - a user redemption record referenced by a single host zone unbonding
+func (s *KeeperTestSuite) SetupInvariants() stakeibctypes.HostZone {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		RedemptionRate: sdk.OneDec(),
+		StakedBal:      sdkmath.NewInt(1000),
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", Weight: 1, DelegationAmt: sdkmath.NewInt(600)},
+			{Address: "val2", Weight: 1, DelegationAmt: sdkmath.NewInt(400)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 1000))
+
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:          "GAIA.1.user",
+		HostZoneId:  HostChainId,
+		EpochNumber: 1,
+		Amount:      sdkmath.ZeroInt(),
+	})
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: HostChainId, UserRedemptionRecords: []string{"GAIA.1.user"}},
+		},
+	})
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestAllInvariants_Healthy() {
+	s.SetupInvariants()
+
+	msg, broken := stakeibckeeper.AllInvariants(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "invariants should not be broken: %s", msg)
+}
+
+func (s *KeeperTestSuite) TestStakedBalanceInvariant() {
+	hostZone := s.SetupInvariants()
+
+	_, broken := stakeibckeeper.StakedBalanceInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "staked balance invariant should hold")
+
+	// Increase the staked balance without updating the validators
+	hostZone.StakedBal = sdkmath.NewInt(1001)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	msg, broken := stakeibckeeper.StakedBalanceInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "staked balance invariant should be broken")
+	s.Require().Contains(msg, "host zone GAIA staked balance (1001) does not equal the sum of validator delegations (1000)")
+
+	_, broken = stakeibckeeper.AllInvariants(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "all invariants should be broken")
+}
+
+func (s *KeeperTestSuite) TestValidatorWeightsInvariant() {
+	hostZone := s.SetupInvariants()
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.SafetyNumValidators = 2
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	// Two weighted validators is within the limit, and a validator with zero weight doesn't count towards it
+	hostZone.Validators = append(hostZone.Validators, &stakeibctypes.Validator{Address: "val3", Weight: 0, DelegationAmt: sdkmath.ZeroInt()})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, broken := stakeibckeeper.ValidatorWeightsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "validator weights invariant should hold")
+
+	// Give the third validator a weight so the limit is exceeded
+	hostZone.Validators[2].Weight = 1
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	msg, broken := stakeibckeeper.ValidatorWeightsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "validator weights invariant should be broken")
+	s.Require().Contains(msg, "host zone GAIA has 3 validators with a non-zero weight, exceeding the max of 2")
+}
+
+func (s *KeeperTestSuite) TestValidatorWeightsInvariant_ParamChange() {
+	s.SetupInvariants()
+
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.SafetyNumValidators = 3
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	paramChangeHandler := s.App.GovKeeper.LegacyRouter().GetRoute(paramproposal.RouterKey)
+	submitParamChange := func(safetyNumValidators string) error {
+		return paramChangeHandler(s.Ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+			{Subspace: stakeibctypes.ModuleName, Key: string(stakeibctypes.KeySafetyNumValidators), Value: safetyNumValidators},
+		}))
+	}
+
+	// Lowering the limit below the number of weighted validators should be rejected
+	err := submitParamChange(`"1"`)
+	s.Require().ErrorIs(err, stakeibctypes.ErrMaxNumValidators, "param change below the weighted validators")
+	s.Require().Equal(uint64(3), s.App.StakeibcKeeper.GetParam(s.Ctx, stakeibctypes.KeySafetyNumValidators), "param after rejected change")
+
+	// Lowering it to the number of weighted validators should succeed, and the invariant should still hold
+	err = submitParamChange(`"2"`)
+	s.Require().NoError(err, "param change to the weighted validators")
+	s.Require().Equal(uint64(2), s.App.StakeibcKeeper.GetParam(s.Ctx, stakeibctypes.KeySafetyNumValidators), "param after successful change")
+
+	_, broken := stakeibckeeper.ValidatorWeightsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "validator weights invariant should hold after the param change")
+}
+
+func (s *KeeperTestSuite) TestUserRedemptionRecordsInvariant_Unreferenced() {
+	s.SetupInvariants()
+
+	_, broken := stakeibckeeper.UserRedemptionRecordsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "user redemption records invariant should hold")
+
+	// Add a record that isn't referenced by any host zone unbonding
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:         "GAIA.2.user",
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.ZeroInt(),
+	})
+
+	msg, broken := stakeibckeeper.UserRedemptionRecordsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "user redemption records invariant should be broken")
+	s.Require().Contains(msg, "user redemption record GAIA.2.user is referenced by 0 host zone unbondings")
+}
+
+func (s *KeeperTestSuite) TestUserRedemptionRecordsInvariant_ReferencedTwice() {
+	s.SetupInvariants()
+
+	// Reference the existing record from a second epoch unbonding record
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{
+			{HostZoneId: HostChainId, UserRedemptionRecords: []string{"GAIA.1.user"}},
+		},
+	})
+
+	msg, broken := stakeibckeeper.UserRedemptionRecordsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "user redemption records invariant should be broken")
+	s.Require().Contains(msg, "user redemption record GAIA.1.user is referenced by 2 host zone unbondings")
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateInvariant() {
+	hostZone := s.SetupInvariants()
+
+	// A small difference (e.g. from rewards since the last update) is within tolerance
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("1.02")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, broken := stakeibckeeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "redemption rate invariant should hold")
+
+	// Deposits that have not yet been staked count towards the tracked assets
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         1,
+		HostZoneId: HostChainId,
+		Amount:     sdkmath.NewInt(200),
+		Status:     recordtypes.DepositRecord_DELEGATION_QUEUE,
+	})
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("1.2")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, broken = stakeibckeeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "redemption rate invariant should hold with the deposit")
+
+	// Remove the deposit so the supply value (1200) exceeds the tracked assets (1000)
+	s.App.RecordsKeeper.RemoveDepositRecord(s.Ctx, 1)
+
+	msg, broken := stakeibckeeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().True(broken, "redemption rate invariant should be broken")
+	s.Require().Contains(msg, "host zone GAIA stToken supply value")
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateInvariant_ToleranceBoundary() {
+	hostZone := s.SetupInvariants()
+
+	// The supply value is 1000, so the tracked assets can differ by at most 5% (50) in either direction
+	testCases := []struct {
+		stakedBal      int64
+		expectedBroken bool
+	}{
+		{stakedBal: 950, expectedBroken: false},
+		{stakedBal: 949, expectedBroken: true},
+		{stakedBal: 1050, expectedBroken: false},
+		{stakedBal: 1051, expectedBroken: true},
+	}
+
+	for _, tc := range testCases {
+		hostZone.StakedBal = sdkmath.NewInt(tc.stakedBal)
+		s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+		_, broken := stakeibckeeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+		s.Require().Equal(tc.expectedBroken, broken, "redemption rate invariant broken with staked balance %d", tc.stakedBal)
+	}
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateInvariant_HaltedZone() {
+	hostZone := s.SetupInvariants()
+
+	// Halted zones are already flagged for an unsafe redemption rate, so they are excluded
+	hostZone.RedemptionRate = sdk.NewDec(2)
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, broken := stakeibckeeper.RedemptionRateInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "redemption rate invariant should skip halted zones")
+}
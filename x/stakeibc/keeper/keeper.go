@@ -48,6 +48,7 @@ type (
 		hooks                 types.StakeIBCHooks
 		accountKeeper         types.AccountKeeper
 		RatelimitKeeper       types.RatelimitKeeper
+		DistributionKeeper    types.DistributionKeeper
 	}
 )
 
@@ -69,6 +70,7 @@ func NewKeeper(
 	StakingKeeper stakingkeeper.Keeper,
 	ICACallbacksKeeper icacallbackskeeper.Keeper,
 	RatelimitKeeper types.RatelimitKeeper,
+	DistributionKeeper types.DistributionKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -90,6 +92,7 @@ func NewKeeper(
 		StakingKeeper:         StakingKeeper,
 		ICACallbacksKeeper:    ICACallbacksKeeper,
 		RatelimitKeeper:       RatelimitKeeper,
+		DistributionKeeper:    DistributionKeeper,
 	}
 }
 
@@ -275,7 +278,15 @@ func (k Keeper) IsRedemptionRateWithinSafetyBounds(ctx sdk.Context, zone types.H
 		maxSafetyThreshold = zone.MaxRedemptionRate
 	}
 
+	// If enabled, compare the time-weighted redemption rate against the bounds instead of the latest sample
+	// If there's no history yet, fall back to the spot rate
 	redemptionRate := zone.RedemptionRate
+	if twapEpochs := k.GetParam(ctx, types.KeyRedemptionRateTwapEpochs); twapEpochs > 0 {
+		twap, err := k.GetTimeWeightedRedemptionRate(ctx, zone.ChainId, twapEpochs)
+		if err == nil {
+			redemptionRate = twap
+		}
+	}
 
 	if redemptionRate.LT(minSafetyThreshold) || redemptionRate.GT(maxSafetyThreshold) {
 		errMsg := fmt.Sprintf("IsRedemptionRateWithinSafetyBounds check failed %s is outside safety bounds [%s, %s]", redemptionRate.String(), minSafetyThreshold.String(), maxSafetyThreshold.String())
@@ -302,7 +313,7 @@ func (k Keeper) ConfirmValSetHasSpace(ctx sdk.Context, validators []*types.Valid
 	// count up the number of validators with non-zero weights
 	numNonzeroWgtValidators := 0
 	for _, validator := range validators {
-		if validator.Weight > 0 {
+		if validator.HasWeight() {
 			numNonzeroWgtValidators++
 		}
 	}
@@ -316,3 +327,24 @@ func (k Keeper) ConfirmValSetHasSpace(ctx sdk.Context, validators []*types.Valid
 
 	return nil
 }
+
+// Check that no host zone has more validators with non-zero weights than the max number of validators
+// This is checked when the param is changed by governance, since the weights are only checked against
+// the param when they're set
+func (k Keeper) ConfirmValSetsWithinLimit(ctx sdk.Context) error {
+	maxNumVals := k.GetParam(ctx, types.KeySafetyNumValidators)
+	for _, hostZone := range k.GetAllHostZone(ctx) {
+		numNonzeroWgtValidators := uint64(0)
+		for _, validator := range hostZone.Validators {
+			if validator.HasWeight() {
+				numNonzeroWgtValidators++
+			}
+		}
+
+		if numNonzeroWgtValidators > maxNumVals {
+			return errorsmod.Wrapf(types.ErrMaxNumValidators, "host zone %s has %d validators with non-zero weights, exceeding the max of %d",
+				hostZone.ChainId, numNonzeroWgtValidators, maxNumVals)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,436 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochstypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetLSMTokenDeposit set a specific lsmTokenDeposit in the store from its index
+func (k Keeper) SetLSMTokenDeposit(ctx sdk.Context, deposit types.LSMTokenDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LSMTokenDepositKeyPrefix))
+	b := k.cdc.MustMarshal(&deposit)
+	store.Set(types.LSMTokenDepositKey(deposit.ChainId, deposit.Denom, deposit.StakerAddress), b)
+}
+
+// GetLSMTokenDeposit returns an lsmTokenDeposit from its index
+func (k Keeper) GetLSMTokenDeposit(ctx sdk.Context, chainId, denom, stakerAddress string) (deposit types.LSMTokenDeposit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LSMTokenDepositKeyPrefix))
+
+	b := store.Get(types.LSMTokenDepositKey(chainId, denom, stakerAddress))
+	if b == nil {
+		return deposit, false
+	}
+
+	k.cdc.MustUnmarshal(b, &deposit)
+	return deposit, true
+}
+
+// RemoveLSMTokenDeposit removes an lsmTokenDeposit from the store
+func (k Keeper) RemoveLSMTokenDeposit(ctx sdk.Context, chainId, denom, stakerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LSMTokenDepositKeyPrefix))
+	store.Delete(types.LSMTokenDepositKey(chainId, denom, stakerAddress))
+}
+
+// GetAllLSMTokenDeposits returns all lsmTokenDeposits
+func (k Keeper) GetAllLSMTokenDeposits(ctx sdk.Context) (list []types.LSMTokenDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LSMTokenDepositKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LSMTokenDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllHostZoneLSMTokenDeposits returns all of a host zone's lsmTokenDeposits
+func (k Keeper) GetAllHostZoneLSMTokenDeposits(ctx sdk.Context, chainId string) (list []types.LSMTokenDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LSMTokenDepositKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.HostZoneKeyPrefix(chainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LSMTokenDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Marshalls LSM liquid stake callback arguments
+func (k Keeper) MarshalLSMLiquidStakeCallbackArgs(ctx sdk.Context, lsmCallback types.LSMLiquidStakeCallback) ([]byte, error) {
+	out, err := proto.Marshal(&lsmCallback)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("MarshalLSMLiquidStakeCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return out, nil
+}
+
+// Unmarshalls LSM liquid stake callback arguments into an LSMLiquidStakeCallback struct
+func (k Keeper) UnmarshalLSMLiquidStakeCallbackArgs(ctx sdk.Context, lsmCallback []byte) (*types.LSMLiquidStakeCallback, error) {
+	unmarshalledLSMCallback := types.LSMLiquidStakeCallback{}
+	if err := proto.Unmarshal(lsmCallback, &unmarshalledLSMCallback); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("UnmarshalLSMLiquidStakeCallbackArgs %v", err.Error()))
+		return nil, err
+	}
+	return &unmarshalledLSMCallback, nil
+}
+
+// Determines the host zone and validator of an LSM token from its IBC denom on stride
+// The token must have been transferred directly from the host over the host zone's transfer channel,
+// and the validator whose shares were tokenized must be one of the host zone's validators
+// Returns the host zone, the LSM token denom on the host, and the validator
+func (k Keeper) GetLSMTokenHostZoneAndValidator(ctx sdk.Context, ibcDenom string) (hostZone types.HostZone, lsmDenom string, validator types.Validator, err error) {
+	if !types.IsIBCToken(ibcDenom) {
+		return hostZone, "", validator, errorsmod.Wrapf(types.ErrInvalidLSMToken, "LSM token denom must be an IBC denom (%s)", ibcDenom)
+	}
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(ibcDenom, transfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return hostZone, "", validator, errorsmod.Wrapf(types.ErrInvalidLSMToken, "invalid IBC denom (%s): %s", ibcDenom, err.Error())
+	}
+	denomTrace, found := k.RecordsKeeper.TransferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return hostZone, "", validator, errorsmod.Wrapf(types.ErrInvalidLSMToken, "denom trace not found for %s", ibcDenom)
+	}
+
+	// The token must have come from the host zone's transfer channel, with only a single hop
+	pathParts := strings.Split(denomTrace.Path, "/")
+	if len(pathParts) != 2 || pathParts[0] != transfertypes.PortID {
+		return hostZone, "", validator, errorsmod.Wrapf(types.ErrInvalidLSMToken,
+			"LSM token must be transferred directly from the host (path: %s)", denomTrace.Path)
+	}
+	// Halted host zones are included so that the caller can return a more specific error
+	found = false
+	for _, zone := range k.GetAllHostZone(ctx) {
+		if zone.TransferChannelId == pathParts[1] {
+			hostZone, found = zone, true
+			break
+		}
+	}
+	if !found {
+		return hostZone, "", validator, errorsmod.Wrapf(types.ErrInvalidLSMToken, "no host zone found for transfer channel %s", pathParts[1])
+	}
+
+	// The base denom of an LSM token is the validator address followed by the tokenize share record ID
+	lsmDenom = denomTrace.BaseDenom
+	validatorAddress, _, err := types.ParseLSMTokenDenom(lsmDenom)
+	if err != nil {
+		return hostZone, "", validator, err
+	}
+	validator, _, found = GetValidatorFromAddress(hostZone.Validators, validatorAddress)
+	if !found {
+		return hostZone, "", validator, errorsmod.Wrapf(types.ErrValidatorNotFound,
+			"validator %s is not registered on host zone %s", validatorAddress, hostZone.ChainId)
+	}
+
+	return hostZone, lsmDenom, validator, nil
+}
+
+// Submits an ICQ for the validator whose shares were tokenized, so that the shares can be valued
+// The callback values every LSM token deposit of the validator that's waiting on the query
+func (k Keeper) SubmitLSMValidatorQuery(ctx sdk.Context, hostZone types.HostZone, validatorAddress string) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ to value LSM tokens of validator %s", validatorAddress))
+
+	_, validatorAddressBz, err := bech32.DecodeAndConvert(validatorAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator operator address, could not decode (%s)", err.Error())
+	}
+	queryData := stakingtypes.GetValidatorKey(validatorAddressBz)
+
+	// The query should timeout at the start of the next epoch
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	if err := k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_LSMValidator,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		queryData,
+		ttl,
+	); err != nil {
+		return errorsmod.Wrapf(types.ErrICQFailed, "unable to submit LSM validator query: %s", err.Error())
+	}
+	return nil
+}
+
+// Values the shares of an LSM token deposit using the validator's exchange rate (queried from the host),
+// determines the stTokens that will be minted from the current redemption rate, and transfers the
+// LSM tokens to the delegation account on the host
+func (k Keeper) ValueLSMTokenDeposit(ctx sdk.Context, hostZone types.HostZone, deposit types.LSMTokenDeposit, queriedValidator stakingtypes.Validator) error {
+	if queriedValidator.Jailed || !queriedValidator.IsBonded() {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s is not active on the host", queriedValidator.OperatorAddress)
+	}
+	if queriedValidator.DelegatorShares.IsZero() {
+		return errorsmod.Wrapf(types.ErrDivisionByZero, "validator %s has no delegator shares", queriedValidator.OperatorAddress)
+	}
+
+	// Each LSM token represents a single share of the validator
+	nativeAmount := queriedValidator.TokensFromShares(sdk.NewDecFromInt(deposit.Amount)).TruncateInt()
+	stAmount := sdk.NewDecFromInt(nativeAmount).Quo(hostZone.RedemptionRate).TruncateInt()
+	if stAmount.IsZero() {
+		return errorsmod.Wrapf(types.ErrInsufficientLiquidStake,
+			"LSM liquid stake of %v%s would return 0 stTokens", deposit.Amount, deposit.Denom)
+	}
+
+	deposit.NativeAmount = nativeAmount
+	deposit.StTokenAmount = stAmount
+
+	return k.TransferLSMTokenDeposit(ctx, hostZone, deposit)
+}
+
+// Transfers the LSM tokens of a deposit from the host zone's module account to the delegation account on the host
+func (k Keeper) TransferLSMTokenDeposit(ctx sdk.Context, hostZone types.HostZone, deposit types.LSMTokenDeposit) error {
+	delegationAccount := hostZone.DelegationAccount
+	if delegationAccount == nil || delegationAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + k.GetParam(ctx, types.KeyIBCTransferTimeoutNanos)
+	transferCoin := sdk.NewCoin(deposit.IbcDenom, deposit.Amount)
+	msg := transfertypes.NewMsgTransfer(transfertypes.PortID, hostZone.TransferChannelId, transferCoin,
+		hostZone.Address, delegationAccount.Address, clienttypes.Height{}, timeoutTimestamp)
+
+	msgTransferResponse, err := k.RecordsKeeper.TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to transfer LSM tokens to host")
+	}
+
+	deposit.Status = types.LSMTokenDeposit_TRANSFER_IN_PROGRESS
+	callbackArgsBz, err := k.MarshalLSMLiquidStakeCallbackArgs(ctx, types.LSMLiquidStakeCallback{Deposit: &deposit})
+	if err != nil {
+		return err
+	}
+	k.ICACallbacksKeeper.SetCallbackData(ctx, icacallbackstypes.CallbackData{
+		CallbackKey:  icacallbackstypes.PacketID(msg.SourcePort, msg.SourceChannel, msgTransferResponse.Sequence),
+		PortId:       msg.SourcePort,
+		ChannelId:    msg.SourceChannel,
+		Sequence:     msgTransferResponse.Sequence,
+		CallbackId:   ICACallbackID_LSMTransfer,
+		CallbackArgs: callbackArgsBz,
+	})
+
+	k.SetLSMTokenDeposit(ctx, deposit)
+	return nil
+}
+
+// Submits an ICA from the delegation account to redeem the LSM tokens of a deposit into a native delegation
+func (k Keeper) DetokenizeLSMTokenDeposit(ctx sdk.Context, hostZone types.HostZone, deposit types.LSMTokenDeposit) error {
+	delegationAccount := hostZone.DelegationAccount
+	if delegationAccount == nil || delegationAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+
+	msgs := []sdk.Msg{&types.MsgRedeemTokensForShares{
+		DelegatorAddress: delegationAccount.Address,
+		Amount:           sdk.NewCoin(deposit.Denom, deposit.Amount),
+	}}
+
+	deposit.Status = types.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS
+	callbackArgsBz, err := k.MarshalLSMLiquidStakeCallbackArgs(ctx, types.LSMLiquidStakeCallback{Deposit: &deposit})
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *delegationAccount, ICACallbackID_LSMRedeem, callbackArgsBz); err != nil {
+		return errorsmod.Wrapf(types.ErrICATxFailed, "unable to submit LSM token redemption: %s", err.Error())
+	}
+
+	k.SetLSMTokenDeposit(ctx, deposit)
+	return nil
+}
+
+// Rolls back an LSM liquid stake whose LSM tokens are still on stride by returning the tokens to the staker
+func (k Keeper) RefundLSMTokenDeposit(ctx sdk.Context, hostZone types.HostZone, deposit types.LSMTokenDeposit) error {
+	hostZoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+	if err != nil {
+		return errorsmod.Wrapf(err, "host zone address is invalid")
+	}
+	stakerAddress, err := sdk.AccAddressFromBech32(deposit.StakerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "staker address is invalid")
+	}
+
+	refundCoin := sdk.NewCoin(deposit.IbcDenom, deposit.Amount)
+	if err := k.bankKeeper.SendCoins(ctx, hostZoneAddress, stakerAddress, sdk.NewCoins(refundCoin)); err != nil {
+		return errorsmod.Wrapf(err, "unable to refund %v to %s", refundCoin, deposit.StakerAddress)
+	}
+
+	k.RemoveLSMTokenDeposit(ctx, deposit.ChainId, deposit.Denom, deposit.StakerAddress)
+	k.emitLSMLiquidStakeEvent(ctx, deposit, types.AttributeValueLSMRolledBack)
+
+	return nil
+}
+
+// Rolls back an LSM liquid stake whose LSM tokens have already been transferred to the host by
+// submitting an ICA to transfer the tokens from the delegation account back to the staker
+func (k Keeper) ReturnLSMTokenDeposit(ctx sdk.Context, hostZone types.HostZone, deposit types.LSMTokenDeposit) error {
+	delegationAccount := hostZone.DelegationAccount
+	if delegationAccount == nil || delegationAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+
+	// The ICA and transfer should both timeout before the end of the epoch
+	timeout, err := k.GetICATimeoutNanos(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Failed to get ICATimeout from %s epoch", epochstypes.STRIDE_EPOCH)
+	}
+
+	// get counterparty chain's transfer channel
+	transferChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, hostZone.TransferChannelId)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "transfer channel %s not found", hostZone.TransferChannelId)
+	}
+	counterpartyChannelId := transferChannel.Counterparty.ChannelId
+
+	msgs := []sdk.Msg{transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		counterpartyChannelId,
+		sdk.NewCoin(deposit.Denom, deposit.Amount),
+		delegationAccount.Address,
+		deposit.StakerAddress,
+		clienttypes.Height{},
+		timeout,
+	)}
+
+	// The deposit is kept until the callback confirms the tokens were returned
+	deposit.Status = types.LSMTokenDeposit_RETURN_IN_PROGRESS
+	callbackArgsBz, err := k.MarshalLSMLiquidStakeCallbackArgs(ctx, types.LSMLiquidStakeCallback{Deposit: &deposit})
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *delegationAccount, ICACallbackID_LSMReturn, callbackArgsBz); err != nil {
+		return errorsmod.Wrapf(types.ErrICATxFailed, "unable to submit LSM token return: %s", err.Error())
+	}
+
+	k.SetLSMTokenDeposit(ctx, deposit)
+	return nil
+}
+
+// Completes an LSM liquid stake once the shares have been redeemed into a native delegation on the host
+// The stTokens are minted to the staker, and the delegation is added to the validator and host zone
+func (k Keeper) FinishLSMLiquidStake(ctx sdk.Context, hostZone types.HostZone, deposit types.LSMTokenDeposit) error {
+	stakerAddress, err := sdk.AccAddressFromBech32(deposit.StakerAddress)
+	if err != nil {
+		return errorsmod.Wrapf(err, "staker address is invalid")
+	}
+
+	// Mint the stTokens and transfer them to the staker
+	stCoin := sdk.NewCoin(types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom), deposit.StTokenAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(stCoin)); err != nil {
+		return errorsmod.Wrapf(err, "Failed to mint coins")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, stakerAddress, sdk.NewCoins(stCoin)); err != nil {
+		return errorsmod.Wrapf(err, "Failed to send %s from module to account", stCoin.String())
+	}
+
+	// Record the new delegation
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, deposit.ValidatorAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s not found on host zone %s", deposit.ValidatorAddress, hostZone.ChainId)
+	}
+	validator.DelegationAmt = validator.DelegationAmt.Add(deposit.NativeAmount)
+	hostZone.Validators[valIndex] = &validator
+	hostZone.StakedBal = hostZone.StakedBal.Add(deposit.NativeAmount)
+	k.SetHostZone(ctx, hostZone)
+
+	k.RemoveLSMTokenDeposit(ctx, deposit.ChainId, deposit.Denom, deposit.StakerAddress)
+	k.emitLSMLiquidStakeEvent(ctx, deposit, types.AttributeValueLSMCompleted)
+
+	k.hooks.AfterLiquidStake(ctx, stakerAddress)
+	return nil
+}
+
+// Emits an event when an LSM liquid stake is requested, completed, or rolled back
+func (k Keeper) emitLSMLiquidStakeEvent(ctx sdk.Context, deposit types.LSMTokenDeposit, status string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLSMLiquidStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyLiquidStaker, deposit.StakerAddress),
+			sdk.NewAttribute(types.AttributeKeyHostZone, deposit.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, deposit.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyLSMTokenDenom, deposit.Denom),
+			sdk.NewAttribute(types.AttributeKeyLSMTokenAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, deposit.NativeAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyStTokenAmount, deposit.StTokenAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyLSMStatus, status),
+		),
+	)
+}
+
+// Progresses each LSM token deposit that is not waiting on a callback:
+//   - Deposits whose valuation query expired (i.e. from a previous epoch) are refunded
+//   - Deposits whose transfer to the host failed or timed out are refunded
+//   - Deposits whose redemption timed out are redeemed again
+//   - Deposits whose redemption failed are returned to the staker
+func (k Keeper) ProcessLSMTokenDeposits(ctx sdk.Context, epochNumber uint64) {
+	for _, deposit := range k.GetAllLSMTokenDeposits(ctx) {
+		hostZone, found := k.GetHostZone(ctx, deposit.ChainId)
+		if !found {
+			k.Logger(ctx).Error(fmt.Sprintf("Host zone %s not found for LSM token deposit %s", deposit.ChainId, deposit.Denom))
+			continue
+		}
+
+		var err error
+		cacheCtx, writeCache := ctx.CacheContext()
+		switch deposit.Status {
+		case types.LSMTokenDeposit_VALUATION_IN_PROGRESS:
+			if deposit.EpochNumber >= epochNumber {
+				continue
+			}
+			k.Logger(ctx).Info(utils.LogWithHostZone(deposit.ChainId, "LSM valuation query expired for %s, refunding %s", deposit.Denom, deposit.StakerAddress))
+			err = k.RefundLSMTokenDeposit(cacheCtx, hostZone, deposit)
+		case types.LSMTokenDeposit_REFUND_QUEUE:
+			err = k.RefundLSMTokenDeposit(cacheCtx, hostZone, deposit)
+		case types.LSMTokenDeposit_DETOKENIZATION_QUEUE:
+			if hostZone.Halted {
+				continue
+			}
+			err = k.DetokenizeLSMTokenDeposit(cacheCtx, hostZone, deposit)
+		case types.LSMTokenDeposit_RETURN_QUEUE:
+			if hostZone.Halted {
+				continue
+			}
+			err = k.ReturnLSMTokenDeposit(cacheCtx, hostZone, deposit)
+		default:
+			continue
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(deposit.ChainId, "Unable to process LSM token deposit %s from %s (status: %s): %s",
+				deposit.Denom, deposit.StakerAddress, deposit.Status.String(), err.Error()))
+			continue
+		}
+		writeCache()
+	}
+}
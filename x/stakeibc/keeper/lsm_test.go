@@ -0,0 +1,448 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v5/modules/apps/transfer"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icacallbacktypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	recordsmodule "github.com/Stride-Labs/stride/v9/x/records"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type LSMTestCase struct {
+	hostZone          types.HostZone
+	validatorAddress  string
+	lsmDenom          string
+	lsmIbcDenom       string
+	staker            sdk.AccAddress
+	hostModuleAddress sdk.AccAddress
+	epochNumber       uint64
+	delegationChannel Channel
+}
+
+func (s *KeeperTestSuite) SetupLSMLiquidStake() LSMTestCase {
+	delegationAccountOwner := fmt.Sprintf("%s.%s", HostChainId, "DELEGATION")
+	delegationChannelID := s.CreateICAChannel(delegationAccountOwner)
+	delegationAddress := s.IcaAddresses[delegationAccountOwner]
+
+	// The LSM token's base denom is the validator address followed by the tokenize share record ID
+	validatorAddress := sdk.ValAddress(s.TestAccs[1]).String()
+	lsmDenom := validatorAddress + "/1"
+	lsmDenomTrace := s.GetIBCDenomTrace(lsmDenom)
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, lsmDenomTrace)
+
+	staker := s.TestAccs[0]
+	s.FundAccount(staker, sdk.NewCoin(lsmDenomTrace.IBCDenom(), sdkmath.NewInt(1000)))
+
+	hostModuleAddress := types.NewZoneAddress(HostChainId)
+	hostZone := types.HostZone{
+		ChainId:           HostChainId,
+		Address:           hostModuleAddress.String(),
+		DelegationAccount: &types.ICAAccount{Address: delegationAddress},
+		ConnectionId:      ibctesting.FirstConnectionID,
+		TransferChannelId: ibctesting.FirstChannelID,
+		HostDenom:         Atom,
+		RedemptionRate:    sdk.MustNewDecFromStr("1.25"),
+		StakedBal:         sdkmath.NewInt(10_000),
+		Validators: []*types.Validator{
+			{Name: "val1", Address: validatorAddress, Weight: 1, DelegationAmt: sdkmath.NewInt(10_000)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	epochNumber := uint64(2)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        epochNumber,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000), // dictates timeouts
+	})
+
+	return LSMTestCase{
+		hostZone:          hostZone,
+		validatorAddress:  validatorAddress,
+		lsmDenom:          lsmDenom,
+		lsmIbcDenom:       lsmDenomTrace.IBCDenom(),
+		staker:            staker,
+		hostModuleAddress: hostModuleAddress,
+		epochNumber:       epochNumber,
+		delegationChannel: Channel{
+			PortID:    icatypes.PortPrefix + delegationAccountOwner,
+			ChannelID: delegationChannelID,
+		},
+	}
+}
+
+// Returns a deposit that has already been escrowed in the host zone account
+func (s *KeeperTestSuite) SetupLSMTokenDeposit(tc LSMTestCase, status types.LSMTokenDeposit_Status) types.LSMTokenDeposit {
+	amount := sdkmath.NewInt(1000)
+	deposit := types.LSMTokenDeposit{
+		ChainId:          HostChainId,
+		Denom:            tc.lsmDenom,
+		IbcDenom:         tc.lsmIbcDenom,
+		StakerAddress:    tc.staker.String(),
+		ValidatorAddress: tc.validatorAddress,
+		Amount:           amount,
+		NativeAmount:     sdkmath.NewInt(2000),
+		StTokenAmount:    sdkmath.NewInt(1600),
+		Status:           status,
+		EpochNumber:      tc.epochNumber,
+	}
+	s.App.StakeibcKeeper.SetLSMTokenDeposit(s.Ctx, deposit)
+
+	err := s.App.BankKeeper.SendCoins(s.Ctx, tc.staker, tc.hostModuleAddress, sdk.NewCoins(sdk.NewCoin(tc.lsmIbcDenom, amount)))
+	s.Require().NoError(err, "no error expected when escrowing LSM tokens")
+
+	return deposit
+}
+
+func (s *KeeperTestSuite) GetLSMCallbackArgs(deposit types.LSMTokenDeposit) []byte {
+	callbackArgs, err := s.App.StakeibcKeeper.MarshalLSMLiquidStakeCallbackArgs(s.Ctx, types.LSMLiquidStakeCallback{Deposit: &deposit})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+	return callbackArgs
+}
+
+func (s *KeeperTestSuite) CheckLSMTokenDepositRefunded(tc LSMTestCase) {
+	_, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().False(found, "deposit should be removed")
+
+	stakerBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.staker, tc.lsmIbcDenom)
+	s.Require().Equal(int64(1000), stakerBalance.Amount.Int64(), "staker LSM token balance")
+}
+
+func (s *KeeperTestSuite) TestLSMTokenDepositStore() {
+	deposits := []types.LSMTokenDeposit{
+		{ChainId: "chain-0", Denom: "valoper0/1", StakerAddress: "staker0"},
+		{ChainId: "chain-0", Denom: "valoper0/2", StakerAddress: "staker0"},
+		{ChainId: "chain-1", Denom: "valoper1/1", StakerAddress: "staker1"},
+	}
+	for _, deposit := range deposits {
+		deposit.Amount = sdkmath.NewInt(1)
+		deposit.NativeAmount = sdkmath.ZeroInt()
+		deposit.StTokenAmount = sdkmath.ZeroInt()
+		s.App.StakeibcKeeper.SetLSMTokenDeposit(s.Ctx, deposit)
+	}
+
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, "chain-0", "valoper0/2", "staker0")
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal("valoper0/2", deposit.Denom, "deposit denom")
+
+	s.Require().Len(s.App.StakeibcKeeper.GetAllLSMTokenDeposits(s.Ctx), 3, "all deposits")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllHostZoneLSMTokenDeposits(s.Ctx, "chain-0"), 2, "chain-0 deposits")
+
+	s.App.StakeibcKeeper.RemoveLSMTokenDeposit(s.Ctx, "chain-0", "valoper0/2", "staker0")
+	_, found = s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, "chain-0", "valoper0/2", "staker0")
+	s.Require().False(found, "deposit should be removed")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllLSMTokenDeposits(s.Ctx), 2, "all deposits after removal")
+}
+
+func (s *KeeperTestSuite) TestGetLSMTokenHostZoneAndValidator() {
+	tc := s.SetupLSMLiquidStake()
+
+	hostZone, lsmDenom, validator, err := s.App.StakeibcKeeper.GetLSMTokenHostZoneAndValidator(s.Ctx, tc.lsmIbcDenom)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(HostChainId, hostZone.ChainId, "host zone")
+	s.Require().Equal(tc.lsmDenom, lsmDenom, "lsm denom")
+	s.Require().Equal(tc.validatorAddress, validator.Address, "validator")
+
+	// Token that was transferred over multiple hops
+	multiHopTrace := transfertypes.ParseDenomTrace("transfer/channel-1/transfer/channel-0/" + tc.lsmDenom)
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, multiHopTrace)
+	_, _, _, err = s.App.StakeibcKeeper.GetLSMTokenHostZoneAndValidator(s.Ctx, multiHopTrace.IBCDenom())
+	s.Require().ErrorContains(err, "LSM token must be transferred directly from the host")
+
+	// Token from a channel that doesn't belong to a host zone
+	otherChannelTrace := transfertypes.ParseDenomTrace("transfer/channel-10/" + tc.lsmDenom)
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, otherChannelTrace)
+	_, _, _, err = s.App.StakeibcKeeper.GetLSMTokenHostZoneAndValidator(s.Ctx, otherChannelTrace.IBCDenom())
+	s.Require().ErrorContains(err, "no host zone found for transfer channel channel-10")
+
+	// Token from a validator that isn't registered on the host zone
+	otherValidatorTrace := s.GetIBCDenomTrace(sdk.ValAddress(s.TestAccs[2]).String() + "/1")
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, otherValidatorTrace)
+	_, _, _, err = s.App.StakeibcKeeper.GetLSMTokenHostZoneAndValidator(s.Ctx, otherValidatorTrace.IBCDenom())
+	s.Require().ErrorIs(err, types.ErrValidatorNotFound)
+
+	// Native token that isn't an LSM token
+	nativeTrace := s.GetIBCDenomTrace(Atom)
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, nativeTrace)
+	_, _, _, err = s.App.StakeibcKeeper.GetLSMTokenHostZoneAndValidator(s.Ctx, nativeTrace.IBCDenom())
+	s.Require().ErrorIs(err, types.ErrInvalidLSMToken)
+
+	// Denom without a trace
+	_, _, _, err = s.App.StakeibcKeeper.GetLSMTokenHostZoneAndValidator(s.Ctx, "ibc/"+strings.Repeat("A", 64))
+	s.Require().ErrorContains(err, "denom trace not found")
+}
+
+func (s *KeeperTestSuite) TestLSMValidatorCallback_Successful() {
+	tc := s.SetupLSMLiquidStake()
+	s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_VALUATION_IN_PROGRESS)
+
+	// Each share is worth 2 native tokens
+	queriedValidator := stakingtypes.Validator{
+		OperatorAddress: tc.validatorAddress,
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdkmath.NewInt(2000),
+		DelegatorShares: sdk.NewDec(1000),
+	}
+	validatorBz := s.App.AppCodec().MustMarshal(&queriedValidator)
+
+	err := stakeibckeeper.LSMValidatorCallback(s.App.StakeibcKeeper, s.Ctx, validatorBz, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "lsm validator callback error")
+
+	// The deposit should be valued and transferred (1000 shares * 2 = 2000 native, 2000 / 1.25 = 1600 stTokens)
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_TRANSFER_IN_PROGRESS, deposit.Status, "deposit status")
+	s.Require().Equal(int64(2000), deposit.NativeAmount.Int64(), "native amount")
+	s.Require().Equal(int64(1600), deposit.StTokenAmount.Int64(), "stToken amount")
+
+	hostBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hostModuleAddress, tc.lsmIbcDenom)
+	s.Require().True(hostBalance.IsZero(), "LSM tokens should be transferred from the host zone account")
+
+	callbackData := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbackData, 1, "number of callbacks")
+	s.Require().Equal(stakeibckeeper.ICACallbackID_LSMTransfer, callbackData[0].CallbackId, "callback id")
+}
+
+func (s *KeeperTestSuite) TestLSMValidatorCallback_InactiveValidator() {
+	tc := s.SetupLSMLiquidStake()
+	s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_VALUATION_IN_PROGRESS)
+
+	queriedValidator := stakingtypes.Validator{
+		OperatorAddress: tc.validatorAddress,
+		Status:          stakingtypes.Bonded,
+		Jailed:          true,
+		Tokens:          sdkmath.NewInt(2000),
+		DelegatorShares: sdk.NewDec(1000),
+	}
+	validatorBz := s.App.AppCodec().MustMarshal(&queriedValidator)
+
+	err := stakeibckeeper.LSMValidatorCallback(s.App.StakeibcKeeper, s.Ctx, validatorBz, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "lsm validator callback error")
+
+	// The liquid stake should be rolled back
+	s.CheckLSMTokenDepositRefunded(tc)
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no transfer should be submitted")
+}
+
+func (s *KeeperTestSuite) TestLSMTransferCallback_Successful() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_TRANSFER_IN_PROGRESS)
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationChannel.PortID, tc.delegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found before callback")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err := stakeibckeeper.LSMTransferCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm transfer callback error")
+
+	// The redemption should be submitted from the delegation account
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationChannel.PortID, tc.delegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found after callback")
+	s.Require().Equal(startSequence+1, endSequence, "tx sequence number after callback")
+
+	deposit, found = s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS, deposit.Status, "deposit status")
+}
+
+func (s *KeeperTestSuite) TestLSMTransferCallback_Failure() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_TRANSFER_IN_PROGRESS)
+
+	// After a failed transfer, the deposit should be queued for a refund
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.LSMTransferCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm transfer callback error")
+
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_REFUND_QUEUE, deposit.Status, "deposit status")
+
+	// The tokens are refunded at the next epoch, once the transfer module has returned them to the host zone account
+	s.App.StakeibcKeeper.ProcessLSMTokenDeposits(s.Ctx, tc.epochNumber)
+	s.CheckLSMTokenDepositRefunded(tc)
+}
+
+func (s *KeeperTestSuite) TestLSMTransferCallback_IBCTimeout() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_VALUATION_IN_PROGRESS)
+
+	// Transfer the LSM tokens to the host, which burns the vouchers from the host zone account
+	sequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, transfertypes.PortID, ibctesting.FirstChannelID)
+	s.Require().True(found, "transfer sequence number found")
+
+	err := s.App.StakeibcKeeper.TransferLSMTokenDeposit(s.Ctx, tc.hostZone, deposit)
+	s.Require().NoError(err, "no error expected when transferring LSM tokens")
+
+	hostZoneBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hostModuleAddress, tc.lsmIbcDenom)
+	s.Require().True(hostZoneBalance.IsZero(), "host zone balance after transfer")
+
+	// Time out the packet through the transfer stack
+	// The stakeibc callback runs before the transfer module re-mints the vouchers to the host zone account
+	packetData := transfertypes.NewFungibleTokenPacketData(
+		s.GetIBCDenomTrace(tc.lsmDenom).GetFullDenomPath(),
+		deposit.Amount.String(),
+		tc.hostModuleAddress.String(),
+		tc.hostZone.DelegationAccount.Address,
+	)
+	packet := channeltypes.NewPacket(packetData.GetBytes(), sequence,
+		transfertypes.PortID, ibctesting.FirstChannelID, transfertypes.PortID, ibctesting.FirstChannelID,
+		clienttypes.Height{}, 0)
+
+	transferStack := recordsmodule.NewIBCModule(s.App.RecordsKeeper, transfer.NewIBCModule(s.App.TransferKeeper))
+	err = transferStack.OnTimeoutPacket(s.Ctx, packet, s.TestAccs[2])
+	s.Require().NoError(err, "no error expected when timing out the transfer")
+
+	hostZoneBalance = s.App.BankKeeper.GetBalance(s.Ctx, tc.hostModuleAddress, tc.lsmIbcDenom)
+	s.Require().Equal(deposit.Amount, hostZoneBalance.Amount, "host zone balance after timeout")
+
+	deposit, found = s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_REFUND_QUEUE, deposit.Status, "deposit status")
+
+	// The tokens should be refunded to the staker at the next epoch
+	s.App.StakeibcKeeper.ProcessLSMTokenDeposits(s.Ctx, tc.epochNumber)
+	s.CheckLSMTokenDepositRefunded(tc)
+}
+
+func (s *KeeperTestSuite) TestLSMRedeemCallback_Successful() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err := stakeibckeeper.LSMRedeemCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm redeem callback error")
+
+	// The stTokens should be minted to the staker
+	stBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.staker, types.StAssetDenomFromHostZoneDenom(Atom))
+	s.Require().Equal(int64(1600), stBalance.Amount.Int64(), "staker stToken balance")
+
+	// The delegation should be recorded on the validator and host zone
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(int64(12_000), hostZone.StakedBal.Int64(), "host zone staked balance")
+	s.Require().Equal(int64(12_000), hostZone.Validators[0].DelegationAmt.Int64(), "validator delegation")
+
+	_, found = s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().False(found, "deposit should be removed")
+}
+
+func (s *KeeperTestSuite) TestLSMRedeemCallback_Timeout() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_TIMEOUT}
+	err := stakeibckeeper.LSMRedeemCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm redeem callback error")
+
+	// The redemption should be queued so that it's retried next epoch
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_DETOKENIZATION_QUEUE, deposit.Status, "deposit status")
+}
+
+func (s *KeeperTestSuite) TestLSMRedeemCallback_Failure() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS)
+
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationChannel.PortID, tc.delegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found before callback")
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.LSMRedeemCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm redeem callback error")
+
+	// The tokens should be returned to the staker from the delegation account
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, tc.delegationChannel.PortID, tc.delegationChannel.ChannelID)
+	s.Require().True(found, "sequence number not found after callback")
+	s.Require().Equal(startSequence+1, endSequence, "tx sequence number after callback")
+
+	// The deposit should be kept until the return is acknowledged
+	deposit, found = s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_RETURN_IN_PROGRESS, deposit.Status, "deposit status")
+
+	stBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.staker, types.StAssetDenomFromHostZoneDenom(Atom))
+	s.Require().True(stBalance.IsZero(), "no stTokens should be minted")
+}
+
+func (s *KeeperTestSuite) TestLSMReturnCallback_Successful() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_RETURN_IN_PROGRESS)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err := stakeibckeeper.LSMReturnCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm return callback error")
+
+	_, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().False(found, "deposit should be removed")
+}
+
+func (s *KeeperTestSuite) TestLSMReturnCallback_Failure() {
+	tc := s.SetupLSMLiquidStake()
+	deposit := s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_RETURN_IN_PROGRESS)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.LSMReturnCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.GetLSMCallbackArgs(deposit))
+	s.Require().NoError(err, "lsm return callback error")
+
+	// The return should be queued so that it's retried next epoch
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_RETURN_QUEUE, deposit.Status, "deposit status")
+}
+
+func (s *KeeperTestSuite) TestProcessLSMTokenDeposits() {
+	tc := s.SetupLSMLiquidStake()
+
+	// A valuation from the previous epoch (whose query expired) should be refunded
+	s.SetupLSMTokenDeposit(tc, types.LSMTokenDeposit_VALUATION_IN_PROGRESS)
+
+	// A queued redemption should be submitted
+	queuedDeposit := types.LSMTokenDeposit{
+		ChainId:          HostChainId,
+		Denom:            tc.validatorAddress + "/2",
+		StakerAddress:    tc.staker.String(),
+		ValidatorAddress: tc.validatorAddress,
+		Amount:           sdkmath.NewInt(500),
+		NativeAmount:     sdkmath.NewInt(1000),
+		StTokenAmount:    sdkmath.NewInt(800),
+		Status:           types.LSMTokenDeposit_DETOKENIZATION_QUEUE,
+		EpochNumber:      tc.epochNumber,
+	}
+	s.App.StakeibcKeeper.SetLSMTokenDeposit(s.Ctx, queuedDeposit)
+
+	// A valuation from the current epoch should be left alone
+	currentDeposit := queuedDeposit
+	currentDeposit.Denom = tc.validatorAddress + "/3"
+	currentDeposit.Status = types.LSMTokenDeposit_VALUATION_IN_PROGRESS
+	currentDeposit.EpochNumber = tc.epochNumber + 1
+	s.App.StakeibcKeeper.SetLSMTokenDeposit(s.Ctx, currentDeposit)
+
+	s.App.StakeibcKeeper.ProcessLSMTokenDeposits(s.Ctx, tc.epochNumber+1)
+
+	s.CheckLSMTokenDepositRefunded(tc)
+
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, queuedDeposit.Denom, tc.staker.String())
+	s.Require().True(found, "queued deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS, deposit.Status, "queued deposit status")
+
+	deposit, found = s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, currentDeposit.Denom, tc.staker.String())
+	s.Require().True(found, "current deposit should be found")
+	s.Require().Equal(currentDeposit, deposit, "current deposit")
+}
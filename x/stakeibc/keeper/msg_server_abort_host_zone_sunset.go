@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Aborts a host zone sunset while its delegations are still being unbonded
+// The funds that were already unbonded are restaked once they've reached the redemption account,
+// after which the host zone returns to normal operation
+func (k msgServer) AbortHostZoneSunset(goCtx context.Context, msg *types.MsgAbortHostZoneSunset) (*types.MsgAbortHostZoneSunsetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+	if hostZone.Halted {
+		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "host zone %s is halted", msg.ChainId)
+	}
+
+	if err := k.StartHostZoneSunsetAbort(ctx, hostZone); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAbortHostZoneSunsetResponse{}, nil
+}
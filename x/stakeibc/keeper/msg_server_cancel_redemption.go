@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Cancels a user's pending redemption, as long as the host zone unbonding has not yet been processed
+// The user redemption record is removed, its amounts are subtracted from the host zone unbonding,
+// and the escrowed stTokens are returned to the redeemer
+func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Logger(ctx).Info(fmt.Sprintf("cancel redemption: %s", msg.String()))
+
+	sender, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "creator address is invalid: %s. err: %s", msg.Creator, err.Error())
+	}
+	hostZone, found := k.GetHostZone(ctx, msg.HostZone)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone is invalid: %s", msg.HostZone)
+	}
+
+	// Confirm the user has a redemption record for the epoch
+	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, msg.EpochNumber, sender.String())
+	userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord, "user redemption record not found: %s", redemptionId)
+	}
+
+	// The redemption can only be cancelled if the unbonding has not been submitted yet
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, msg.EpochNumber, hostZone.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", hostZone.ChainId)
+	}
+	if hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_QUEUE {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionNotCancellable, "host zone unbonding has status %s, expected %s",
+			hostZoneUnbonding.Status.String(), recordstypes.HostZoneUnbonding_UNBONDING_QUEUE.String())
+	}
+	if !utils.ContainsString(hostZoneUnbonding.UserRedemptionRecords, redemptionId) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidUserRedemptionRecord, "user redemption record %s not found in host zone unbonding", redemptionId)
+	}
+
+	// Records created before the escrowed stToken amount was tracked cannot be cancelled
+	stTokenAmount := userRedemptionRecord.StTokenAmount
+	if stTokenAmount.IsNil() || !stTokenAmount.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionNotCancellable, "user redemption record %s has no escrowed stToken amount", redemptionId)
+	}
+	if hostZoneUnbonding.StTokenAmount.LT(stTokenAmount) || hostZoneUnbonding.NativeTokenAmount.LT(userRedemptionRecord.Amount) {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionNotCancellable, "host zone unbonding amounts are lower than the redemption amounts")
+	}
+
+	// Return the escrowed stTokens
+	zoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+	if err != nil {
+		return nil, fmt.Errorf("could not bech32 decode address %s of zone with id: %s", hostZone.Address, hostZone.ChainId)
+	}
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stCoin := sdk.NewCoin(stDenom, stTokenAmount)
+	if err := k.bankKeeper.SendCoins(ctx, zoneAddress, sender, sdk.NewCoins(stCoin)); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't return %v to redeemer. err: %s", stCoin, err.Error())
+	}
+
+	// Remove the redemption from the host zone unbonding
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Sub(userRedemptionRecord.Amount)
+	hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Sub(stTokenAmount)
+	remainingRecordIds := []string{}
+	for _, recordId := range hostZoneUnbonding.UserRedemptionRecords {
+		if recordId != redemptionId {
+			remainingRecordIds = append(remainingRecordIds, recordId)
+		}
+	}
+	hostZoneUnbonding.UserRedemptionRecords = remainingRecordIds
+
+	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, msg.EpochNumber, hostZone.ChainId, hostZoneUnbonding)
+	if !success {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "couldn't set host zone epoch unbonding record for epoch %d", msg.EpochNumber)
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+	k.RecordsKeeper.RemoveUserRedemptionRecord(ctx, redemptionId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedemptionCancel,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRecipientAddress, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, userRedemptionRecord.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyStTokenAmount, stTokenAmount.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(fmt.Sprintf("executed cancel redemption: %s", msg.String()))
+	return &types.MsgCancelRedemptionResponse{}, nil
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type CancelRedemptionTestCase struct {
+	redeemTestCase RedeemStakeTestCase
+	redemptionId   string
+	validMsg       stakeibctypes.MsgCancelRedemption
+}
+
+// Creates a pending redemption that can then be cancelled
+func (s *KeeperTestSuite) SetupCancelRedemption() CancelRedemptionTestCase {
+	redeemTc := s.SetupRedeemStake()
+
+	redeemMsg := redeemTc.validMsg
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	epochNumber := redeemTc.initialState.epochNumber
+	return CancelRedemptionTestCase{
+		redeemTestCase: redeemTc,
+		redemptionId:   recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, epochNumber, redeemTc.user.acc.String()),
+		validMsg: stakeibctypes.MsgCancelRedemption{
+			Creator:     redeemTc.user.acc.String(),
+			HostZone:    HostChainId,
+			EpochNumber: epochNumber,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_Successful() {
+	tc := s.SetupCancelRedemption()
+	user := tc.redeemTestCase.user
+	zoneAccount := tc.redeemTestCase.zoneAccount
+
+	// Add a redemption from another user to confirm it is left untouched
+	otherUser := s.TestAccs[1]
+	s.FundAccount(otherUser, sdk.NewInt64Coin("stuatom", 1_000_000))
+	otherRedeemMsg := tc.redeemTestCase.validMsg
+	otherRedeemMsg.Creator = otherUser.String()
+	otherRedeemMsg.Amount = sdkmath.NewInt(400_000)
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &otherRedeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming from second user")
+	otherRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, tc.validMsg.EpochNumber, otherUser.String())
+
+	_, err = s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when cancelling redemption")
+
+	// The escrowed stTokens should be returned to the user
+	actualUserStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, user.acc, "stuatom")
+	s.CompareCoins(user.stAtomBalance, actualUserStAtomBalance, "user stuatom balance")
+
+	// The zone account should only hold the other user's escrow
+	expectedZoneStAtomBalance := zoneAccount.stAtomBalance.AddAmount(otherRedeemMsg.Amount)
+	actualZoneStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, zoneAccount.acc, "stuatom")
+	s.CompareCoins(expectedZoneStAtomBalance, actualZoneStAtomBalance, "zone stuatom balance")
+
+	// The user redemption record should be removed
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.redemptionId)
+	s.Require().False(found, "user redemption record should be removed")
+
+	// The host zone unbonding should only reflect the other user's redemption
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.validMsg.EpochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Equal([]string{otherRedemptionId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding record ids")
+	s.Require().Equal(otherRedeemMsg.Amount, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+	s.Require().Equal(otherRedeemMsg.Amount, hostZoneUnbonding.StTokenAmount, "host zone unbonding stToken amount")
+
+	// Every remaining record referenced by the unbonding should exist and sum to the unbonding amounts
+	nativeTotal := sdkmath.ZeroInt()
+	stTokenTotal := sdkmath.ZeroInt()
+	for _, recordId := range hostZoneUnbonding.UserRedemptionRecords {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, recordId)
+		s.Require().True(found, "user redemption record %s", recordId)
+		nativeTotal = nativeTotal.Add(record.Amount)
+		stTokenTotal = stTokenTotal.Add(record.StTokenAmount)
+	}
+	s.Require().Equal(hostZoneUnbonding.NativeTokenAmount, nativeTotal, "sum of native record amounts")
+	s.Require().Equal(hostZoneUnbonding.StTokenAmount, stTokenTotal, "sum of stToken record amounts")
+
+	// The user should be able to redeem again in the same epoch
+	redeemMsg := tc.redeemTestCase.validMsg
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming after cancellation")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_DirectedDelegationsUnchanged() {
+	redeemTc := s.SetupRedeemStake()
+
+	// Add validators with liquid stakes directed to them
+	hostZone := redeemTc.hostZone
+	hostZone.Validators = []*stakeibctypes.Validator{
+		{Address: "val1", Weight: 1, DirectedDelegationAmt: sdkmath.NewInt(100_000_000), UndelegatedDirectedAmt: sdkmath.NewInt(10_000_000)},
+		{Address: "val2", Weight: 1, DirectedDelegationAmt: sdkmath.NewInt(50_000_000), UndelegatedDirectedAmt: sdkmath.ZeroInt()},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Redeem and then cancel the redemption
+	redeemMsg := redeemTc.validMsg
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	cancelMsg := stakeibctypes.MsgCancelRedemption{
+		Creator:     redeemTc.user.acc.String(),
+		HostZone:    HostChainId,
+		EpochNumber: redeemTc.initialState.epochNumber,
+	}
+	_, err = s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &cancelMsg)
+	s.Require().NoError(err, "no error expected when cancelling redemption")
+
+	// The directed amounts are only reduced once the tokens are undelegated, so they should be unchanged
+	actualHostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	for i, validator := range actualHostZone.Validators {
+		s.Require().Equal(hostZone.Validators[i].DirectedDelegationAmt, validator.DirectedDelegationAmt, "directed amount for %s", validator.Address)
+		s.Require().Equal(hostZone.Validators[i].UndelegatedDirectedAmt, validator.UndelegatedDirectedAmt, "undelegated directed amount for %s", validator.Address)
+	}
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_HostZoneNotFound() {
+	tc := s.SetupCancelRedemption()
+
+	invalidMsg := tc.validMsg
+	invalidMsg.HostZone = "fake_host_zone"
+	_, err := s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().EqualError(err, "host zone is invalid: fake_host_zone: host zone not registered")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_RecordNotFound() {
+	tc := s.SetupCancelRedemption()
+
+	// Wrong epoch
+	invalidMsg := tc.validMsg
+	invalidMsg.EpochNumber = 2
+	_, err := s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorContains(err, "user redemption record not found")
+
+	// User that never redeemed
+	invalidMsg = tc.validMsg
+	invalidMsg.Creator = s.TestAccs[1].String()
+	_, err = s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorContains(err, "user redemption record not found")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_UnbondingAlreadyProcessed() {
+	tc := s.SetupCancelRedemption()
+
+	for _, status := range []recordtypes.HostZoneUnbonding_Status{
+		recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS,
+		recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
+		recordtypes.HostZoneUnbonding_CLAIMABLE,
+	} {
+		err := s.App.RecordsKeeper.SetHostZoneUnbondings(s.Ctx, HostChainId, []uint64{tc.validMsg.EpochNumber}, status)
+		s.Require().NoError(err, "no error expected when updating status")
+
+		_, err = s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+		s.Require().ErrorContains(err, "redemption cannot be cancelled", "status %s", status.String())
+	}
+
+	// The record should be untouched
+	_, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.redemptionId)
+	s.Require().True(found, "user redemption record should still exist")
+}
+
+func (s *KeeperTestSuite) TestCancelRedemption_MissingStTokenAmount() {
+	tc := s.SetupCancelRedemption()
+
+	// Remove the stToken amount to mimic a record created before it was tracked
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, tc.redemptionId)
+	s.Require().True(found, "user redemption record")
+	record.StTokenAmount = sdkmath.Int{}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+
+	_, err := s.GetMsgServer().CancelRedemption(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorContains(err, "has no escrowed stToken amount")
+}
@@ -19,18 +19,29 @@ func (k msgServer) ChangeValidatorWeight(goCtx context.Context, msg *types.MsgCh
 		return nil, types.ErrInvalidHostZone
 	}
 
+	// Weights on host zones with a weight policy are recomputed each epoch, so manual changes would be overridden
+	if hostZone.ValidatorWeightPolicy != nil {
+		return nil, errorsmod.Wrapf(types.ErrValidatorWeightsManaged, "cannot change validator weight on host zone %s", msg.HostZone)
+	}
+
 	validators := hostZone.Validators
 	for _, validator := range validators {
 		if validator.GetAddress() == msg.ValAddr {
 
 			// when changing a weight from 0 to non-zero, make sure we have space in the val set for this new validator
-			if validator.Weight == 0 && msg.Weight > 0 {
+			if !validator.HasWeight() && msg.Weight > 0 {
 				err := k.ConfirmValSetHasSpace(ctx, validators)
 				if err != nil {
 					return nil, errorsmod.Wrap(types.ErrMaxNumValidators, "cannot set val weight from zero to nonzero on host zone")
 				}
 			}
-			validator.Weight = msg.Weight
+
+			// an inactive validator keeps a weight of zero, so the new weight is applied once it's reactivated
+			if validator.IsActive() {
+				validator.Weight = msg.Weight
+			} else {
+				validator.DeactivatedWeight = msg.Weight
+			}
 			k.SetHostZone(ctx, hostZone)
 			return &types.MsgChangeValidatorWeightResponse{}, nil
 
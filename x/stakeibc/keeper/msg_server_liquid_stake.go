@@ -35,6 +35,11 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "halted host zone found for denom (%s)", msg.HostDenom)
 	}
 
+	// Liquid stakes are disabled once a host zone starts to be sunset
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is being sunset", hostZone.ChainId)
+	}
+
 	// Get user and module account addresses
 	liquidStakerAddress, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
@@ -81,6 +86,19 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 			"Liquid stake of %s%s would return 0 stTokens", msg.Amount.String(), hostZone.HostDenom)
 	}
 
+	// Slippage protection: the user can optionally specify the minimum number of stTokens they're willing to accept
+	if !msg.MinStTokenOut.IsNil() && stAmount.LT(msg.MinStTokenOut) {
+		return nil, errorsmod.Wrapf(types.ErrMinAmountOutNotMet,
+			"liquid stake would return %v%s, minimum specified: %v", stAmount, types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom), msg.MinStTokenOut)
+	}
+
+	// If the user directed their stake to a validator, record it on the validator (the host zone is stored below)
+	if msg.ValidatorAddress != "" {
+		if err := k.AddDirectedLiquidStake(hostZone, msg.ValidatorAddress, msg.Amount); err != nil {
+			return nil, err
+		}
+	}
+
 	// Transfer the native tokens from the user to module account
 	if err := k.bankKeeper.SendCoins(ctx, liquidStakerAddress, hostZoneAddress, sdk.NewCoins(nativeCoin)); err != nil {
 		return nil, errorsmod.Wrap(err, "failed to send tokens from Account to Module")
@@ -99,6 +117,9 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 	// Update the liquid staked amount on the deposit record
 	depositRecord.Amount = depositRecord.Amount.Add(msg.Amount)
 	k.RecordsKeeper.SetDepositRecord(ctx, *depositRecord)
+	if msg.ValidatorAddress != "" {
+		k.SetHostZone(ctx, *hostZone)
+	}
 
 	// Emit liquid stake event
 	ctx.EventManager().EmitEvent(
@@ -111,6 +132,7 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 			sdk.NewAttribute(types.AttributeKeyNativeIBCDenom, hostZone.IbcDenom),
 			sdk.NewAttribute(types.AttributeKeyNativeAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyStTokenAmount, stAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 		),
 	)
 
@@ -275,6 +275,31 @@ func (s *KeeperTestSuite) TestLiquidStake_ZeroStTokens() {
 	s.Require().EqualError(err, "Liquid stake of 1uatom would return 0 stTokens: Liquid staked amount is too small")
 }
 
+func (s *KeeperTestSuite) TestLiquidStake_MinStTokenOut() {
+	tc := s.SetupLiquidStake()
+
+	// With a redemption rate of 1.25, 1_000_000 native tokens mints 800_000 stTokens
+	hostZone := tc.initialState.hostZone
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("1.25")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	tc.validMsg.Amount = sdkmath.NewInt(1_000_000)
+
+	// If the minimum is above the amount minted, the liquid stake should fail
+	invalidMsg := tc.validMsg
+	invalidMsg.MinStTokenOut = sdkmath.NewInt(800_001)
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().EqualError(err, "liquid stake would return 800000stuatom, minimum specified: 800001: amount out is less than the specified minimum")
+
+	// If the minimum is met, the liquid stake should succeed
+	validMsg := tc.validMsg
+	validMsg.MinStTokenOut = sdkmath.NewInt(800_000)
+	_, err = s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &validMsg)
+	s.Require().NoError(err, "no error expected when minimum is met")
+
+	stAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, StAtom)
+	s.Require().Equal(int64(800_000), stAtomBalance.Amount.Int64(), "stAtom minted")
+}
+
 func (s *KeeperTestSuite) TestLiquidStake_InsufficientBalance() {
 	tc := s.SetupLiquidStake()
 	// Set liquid stake amount to value greater than account balance
@@ -297,3 +322,32 @@ func (s *KeeperTestSuite) TestLiquidStake_HaltedZone() {
 
 	s.Require().EqualError(err, fmt.Sprintf("halted host zone found for denom (%s): Halted host zone found", haltedHostZone.HostDenom))
 }
+
+func (s *KeeperTestSuite) TestLiquidStake_DirectedToValidator() {
+	tc := s.SetupLiquidStake()
+
+	hostZone := tc.initialState.hostZone
+	hostZone.Validators = []*stakeibctypes.Validator{
+		{Address: "val1", Weight: 1},
+		{Address: "val2", Weight: 0},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// Directing the stake to a validator outside the active set should fail
+	invalidMsg := tc.validMsg
+	invalidMsg.ValidatorAddress = "val2"
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrValidatorNotActive)
+
+	// Directing the stake to an active validator should record the directed amount
+	validMsg := tc.validMsg
+	validMsg.ValidatorAddress = "val1"
+	_, err = s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &validMsg)
+	s.Require().NoError(err)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should have been found")
+	s.Require().Equal(validMsg.Amount, hostZone.Validators[0].GetDirectedDelegationAmt(), "val1 directed amount")
+	s.Require().Equal(validMsg.Amount, hostZone.Validators[0].GetUndelegatedDirectedAmt(), "val1 undelegated directed amount")
+	s.Require().True(hostZone.Validators[1].GetDirectedDelegationAmt().IsZero(), "val2 directed amount")
+}
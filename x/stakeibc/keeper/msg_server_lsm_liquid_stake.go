@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Exchanges a user's LSM tokens (tokenized delegations from the host) for stTokens
+// The LSM tokens must live on Stride with an IBC denomination before this function is called
+// Since the value of the tokens depends on the validator's exchange rate, the liquid stake is asynchronous:
+//   1. The LSM tokens are escrowed in the host zone account and an ICQ is submitted for the validator
+//   2. The ICQ callback values the tokens and transfers them to the delegation account on the host
+//   3. Once the transfer succeeds, the delegation account redeems the tokens for a native delegation
+//   4. Once the redemption succeeds, stTokens are minted to the user at the redemption rate from step 2
+// If any of the steps fail, the LSM tokens are returned to the user
+func (k msgServer) LSMLiquidStake(goCtx context.Context, msg *types.MsgLSMLiquidStake) (*types.MsgLSMLiquidStakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the host zone and validator from the LSM token's denom trace
+	hostZone, lsmDenom, validator, err := k.GetLSMTokenHostZoneAndValidator(ctx, msg.LsmTokenIbcDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Error immediately if the host zone is halted
+	if hostZone.Halted {
+		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "halted host zone found for LSM token (%s)", msg.LsmTokenIbcDenom)
+	}
+
+	// Liquid stakes are disabled once a host zone starts to be sunset
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is being sunset", hostZone.ChainId)
+	}
+
+	// The tokens are redeemed by the delegation account, so it must be registered
+	if hostZone.DelegationAccount == nil || hostZone.DelegationAccount.Address == "" {
+		return nil, errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+
+	// Get user and module account addresses
+	liquidStakerAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "user's address is invalid")
+	}
+	hostZoneAddress, err := sdk.AccAddressFromBech32(hostZone.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "host zone address is invalid")
+	}
+
+	// Safety check: redemption rate must be within safety bounds
+	rateIsSafe, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
+	if !rateIsSafe || (err != nil) {
+		return nil, errorsmod.Wrapf(types.ErrRedemptionRateOutsideSafetyBounds, "HostZone: %s, err: %s", hostZone.ChainId, err.Error())
+	}
+
+	// Only one liquid stake of the same LSM token can be in progress for a user at a time
+	if _, found := k.GetLSMTokenDeposit(ctx, hostZone.ChainId, lsmDenom, msg.Creator); found {
+		return nil, errorsmod.Wrapf(types.ErrLSMLiquidStakeInProgress, "liquid stake of %s already in progress for %s", lsmDenom, msg.Creator)
+	}
+
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no epoch number for epoch (%s)", epochtypes.STRIDE_EPOCH)
+	}
+
+	// Confirm the user has a sufficient balance to execute the liquid stake
+	lsmCoin := sdk.NewCoin(msg.LsmTokenIbcDenom, msg.Amount)
+	balance := k.bankKeeper.GetBalance(ctx, liquidStakerAddress, msg.LsmTokenIbcDenom)
+	if balance.IsLT(lsmCoin) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "balance is lower than staking amount. staking amount: %v, balance: %v", msg.Amount, balance.Amount)
+	}
+
+	// Escrow the LSM tokens in the host zone account until the liquid stake completes
+	if err := k.bankKeeper.SendCoins(ctx, liquidStakerAddress, hostZoneAddress, sdk.NewCoins(lsmCoin)); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to send tokens from Account to Module")
+	}
+
+	deposit := types.LSMTokenDeposit{
+		ChainId:          hostZone.ChainId,
+		Denom:            lsmDenom,
+		IbcDenom:         msg.LsmTokenIbcDenom,
+		StakerAddress:    msg.Creator,
+		ValidatorAddress: validator.Address,
+		Amount:           msg.Amount,
+		NativeAmount:     sdk.ZeroInt(),
+		StTokenAmount:    sdk.ZeroInt(),
+		Status:           types.LSMTokenDeposit_VALUATION_IN_PROGRESS,
+		EpochNumber:      strideEpochTracker.EpochNumber,
+	}
+	k.SetLSMTokenDeposit(ctx, deposit)
+
+	// Query the validator's exchange rate to value the tokens
+	if err := k.SubmitLSMValidatorQuery(ctx, hostZone, validator.Address); err != nil {
+		return nil, err
+	}
+
+	k.emitLSMLiquidStakeEvent(ctx, deposit, types.AttributeValueLSMRequested)
+
+	return &types.MsgLSMLiquidStakeResponse{}, nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestLSMLiquidStake_Successful() {
+	tc := s.SetupLSMLiquidStake()
+
+	msg := types.NewMsgLSMLiquidStake(tc.staker.String(), sdkmath.NewInt(600), tc.lsmIbcDenom)
+	_, err := s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err, "no error expected when LSM liquid staking")
+
+	// The tokens should be escrowed in the host zone account
+	stakerBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.staker, tc.lsmIbcDenom)
+	s.Require().Equal(int64(400), stakerBalance.Amount.Int64(), "staker LSM token balance")
+	hostBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.hostModuleAddress, tc.lsmIbcDenom)
+	s.Require().Equal(int64(600), hostBalance.Amount.Int64(), "host zone LSM token balance")
+
+	// The deposit should be stored and awaiting valuation
+	deposit, found := s.App.StakeibcKeeper.GetLSMTokenDeposit(s.Ctx, HostChainId, tc.lsmDenom, tc.staker.String())
+	s.Require().True(found, "deposit should be found")
+	s.Require().Equal(types.LSMTokenDeposit{
+		ChainId:          HostChainId,
+		Denom:            tc.lsmDenom,
+		IbcDenom:         tc.lsmIbcDenom,
+		StakerAddress:    tc.staker.String(),
+		ValidatorAddress: tc.validatorAddress,
+		Amount:           sdkmath.NewInt(600),
+		NativeAmount:     sdkmath.ZeroInt(),
+		StTokenAmount:    sdkmath.ZeroInt(),
+		Status:           types.LSMTokenDeposit_VALUATION_IN_PROGRESS,
+		EpochNumber:      tc.epochNumber,
+	}, deposit, "deposit")
+
+	// The validator query should be submitted
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 1, "number of queries")
+	s.Require().Equal(stakeibckeeper.ICQCallbackID_LSMValidator, queries[0].CallbackId, "query callback id")
+
+	// No stTokens are minted until the liquid stake completes
+	stBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.staker, types.StAssetDenomFromHostZoneDenom(Atom))
+	s.Require().True(stBalance.IsZero(), "no stTokens should be minted")
+}
+
+func (s *KeeperTestSuite) TestLSMLiquidStake_Failures() {
+	tc := s.SetupLSMLiquidStake()
+
+	// Halted host zone
+	hostZone := tc.hostZone
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	msg := types.NewMsgLSMLiquidStake(tc.staker.String(), sdkmath.NewInt(600), tc.lsmIbcDenom)
+	_, err := s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorIs(err, types.ErrHaltedHostZone, "halted host zone")
+
+	// Redemption rate outside of the safety bounds
+	hostZone.Halted = false
+	hostZone.RedemptionRate = sdk.NewDec(2)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err = s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorIs(err, types.ErrRedemptionRateOutsideSafetyBounds, "redemption rate outside bounds")
+
+	// Insufficient balance
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	tooLargeMsg := types.NewMsgLSMLiquidStake(tc.staker.String(), sdkmath.NewInt(1001), tc.lsmIbcDenom)
+	_, err = s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), tooLargeMsg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds, "insufficient balance")
+
+	// Liquid stake already in progress
+	_, err = s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err, "no error expected for first liquid stake")
+
+	_, err = s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().ErrorIs(err, types.ErrLSMLiquidStakeInProgress, "liquid stake in progress")
+
+	// Token that isn't an LSM token
+	nativeTrace := s.GetIBCDenomTrace(Atom)
+	s.App.TransferKeeper.SetDenomTrace(s.Ctx, nativeTrace)
+
+	nativeMsg := types.NewMsgLSMLiquidStake(tc.staker.String(), sdkmath.NewInt(1), nativeTrace.IBCDenom())
+	_, err = s.GetMsgServer().LSMLiquidStake(sdk.WrapSDKContext(s.Ctx), nativeMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidLSMToken, "native token")
+}
@@ -3,16 +3,13 @@ package keeper
 import (
 	"context"
 	"fmt"
-	"sort"
 
-	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
-	"github.com/Stride-Labs/stride/v9/utils"
 	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
 )
 
@@ -26,32 +23,15 @@ func (k msgServer) RebalanceValidators(goCtx context.Context, msg *types.MsgReba
 		return nil, types.ErrInvalidHostZone
 	}
 
-	validatorDeltas, err := k.GetValidatorDelegationAmtDifferences(ctx, hostZone)
+	// Build the redelegations, taking into account any redelegations that are still pending on the host
+	rebalancings, err := k.GetRebalancePlan(ctx, hostZone, msg.NumRebalance)
 	if err != nil {
-		k.Logger(ctx).Error(fmt.Sprintf("Error getting validator deltas for Host Zone %s: %s", hostZone.ChainId, err))
+		k.Logger(ctx).Error(fmt.Sprintf("Error getting rebalance plan for Host Zone %s: %s", hostZone.ChainId, err))
 		return nil, err
 	}
-
-	// we convert the above map into a list of tuples
-	type valPair struct {
-		deltaAmt sdkmath.Int
-		valAddr  string
-	}
-	valDeltaList := make([]valPair, 0)
-	// DO NOT REMOVE: StringMapKeys fixes non-deterministic map iteration
-	for _, valAddr := range utils.StringMapKeys(validatorDeltas) {
-		deltaAmt := validatorDeltas[valAddr]
-		k.Logger(ctx).Info(fmt.Sprintf("Adding deltaAmt: %v to validator: %s", deltaAmt, valAddr))
-		valDeltaList = append(valDeltaList, valPair{deltaAmt, valAddr})
+	if len(rebalancings) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no valid rebalancings found for Host Zone %s", hostZone.ChainId)
 	}
-	// now we sort that list
-	lessFunc := func(i, j int) bool {
-		return valDeltaList[i].deltaAmt.LT(valDeltaList[j].deltaAmt)
-	}
-	sort.SliceStable(valDeltaList, lessFunc)
-	// now varDeltaList is sorted by deltaAmt
-	overWeightIndex := 0
-	underWeightIndex := len(valDeltaList) - 1
 
 	// check if there is a large enough rebalance, if not, just exit
 	total_delegation := k.GetTotalValidatorDelegations(hostZone)
@@ -59,83 +39,31 @@ func (k msgServer) RebalanceValidators(goCtx context.Context, msg *types.MsgReba
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no validator delegations found for Host Zone %s, cannot rebalance 0 delegations!", hostZone.ChainId)
 	}
 
-	var msgs []sdk.Msg
 	delegationIca := hostZone.GetDelegationAccount()
 	if delegationIca == nil || delegationIca.GetAddress() == "" {
 		k.Logger(ctx).Error(fmt.Sprintf("Zone %s is missing a delegation address!", hostZone.ChainId))
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegation account")
 	}
 
-	delegatorAddress := delegationIca.GetAddress()
-
 	// start construction callback
+	var msgs []sdk.Msg
 	rebalanceCallback := types.RebalanceCallback{
 		HostZoneId:   hostZone.ChainId,
 		Rebalancings: []*types.Rebalancing{},
 	}
+	for i := range rebalancings {
+		rebalancing := rebalancings[i]
+		k.Logger(ctx).Info(fmt.Sprintf("Rebalancing %v from %s to %s", rebalancing.Amt, rebalancing.SrcValidator, rebalancing.DstValidator))
 
-	for i := uint64(1); i <= msg.NumRebalance; i++ {
-		underWeightElem := valDeltaList[underWeightIndex]
-		overWeightElem := valDeltaList[overWeightIndex]
-		if underWeightElem.deltaAmt.LT(sdkmath.ZeroInt()) {
-			// if underWeightElem is negative, we're done rebalancing
-			break
-		}
-		if overWeightElem.deltaAmt.GT(sdkmath.ZeroInt()) {
-			// if overWeightElem is positive, we're done rebalancing
-			break
-		}
-		// underweight Elem is positive, overweight Elem is negative
-		overWeightElemAbs := overWeightElem.deltaAmt.Abs()
-		var redelegateMsg *stakingTypes.MsgBeginRedelegate
-		if underWeightElem.deltaAmt.GT(overWeightElemAbs) {
-			// if the underweight element is more off than the overweight element
-			// we transfer stake from the underweight element to the overweight element
-			underWeightElem.deltaAmt = underWeightElem.deltaAmt.Sub(overWeightElemAbs)
-			overWeightIndex += 1
-			// issue an ICA call to the host zone to rebalance the validator
-			redelegateMsg = &stakingTypes.MsgBeginRedelegate{
-				DelegatorAddress:    delegatorAddress,
-				ValidatorSrcAddress: overWeightElem.valAddr,
-				ValidatorDstAddress: underWeightElem.valAddr,
-				Amount:              sdk.NewCoin(hostZone.HostDenom, overWeightElemAbs)}
-			msgs = append(msgs, redelegateMsg)
-			overWeightElem.deltaAmt = sdkmath.ZeroInt()
-		} else if underWeightElem.deltaAmt.LT(overWeightElemAbs) {
-			// if the overweight element is more overweight than the underweight element
-			overWeightElem.deltaAmt = overWeightElem.deltaAmt.Add(underWeightElem.deltaAmt)
-			underWeightIndex -= 1
-			// issue an ICA call to the host zone to rebalance the validator
-			redelegateMsg = &stakingTypes.MsgBeginRedelegate{
-				DelegatorAddress:    delegatorAddress,
-				ValidatorSrcAddress: overWeightElem.valAddr,
-				ValidatorDstAddress: underWeightElem.valAddr,
-				Amount:              sdk.NewCoin(hostZone.HostDenom, underWeightElem.deltaAmt)}
-			msgs = append(msgs, redelegateMsg)
-			underWeightElem.deltaAmt = sdkmath.ZeroInt()
-		} else {
-			// if the two elements are equal, we increment both slices
-			underWeightIndex -= 1
-			overWeightIndex += 1
-			// issue an ICA call to the host zone to rebalance the validator
-			redelegateMsg = &stakingTypes.MsgBeginRedelegate{
-				DelegatorAddress:    delegatorAddress,
-				ValidatorSrcAddress: overWeightElem.valAddr,
-				ValidatorDstAddress: underWeightElem.valAddr,
-				Amount:              sdk.NewCoin(hostZone.HostDenom, underWeightElem.deltaAmt)}
-			msgs = append(msgs, redelegateMsg)
-			overWeightElem.deltaAmt = sdkmath.ZeroInt()
-			underWeightElem.deltaAmt = sdkmath.ZeroInt()
-		}
-		// add the rebalancing to the callback
-		// lastMsg grabs rebalanceMsg from above (due to the type, it's hard to )
-		// lastMsg := (msgs[len(msgs)-1]).(*stakingTypes.MsgBeginRedelegate)
-		rebalanceCallback.Rebalancings = append(rebalanceCallback.Rebalancings, &types.Rebalancing{
-			SrcValidator: redelegateMsg.ValidatorSrcAddress,
-			DstValidator: redelegateMsg.ValidatorDstAddress,
-			Amt:          redelegateMsg.Amount.Amount,
+		msgs = append(msgs, &stakingTypes.MsgBeginRedelegate{
+			DelegatorAddress:    delegationIca.GetAddress(),
+			ValidatorSrcAddress: rebalancing.SrcValidator,
+			ValidatorDstAddress: rebalancing.DstValidator,
+			Amount:              sdk.NewCoin(hostZone.HostDenom, rebalancing.Amt),
 		})
+		rebalanceCallback.Rebalancings = append(rebalanceCallback.Rebalancings, &rebalancing)
 	}
+
 	// marshall the callback
 	marshalledCallbackArgs, err := k.MarshalRebalanceCallbackArgs(ctx, rebalanceCallback)
 	if err != nil {
@@ -149,5 +77,8 @@ func (k msgServer) RebalanceValidators(goCtx context.Context, msg *types.MsgReba
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s, %s", connectionId, hostZone.ChainId, msgs, err.Error())
 	}
 
+	// Track the redelegations until the ack so that they're accounted for in subsequent rebalance plans
+	k.RecordInFlightRedelegations(ctx, hostZone.ChainId, rebalanceCallback.Rebalancings)
+
 	return &types.MsgRebalanceValidatorsResponse{}, nil
 }
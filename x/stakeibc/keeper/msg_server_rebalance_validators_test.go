@@ -150,6 +150,13 @@ func (s *KeeperTestSuite) TestRebalanceValidators_Successful() {
 	s.Require().Equal(sdkmath.NewInt(13), secondRebal.Amt, "second rebalance should rebalance 13 ATOM")
 	s.Require().Equal("stride_VAL1", secondRebal.DstValidator, "second rebalance moves to val1")
 	s.Require().Equal("stride_VAL4", secondRebal.SrcValidator, "second rebalance takes from val4")
+
+	// the redelegations should be tracked as in-flight until the ack
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		pendingRedelegation, found := s.App.StakeibcKeeper.GetPendingRedelegation(s.Ctx, HostChainId, rebalancing.SrcValidator, rebalancing.DstValidator, 0)
+		s.Require().True(found, "in-flight redelegation from %s to %s found", rebalancing.SrcValidator, rebalancing.DstValidator)
+		s.Require().Equal(rebalancing.Amt, pendingRedelegation.Amount, "in-flight redelegation amount")
+	}
 }
 
 func (s *KeeperTestSuite) TestRebalanceValidators_InvalidNoValidators() {
@@ -40,11 +40,6 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker found: %s", "day")
 	}
 	senderAddr := sender.String()
-	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, epochTracker.EpochNumber, senderAddr)
-	_, found = k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
-	if found {
-		return nil, errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists, "user already redeemed this epoch: %s", redemptionId)
-	}
 
 	// ensure the recipient address is a valid bech32 address on the hostZone
 	// TODO(TEST-112) do we need to check the hostZone before this check? Would need access to keeper
@@ -53,10 +48,31 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
 	}
 
+	// While a host zone is being sunset, redemptions are paused until all delegations are unbonded,
+	// after which stTokens are redeemed at the fixed sunset redemption rate
+	switch hostZone.SunsetStatus {
+	case types.SunsetStatus_SUNSET_UNBONDING:
+		return nil, errorsmod.Wrapf(types.ErrHostZoneSunset, "redemptions are paused while host zone %s is unbonding", hostZone.ChainId)
+	case types.SunsetStatus_SUNSET_SWEEPING, types.SunsetStatus_SUNSET_ABORTING, types.SunsetStatus_SUNSET_ABORT_RESTAKING:
+		return nil, errorsmod.Wrapf(types.ErrHostZoneSunset, "redemptions are paused while host zone %s is being sunset (status: %s)",
+			hostZone.ChainId, hostZone.SunsetStatus)
+	case types.SunsetStatus_SUNSET_CLAIMABLE:
+		if err := k.RedeemSunsetStake(ctx, hostZone, sender, msg); err != nil {
+			return nil, err
+		}
+		return &types.MsgRedeemStakeResponse{}, nil
+	}
+
 	// construct desired unstaking amount from host zone
 	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
 	nativeAmount := sdk.NewDecFromInt(msg.Amount).Mul(hostZone.RedemptionRate).RoundInt()
 
+	// Slippage protection: the user can optionally specify the minimum number of native tokens they're willing to accept
+	if !msg.MinNativeOut.IsNil() && nativeAmount.LT(msg.MinNativeOut) {
+		return nil, errorsmod.Wrapf(types.ErrMinAmountOutNotMet,
+			"redemption would return %v%s, minimum specified: %v", nativeAmount, hostZone.HostDenom, msg.MinNativeOut)
+	}
+
 	if nativeAmount.GT(hostZone.StakedBal) {
 		return nil, errorsmod.Wrapf(types.ErrInvalidAmount, "cannot unstake an amount g.t. staked balance on host zone: %v", msg.Amount)
 	}
@@ -86,18 +102,52 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 	if balance.Amount.LT(msg.Amount) {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "balance is lower than redemption amount. redemption amount: %v, balance %v: ", msg.Amount, balance.Amount)
 	}
+
+	// If requested, try to redeem immediately from the instant redemption buffer
+	// If the buffer is insufficient, or the payout after the instant redemption fee would be
+	// less than the specified minimum, fall back to the unbonding queue
+	instantPayout, _ := k.GetInstantRedemptionPayout(ctx, nativeAmount)
+	instantMeetsMinimum := msg.MinNativeOut.IsNil() || instantPayout.GTE(msg.MinNativeOut)
+	if msg.Instant && instantMeetsMinimum {
+		redeemed, err := k.InstantRedeemStake(ctx, hostZone, sender, msg.Amount, nativeAmount)
+		if err != nil {
+			return nil, err
+		}
+		if redeemed {
+			k.Logger(ctx).Info(fmt.Sprintf("executed instant redeem stake: %s", msg.String()))
+			return &types.MsgRedeemStakeResponse{}, nil
+		}
+	}
+
 	// UNBONDING RECORD KEEPING
-	userRedemptionRecord := recordstypes.UserRedemptionRecord{
-		Id:          redemptionId,
-		Sender:      senderAddr,
-		Receiver:    msg.Receiver,
-		Amount:      nativeAmount,
-		Denom:       hostZone.HostDenom,
-		HostZoneId:  hostZone.ChainId,
-		EpochNumber: epochTracker.EpochNumber,
-		// claimIsPending represents whether a redemption is currently being claimed,
-		// contingent on the host zone unbonding having status CLAIMABLE
-		ClaimIsPending: false,
+	// If the user already redeemed this epoch, the new redemption is merged into the existing record
+	// (the receiver must match since the record can only be claimed to a single address)
+	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(hostZone.ChainId, epochTracker.EpochNumber, senderAddr)
+	userRedemptionRecord, redemptionExists := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if redemptionExists {
+		if userRedemptionRecord.Receiver != msg.Receiver {
+			return nil, errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+				"user already redeemed this epoch to a different receiver (%s): %s", userRedemptionRecord.Receiver, redemptionId)
+		}
+		userRedemptionRecord.Amount = userRedemptionRecord.Amount.Add(nativeAmount)
+		// Records created before the stToken amount was tracked are left without it
+		if !userRedemptionRecord.StTokenAmount.IsNil() {
+			userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Add(msg.Amount)
+		}
+	} else {
+		userRedemptionRecord = recordstypes.UserRedemptionRecord{
+			Id:            redemptionId,
+			Sender:        senderAddr,
+			Receiver:      msg.Receiver,
+			Amount:        nativeAmount,
+			StTokenAmount: msg.Amount,
+			Denom:         hostZone.HostDenom,
+			HostZoneId:    hostZone.ChainId,
+			EpochNumber:   epochTracker.EpochNumber,
+			// claimIsPending represents whether a redemption is currently being claimed,
+			// contingent on the host zone unbonding having status CLAIMABLE
+			ClaimIsPending: false,
+		}
 	}
 	// then add undelegation amount to epoch unbonding records
 	epochUnbondingRecord, found := k.RecordsKeeper.GetEpochUnbondingRecord(ctx, epochTracker.EpochNumber)
@@ -111,7 +161,9 @@ func (k msgServer) RedeemStake(goCtx context.Context, msg *types.MsgRedeemStake)
 		return nil, errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", hostZone.ChainId)
 	}
 	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(nativeAmount)
-	hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, userRedemptionRecord.Id)
+	if !redemptionExists {
+		hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, userRedemptionRecord.Id)
+	}
 
 	// Escrow user's balance
 	redeemCoin := sdk.NewCoins(sdk.NewCoin(stDenom, msg.Amount))
@@ -152,6 +152,31 @@ func (s *KeeperTestSuite) TestRedeemStake_Successful() {
 	s.Require().NotEqual(hostZoneUnbonding.Status, recordtypes.HostZoneUnbonding_CLAIMABLE, "host zone unbonding should NOT be marked as CLAIMABLE")
 }
 
+func (s *KeeperTestSuite) TestRedeemStake_MinNativeOut() {
+	tc := s.SetupRedeemStake()
+
+	// With a redemption rate of 0.9, redeeming 1_000_000 stTokens returns 900_000 native tokens
+	hostZone := tc.hostZone
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("0.9")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// If the minimum is above the native amount, the redemption should fail
+	invalidMsg := tc.validMsg
+	invalidMsg.MinNativeOut = sdkmath.NewInt(900_001)
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().EqualError(err, "redemption would return 900000uatom, minimum specified: 900001: amount out is less than the specified minimum")
+
+	// If the minimum is met, the redemption should succeed
+	validMsg := tc.validMsg
+	validMsg.MinNativeOut = sdkmath.NewInt(900_000)
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &validMsg)
+	s.Require().NoError(err, "no error expected when minimum is met")
+
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Equal(sdkmath.NewInt(900_000), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+}
+
 func (s *KeeperTestSuite) TestRedeemStake_InvalidCreatorAddress() {
 	tc := s.SetupRedeemStake()
 	invalidMsg := tc.validMsg
@@ -244,14 +269,54 @@ func (s *KeeperTestSuite) TestRedeemStake_NoEpochTrackerDay() {
 	s.Require().EqualError(err, "latest epoch unbonding record not found: epoch unbonding record not found")
 }
 
-func (s *KeeperTestSuite) TestRedeemStake_UserAlreadyRedeemedThisEpoch() {
+func (s *KeeperTestSuite) TestRedeemStake_MultipleRedemptionsSameEpoch() {
 	tc := s.SetupRedeemStake()
 
-	invalidMsg := tc.validMsg
-	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	// Redeem twice in the same epoch with different amounts
+	firstMsg := tc.validMsg
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &firstMsg)
+	s.Require().NoError(err, "no error expected for first redemption")
+
+	secondMsg := tc.validMsg
+	secondMsg.Amount = sdkmath.NewInt(250_000)
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &secondMsg)
+	s.Require().NoError(err, "no error expected for second redemption")
+
+	totalRedeemed := firstMsg.Amount.Add(secondMsg.Amount)
+
+	// Both redemptions should be escrowed
+	expectedUserStAtomBalance := tc.user.stAtomBalance.SubAmount(totalRedeemed)
+	actualUserStAtomBalance := s.App.BankKeeper.GetBalance(s.Ctx, tc.user.acc, "stuatom")
+	s.CompareCoins(expectedUserStAtomBalance, actualUserStAtomBalance, "user stuatom balance")
+
+	// The redemptions should be merged into a single record (redemption rate is 1)
+	redemptionId := fmt.Sprintf("GAIA.1.%s", s.TestAccs[0])
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().True(found, "user redemption record")
+	s.Require().Equal(totalRedeemed, userRedemptionRecord.Amount, "redemption record native amount")
+	s.Require().Equal(totalRedeemed, userRedemptionRecord.StTokenAmount, "redemption record stToken amount")
+	s.Require().Equal(firstMsg.Receiver, userRedemptionRecord.Receiver, "redemption record receiver")
+
+	// The host zone unbonding should reference the record once and include both amounts
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, tc.initialState.epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding")
+	s.Require().Equal([]string{redemptionId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding record ids")
+	s.Require().Equal(totalRedeemed, hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+	s.Require().Equal(totalRedeemed, hostZoneUnbonding.StTokenAmount, "host zone unbonding stToken amount")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_MultipleRedemptionsSameEpoch_DifferentReceiver() {
+	tc := s.SetupRedeemStake()
+
+	firstMsg := tc.validMsg
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &firstMsg)
 	s.Require().NoError(err)
+
+	invalidMsg := tc.validMsg
+	invalidMsg.Receiver = "cosmos1pcag0cj4ttxg8l7pcg0q4ksuglswuuedcextl2"
 	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
-	s.Require().EqualError(err, fmt.Sprintf("user already redeemed this epoch: GAIA.1.%s: redemption record already exists", s.TestAccs[0]))
+	s.Require().EqualError(err, fmt.Sprintf("user already redeemed this epoch to a different receiver (%s): GAIA.1.%s: redemption record already exists",
+		firstMsg.Receiver, s.TestAccs[0]))
 }
 
 func (s *KeeperTestSuite) TestRedeemStake_HostZoneNoUnbondings() {
@@ -69,6 +69,26 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 		}
 	}
 
+	// confirm the unbonding frequency is compatible with the host's unbonding period
+	unbondingPeriod, err := k.GetHostUnbondingPeriod(ctx, msg.ConnectionId)
+	if err != nil {
+		errMsg := fmt.Sprintf("unable to obtain unbonding period from connection %s, err: %s", msg.ConnectionId, err.Error())
+		k.Logger(ctx).Error(errMsg)
+		return nil, errorsmod.Wrapf(types.ErrFailedToRegisterHostZone, errMsg)
+	}
+	hostStakingParams := types.HostStakingParams{UnbondingPeriod: uint64(unbondingPeriod)}
+	if err := hostStakingParams.ValidateUnbondingFrequency(msg.UnbondingFrequency); err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return nil, err
+	}
+
+	// build the stToken's bank metadata (any fields that are not specified are derived from the host denom)
+	stTokenMetadata, err := k.BuildStTokenMetadata(ctx, msg.HostDenom, msg.StTokenDisplayName, msg.StTokenSymbol, msg.StTokenExponent)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return nil, err
+	}
+
 	// create and save the zones's module account
 	zoneAddress := types.NewZoneAddress(chainId)
 	if err := utils.CreateModuleAccount(ctx, k.accountKeeper, zoneAddress); err != nil {
@@ -84,6 +104,7 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 	}
 
 	// set the zone
+	strideCommission := k.GetDefaultStrideCommission(ctx)
 	zone := types.HostZone{
 		ChainId:           chainId,
 		ConnectionId:      msg.ConnectionId,
@@ -98,10 +119,22 @@ func (k msgServer) RegisterHostZone(goCtx context.Context, msg *types.MsgRegiste
 		Address:            zoneAddress.String(),
 		MinRedemptionRate:  msg.MinRedemptionRate,
 		MaxRedemptionRate:  msg.MaxRedemptionRate,
+		// The instant redemption buffer is filled from deposits
+		InstantRedemptionBuffer: sdkmath.ZeroInt(),
+		// The commission starts at the module-wide default and can later be updated by governance
+		StrideCommission: &strideCommission,
 	}
 	// write the zone back to the store
 	k.SetHostZone(ctx, zone)
 
+	// register the stToken's metadata with the bank module so that it's displayed by wallets and explorers
+	k.bankKeeper.SetDenomMetaData(ctx, stTokenMetadata)
+
+	// query the host's staking params (if the query can't be submitted, it will be retried in the next day epoch)
+	if err := k.QueryHostStakingParamsIcq(ctx, zone); err != nil {
+		k.Logger(ctx).Error(utils.LogWithHostZone(chainId, "Unable to submit host staking params ICQ, err: %s", err.Error()))
+	}
+
 	appVersion := string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
 		Version:                icatypes.Version,
 		ControllerConnectionId: zone.ConnectionId,
@@ -5,6 +5,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ibctesting "github.com/cosmos/ibc-go/v5/testing"
 	_ "github.com/stretchr/testify/suite"
 
@@ -15,6 +16,7 @@ import (
 	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
 	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
 	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
 	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
 )
 
@@ -30,7 +32,7 @@ type RegisterHostZoneTestCase struct {
 func (s *KeeperTestSuite) SetupRegisterHostZone() RegisterHostZoneTestCase {
 	epochUnbondingRecordNumber := uint64(3)
 	strideEpochNumber := uint64(4)
-	unbondingFrequency := uint64(3)
+	unbondingFrequency := uint64(4)
 	defaultRedemptionRate := sdk.NewDec(1)
 	atomHostZoneChainId := "GAIA"
 
@@ -126,7 +128,7 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	defaultMaxThreshold := sdk.NewDec(int64(stakeibctypes.DefaultMaxRedemptionRateThreshold)).Quo(sdk.NewDec(100))
 	s.Require().Equal(defaultMinThreshold, hostZone.MinRedemptionRate, "min redemption rate set to default")
 	s.Require().Equal(defaultMaxThreshold, hostZone.MaxRedemptionRate, "max redemption rate set to default")
-	s.Require().Equal(tc.unbondingFrequency, hostZone.UnbondingFrequency, "unbonding frequency set to default: 3")
+	s.Require().Equal(tc.unbondingFrequency, hostZone.UnbondingFrequency, "unbonding frequency")
 
 	// Confirm host zone unbonding record was created
 	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx, tc.epochUnbondingRecordNumber)
@@ -158,6 +160,59 @@ func (s *KeeperTestSuite) TestRegisterHostZone_Success() {
 	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
 	s.Require().Len(depositRecords, 1, "number of deposit records")
 	s.Require().Equal(expectedDepositRecord, depositRecords[0], "deposit record")
+
+	// Confirm the host staking and slashing params were queried
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 5, "number of host staking param queries")
+	for _, query := range queries {
+		s.Require().Equal(stakeibckeeper.ICQCallbackID_HostStakingParam, query.CallbackId, "query callback id")
+		s.Require().Equal(HostChainId, query.ChainId, "query chain id")
+	}
+
+	// Confirm the stToken metadata was registered with the defaults derived from the host denom
+	stTokenMetadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, StAtom)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal(stakeibctypes.NewStTokenMetadata(Atom, "Stride Staked ATOM", "stATOM", 6), stTokenMetadata, "stToken metadata")
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_StTokenMetadata() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+	msg.StTokenDisplayName = "Stride Staked Atom"
+	msg.StTokenSymbol = "stAtom"
+	msg.StTokenExponent = 3
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "able to successfully register host zone")
+
+	expectedMetadata := banktypes.Metadata{
+		Description: "Stride liquid staked uatom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "stuatom", Exponent: 0},
+			{Denom: "statom", Exponent: 3},
+		},
+		Base:    "stuatom",
+		Display: "statom",
+		Name:    "Stride Staked Atom",
+		Symbol:  "stAtom",
+	}
+	stTokenMetadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, StAtom)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal(expectedMetadata, stTokenMetadata, "stToken metadata")
+}
+
+func (s *KeeperTestSuite) TestRegisterHostZone_InvalidStTokenMetadata() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+
+	// The display unit can't have the same denom as the base unit
+	msg.StTokenSymbol = "stuatom"
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrInvalidStTokenMetadata)
+
+	_, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().False(found, "host zone should not have been registered")
 }
 
 func (s *KeeperTestSuite) TestRegisterHostZone_InvalidConnectionId() {
@@ -169,6 +224,17 @@ func (s *KeeperTestSuite) TestRegisterHostZone_InvalidConnectionId() {
 	s.Require().EqualError(err, "invalid connection id, connection-10 not found: failed to register host zone")
 }
 
+func (s *KeeperTestSuite) TestRegisterHostZone_UnbondingFrequencyTooLow() {
+	tc := s.SetupRegisterHostZone()
+	msg := tc.validMsg
+
+	// The test client has a 21 day unbonding period, which requires an unbonding frequency of at least 4
+	msg.UnbondingFrequency = 3
+
+	_, err := s.GetMsgServer().RegisterHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "unbonding frequency 3 is below the minimum of 4")
+}
+
 func (s *KeeperTestSuite) TestRegisterHostZone_DuplicateConnectionIdInIBCState() {
 	// tests for a failure if we register the same host zone twice
 	// (with a duplicate connectionId stored in the IBCKeeper's state)
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Resolves an operation in the ICA retry queue
+//   - FORCE_RETRY submits the operation immediately (even if it's in the dead letter state) with a fresh attempt count
+//     (undelegations are instead re-queued with a fresh attempt count for the host's next unbonding day)
+//   - DROP_RETRY removes the operation from the queue, handing it back to the regular epochly flow
+func (k msgServer) ResolveICARetry(goCtx context.Context, msg *types.MsgResolveICARetry) (*types.MsgResolveICARetryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+	icaRetry, found := k.GetICARetry(ctx, msg.ChainId, msg.Operation, msg.RecordId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrICARetryNotFound, "no %s retry found for host zone %s and record %d",
+			msg.Operation.String(), msg.ChainId, msg.RecordId)
+	}
+
+	if msg.Action == types.ICARetryAction_DROP_RETRY {
+		k.Logger(ctx).Info(fmt.Sprintf("Dropping %s retry for host zone %s and record %d", msg.Operation.String(), msg.ChainId, msg.RecordId))
+		k.RemoveICARetry(ctx, msg.ChainId, msg.Operation, msg.RecordId)
+		return &types.MsgResolveICARetryResponse{}, nil
+	}
+
+	if hostZone.Halted {
+		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "host zone %s is halted", msg.ChainId)
+	}
+	strideEpochTracker, found := k.GetEpochTracker(ctx, epochstypes.STRIDE_EPOCH)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochstypes.STRIDE_EPOCH)
+	}
+
+	// A forced retry gets a fresh set of attempts, and the tx fails if the operation can't be submitted
+	icaRetry.Attempts = 0
+	icaRetry.Status = types.ICARetry_PENDING
+	k.SetICARetry(ctx, icaRetry)
+
+	completed, err := k.SubmitICARetry(ctx, hostZone, icaRetry)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to retry %s operation", msg.Operation.String())
+	}
+
+	if completed {
+		k.RemoveICARetry(ctx, msg.ChainId, msg.Operation, msg.RecordId)
+	} else {
+		icaRetry.NextAttemptEpoch = strideEpochTracker.EpochNumber + types.GetICARetryBackoff(icaRetry.Attempts)
+		k.SetICARetry(ctx, icaRetry)
+	}
+
+	return &types.MsgResolveICARetryResponse{}, nil
+}
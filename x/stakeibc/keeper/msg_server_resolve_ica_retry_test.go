@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type ResolveICARetryTestCase struct {
+	depositRecordsTestCase DepositRecordsTestCase
+	depositRecord          recordtypes.DepositRecord
+	validMsg               stakeibctypes.MsgResolveICARetry
+}
+
+func (s *KeeperTestSuite) SetupResolveICARetry() ResolveICARetryTestCase {
+	tc := s.SetupDepositRecords()
+	depositRecord := tc.initialDepositRecords.recordsToBeStaked[0]
+
+	// Store a delegation retry that has exhausted all of its attempts
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, stakeibctypes.ICARetry{
+		ChainId:          HostChainId,
+		Operation:        stakeibctypes.ICARetry_DELEGATE,
+		RecordId:         depositRecord.Id,
+		Status:           stakeibctypes.ICARetry_DEAD_LETTER,
+		Attempts:         stakeibctypes.ICARetryMaxAttempts,
+		NextAttemptEpoch: tc.epochNumber + 16,
+		LastError:        "failed",
+	})
+
+	return ResolveICARetryTestCase{
+		depositRecordsTestCase: tc,
+		depositRecord:          depositRecord,
+		validMsg: stakeibctypes.MsgResolveICARetry{
+			Creator:   s.TestAccs[0].String(),
+			ChainId:   HostChainId,
+			Operation: stakeibctypes.ICARetry_DELEGATE,
+			RecordId:  depositRecord.Id,
+			Action:    stakeibctypes.ICARetryAction_FORCE_RETRY,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_ForceRetry() {
+	tc := s.SetupResolveICARetry()
+
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when forcing retry")
+
+	// The delegation should be submitted and the retry should be removed
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 1, "delegation tx should be submitted")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARetries(s.Ctx), 0, "retry should be removed")
+
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, tc.depositRecord.Id)
+	s.Require().True(found, "deposit record found")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, depositRecord.Status, "deposit record status")
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_ForceRetryUndelegation() {
+	tc := s.SetupResolveICARetry()
+
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, stakeibctypes.ICARetry{
+		ChainId:   HostChainId,
+		Operation: stakeibctypes.ICARetry_UNDELEGATE,
+		Status:    stakeibctypes.ICARetry_DEAD_LETTER,
+		Attempts:  stakeibctypes.ICARetryMaxAttempts,
+	})
+
+	msg := tc.validMsg
+	msg.Operation = stakeibctypes.ICARetry_UNDELEGATE
+	msg.RecordId = 0
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when forcing retry")
+
+	// The undelegation should be re-queued for the next unbonding day instead of being submitted
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+	icaRetry, found := s.App.StakeibcKeeper.GetICARetry(s.Ctx, HostChainId, stakeibctypes.ICARetry_UNDELEGATE, 0)
+	s.Require().True(found, "retry should still be queued")
+	s.Require().Equal(stakeibctypes.ICARetry_PENDING, icaRetry.Status, "retry status")
+	s.Require().Equal(uint64(0), icaRetry.Attempts, "retry attempts")
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_ForceRetryFailed() {
+	tc := s.SetupResolveICARetry()
+
+	// Remove the delegation account so that the retry fails
+	hostZone := tc.depositRecordsTestCase.hostZone
+	hostZone.DelegationAccount = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorContains(err, "unable to retry DELEGATE operation: Invalid delegation account")
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_DropRetry() {
+	tc := s.SetupResolveICARetry()
+
+	msg := tc.validMsg
+	msg.Action = stakeibctypes.ICARetryAction_DROP_RETRY
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when dropping retry")
+
+	// The retry should be removed without submitting a tx
+	s.Require().Len(s.App.StakeibcKeeper.GetAllICARetries(s.Ctx), 0, "retry should be removed")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_HaltedHostZone() {
+	tc := s.SetupResolveICARetry()
+
+	hostZone := tc.depositRecordsTestCase.hostZone
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorContains(err, "host zone GAIA is halted")
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_RetryNotFound() {
+	tc := s.SetupResolveICARetry()
+
+	msg := tc.validMsg
+	msg.Operation = stakeibctypes.ICARetry_UNDELEGATE
+	msg.RecordId = 0
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "no UNDELEGATE retry found for host zone GAIA and record 0")
+}
+
+func (s *KeeperTestSuite) TestResolveICARetry_HostZoneNotFound() {
+	tc := s.SetupResolveICARetry()
+
+	msg := tc.validMsg
+	msg.ChainId = "fake_host_zone"
+	_, err := s.GetMsgServer().ResolveICARetry(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Resolves a quarantined slash
+//   - CONFIRM_SLASH applies the slash to the validator's delegation and weight, as long as the
+//     delegation hasn't changed since the slash was detected
+//   - DISMISS_SLASH removes the slash without modifying the host zone's records
+func (k msgServer) ResolveUnconfirmedSlash(goCtx context.Context, msg *types.MsgResolveUnconfirmedSlash) (*types.MsgResolveUnconfirmedSlashResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+	unconfirmedSlash, found := k.GetUnconfirmedSlash(ctx, msg.ChainId, msg.ValidatorAddress)
+	if !found || unconfirmedSlash.Status != types.UnconfirmedSlash_QUARANTINED {
+		return nil, errorsmod.Wrapf(types.ErrUnconfirmedSlashNotFound, "no quarantined slash for validator %s on host zone %s",
+			msg.ValidatorAddress, msg.ChainId)
+	}
+
+	if msg.Action == types.UnconfirmedSlashAction_DISMISS_SLASH {
+		k.Logger(ctx).Info(utils.LogWithHostZone(msg.ChainId, "Dismissing quarantined slash for validator %s", msg.ValidatorAddress))
+		k.RemoveUnconfirmedSlash(ctx, msg.ChainId, msg.ValidatorAddress)
+		return &types.MsgResolveUnconfirmedSlashResponse{}, nil
+	}
+
+	validator, valIndex, found := GetValidatorFromAddress(hostZone.Validators, msg.ValidatorAddress)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s not found on host zone %s", msg.ValidatorAddress, msg.ChainId)
+	}
+
+	// The queried delegation is only valid against the delegation it was compared to when the slash was detected
+	if !validator.DelegationAmt.Equal(unconfirmedSlash.DelegationAmt) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAmount,
+			"delegation to validator %s changed since the slash was detected (current: %v, at detection: %v), the slash must be dismissed",
+			msg.ValidatorAddress, validator.DelegationAmt, unconfirmedSlash.DelegationAmt)
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(msg.ChainId, "Confirming quarantined slash for validator %s", msg.ValidatorAddress))
+	if err := k.ConfirmSlash(ctx, hostZone, valIndex, unconfirmedSlash); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolveUnconfirmedSlashResponse{}, nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type ResolveUnconfirmedSlashTestCase struct {
+	signingInfoTestCase SigningInfoICQCallbackTestCase
+	validMsg            stakeibctypes.MsgResolveUnconfirmedSlash
+}
+
+func (s *KeeperTestSuite) SetupResolveUnconfirmedSlash() ResolveUnconfirmedSlashTestCase {
+	// Quarantine the slash by returning signing info for a validator that was never jailed
+	tc := s.SetupSigningInfoICQCallback()
+	callbackArgs := s.CreateSigningInfoQueryResponse(tc.delegatorSharesTestCase.consensusAddress, time.Unix(0, 0), false)
+	err := stakeibckeeper.SigningInfoCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, tc.query)
+	s.Require().NoError(err, "signing info callback error")
+	s.checkSlashQuarantined(tc.validatorAddress)
+
+	return ResolveUnconfirmedSlashTestCase{
+		signingInfoTestCase: tc,
+		validMsg: stakeibctypes.MsgResolveUnconfirmedSlash{
+			Creator:          s.TestAccs[0].String(),
+			ChainId:          HostChainId,
+			ValidatorAddress: tc.validatorAddress,
+			Action:           stakeibctypes.UnconfirmedSlashAction_CONFIRM_SLASH,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestResolveUnconfirmedSlash_Confirm() {
+	tc := s.SetupResolveUnconfirmedSlash()
+
+	_, err := s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when confirming slash")
+
+	// The slash should be applied and removed from the store
+	s.checkSlashApplied(tc.signingInfoTestCase, tc.signingInfoTestCase.delegatorSharesTestCase.expectedWeight)
+}
+
+func (s *KeeperTestSuite) TestResolveUnconfirmedSlash_Dismiss() {
+	tc := s.SetupResolveUnconfirmedSlash()
+
+	msg := tc.validMsg
+	msg.Action = stakeibctypes.UnconfirmedSlashAction_DISMISS_SLASH
+	_, err := s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when dismissing slash")
+
+	// The slash should be removed without modifying the host zone
+	s.checkStateIfValidatorNotSlashed(tc.signingInfoTestCase.delegatorSharesTestCase)
+	_, found := s.App.StakeibcKeeper.GetUnconfirmedSlash(s.Ctx, HostChainId, tc.validMsg.ValidatorAddress)
+	s.Require().False(found, "unconfirmed slash should have been removed")
+}
+
+func (s *KeeperTestSuite) TestResolveUnconfirmedSlash_DelegationChanged() {
+	tc := s.SetupResolveUnconfirmedSlash()
+	valIndex := tc.signingInfoTestCase.delegatorSharesTestCase.valIndexQueried
+
+	// Update the validator's delegation after the slash was detected
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	validator := *hostZone.Validators[valIndex]
+	validator.DelegationAmt = validator.DelegationAmt.AddRaw(100)
+	hostZone.Validators[valIndex] = &validator
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorContains(err, "changed since the slash was detected")
+
+	// The slash can still be dismissed
+	msg := tc.validMsg
+	msg.Action = stakeibctypes.UnconfirmedSlashAction_DISMISS_SLASH
+	_, err = s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when dismissing slash")
+}
+
+func (s *KeeperTestSuite) TestResolveUnconfirmedSlash_PendingConfirmation() {
+	// A slash that's still awaiting its signing info query can't be resolved
+	tc := s.SetupSigningInfoICQCallback()
+
+	msg := stakeibctypes.MsgResolveUnconfirmedSlash{
+		Creator:          s.TestAccs[0].String(),
+		ChainId:          HostChainId,
+		ValidatorAddress: tc.validatorAddress,
+		Action:           stakeibctypes.UnconfirmedSlashAction_DISMISS_SLASH,
+	}
+	_, err := s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "no quarantined slash for validator")
+
+	_, found := s.App.StakeibcKeeper.GetUnconfirmedSlash(s.Ctx, HostChainId, tc.validatorAddress)
+	s.Require().True(found, "unconfirmed slash should not have been removed")
+}
+
+func (s *KeeperTestSuite) TestResolveUnconfirmedSlash_SlashNotFound() {
+	tc := s.SetupResolveUnconfirmedSlash()
+
+	msg := tc.validMsg
+	msg.ValidatorAddress = "fake_validator"
+	_, err := s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "no quarantined slash for validator fake_validator on host zone GAIA")
+}
+
+func (s *KeeperTestSuite) TestResolveUnconfirmedSlash_HostZoneNotFound() {
+	tc := s.SetupResolveUnconfirmedSlash()
+
+	msg := tc.validMsg
+	msg.ChainId = "fake_host_zone"
+	_, err := s.GetMsgServer().ResolveUnconfirmedSlash(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Unhalts a host zone that was halted by the BeginBlocker after its redemption rate left the safety bounds
+// The redemption rate must be back within bounds before the zone is resumed
+func (k msgServer) ResumeHostZone(goCtx context.Context, msg *types.MsgResumeHostZone) (*types.MsgResumeHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ResumeHaltedHostZone(ctx, msg.ChainId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResumeHostZoneResponse{}, nil
+}
+
+// Unhalts a host zone and removes its stToken from the rate limit blacklist
+// Used by both MsgResumeHostZone and ResumeHostZoneProposal
+func (k Keeper) ResumeHaltedHostZone(ctx sdk.Context, chainId string) error {
+	hostZone, found := k.GetHostZone(ctx, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", chainId)
+	}
+	if !hostZone.Halted {
+		return errorsmod.Wrapf(types.ErrHostZoneNotHalted, "host zone %s is not halted", chainId)
+	}
+	if hostZone.SunsetStatus == types.SunsetStatus_SUNSET_COMPLETE {
+		return errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s has been sunset", chainId)
+	}
+
+	// Confirm the redemption rate has recovered, otherwise the zone would be re-halted in the next BeginBlocker
+	rrSafe, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone)
+	if !rrSafe {
+		return errorsmod.Wrapf(err, "unable to resume host zone %s", chainId)
+	}
+
+	hostZone.Halted = false
+	k.SetHostZone(ctx, hostZone)
+
+	// remove the rate limit on the stAsset
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	k.RatelimitKeeper.RemoveDenomFromBlacklist(ctx, stDenom)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Resumed host zone %s, RR: %s", hostZone.ChainId, hostZone.RedemptionRate.String()))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostZoneResume,
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyRedemptionRate, hostZone.RedemptionRate.String()),
+		),
+	)
+
+	return nil
+}
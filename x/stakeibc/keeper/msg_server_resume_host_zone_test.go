@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type ResumeHostZoneTestCase struct {
+	validMsg stakeibctypes.MsgResumeHostZone
+	stDenom  string
+}
+
+func (s *KeeperTestSuite) SetupResumeHostZone() ResumeHostZoneTestCase {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:        HostChainId,
+		HostDenom:      Atom,
+		RedemptionRate: sdk.OneDec(),
+		Halted:         true,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	stDenom := stakeibctypes.StAssetDenomFromHostZoneDenom(Atom)
+	s.App.RatelimitKeeper.AddDenomToBlacklist(s.Ctx, stDenom)
+
+	return ResumeHostZoneTestCase{
+		validMsg: stakeibctypes.MsgResumeHostZone{
+			Creator: s.TestAccs[0].String(),
+			ChainId: HostChainId,
+		},
+		stDenom: stDenom,
+	}
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_Successful() {
+	tc := s.SetupResumeHostZone()
+
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when resuming host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().False(hostZone.Halted, "host zone should no longer be halted")
+	s.Require().False(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, tc.stDenom), "stDenom should be removed from the blacklist")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_HostZoneNotFound() {
+	tc := s.SetupResumeHostZone()
+
+	invalidMsg := tc.validMsg
+	invalidMsg.ChainId = "fake_host_zone"
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().EqualError(err, "host zone fake_host_zone not found: host zone not found")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_HostZoneNotHalted() {
+	tc := s.SetupResumeHostZone()
+
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.Halted = false
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().EqualError(err, "host zone GAIA is not halted: host zone is not halted")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZone_RedemptionRateOutsideBounds() {
+	tc := s.SetupResumeHostZone()
+
+	// Set the redemption rate below the default min threshold (0.9)
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("0.5")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().ErrorContains(err, "unable to resume host zone GAIA")
+
+	// Confirm the zone is still halted and the stDenom is still blacklisted
+	hostZone, _ = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(hostZone.Halted, "host zone should still be halted")
+	s.Require().True(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, tc.stDenom), "stDenom should still be blacklisted")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZoneProposal_Successful() {
+	tc := s.SetupResumeHostZone()
+
+	proposal := stakeibctypes.ResumeHostZoneProposal{
+		Title:       "Resume host zone GAIA",
+		Description: "Proposal to resume the halted GAIA host zone",
+		ChainId:     HostChainId,
+	}
+	s.Require().NoError(proposal.ValidateBasic(), "proposal should be valid")
+
+	// Submit the proposal through the gov router to confirm the proposal type is routed to stakeibc
+	proposalHandler := s.App.GovKeeper.LegacyRouter().GetRoute(proposal.ProposalRoute())
+	err := proposalHandler(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected when resuming host zone through governance")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().False(hostZone.Halted, "host zone should no longer be halted")
+	s.Require().False(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, tc.stDenom), "stDenom should be removed from the blacklist")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZoneProposal_RedemptionRateOutsideBounds() {
+	tc := s.SetupResumeHostZone()
+
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.RedemptionRate = sdk.MustNewDecFromStr("0.5")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.ResumeHostZoneProposal(s.Ctx, &stakeibctypes.ResumeHostZoneProposal{
+		Title:       "Resume host zone GAIA",
+		Description: "Proposal to resume the halted GAIA host zone",
+		ChainId:     HostChainId,
+	})
+	s.Require().ErrorContains(err, "unable to resume host zone GAIA")
+
+	hostZone, _ = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(hostZone.Halted, "host zone should still be halted")
+	s.Require().True(s.App.RatelimitKeeper.IsDenomBlacklisted(s.Ctx, tc.stDenom), "stDenom should still be blacklisted")
+}
+
+func (s *KeeperTestSuite) TestResumeHostZoneProposal_MissingChainId() {
+	proposal := stakeibctypes.ResumeHostZoneProposal{
+		Title:       "Resume host zone",
+		Description: "Proposal to resume a halted host zone",
+	}
+	s.Require().ErrorContains(proposal.ValidateBasic(), "chainid is required")
+}
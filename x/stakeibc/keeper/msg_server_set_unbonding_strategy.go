@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Sets the strategy used to split a host zone's unbondings across its validators
+// The new strategy applies from the next unbonding
+func (k msgServer) SetUnbondingStrategy(goCtx context.Context, msg *types.MsgSetUnbondingStrategy) (*types.MsgSetUnbondingStrategyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.UnbondingStrategy = msg.Strategy
+	k.SetHostZone(ctx, hostZone)
+	k.Logger(ctx).Info(fmt.Sprintf("Updated unbonding strategy for %s: %s", msg.ChainId, msg.Strategy))
+
+	return &types.MsgSetUnbondingStrategyResponse{}, nil
+}
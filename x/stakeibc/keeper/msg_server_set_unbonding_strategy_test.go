@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) TestSetUnbondingStrategy_Successful() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: HostChainId})
+
+	msg := stakeibctypes.MsgSetUnbondingStrategy{
+		Creator:  s.TestAccs[0].String(),
+		ChainId:  HostChainId,
+		Strategy: stakeibctypes.UnbondingStrategy_REBALANCE,
+	}
+	_, err := s.GetMsgServer().SetUnbondingStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting the rebalance strategy")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(stakeibctypes.UnbondingStrategy_REBALANCE, hostZone.UnbondingStrategy, "unbonding strategy after update")
+
+	// The original strategy can be restored
+	msg.Strategy = stakeibctypes.UnbondingStrategy_TARGET_WEIGHT
+	_, err = s.GetMsgServer().SetUnbondingStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting the target weight strategy")
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(stakeibctypes.UnbondingStrategy_TARGET_WEIGHT, hostZone.UnbondingStrategy, "unbonding strategy after revert")
+}
+
+func (s *KeeperTestSuite) TestSetUnbondingStrategy_HostZoneNotFound() {
+	msg := stakeibctypes.MsgSetUnbondingStrategy{
+		Creator:  s.TestAccs[0].String(),
+		ChainId:  "fake_host_zone",
+		Strategy: stakeibctypes.UnbondingStrategy_REBALANCE,
+	}
+	_, err := s.GetMsgServer().SetUnbondingStrategy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().EqualError(err, "host zone fake_host_zone not found: host zone not found")
+}
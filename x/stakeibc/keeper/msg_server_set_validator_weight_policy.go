@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Sets or clears the policy used to automatically derive a host zone's validator weights
+// The weights are recomputed from the policy at the start of each day epoch
+func (k msgServer) SetValidatorWeightPolicy(goCtx context.Context, msg *types.MsgSetValidatorWeightPolicy) (*types.MsgSetValidatorWeightPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+
+	hostZone.ValidatorWeightPolicy = msg.Policy
+	k.SetHostZone(ctx, hostZone)
+	k.Logger(ctx).Info(fmt.Sprintf("Updated validator weight policy for %s: %v", msg.ChainId, msg.Policy))
+
+	return &types.MsgSetValidatorWeightPolicyResponse{}, nil
+}
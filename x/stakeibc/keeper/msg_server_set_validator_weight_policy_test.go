@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupSetValidatorWeightPolicy() stakeibctypes.MsgSetValidatorWeightPolicy {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{
+		ChainId: HostChainId,
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", Weight: 10, DelegationAmt: sdkmath.ZeroInt()},
+		},
+	})
+
+	return stakeibctypes.MsgSetValidatorWeightPolicy{
+		Creator: s.TestAccs[0].String(),
+		ChainId: HostChainId,
+		Policy: &stakeibctypes.ValidatorWeightPolicy{
+			MaxCommissionRate:   sdk.MustNewDecFromStr("0.10"),
+			MaxVotingPowerShare: sdk.MustNewDecFromStr("0.05"),
+			MaxJailedCount:      2,
+			MaxMissedBlocks:     500,
+			MaxWeightChange:     10,
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestSetValidatorWeightPolicy_Successful() {
+	msg := s.SetupSetValidatorWeightPolicy()
+
+	_, err := s.GetMsgServer().SetValidatorWeightPolicy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting policy")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(*msg.Policy, *hostZone.ValidatorWeightPolicy, "validator weight policy")
+
+	// Weights can no longer be changed manually
+	_, err = s.GetMsgServer().ChangeValidatorWeight(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.MsgChangeValidatorWeight{
+		HostZone: HostChainId,
+		ValAddr:  "val1",
+		Weight:   20,
+	})
+	s.Require().ErrorIs(err, stakeibctypes.ErrValidatorWeightsManaged, "manual weight change error")
+}
+
+func (s *KeeperTestSuite) TestSetValidatorWeightPolicy_ClearPolicy() {
+	msg := s.SetupSetValidatorWeightPolicy()
+
+	_, err := s.GetMsgServer().SetValidatorWeightPolicy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when setting policy")
+
+	msg.Policy = nil
+	_, err = s.GetMsgServer().SetValidatorWeightPolicy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when clearing policy")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Nil(hostZone.ValidatorWeightPolicy, "validator weight policy should be cleared")
+
+	// Weights can be changed manually again
+	_, err = s.GetMsgServer().ChangeValidatorWeight(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.MsgChangeValidatorWeight{
+		HostZone: HostChainId,
+		ValAddr:  "val1",
+		Weight:   20,
+	})
+	s.Require().NoError(err, "no error expected when changing weight")
+}
+
+func (s *KeeperTestSuite) TestSetValidatorWeightPolicy_HostZoneNotFound() {
+	msg := s.SetupSetValidatorWeightPolicy()
+	msg.ChainId = "fake_host_zone"
+
+	_, err := s.GetMsgServer().SetValidatorWeightPolicy(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().EqualError(err, "host zone fake_host_zone not found: host zone not found")
+}
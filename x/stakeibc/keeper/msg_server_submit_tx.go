@@ -15,8 +15,10 @@ import (
 	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
 	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -50,7 +52,7 @@ func (k Keeper) DelegateOnHost(ctx sdk.Context, hostZone types.HostZone, amt sdk
 	}
 
 	// Construct the transaction
-	targetDelegatedAmts, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, amt.Amount)
+	targetDelegatedAmts, err := k.GetDelegationValAmtsForHostZone(ctx, hostZone, amt.Amount)
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Sprintf("Error getting target delegation amounts for host zone %s", hostZone.ChainId))
 		return err
@@ -184,6 +186,40 @@ func (k Keeper) UpdateWithdrawalBalance(ctx sdk.Context, hostZone types.HostZone
 	return nil
 }
 
+// Submits an ICQ for the fee account balance, so that the commission can be sent to stride
+func (k Keeper) UpdateFeeAccountBalance(ctx sdk.Context, hostZone types.HostZone) error {
+	// Encode the fee account address for the query request
+	// The query request consists of the fee account address and denom
+	feeAccount := hostZone.FeeAccount
+	if feeAccount == nil || feeAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no fee account found for %s", hostZone.ChainId)
+	}
+	_, feeAddressBz, err := bech32.DecodeAndConvert(feeAccount.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fee account address, could not decode (%s)", err.Error())
+	}
+	queryData := append(bankTypes.CreateAccountBalancesPrefix(feeAddressBz), []byte(hostZone.HostDenom)...)
+
+	// The query should timeout before the next epoch
+	timeout, err := k.GetICATimeoutNanos(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Failed to get ICATimeout from %s epoch", epochstypes.STRIDE_EPOCH)
+	}
+
+	// Submit an ICQ for the rewards balance in the fee account
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for fee account balance"))
+	return k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_FeeBalance,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		queryData,
+		timeout,
+	)
+}
+
 // helper to get time at which next epoch begins, in unix nano units
 func (k Keeper) GetStartTimeNextEpoch(ctx sdk.Context, epochType string) (uint64, error) {
 	epochTracker, found := k.GetEpochTracker(ctx, epochType)
@@ -460,3 +496,142 @@ func (k Keeper) QueryDelegationsIcq(ctx sdk.Context, hostZone types.HostZone, va
 
 	return nil
 }
+
+// Submits an ICQ for a validator's signing info from the host's slashing store
+// This is used to confirm that a decrease in delegation was the result of a slash, and to track validator uptime
+func (k Keeper) QuerySigningInfoIcq(ctx sdk.Context, hostZone types.HostZone, consensusAddress string, callbackId string) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for signing info of %s", consensusAddress))
+
+	_, consensusAddressBz, err := bech32.DecodeAndConvert(consensusAddress)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid consensus address, could not decode (%s)", err.Error())
+	}
+	queryData := slashingtypes.ValidatorSigningInfoKey(sdk.ConsAddress(consensusAddressBz))
+
+	// The query should timeout at the start of the next epoch
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	// Submit signing info ICQ
+	if err := k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		callbackId,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.SLASHING_STORE_QUERY_WITH_PROOF,
+		queryData,
+		ttl,
+	); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for signing info, error : %s", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// Submits an ICQ for a validator's record on the host, to update the validator's bond status and jailed flag
+// Unlike the exchange rate query, the status query is not restricted to the ICQ buffer window
+func (k Keeper) QueryValidatorStatusIcq(ctx sdk.Context, hostZone types.HostZone, valoper string) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for validator status of %s", valoper))
+
+	_, validatorAddressBz, err := bech32.DecodeAndConvert(valoper)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator operator address, could not decode (%s)", err.Error())
+	}
+	queryData := stakingtypes.GetValidatorKey(validatorAddressBz)
+
+	// The query should timeout at the start of the next epoch
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	// Submit validator status ICQ
+	if err := k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_ValidatorStatus,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.STAKING_STORE_QUERY_WITH_PROOF,
+		queryData,
+		ttl,
+	); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for validator status, error : %s", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// Submits an ICQ for each of the host's staking params that are tracked on the host zone (unbonding time, max validators
+// and bond denom), as well as the slashing params used to confirm slashes (the double sign and downtime slash fractions)
+func (k Keeper) QueryHostStakingParamsIcq(ctx sdk.Context, hostZone types.HostZone) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQs for host staking params"))
+
+	// The query should timeout at the start of the next epoch
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	paramKeys := [][]byte{
+		types.GetHostStakingParamKey(stakingtypes.KeyUnbondingTime),
+		types.GetHostStakingParamKey(stakingtypes.KeyMaxValidators),
+		types.GetHostStakingParamKey(stakingtypes.KeyBondDenom),
+		types.GetHostSlashingParamKey(slashingtypes.KeySlashFractionDoubleSign),
+		types.GetHostSlashingParamKey(slashingtypes.KeySlashFractionDowntime),
+	}
+	for _, paramKey := range paramKeys {
+		if err := k.InterchainQueryKeeper.MakeRequest(
+			ctx,
+			types.ModuleName,
+			ICQCallbackID_HostStakingParam,
+			hostZone.ChainId,
+			hostZone.ConnectionId,
+			icqtypes.PARAMS_STORE_QUERY_WITH_PROOF,
+			paramKey,
+			ttl,
+		); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for host staking param %s, error : %s", paramKey, err.Error()))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Submits an ICQ for the balance of the host's bonded pool (i.e. the total tokens bonded on the host),
+// which is used to measure each validator's share of the host's voting power
+// The bonded pool is a module account, so its address is derived the same way on the host
+func (k Keeper) QueryHostBondedPoolIcq(ctx sdk.Context, hostZone types.HostZone) error {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Submitting ICQ for host bonded pool"))
+
+	bondedPoolAddress := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+	queryData := append(bankTypes.CreateAccountBalancesPrefix(bondedPoolAddress), []byte(hostZone.HostDenom)...)
+
+	// The query should timeout at the start of the next epoch
+	ttl, err := k.GetStartTimeNextEpoch(ctx, epochstypes.STRIDE_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "could not get start time for next epoch: %s", err.Error())
+	}
+
+	if err := k.InterchainQueryKeeper.MakeRequest(
+		ctx,
+		types.ModuleName,
+		ICQCallbackID_HostBondedPool,
+		hostZone.ChainId,
+		hostZone.ConnectionId,
+		icqtypes.BANK_STORE_QUERY_WITH_PROOF,
+		queryData,
+		ttl,
+	); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Error submitting ICQ for host bonded pool, error : %s", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Begins winding down a host zone
+// Liquid stakes and redemptions are disabled, and all delegations are unbonded over the following day epochs
+// Once everything has been swept to the redemption account, remaining stTokens can be redeemed at a fixed rate
+func (k msgServer) SunsetHostZone(goCtx context.Context, msg *types.MsgSunsetHostZone) (*types.MsgSunsetHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hostZone, found := k.GetHostZone(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", msg.ChainId)
+	}
+	if hostZone.Halted {
+		return nil, errorsmod.Wrapf(types.ErrHaltedHostZone, "host zone %s is halted", msg.ChainId)
+	}
+
+	if err := k.StartHostZoneSunset(ctx, hostZone); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSunsetHostZoneResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupSunsetHostZone() stakeibctypes.MsgSunsetHostZone {
+	hostZone := s.SetupSunset(1)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_NONE
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	return stakeibctypes.MsgSunsetHostZone{
+		Creator: s.TestAccs[0].String(),
+		ChainId: HostChainId,
+	}
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_Successful() {
+	msg := s.SetupSunsetHostZone()
+
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when sunsetting host zone")
+
+	hostZone := s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_UNBONDING, hostZone.SunsetStatus, "sunset status")
+
+	// An event should be emitted for the new status
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeHostZoneSunset {
+			numEvents++
+		}
+	}
+	s.Require().Equal(1, numEvents, "number of sunset events")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_AlreadySunset() {
+	msg := s.SetupSunsetHostZone()
+
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when sunsetting host zone")
+
+	_, err = s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone GAIA is already being sunset (status: SUNSET_UNBONDING)")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_HostZoneNotFound() {
+	msg := s.SetupSunsetHostZone()
+	msg.ChainId = "fake_host_zone"
+
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
+
+func (s *KeeperTestSuite) TestSunsetHostZone_Halted() {
+	msg := s.SetupSunsetHostZone()
+
+	hostZone := s.getSunsetHostZone()
+	hostZone.Halted = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().SunsetHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone GAIA is halted")
+}
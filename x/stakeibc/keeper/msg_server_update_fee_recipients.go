@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Replaces the list of recipients that protocol revenue is distributed to
+// Removing all recipients reverts to sending all revenue to the fee collector
+func (k msgServer) UpdateFeeRecipients(goCtx context.Context, msg *types.MsgUpdateFeeRecipients) (*types.MsgUpdateFeeRecipientsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ReplaceFeeRecipients(ctx, msg.FeeRecipients); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeRecipientsResponse{}, nil
+}
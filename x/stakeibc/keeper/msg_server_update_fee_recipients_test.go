@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) SetupUpdateFeeRecipients() stakeibctypes.MsgUpdateFeeRecipients {
+	// Start with a single recipient that should be replaced
+	s.App.StakeibcKeeper.SetFeeRecipients(s.Ctx, []stakeibctypes.FeeRecipient{
+		{Name: "old_recipient", Type: stakeibctypes.FeeRecipientType_ACCOUNT, Address: s.TestAccs[1].String(), Weight: 1},
+	})
+
+	return stakeibctypes.MsgUpdateFeeRecipients{
+		Creator: s.TestAccs[0].String(),
+		FeeRecipients: []stakeibctypes.FeeRecipient{
+			{Name: "fee_collector", Type: stakeibctypes.FeeRecipientType_MODULE_ACCOUNT, Address: authtypes.FeeCollectorName, Weight: 9},
+			{Name: "community_pool", Type: stakeibctypes.FeeRecipientType_COMMUNITY_POOL, Weight: 1},
+		},
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeRecipients_Successful() {
+	msg := s.SetupUpdateFeeRecipients()
+
+	_, err := s.GetMsgServer().UpdateFeeRecipients(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating fee recipients")
+
+	s.Require().ElementsMatch(msg.FeeRecipients, s.App.StakeibcKeeper.GetAllFeeRecipients(s.Ctx), "fee recipients")
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeRecipients_ResetToDefault() {
+	msg := s.SetupUpdateFeeRecipients()
+	msg.FeeRecipients = []stakeibctypes.FeeRecipient{}
+
+	_, err := s.GetMsgServer().UpdateFeeRecipients(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when clearing fee recipients")
+
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllFeeRecipients(s.Ctx), "fee recipients should be cleared")
+	activeRecipients := s.App.StakeibcKeeper.GetActiveFeeRecipients(s.Ctx)
+	s.Require().Len(activeRecipients, 1, "should default to the fee collector")
+	s.Require().Equal(authtypes.FeeCollectorName, activeRecipients[0].Address)
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeRecipients_ModuleAccountNotFound() {
+	msg := s.SetupUpdateFeeRecipients()
+	msg.FeeRecipients[0].Address = "fake_module"
+
+	_, err := s.GetMsgServer().UpdateFeeRecipients(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().EqualError(err, "module account fake_module does not exist: invalid fee recipient")
+
+	// The existing recipients should be unchanged
+	feeRecipients := s.App.StakeibcKeeper.GetAllFeeRecipients(s.Ctx)
+	s.Require().Len(feeRecipients, 1)
+	s.Require().Equal("old_recipient", feeRecipients[0].Name)
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeRecipientsProposal() {
+	msg := s.SetupUpdateFeeRecipients()
+	proposal := stakeibctypes.UpdateFeeRecipientsProposal{
+		Title:         "Update fee recipients",
+		Description:   "Proposal to update fee recipients",
+		FeeRecipients: msg.FeeRecipients,
+	}
+
+	err := s.App.StakeibcKeeper.UpdateFeeRecipientsProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected when updating fee recipients through governance")
+	s.Require().ElementsMatch(msg.FeeRecipients, s.App.StakeibcKeeper.GetAllFeeRecipients(s.Ctx), "fee recipients")
+
+	// A proposal with a missing module account should not modify the recipients
+	proposal.FeeRecipients = []stakeibctypes.FeeRecipient{
+		{Name: "fake", Type: stakeibctypes.FeeRecipientType_MODULE_ACCOUNT, Address: "fake_module", Weight: 1},
+	}
+	err = s.App.StakeibcKeeper.UpdateFeeRecipientsProposal(s.Ctx, &proposal)
+	s.Require().EqualError(err, "module account fake_module does not exist: invalid fee recipient")
+	s.Require().ElementsMatch(msg.FeeRecipients, s.App.StakeibcKeeper.GetAllFeeRecipients(s.Ctx), "fee recipients should be unchanged")
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Updates the configurable fields of a host zone
+// Only the fields specified in the message are modified
+func (k msgServer) UpdateHostZone(goCtx context.Context, msg *types.MsgUpdateHostZone) (*types.MsgUpdateHostZoneResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.UpdateHostZoneConfig(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateHostZoneResponse{}, nil
+}
+
+// Validates the specified fields against the host zone's current state and applies the update
+// Used by both MsgUpdateHostZone and UpdateHostZoneProposal
+func (k Keeper) UpdateHostZoneConfig(ctx sdk.Context, update *types.MsgUpdateHostZone) error {
+	hostZone, found := k.GetHostZone(ctx, update.ChainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHostZoneNotFound, "host zone %s not found", update.ChainId)
+	}
+
+	if update.StrideCommission != nil {
+		hostZone.StrideCommission = update.StrideCommission
+	}
+
+	if update.UnbondingFrequency != 0 {
+		if err := k.ValidateUpdatedUnbondingFrequency(ctx, hostZone, update.UnbondingFrequency); err != nil {
+			return err
+		}
+		hostZone.UnbondingFrequency = update.UnbondingFrequency
+	}
+
+	if update.MinRedemptionRate != nil || update.MaxRedemptionRate != nil {
+		if update.MinRedemptionRate != nil {
+			hostZone.MinRedemptionRate = *update.MinRedemptionRate
+		}
+		if update.MaxRedemptionRate != nil {
+			hostZone.MaxRedemptionRate = *update.MaxRedemptionRate
+		}
+		if err := k.ValidateUpdatedRedemptionRateBounds(ctx, hostZone); err != nil {
+			return err
+		}
+	}
+
+	if update.TransferChannelId != "" && update.TransferChannelId != hostZone.TransferChannelId {
+		if err := k.ValidateUpdatedTransferChannel(ctx, hostZone, update.TransferChannelId); err != nil {
+			return err
+		}
+		// Native tokens received over the new channel will have a different ibc denom
+		hostZone.TransferChannelId = update.TransferChannelId
+		hostZone.IbcDenom = transfertypes.ParseDenomTrace(
+			transfertypes.GetPrefixedDenom(transfertypes.PortID, update.TransferChannelId, hostZone.HostDenom),
+		).IBCDenom()
+	}
+
+	if update.Bech32Prefix != "" && update.Bech32Prefix != hostZone.Bech32Prefix {
+		if err := k.ValidateUpdatedBech32Prefix(ctx, hostZone, update.Bech32Prefix); err != nil {
+			return err
+		}
+		hostZone.Bech32Prefix = update.Bech32Prefix
+	}
+
+	// The epoch intervals replace the host zone's existing overrides (zero intervals fall back to the params)
+	if update.EpochIntervals != nil {
+		hostZone.EpochIntervals = update.EpochIntervals
+	}
+
+	// The stToken metadata is stored in the bank module (unspecified fields keep their current value)
+	if update.HasStTokenMetadataUpdate() {
+		if err := k.SetStTokenMetadata(ctx, hostZone.HostDenom, update.StTokenDisplayName, update.StTokenSymbol, update.StTokenExponent); err != nil {
+			return err
+		}
+	}
+
+	k.SetHostZone(ctx, hostZone)
+
+	strideCommission := k.GetStrideCommission(ctx, hostZone)
+	k.Logger(ctx).Info(fmt.Sprintf("Updated host zone %s, stride commission: %v, unbonding frequency: %d, redemption rate bounds: [%v, %v], transfer channel: %s, bech32 prefix: %s, epoch intervals: %v",
+		hostZone.ChainId, strideCommission, hostZone.UnbondingFrequency, hostZone.MinRedemptionRate, hostZone.MaxRedemptionRate,
+		hostZone.TransferChannelId, hostZone.Bech32Prefix, hostZone.EpochIntervals))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostZoneUpdate,
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeyStrideCommission, strideCommission.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingFrequency, fmt.Sprintf("%d", hostZone.UnbondingFrequency)),
+			sdk.NewAttribute(types.AttributeKeyMinRedemptionRate, hostZone.MinRedemptionRate.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxRedemptionRate, hostZone.MaxRedemptionRate.String()),
+			sdk.NewAttribute(types.AttributeKeyTransferChannelId, hostZone.TransferChannelId),
+			sdk.NewAttribute(types.AttributeKeyBech32Prefix, hostZone.Bech32Prefix),
+		),
+	)
+
+	return nil
+}
+
+// Confirms the unbonding frequency is high enough for the host's unbonding period
+// The queried host staking params are used if available, otherwise the unbonding period is read from the light client
+func (k Keeper) ValidateUpdatedUnbondingFrequency(ctx sdk.Context, hostZone types.HostZone, unbondingFrequency uint64) error {
+	hostStakingParams := types.HostStakingParams{}
+	if hostZone.HostStakingParams != nil && hostZone.HostStakingParams.UnbondingPeriod != 0 {
+		hostStakingParams = *hostZone.HostStakingParams
+	} else {
+		unbondingPeriod, err := k.GetHostUnbondingPeriod(ctx, hostZone.ConnectionId)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "unable to obtain unbonding period for %s: %s", hostZone.ChainId, err.Error())
+		}
+		hostStakingParams.UnbondingPeriod = uint64(unbondingPeriod)
+	}
+	return hostStakingParams.ValidateUnbondingFrequency(unbondingFrequency)
+}
+
+// Confirms the updated redemption rate bounds are ordered, and that the current redemption rate
+// is within them (otherwise the host zone would be halted in the next BeginBlocker)
+func (k Keeper) ValidateUpdatedRedemptionRateBounds(ctx sdk.Context, hostZone types.HostZone) error {
+	if !hostZone.MinRedemptionRate.IsNil() && !hostZone.MaxRedemptionRate.IsNil() &&
+		hostZone.MinRedemptionRate.IsPositive() && hostZone.MinRedemptionRate.GTE(hostZone.MaxRedemptionRate) {
+		return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "min redemption rate (%v) should be lower than max redemption rate (%v)",
+			hostZone.MinRedemptionRate, hostZone.MaxRedemptionRate)
+	}
+	if _, err := k.IsRedemptionRateWithinSafetyBounds(ctx, hostZone); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "current redemption rate is outside the updated bounds: %s", err.Error())
+	}
+	return nil
+}
+
+// Confirms the new transfer channel is an open transfer channel on the host zone's connection, and that
+// no deposits are in flight (or waiting to be transferred) under the ibc denom of the current channel
+func (k Keeper) ValidateUpdatedTransferChannel(ctx sdk.Context, hostZone types.HostZone, transferChannelId string) error {
+	for _, otherHostZone := range k.GetAllHostZone(ctx) {
+		if otherHostZone.ChainId != hostZone.ChainId && otherHostZone.TransferChannelId == transferChannelId {
+			return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "transfer channel %s already registered to %s", transferChannelId, otherHostZone.ChainId)
+		}
+	}
+
+	channel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, transferChannelId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "transfer channel %s not found", transferChannelId)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "transfer channel %s is not open (%s)", transferChannelId, channel.State)
+	}
+	if len(channel.ConnectionHops) == 0 || channel.ConnectionHops[0] != hostZone.ConnectionId {
+		return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "transfer channel %s is not on the host zone's connection (%s)",
+			transferChannelId, hostZone.ConnectionId)
+	}
+
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId != hostZone.ChainId {
+			continue
+		}
+		inProgress := depositRecord.Status == recordstypes.DepositRecord_TRANSFER_IN_PROGRESS
+		queued := depositRecord.Status == recordstypes.DepositRecord_TRANSFER_QUEUE && depositRecord.Amount.IsPositive()
+		if inProgress || queued {
+			return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "deposit record %d is pending transfer on the current channel (status: %s)",
+				depositRecord.Id, depositRecord.Status)
+		}
+	}
+
+	if GetInstantRedemptionBuffer(hostZone).IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "the instant redemption buffer is held in the ibc denom of the current channel")
+	}
+
+	return nil
+}
+
+// Confirms the new bech32 prefix matches the host zone's ICA addresses, and that there are no outstanding
+// redemptions with receivers that were validated against the current prefix
+func (k Keeper) ValidateUpdatedBech32Prefix(ctx sdk.Context, hostZone types.HostZone, bech32Prefix string) error {
+	for _, otherHostZone := range k.GetAllHostZone(ctx) {
+		if otherHostZone.ChainId != hostZone.ChainId && otherHostZone.Bech32Prefix == bech32Prefix {
+			return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "bech32 prefix %s already registered to %s", bech32Prefix, otherHostZone.ChainId)
+		}
+	}
+
+	icaAccounts := []*types.ICAAccount{
+		hostZone.DelegationAccount,
+		hostZone.WithdrawalAccount,
+		hostZone.RedemptionAccount,
+		hostZone.FeeAccount,
+	}
+	for _, icaAccount := range icaAccounts {
+		if icaAccount == nil || icaAccount.Address == "" {
+			continue
+		}
+		if _, err := utils.AccAddressFromBech32(icaAccount.Address, bech32Prefix); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "%s account address %s does not match bech32 prefix %s",
+				icaAccount.Target, icaAccount.Address, bech32Prefix)
+		}
+	}
+
+	for _, userRedemptionRecord := range k.RecordsKeeper.GetAllUserRedemptionRecord(ctx) {
+		if userRedemptionRecord.HostZoneId == hostZone.ChainId {
+			return errorsmod.Wrapf(types.ErrInvalidHostZoneUpdate, "user redemption record %s is outstanding", userRedemptionRecord.Id)
+		}
+	}
+
+	return nil
+}
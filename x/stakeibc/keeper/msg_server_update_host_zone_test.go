@@ -0,0 +1,383 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type UpdateHostZoneTestCase struct {
+	validMsg           stakeibctypes.MsgUpdateHostZone
+	expectedCommission sdk.Dec
+}
+
+func (s *KeeperTestSuite) SetupUpdateHostZone() UpdateHostZoneTestCase {
+	initialCommission := sdk.NewDecWithPrec(1, 1)
+	hostZone := stakeibctypes.HostZone{
+		ChainId:          HostChainId,
+		HostDenom:        Atom,
+		StrideCommission: &initialCommission,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	newCommission := sdk.MustNewDecFromStr("0.075")
+	return UpdateHostZoneTestCase{
+		validMsg: stakeibctypes.MsgUpdateHostZone{
+			Creator:          s.TestAccs[0].String(),
+			ChainId:          HostChainId,
+			StrideCommission: &newCommission,
+		},
+		expectedCommission: newCommission,
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_Successful() {
+	tc := s.SetupUpdateHostZone()
+
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when updating host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().Equal(tc.expectedCommission.String(), hostZone.StrideCommission.String(), "stride commission")
+	s.Require().Equal(tc.expectedCommission.String(), s.App.StakeibcKeeper.GetStrideCommission(s.Ctx, hostZone).String(), "effective commission")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_ZeroCommission() {
+	tc := s.SetupUpdateHostZone()
+
+	// A zero commission is a valid override and should not fall back to the param
+	zeroCommission := sdk.ZeroDec()
+	tc.validMsg.StrideCommission = &zeroCommission
+
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when updating host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().True(s.App.StakeibcKeeper.GetStrideCommission(s.Ctx, hostZone).IsZero(), "effective commission should be zero")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_EpochIntervals() {
+	tc := s.SetupUpdateHostZone()
+
+	// Set an initial override
+	tc.validMsg.EpochIntervals = &stakeibctypes.EpochIntervals{DepositInterval: 2, DelegateInterval: 3}
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when updating host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().Equal(uint64(3), s.App.StakeibcKeeper.GetHostZoneInterval(s.Ctx, hostZone, stakeibctypes.KeyDelegateInterval), "delegate interval")
+
+	// A new override should replace the previous one entirely
+	tc.validMsg.EpochIntervals = &stakeibctypes.EpochIntervals{RedemptionRateInterval: 4}
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when updating host zone")
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().Equal(&stakeibctypes.EpochIntervals{RedemptionRateInterval: 4}, hostZone.EpochIntervals, "epoch intervals")
+
+	// Leaving the intervals out of the update should keep the existing override
+	tc.validMsg.EpochIntervals = nil
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &tc.validMsg)
+	s.Require().NoError(err, "no error expected when updating host zone")
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().Equal(uint64(4), hostZone.EpochIntervals.RedemptionRateInterval, "redemption rate interval")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_HostZoneNotFound() {
+	tc := s.SetupUpdateHostZone()
+
+	invalidMsg := tc.validMsg
+	invalidMsg.ChainId = "fake_host_zone"
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &invalidMsg)
+	s.Require().EqualError(err, "host zone fake_host_zone not found: host zone not found")
+}
+
+// Adds a transfer channel on the host zone's connection directly to the channel store
+func (s *KeeperTestSuite) setUpdateHostZoneTransferChannel(channelId string, state channeltypes.State, connectionId string) {
+	s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, channelId, channeltypes.Channel{
+		State:          state,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty(transfertypes.PortID, "channel-10"),
+		ConnectionHops: []string{connectionId},
+		Version:        transfertypes.Version,
+	})
+}
+
+func (s *KeeperTestSuite) SetupUpdateHostZoneConfig() stakeibctypes.MsgUpdateHostZone {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostDenom:         Atom,
+		IbcDenom:          IbcAtom,
+		ConnectionId:      ibctesting.FirstConnectionID,
+		TransferChannelId: ibctesting.FirstChannelID,
+		Bech32Prefix:      Bech32Prefix,
+		RedemptionRate:    sdk.OneDec(),
+		MinRedemptionRate: sdk.MustNewDecFromStr("0.9"),
+		MaxRedemptionRate: sdk.MustNewDecFromStr("1.5"),
+		// 21 day unbonding period
+		HostStakingParams:  &stakeibctypes.HostStakingParams{UnbondingPeriod: uint64(time.Hour * 24 * 21)},
+		UnbondingFrequency: 3,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.setUpdateHostZoneTransferChannel("channel-5", channeltypes.OPEN, ibctesting.FirstConnectionID)
+
+	minRedemptionRate := sdk.MustNewDecFromStr("0.95")
+	maxRedemptionRate := sdk.MustNewDecFromStr("1.2")
+	return stakeibctypes.MsgUpdateHostZone{
+		Creator:            s.TestAccs[0].String(),
+		ChainId:            HostChainId,
+		UnbondingFrequency: 4,
+		MinRedemptionRate:  &minRedemptionRate,
+		MaxRedemptionRate:  &maxRedemptionRate,
+		TransferChannelId:  "channel-5",
+		Bech32Prefix:       "cosmosnew",
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_AllFields() {
+	msg := s.SetupUpdateHostZoneConfig()
+
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating host zone")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().Equal(uint64(4), hostZone.UnbondingFrequency, "unbonding frequency")
+	s.Require().Equal(*msg.MinRedemptionRate, hostZone.MinRedemptionRate, "min redemption rate")
+	s.Require().Equal(*msg.MaxRedemptionRate, hostZone.MaxRedemptionRate, "max redemption rate")
+	s.Require().Equal("channel-5", hostZone.TransferChannelId, "transfer channel")
+	s.Require().Equal("cosmosnew", hostZone.Bech32Prefix, "bech32 prefix")
+
+	// The ibc denom should be updated to match the new channel
+	expectedIbcDenom := transfertypes.ParseDenomTrace("transfer/channel-5/uatom").IBCDenom()
+	s.Require().Equal(expectedIbcDenom, hostZone.IbcDenom, "ibc denom")
+
+	// The stride commission was not specified and should be unchanged
+	s.Require().Nil(hostZone.StrideCommission, "stride commission")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZoneProposal() {
+	msg := s.SetupUpdateHostZoneConfig()
+
+	// The host zone can also be updated with a legacy governance proposal
+	strideCommission := sdk.MustNewDecFromStr("0.05")
+	proposal := stakeibctypes.UpdateHostZoneProposal{
+		Title:              "Update host zone GAIA",
+		Description:        "Unbond every 4 days and take a 5% commission",
+		ChainId:            HostChainId,
+		UnbondingFrequency: msg.UnbondingFrequency,
+		StrideCommission:   &strideCommission,
+	}
+	err := s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected when updating host zone through governance")
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone should be found")
+	s.Require().Equal(uint64(4), hostZone.UnbondingFrequency, "unbonding frequency")
+	s.Require().Equal(strideCommission, *hostZone.StrideCommission, "stride commission")
+	s.Require().Equal(ibctesting.FirstChannelID, hostZone.TransferChannelId, "transfer channel should be unchanged")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_StTokenMetadata() {
+	s.SetupUpdateHostZoneConfig()
+	s.App.BankKeeper.SetDenomMetaData(s.Ctx, stakeibctypes.NewStTokenMetadata(Atom, "Stride Staked ATOM", "stATOM", 6))
+
+	// Only the specified fields should be updated
+	msg := stakeibctypes.MsgUpdateHostZone{
+		Creator:            s.TestAccs[0].String(),
+		ChainId:            HostChainId,
+		StTokenDisplayName: "Stride Staked Cosmos Hub ATOM",
+	}
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when updating stToken metadata")
+
+	stTokenMetadata, found := s.App.BankKeeper.GetDenomMetaData(s.Ctx, StAtom)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal(stakeibctypes.NewStTokenMetadata(Atom, "Stride Staked Cosmos Hub ATOM", "stATOM", 6), stTokenMetadata, "stToken metadata")
+
+	// The metadata can also be updated through governance
+	proposal := stakeibctypes.UpdateHostZoneProposal{
+		Title:           "Update host zone GAIA",
+		Description:     "Update the stATOM display unit",
+		ChainId:         HostChainId,
+		StTokenSymbol:   "stAtom",
+		StTokenExponent: 3,
+	}
+	err = s.App.StakeibcKeeper.UpdateHostZoneProposal(s.Ctx, &proposal)
+	s.Require().NoError(err, "no error expected when updating stToken metadata through governance")
+
+	stTokenMetadata, found = s.App.BankKeeper.GetDenomMetaData(s.Ctx, StAtom)
+	s.Require().True(found, "stToken metadata found")
+	s.Require().Equal(stakeibctypes.NewStTokenMetadata(Atom, "Stride Staked Cosmos Hub ATOM", "stAtom", 3), stTokenMetadata, "stToken metadata")
+
+	// An update that results in invalid metadata should fail
+	msg = stakeibctypes.MsgUpdateHostZone{
+		Creator:       s.TestAccs[0].String(),
+		ChainId:       HostChainId,
+		StTokenSymbol: "stuatom",
+	}
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorIs(err, stakeibctypes.ErrInvalidStTokenMetadata)
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_UnbondingFrequencyTooLow() {
+	msg := s.SetupUpdateHostZoneConfig()
+	msg.UnbondingFrequency = 3
+
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "unbonding frequency 3 is below the minimum of 4")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_UnbondingFrequencyFromLightClient() {
+	s.CreateTransferChannel(HostChainId)
+	msg := s.SetupUpdateHostZoneConfig()
+
+	// Without queried staking params, the unbonding period should be read from the light client (21 days)
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.HostStakingParams = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	msg.UnbondingFrequency = 3
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "unbonding frequency 3 is below the minimum of 4")
+
+	msg.UnbondingFrequency = 4
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected with a valid unbonding frequency")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_RedemptionRateBounds() {
+	msg := s.SetupUpdateHostZoneConfig()
+	msg.TransferChannelId = ""
+	msg.Bech32Prefix = ""
+
+	// Updating only the min above the existing max should fail
+	invalidMin := sdk.MustNewDecFromStr("1.6")
+	msg.MinRedemptionRate = &invalidMin
+	msg.MaxRedemptionRate = nil
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "min redemption rate (1.600000000000000000) should be lower than max redemption rate (1.500000000000000000)")
+
+	// Bounds that exclude the current redemption rate should fail
+	outOfBoundsMin := sdk.MustNewDecFromStr("1.1")
+	msg.MinRedemptionRate = &outOfBoundsMin
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "current redemption rate is outside the updated bounds")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_InvalidTransferChannel() {
+	msg := s.SetupUpdateHostZoneConfig()
+	msg.Bech32Prefix = ""
+
+	// Channel does not exist
+	msg.TransferChannelId = "channel-6"
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "transfer channel channel-6 not found")
+
+	// Channel is closed
+	s.setUpdateHostZoneTransferChannel("channel-6", channeltypes.CLOSED, ibctesting.FirstConnectionID)
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "transfer channel channel-6 is not open")
+
+	// Channel is on a different connection
+	s.setUpdateHostZoneTransferChannel("channel-6", channeltypes.OPEN, "connection-1")
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "transfer channel channel-6 is not on the host zone's connection (connection-0)")
+
+	// Channel is already used by another host zone
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: OsmoChainId, TransferChannelId: "channel-5"})
+	msg.TransferChannelId = "channel-5"
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "transfer channel channel-5 already registered to OSMO")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_TransferChannelWithPendingDeposits() {
+	testCases := []struct {
+		name   string
+		status recordtypes.DepositRecord_Status
+		amount int64
+		err    string
+	}{
+		{name: "transfer in progress", status: recordtypes.DepositRecord_TRANSFER_IN_PROGRESS, amount: 0, err: "is pending transfer on the current channel"},
+		{name: "transfer queue", status: recordtypes.DepositRecord_TRANSFER_QUEUE, amount: 100, err: "is pending transfer on the current channel"},
+		{name: "empty transfer queue", status: recordtypes.DepositRecord_TRANSFER_QUEUE, amount: 0},
+		{name: "delegation queue", status: recordtypes.DepositRecord_DELEGATION_QUEUE, amount: 100},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			msg := s.SetupUpdateHostZoneConfig()
+			msg.Bech32Prefix = ""
+
+			s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+				Id:         1,
+				HostZoneId: HostChainId,
+				Amount:     sdkmath.NewInt(tc.amount),
+				Status:     tc.status,
+			})
+
+			_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+			if tc.err == "" {
+				s.Require().NoError(err, "no error expected")
+			} else {
+				s.Require().ErrorContains(err, tc.err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_TransferChannelWithInstantRedemptionBuffer() {
+	msg := s.SetupUpdateHostZoneConfig()
+	msg.Bech32Prefix = ""
+
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.InstantRedemptionBuffer = sdkmath.NewInt(100)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "the instant redemption buffer is held in the ibc denom of the current channel")
+}
+
+func (s *KeeperTestSuite) TestUpdateHostZone_InvalidBech32Prefix() {
+	msg := s.SetupUpdateHostZoneConfig()
+	msg.TransferChannelId = ""
+
+	// The ICA addresses must be valid under the new prefix
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	delegationAddress := "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf"
+	hostZone.DelegationAccount = &stakeibctypes.ICAAccount{Address: delegationAddress, Target: stakeibctypes.ICAAccountType_DELEGATION}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "DELEGATION account address "+delegationAddress+" does not match bech32 prefix cosmosnew")
+
+	// Outstanding redemptions were validated against the old prefix
+	hostZone.DelegationAccount = nil
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{Id: "GAIA.1.user", HostZoneId: HostChainId})
+
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "user redemption record GAIA.1.user is outstanding")
+
+	// The prefix cannot be shared with another host zone
+	s.App.RecordsKeeper.RemoveUserRedemptionRecord(s.Ctx, "GAIA.1.user")
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: OsmoChainId, Bech32Prefix: "cosmosnew"})
+
+	_, err = s.GetMsgServer().UpdateHostZone(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "bech32 prefix cosmosnew already registered to OSMO")
+}
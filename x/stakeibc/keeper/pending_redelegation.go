@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetPendingRedelegation set a specific pendingRedelegation in the store from its index
+func (k Keeper) SetPendingRedelegation(ctx sdk.Context, pendingRedelegation types.PendingRedelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRedelegationKeyPrefix))
+	key := types.PendingRedelegationKey(
+		pendingRedelegation.ChainId,
+		pendingRedelegation.SrcValidator,
+		pendingRedelegation.DstValidator,
+		pendingRedelegation.CompletionTime,
+	)
+	b := k.cdc.MustMarshal(&pendingRedelegation)
+	store.Set(key, b)
+}
+
+// GetPendingRedelegation returns a pendingRedelegation from its index
+func (k Keeper) GetPendingRedelegation(
+	ctx sdk.Context,
+	chainId string,
+	srcValidator string,
+	dstValidator string,
+	completionTime uint64,
+) (val types.PendingRedelegation, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRedelegationKeyPrefix))
+
+	b := store.Get(types.PendingRedelegationKey(chainId, srcValidator, dstValidator, completionTime))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingRedelegation removes a pendingRedelegation from the store
+func (k Keeper) RemovePendingRedelegation(ctx sdk.Context, pendingRedelegation types.PendingRedelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRedelegationKeyPrefix))
+	store.Delete(types.PendingRedelegationKey(
+		pendingRedelegation.ChainId,
+		pendingRedelegation.SrcValidator,
+		pendingRedelegation.DstValidator,
+		pendingRedelegation.CompletionTime,
+	))
+}
+
+// GetAllPendingRedelegations returns all pendingRedelegations
+func (k Keeper) GetAllPendingRedelegations(ctx sdk.Context) (list []types.PendingRedelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRedelegationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllHostZonePendingRedelegations returns all of a host zone's pendingRedelegations
+func (k Keeper) GetAllHostZonePendingRedelegations(ctx sdk.Context, chainId string) (list []types.PendingRedelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingRedelegationKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.HostZoneKeyPrefix(chainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Records each rebalancing as an in-flight redelegation (with a completion time of 0) while the redelegation ICA awaits its ack
+func (k Keeper) RecordInFlightRedelegations(ctx sdk.Context, chainId string, rebalancings []*types.Rebalancing) {
+	for _, rebalancing := range rebalancings {
+		pendingRedelegation, found := k.GetPendingRedelegation(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator, 0)
+		if !found {
+			pendingRedelegation = types.PendingRedelegation{
+				ChainId:      chainId,
+				SrcValidator: rebalancing.SrcValidator,
+				DstValidator: rebalancing.DstValidator,
+				Amount:       sdk.ZeroInt(),
+			}
+		}
+		pendingRedelegation.Amount = pendingRedelegation.Amount.Add(rebalancing.Amt)
+		k.SetPendingRedelegation(ctx, pendingRedelegation)
+	}
+}
+
+// Removes the in-flight redelegations for each rebalancing once the redelegation ICA has been acknowledged (or timed out)
+func (k Keeper) RemoveInFlightRedelegations(ctx sdk.Context, chainId string, rebalancings []*types.Rebalancing) {
+	for _, rebalancing := range rebalancings {
+		pendingRedelegation, found := k.GetPendingRedelegation(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator, 0)
+		if !found {
+			continue
+		}
+		pendingRedelegation.Amount = pendingRedelegation.Amount.Sub(rebalancing.Amt)
+		if pendingRedelegation.Amount.IsPositive() {
+			k.SetPendingRedelegation(ctx, pendingRedelegation)
+		} else {
+			k.RemovePendingRedelegation(ctx, pendingRedelegation)
+		}
+	}
+}
+
+// Returns true if a redelegation out of the validator has been submitted and is still awaiting its ack
+func (k Keeper) HasInFlightRedelegation(ctx sdk.Context, chainId string, srcValidator string) bool {
+	for _, pendingRedelegation := range k.GetAllHostZonePendingRedelegations(ctx, chainId) {
+		if pendingRedelegation.CompletionTime == 0 && pendingRedelegation.SrcValidator == srcValidator {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the host zone's redelegations that are still awaiting their ack or have not yet matured as of the current block time
+// In-flight redelegations are included since they will become pending entries on the host once they're processed
+func (k Keeper) GetActivePendingRedelegations(ctx sdk.Context, chainId string) (list []types.PendingRedelegation) {
+	currentTime := cast.ToUint64(ctx.BlockTime().UnixNano())
+	for _, pendingRedelegation := range k.GetAllHostZonePendingRedelegations(ctx, chainId) {
+		if pendingRedelegation.CompletionTime == 0 || pendingRedelegation.CompletionTime > currentTime {
+			list = append(list, pendingRedelegation)
+		}
+	}
+	return list
+}
+
+// Stores a pending redelegation for each rebalancing in a successful redelegation ICA, using the completion
+// times from the MsgBeginRedelegateResponses in the acknowledgement
+// Each rebalancing corresponds to the message response at the same index
+func (k Keeper) RecordPendingRedelegations(ctx sdk.Context, chainId string, rebalancings []*types.Rebalancing, msgResponses [][]byte) error {
+	if len(msgResponses) != len(rebalancings) {
+		return errorsmod.Wrapf(types.ErrTxMsgDataInvalid,
+			"number of redelegation responses (%d) does not match the number of rebalancings (%d)", len(msgResponses), len(rebalancings))
+	}
+
+	for i, rebalancing := range rebalancings {
+		var redelegateResponse stakingtypes.MsgBeginRedelegateResponse
+		if err := proto.Unmarshal(msgResponses[i], &redelegateResponse); err != nil {
+			return errorsmod.Wrapf(types.ErrUnmarshalFailure, "Unable to unmarshal redelegation tx response: %s", err.Error())
+		}
+		if redelegateResponse.CompletionTime.IsZero() {
+			return errorsmod.Wrapf(types.ErrInvalidPacketCompletionTime, "Invalid completion time (%s) from txMsg", redelegateResponse.CompletionTime.String())
+		}
+		completionTime := cast.ToUint64(redelegateResponse.CompletionTime.UnixNano())
+
+		// Entries that complete at the same time are merged, as they are on the host
+		pendingRedelegation, found := k.GetPendingRedelegation(ctx, chainId, rebalancing.SrcValidator, rebalancing.DstValidator, completionTime)
+		if !found {
+			pendingRedelegation = types.PendingRedelegation{
+				ChainId:        chainId,
+				SrcValidator:   rebalancing.SrcValidator,
+				DstValidator:   rebalancing.DstValidator,
+				Amount:         sdk.ZeroInt(),
+				CompletionTime: completionTime,
+			}
+		}
+		pendingRedelegation.Amount = pendingRedelegation.Amount.Add(rebalancing.Amt)
+		k.SetPendingRedelegation(ctx, pendingRedelegation)
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Redelegation of %v from %s to %s pending until %s",
+			rebalancing.Amt, rebalancing.SrcValidator, rebalancing.DstValidator, redelegateResponse.CompletionTime.Format(time.RFC3339)))
+	}
+
+	return nil
+}
+
+// Removes any pending redelegations that have matured on the host
+// In-flight redelegations are only removed by the rebalance callback
+func (k Keeper) CleanupCompletedRedelegations(ctx sdk.Context) {
+	currentTime := cast.ToUint64(ctx.BlockTime().UnixNano())
+	for _, pendingRedelegation := range k.GetAllPendingRedelegations(ctx) {
+		if pendingRedelegation.CompletionTime != 0 && pendingRedelegation.CompletionTime <= currentTime {
+			k.RemovePendingRedelegation(ctx, pendingRedelegation)
+		}
+	}
+}
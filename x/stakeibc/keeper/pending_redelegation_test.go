@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/spf13/cast"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) CreateRedelegateResponse(completionTime time.Time) []byte {
+	response, err := proto.Marshal(&stakingtypes.MsgBeginRedelegateResponse{CompletionTime: completionTime})
+	s.Require().NoError(err, "no error expected when marshalling redelegate response")
+	return response
+}
+
+func (s *KeeperTestSuite) TestRecordPendingRedelegations() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	completionTime := s.Ctx.BlockTime().Add(time.Hour)
+	rebalancings := []*stakeibctypes.Rebalancing{
+		{SrcValidator: "val1", DstValidator: "val2", Amt: sdkmath.NewInt(100)},
+		{SrcValidator: "val1", DstValidator: "val3", Amt: sdkmath.NewInt(200)},
+	}
+	msgResponses := [][]byte{s.CreateRedelegateResponse(completionTime), s.CreateRedelegateResponse(completionTime)}
+
+	// Record the redelegations twice to confirm entries with the same completion time are merged
+	for i := 0; i < 2; i++ {
+		err := s.App.StakeibcKeeper.RecordPendingRedelegations(s.Ctx, HostChainId, rebalancings, msgResponses)
+		s.Require().NoError(err, "no error expected when recording pending redelegations")
+	}
+
+	pendingRedelegations := s.App.StakeibcKeeper.GetAllHostZonePendingRedelegations(s.Ctx, HostChainId)
+	s.Require().Len(pendingRedelegations, 2, "number of pending redelegations")
+
+	expectedCompletionTime := cast.ToUint64(completionTime.UnixNano())
+	pendingRedelegation, found := s.App.StakeibcKeeper.GetPendingRedelegation(s.Ctx, HostChainId, "val1", "val3", expectedCompletionTime)
+	s.Require().True(found, "pending redelegation found")
+	s.Require().Equal(int64(400), pendingRedelegation.Amount.Int64(), "pending redelegation amount")
+}
+
+func (s *KeeperTestSuite) TestRecordPendingRedelegations_MissingResponses() {
+	rebalancings := []*stakeibctypes.Rebalancing{
+		{SrcValidator: "val1", DstValidator: "val2", Amt: sdkmath.NewInt(100)},
+	}
+
+	err := s.App.StakeibcKeeper.RecordPendingRedelegations(s.Ctx, HostChainId, rebalancings, [][]byte{})
+	s.Require().ErrorContains(err, "number of redelegation responses (0) does not match the number of rebalancings (1)")
+	s.Require().Empty(s.App.StakeibcKeeper.GetAllPendingRedelegations(s.Ctx), "no pending redelegations")
+}
+
+func (s *KeeperTestSuite) TestRecordPendingRedelegations_InvalidCompletionTime() {
+	rebalancings := []*stakeibctypes.Rebalancing{
+		{SrcValidator: "val1", DstValidator: "val2", Amt: sdkmath.NewInt(100)},
+	}
+	msgResponses := [][]byte{s.CreateRedelegateResponse(time.Time{})}
+
+	err := s.App.StakeibcKeeper.RecordPendingRedelegations(s.Ctx, HostChainId, rebalancings, msgResponses)
+	s.Require().ErrorContains(err, "Invalid completion time")
+}
+
+func (s *KeeperTestSuite) TestCleanupCompletedRedelegations() {
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	s.AddPendingRedelegation("val1", "val2", s.Ctx.BlockTime().Add(-time.Hour))
+	s.AddPendingRedelegation("val1", "val3", s.Ctx.BlockTime())
+	s.AddPendingRedelegation("val2", "val3", s.Ctx.BlockTime().Add(time.Hour))
+
+	s.App.StakeibcKeeper.CleanupCompletedRedelegations(s.Ctx)
+
+	// Only the redelegation that has not yet matured should remain
+	pendingRedelegations := s.App.StakeibcKeeper.GetAllPendingRedelegations(s.Ctx)
+	s.Require().Len(pendingRedelegations, 1, "number of pending redelegations")
+	s.Require().Equal("val2", pendingRedelegations[0].SrcValidator, "remaining redelegation source")
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Tracks the restrictions the host places on new redelegations, given the redelegations that are still pending
+//   - A validator with an incoming redelegation cannot be the source of another redelegation until it matures
+//     (since redelegations are not transitive)
+//   - Each source/destination pair can have at most MaxPendingRedelegationEntries pending entries
+type RedelegationConstraints struct {
+	bannedSources map[string]bool
+	numEntries    map[string]uint64
+}
+
+// Builds the redelegation constraints from the host zone's pending redelegations
+func NewRedelegationConstraints(pendingRedelegations []types.PendingRedelegation) RedelegationConstraints {
+	constraints := RedelegationConstraints{
+		bannedSources: map[string]bool{},
+		numEntries:    map[string]uint64{},
+	}
+	for _, pendingRedelegation := range pendingRedelegations {
+		constraints.AddRedelegation(pendingRedelegation.SrcValidator, pendingRedelegation.DstValidator)
+	}
+	return constraints
+}
+
+// Records a new redelegation, banning the destination as a source and using up one of the pair's entries
+func (c RedelegationConstraints) AddRedelegation(srcValidator string, dstValidator string) {
+	c.bannedSources[dstValidator] = true
+	c.numEntries[srcValidator+"/"+dstValidator]++
+}
+
+// Returns true if the source validator can be redelegated from
+func (c RedelegationConstraints) CanRedelegateFrom(srcValidator string) bool {
+	return !c.bannedSources[srcValidator]
+}
+
+// Returns true if a redelegation between the two validators would be accepted by the host
+func (c RedelegationConstraints) CanRedelegate(srcValidator string, dstValidator string) bool {
+	return c.CanRedelegateFrom(srcValidator) && c.numEntries[srcValidator+"/"+dstValidator] < types.MaxPendingRedelegationEntries
+}
+
+// Builds the list of redelegations required to move the host zone's delegations towards their target weights
+//
+// On each iteration, the most overweight validator is paired with the most underweight validator, and the smaller
+// of the two differences is redelegated so that at least one of them is fully rebalanced. This keeps the number
+// of redelegations low. Pairs that would be rejected by the host, given the redelegations still pending,
+// are skipped in favor of the next best pair. A maxRebalancings of 0 places no limit on the size of the plan
+func (k Keeper) GetRebalancePlan(ctx sdk.Context, hostZone types.HostZone, maxRebalancings uint64) ([]types.Rebalancing, error) {
+	validatorDeltas, err := k.GetValidatorDelegationAmtDifferences(ctx, hostZone)
+	if err != nil {
+		return nil, err
+	}
+	constraints := NewRedelegationConstraints(k.GetActivePendingRedelegations(ctx, hostZone.ChainId))
+
+	// Split the validators into those with excess delegation and those with a shortfall
+	type validatorDelta struct {
+		address string
+		amount  sdkmath.Int
+	}
+	overweight := []*validatorDelta{}
+	underweight := []*validatorDelta{}
+	// DO NOT REMOVE: StringMapKeys fixes non-deterministic map iteration
+	for _, address := range utils.StringMapKeys(validatorDeltas) {
+		delta := validatorDeltas[address]
+		if delta.IsNegative() {
+			overweight = append(overweight, &validatorDelta{address: address, amount: delta.Neg()})
+		} else if delta.IsPositive() {
+			underweight = append(underweight, &validatorDelta{address: address, amount: delta})
+		}
+	}
+	sortByAmount := func(deltas []*validatorDelta) {
+		sort.SliceStable(deltas, func(i, j int) bool {
+			return deltas[i].amount.GT(deltas[j].amount)
+		})
+	}
+
+	plan := []types.Rebalancing{}
+	for maxRebalancings == 0 || uint64(len(plan)) < maxRebalancings {
+		sortByAmount(overweight)
+		sortByAmount(underweight)
+
+		// Find the largest pair that the host will accept
+		var src, dst *validatorDelta
+		for _, overweightVal := range overweight {
+			if overweightVal.amount.IsZero() || !constraints.CanRedelegateFrom(overweightVal.address) {
+				continue
+			}
+			for _, underweightVal := range underweight {
+				if underweightVal.amount.IsZero() || !constraints.CanRedelegate(overweightVal.address, underweightVal.address) {
+					continue
+				}
+				src, dst = overweightVal, underweightVal
+				break
+			}
+			if src != nil {
+				break
+			}
+		}
+		if src == nil {
+			break
+		}
+
+		amount := sdkmath.MinInt(src.amount, dst.amount)
+		src.amount = src.amount.Sub(amount)
+		dst.amount = dst.amount.Sub(amount)
+		constraints.AddRedelegation(src.address, dst.address)
+
+		plan = append(plan, types.Rebalancing{
+			SrcValidator: src.address,
+			DstValidator: dst.address,
+			Amt:          amount,
+		})
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/spf13/cast"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Modifies the weights of the rebalance validators test case so that val1 is underweight by 142,
+// val2 is overweight by 12, val3 by 104, val4 by 13 and val5 by 13
+func (s *KeeperTestSuite) SetupRebalancePlan() stakeibctypes.HostZone {
+	tc := s.SetupRebalanceValidators()
+
+	hostZone := tc.hostZone
+	hostZone.Validators[0].Weight = 250
+	hostZone.Validators[2].Weight = 100
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) AddPendingRedelegation(src, dst string, completionTime time.Time) {
+	s.App.StakeibcKeeper.SetPendingRedelegation(s.Ctx, stakeibctypes.PendingRedelegation{
+		ChainId:        HostChainId,
+		SrcValidator:   src,
+		DstValidator:   dst,
+		Amount:         sdkmath.NewInt(10),
+		CompletionTime: cast.ToUint64(completionTime.UnixNano()),
+	})
+}
+
+func (s *KeeperTestSuite) checkRebalancePlan(expected []stakeibctypes.Rebalancing, actual []stakeibctypes.Rebalancing) {
+	s.Require().Len(actual, len(expected), "number of rebalancings")
+	for i := range expected {
+		s.Require().Equal(expected[i].SrcValidator, actual[i].SrcValidator, "rebalancing %d source", i)
+		s.Require().Equal(expected[i].DstValidator, actual[i].DstValidator, "rebalancing %d destination", i)
+		s.Require().Equal(expected[i].Amt.Int64(), actual[i].Amt.Int64(), "rebalancing %d amount", i)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_NoPendingRedelegations() {
+	hostZone := s.SetupRebalancePlan()
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, hostZone, 0)
+	s.Require().NoError(err, "no error expected when building plan")
+
+	// The largest overweight validator should be moved first, with one redelegation per overweight validator
+	s.checkRebalancePlan([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL3", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(104)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, plan)
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_MaxRebalancings() {
+	hostZone := s.SetupRebalancePlan()
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, hostZone, 2)
+	s.Require().NoError(err, "no error expected when building plan")
+
+	s.checkRebalancePlan([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL3", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(104)},
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+	}, plan)
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_SourceHasIncomingRedelegation() {
+	hostZone := s.SetupRebalancePlan()
+
+	// val3 received a redelegation that hasn't matured, so it cannot be redelegated from
+	s.AddPendingRedelegation("stride_VAL2", "stride_VAL3", s.Ctx.BlockTime().Add(time.Hour))
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, hostZone, 0)
+	s.Require().NoError(err, "no error expected when building plan")
+
+	s.checkRebalancePlan([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, plan)
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_SourceHasInFlightRedelegation() {
+	hostZone := s.SetupRebalancePlan()
+
+	// val3 has an incoming redelegation that is still awaiting its ack (completion time of 0),
+	// so it will be pending on the host and cannot be redelegated from
+	s.App.StakeibcKeeper.RecordInFlightRedelegations(s.Ctx, HostChainId, []*stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL3", Amt: sdkmath.NewInt(10)},
+	})
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, hostZone, 0)
+	s.Require().NoError(err, "no error expected when building plan")
+
+	s.checkRebalancePlan([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, plan)
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_MaxEntriesReached() {
+	hostZone := s.SetupRebalancePlan()
+
+	// val3 -> val1 has the max number of pending entries
+	for i := 1; i <= stakeibctypes.MaxPendingRedelegationEntries; i++ {
+		s.AddPendingRedelegation("stride_VAL3", "stride_VAL1", s.Ctx.BlockTime().Add(time.Duration(i)*time.Hour))
+	}
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, hostZone, 0)
+	s.Require().NoError(err, "no error expected when building plan")
+
+	s.checkRebalancePlan([]stakeibctypes.Rebalancing{
+		{SrcValidator: "stride_VAL4", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL5", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(13)},
+		{SrcValidator: "stride_VAL2", DstValidator: "stride_VAL1", Amt: sdkmath.NewInt(12)},
+	}, plan)
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_CompletedRedelegationsIgnored() {
+	hostZone := s.SetupRebalancePlan()
+
+	// Redelegations that have already matured should not restrict the plan
+	s.AddPendingRedelegation("stride_VAL2", "stride_VAL3", s.Ctx.BlockTime().Add(-time.Hour))
+	for i := 1; i <= stakeibctypes.MaxPendingRedelegationEntries; i++ {
+		s.AddPendingRedelegation("stride_VAL3", "stride_VAL1", s.Ctx.BlockTime().Add(-time.Duration(i)*time.Hour))
+	}
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, hostZone, 0)
+	s.Require().NoError(err, "no error expected when building plan")
+	s.Require().Len(plan, 4, "number of rebalancings")
+	s.Require().Equal("stride_VAL3", plan[0].SrcValidator, "first rebalancing source")
+}
+
+func (s *KeeperTestSuite) TestGetRebalancePlan_AlreadyBalanced() {
+	tc := s.SetupRebalanceValidators()
+
+	plan, err := s.App.StakeibcKeeper.GetRebalancePlan(s.Ctx, tc.hostZone, 0)
+	s.Require().NoError(err, "no error expected when building plan")
+	s.Require().Empty(plan, "no rebalancings expected")
+}
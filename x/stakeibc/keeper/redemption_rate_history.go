@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetRedemptionRateRecord set a specific redemptionRateRecord in the store from its index
+func (k Keeper) SetRedemptionRateRecord(ctx sdk.Context, redemptionRateRecord types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&redemptionRateRecord)
+	store.Set(types.RedemptionRateRecordKey(redemptionRateRecord.ChainId, redemptionRateRecord.EpochNumber), b)
+}
+
+// GetRedemptionRateRecord returns a redemptionRateRecord from its index
+func (k Keeper) GetRedemptionRateRecord(ctx sdk.Context, chainId string, epochNumber uint64) (val types.RedemptionRateRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateRecordKeyPrefix))
+
+	b := store.Get(types.RedemptionRateRecordKey(chainId, epochNumber))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRedemptionRateRecord removes a redemptionRateRecord from the store
+func (k Keeper) RemoveRedemptionRateRecord(ctx sdk.Context, chainId string, epochNumber uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateRecordKeyPrefix))
+	store.Delete(types.RedemptionRateRecordKey(chainId, epochNumber))
+}
+
+// GetAllRedemptionRateRecords returns all of a host zone's redemptionRateRecords, sorted by epoch number
+func (k Keeper) GetAllRedemptionRateRecords(ctx sdk.Context, chainId string) (list []types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.HostZoneKeyPrefix(chainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedemptionRateRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRedemptionRateRecordsForAllHostZones returns the redemptionRateRecords of every host zone
+func (k Keeper) GetRedemptionRateRecordsForAllHostZones(ctx sdk.Context) (list []types.RedemptionRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RedemptionRateRecordKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RedemptionRateRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveRedemptionRateHistory removes all of a host zone's redemptionRateRecords
+func (k Keeper) RemoveRedemptionRateHistory(ctx sdk.Context, chainId string) {
+	for _, record := range k.GetAllRedemptionRateRecords(ctx, chainId) {
+		k.RemoveRedemptionRateRecord(ctx, chainId, record.EpochNumber)
+	}
+}
+
+// Stores the redemption rate for the given stride epoch and prunes any records that have
+// fallen outside of the history window, so that the history behaves as a bounded ring buffer
+func (k Keeper) AddRedemptionRateToHistory(ctx sdk.Context, chainId string, epochNumber uint64, redemptionRate sdk.Dec) {
+	k.SetRedemptionRateRecord(ctx, types.RedemptionRateRecord{
+		ChainId:        chainId,
+		EpochNumber:    epochNumber,
+		RedemptionRate: redemptionRate,
+	})
+
+	historySize := k.GetParam(ctx, types.KeyRedemptionRateHistorySize)
+	if epochNumber < historySize {
+		return
+	}
+	oldestEpochRetained := epochNumber - historySize + 1
+
+	for _, record := range k.GetAllRedemptionRateRecords(ctx, chainId) {
+		if record.EpochNumber >= oldestEpochRetained {
+			break
+		}
+		k.RemoveRedemptionRateRecord(ctx, chainId, record.EpochNumber)
+	}
+}
+
+// Returns the time-weighted redemption rate over the last `numEpochs` stride epochs (including the current epoch)
+// Each record is weighted by the number of epochs that it was in effect, i.e. until the next record or the current epoch
+// Epochs in the window that precede the earliest record are not counted
+func (k Keeper) GetTimeWeightedRedemptionRate(ctx sdk.Context, chainId string, numEpochs uint64) (sdk.Dec, error) {
+	records := k.GetAllRedemptionRateRecords(ctx, chainId)
+	if len(records) == 0 {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrRedemptionRateHistoryNotFound, "no redemption rate history for host zone %s", chainId)
+	}
+	if numEpochs == 0 {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrRedemptionRateHistoryNotFound, "number of epochs must be greater than zero")
+	}
+
+	// The window ends after the current stride epoch (exclusive)
+	// If the epoch tracker is not available, fall back to the latest record
+	currentEpoch := records[len(records)-1].EpochNumber
+	if strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH); found && strideEpochTracker.EpochNumber > currentEpoch {
+		currentEpoch = strideEpochTracker.EpochNumber
+	}
+	windowEnd := currentEpoch + 1
+	windowStart := uint64(0)
+	if windowEnd > numEpochs {
+		windowStart = windowEnd - numEpochs
+	}
+
+	weightedSum := sdk.ZeroDec()
+	totalWeight := uint64(0)
+	for i, record := range records {
+		recordStart := record.EpochNumber
+		recordEnd := windowEnd
+		if i+1 < len(records) {
+			recordEnd = records[i+1].EpochNumber
+		}
+
+		// Clip the record's active period to the window
+		if recordStart < windowStart {
+			recordStart = windowStart
+		}
+		if recordEnd <= recordStart {
+			continue
+		}
+
+		weight := recordEnd - recordStart
+		weightedSum = weightedSum.Add(record.RedemptionRate.MulInt64(int64(weight)))
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return sdk.ZeroDec(), errorsmod.Wrapf(types.ErrRedemptionRateHistoryNotFound,
+			"no redemption rate history for host zone %s in the last %d epochs", chainId, numEpochs)
+	}
+
+	return weightedSum.QuoInt64(int64(totalWeight)), nil
+}
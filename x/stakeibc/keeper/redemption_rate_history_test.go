@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) setStrideEpoch(epochNumber uint64) {
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, types.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     epochNumber,
+	})
+}
+
+func (s *KeeperTestSuite) setRedemptionRateHistorySize(historySize uint64) {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.RedemptionRateHistorySize = historySize
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestAddRedemptionRateToHistory() {
+	s.setRedemptionRateHistorySize(3)
+
+	// Add a record for a different host zone to confirm it's not pruned
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, "OSMO", 1, sdk.OneDec())
+
+	// Add records for epochs 1 through 5 - only the last 3 should be retained
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, epoch, sdk.NewDec(int64(epoch)))
+	}
+
+	records := s.App.StakeibcKeeper.GetAllRedemptionRateRecords(s.Ctx, HostChainId)
+	s.Require().Len(records, 3, "number of records after pruning")
+	for i, expectedEpoch := range []uint64{3, 4, 5} {
+		s.Require().Equal(HostChainId, records[i].ChainId, "chain-id for record %d", i)
+		s.Require().Equal(expectedEpoch, records[i].EpochNumber, "epoch number for record %d", i)
+		s.Require().Equal(sdk.NewDec(int64(expectedEpoch)), records[i].RedemptionRate, "redemption rate for record %d", i)
+	}
+
+	_, found := s.App.StakeibcKeeper.GetRedemptionRateRecord(s.Ctx, "OSMO", 1)
+	s.Require().True(found, "other host zone's record should not be pruned")
+}
+
+func (s *KeeperTestSuite) TestRemoveHostZone_RemovesRedemptionRateHistory() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 1, sdk.OneDec())
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 2, sdk.OneDec())
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, "OSMO", 1, sdk.OneDec())
+
+	s.App.StakeibcKeeper.RemoveHostZone(s.Ctx, HostChainId)
+
+	s.Require().Len(s.App.StakeibcKeeper.GetAllRedemptionRateRecords(s.Ctx, HostChainId), 0, "removed host zone's history")
+	s.Require().Len(s.App.StakeibcKeeper.GetRedemptionRateRecordsForAllHostZones(s.Ctx), 1, "other host zone's history should be kept")
+}
+
+func (s *KeeperTestSuite) TestGetTimeWeightedRedemptionRate() {
+	// Records at epochs 2, 3, and 6, with the current epoch at 7
+	//   epoch 2    -> 1.0 (1 epoch)
+	//   epoch 3-5  -> 1.3 (3 epochs)
+	//   epoch 6-7  -> 1.6 (2 epochs)
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 2, sdk.MustNewDecFromStr("1.0"))
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 3, sdk.MustNewDecFromStr("1.3"))
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 6, sdk.MustNewDecFromStr("1.6"))
+	s.setStrideEpoch(7)
+
+	testCases := []struct {
+		numEpochs    uint64
+		expectedTwap sdk.Dec
+	}{
+		// Only the latest record is in the window
+		{numEpochs: 1, expectedTwap: sdk.MustNewDecFromStr("1.6")},
+		{numEpochs: 2, expectedTwap: sdk.MustNewDecFromStr("1.6")},
+		// (1.3 * 2 + 1.6 * 2) / 4 = 1.45
+		{numEpochs: 4, expectedTwap: sdk.MustNewDecFromStr("1.45")},
+		// (1.0 + 1.3 * 3 + 1.6 * 2) / 6 = 1.35
+		{numEpochs: 6, expectedTwap: sdk.MustNewDecFromStr("1.35")},
+		// Epochs before the first record are not counted
+		{numEpochs: 100, expectedTwap: sdk.MustNewDecFromStr("1.35")},
+	}
+	for _, tc := range testCases {
+		twap, err := s.App.StakeibcKeeper.GetTimeWeightedRedemptionRate(s.Ctx, HostChainId, tc.numEpochs)
+		s.Require().NoError(err, "no error expected for %d epochs", tc.numEpochs)
+		s.Require().Equal(tc.expectedTwap, twap, "twap over %d epochs", tc.numEpochs)
+	}
+}
+
+func (s *KeeperTestSuite) TestGetTimeWeightedRedemptionRate_NoHistory() {
+	_, err := s.App.StakeibcKeeper.GetTimeWeightedRedemptionRate(s.Ctx, HostChainId, 10)
+	s.Require().ErrorContains(err, "no redemption rate history for host zone GAIA")
+}
+
+func (s *KeeperTestSuite) TestIsRedemptionRateWithinSafetyBounds_Twap() {
+	// The spot rate is outside the bounds, but the time-weighted rate is within them
+	hostZone := types.HostZone{
+		ChainId:           HostChainId,
+		RedemptionRate:    sdk.MustNewDecFromStr("1.6"),
+		MinRedemptionRate: sdk.MustNewDecFromStr("0.9"),
+		MaxRedemptionRate: sdk.MustNewDecFromStr("1.5"),
+	}
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 1, sdk.MustNewDecFromStr("1.0"))
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 4, sdk.MustNewDecFromStr("1.6"))
+	s.setStrideEpoch(4)
+
+	// With the TWAP disabled, the spot rate is used
+	safe, _ := s.App.StakeibcKeeper.IsRedemptionRateWithinSafetyBounds(s.Ctx, hostZone)
+	s.Require().False(safe, "spot rate should be outside the safety bounds")
+
+	// With the TWAP enabled over 4 epochs: (1.0 * 3 + 1.6) / 4 = 1.15
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.RedemptionRateTwapEpochs = 4
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	safe, err := s.App.StakeibcKeeper.IsRedemptionRateWithinSafetyBounds(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when the twap is within the safety bounds")
+	s.Require().True(safe, "twap should be within the safety bounds")
+}
+
+func (s *KeeperTestSuite) TestRedemptionRateHistoryQuery() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, types.HostZone{ChainId: HostChainId})
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 1, sdk.MustNewDecFromStr("1.0"))
+	s.App.StakeibcKeeper.AddRedemptionRateToHistory(s.Ctx, HostChainId, 2, sdk.MustNewDecFromStr("1.2"))
+	s.setStrideEpoch(2)
+
+	queryResponse, err := s.App.StakeibcKeeper.RedemptionRateHistory(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionRateHistoryRequest{
+		ChainId: HostChainId,
+	})
+	s.Require().NoError(err, "no error expected when querying redemption rate history")
+	s.Require().Len(queryResponse.RedemptionRateRecords, 2, "number of records")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.1"), queryResponse.TimeWeightedRedemptionRate, "twap over the full history")
+
+	queryResponse, err = s.App.StakeibcKeeper.RedemptionRateHistory(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionRateHistoryRequest{
+		ChainId:    HostChainId,
+		TwapEpochs: 1,
+	})
+	s.Require().NoError(err, "no error expected when querying redemption rate history")
+	s.Require().Equal(sdk.MustNewDecFromStr("1.2"), queryResponse.TimeWeightedRedemptionRate, "twap over 1 epoch")
+
+	_, err = s.App.StakeibcKeeper.RedemptionRateHistory(sdk.WrapSDKContext(s.Ctx), &types.QueryRedemptionRateHistoryRequest{
+		ChainId: "fake_chain",
+	})
+	s.Require().ErrorContains(err, "host zone not found")
+}
@@ -3,11 +3,8 @@ package keeper
 import (
 	"fmt"
 
-	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-
 	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
 )
 
@@ -47,9 +44,9 @@ func (k Keeper) LiquidStakeRewardCollectorBalance(ctx sdk.Context, msgSvr types.
 	return rewardsAccrued
 }
 
-// Sweep stTokens from Reward Collector to Fee Collector
+// Sweep stTokens from Reward Collector to the Fee Recipients (by default, just the Fee Collector)
 func (k Keeper) SweepStTokensFromRewardCollToFeeColl(ctx sdk.Context) error {
-	// Send all stTokens to fee collector to distribute to delegator later
+	// Send all stTokens to the fee recipients (the fee collector distributes to delegators later)
 	rewardCollectorAddress := k.accountKeeper.GetModuleAccount(ctx, types.RewardCollectorName).GetAddress()
 
 	rewardCollCoins := k.bankKeeper.GetAllBalances(ctx, rewardCollectorAddress)
@@ -63,12 +60,12 @@ func (k Keeper) SweepStTokensFromRewardCollToFeeColl(ctx sdk.Context) error {
 			stTokens = append(stTokens, token)
 		}
 	}
-	k.Logger(ctx).Info(fmt.Sprintf("Sending %s stTokens from %s to %s", stTokens.String(), types.RewardCollectorName, authtypes.FeeCollectorName))
-
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardCollectorName, authtypes.FeeCollectorName, stTokens)
-	if err != nil {
-		return errorsmod.Wrapf(err, fmt.Sprintf("Can't send coins from module %s to module %s, err %s", types.RewardCollectorName, authtypes.FeeCollectorName, err.Error()))
+	if stTokens.IsZero() {
+		return nil
 	}
+	k.Logger(ctx).Info(fmt.Sprintf("Distributing %s stTokens from %s to the fee recipients", stTokens.String(), types.RewardCollectorName))
+
+	k.DistributeToFeeRecipients(ctx, stTokens)
 	return nil
 }
 
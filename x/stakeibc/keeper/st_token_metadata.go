@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Returns the bank metadata of a host zone's stToken
+func (k Keeper) GetStTokenMetadata(ctx sdk.Context, hostDenom string) (banktypes.Metadata, bool) {
+	return k.bankKeeper.GetDenomMetaData(ctx, types.StAssetDenomFromHostZoneDenom(hostDenom))
+}
+
+// Builds and validates the bank metadata of a host zone's stToken
+// Any of the display name, symbol or exponent that are not specified fall back to the stToken's
+// current metadata, or, if the metadata has not been registered, to the defaults derived from the host denom
+func (k Keeper) BuildStTokenMetadata(ctx sdk.Context, hostDenom, displayName, symbol string, exponent uint32) (banktypes.Metadata, error) {
+	currentDisplayName, currentSymbol, currentExponent := types.GetDefaultStTokenMetadataFields(hostDenom)
+	if currentMetadata, found := k.GetStTokenMetadata(ctx, hostDenom); found {
+		currentDisplayName, currentSymbol, currentExponent = types.GetStTokenMetadataFields(currentMetadata)
+	}
+
+	if displayName == "" {
+		displayName = currentDisplayName
+	}
+	if symbol == "" {
+		symbol = currentSymbol
+	}
+	if exponent == 0 {
+		exponent = currentExponent
+	}
+
+	metadata := types.NewStTokenMetadata(hostDenom, displayName, symbol, exponent)
+	if err := metadata.Validate(); err != nil {
+		return banktypes.Metadata{}, errorsmod.Wrapf(types.ErrInvalidStTokenMetadata, "stToken metadata for %s: %s", hostDenom, err.Error())
+	}
+
+	return metadata, nil
+}
+
+// Writes the bank metadata of a host zone's stToken (see BuildStTokenMetadata for how unspecified fields are filled in)
+func (k Keeper) SetStTokenMetadata(ctx sdk.Context, hostDenom, displayName, symbol string, exponent uint32) error {
+	metadata, err := k.BuildStTokenMetadata(ctx, hostDenom, displayName, symbol, exponent)
+	if err != nil {
+		return err
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	k.Logger(ctx).Info(fmt.Sprintf("Set stToken metadata for %s - name: %s, symbol: %s, display: %s",
+		metadata.Base, metadata.Name, metadata.Symbol, metadata.Display))
+
+	return nil
+}
@@ -0,0 +1,706 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Returns the rate at which stTokens are redeemed during a host zone's sunset
+// Host zones that have not reached the claimable phase will have a nil value
+func GetSunsetRedemptionRate(hostZone types.HostZone) sdk.Dec {
+	if hostZone.SunsetRedemptionRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return hostZone.SunsetRedemptionRate
+}
+
+// Returns the native tokens in the redemption account that are still reserved for sunset redemptions
+// Host zones that have not reached the claimable phase will have a nil value
+func GetSunsetClaimableBalance(hostZone types.HostZone) sdkmath.Int {
+	if hostZone.SunsetClaimableBalance.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return hostZone.SunsetClaimableBalance
+}
+
+// Returns whether funds can be added to a host zone's sunset claimable balance
+// Funds are held back while the balance is being swept or restaked, since it's reset once that completes
+func CanAddToSunsetClaimableBalance(hostZone types.HostZone) bool {
+	switch hostZone.SunsetStatus {
+	case types.SunsetStatus_SUNSET_UNBONDING, types.SunsetStatus_SUNSET_CLAIMABLE, types.SunsetStatus_SUNSET_ABORTING:
+		return true
+	default:
+		return false
+	}
+}
+
+// Emits an event each time a host zone's sunset moves to a new phase
+func (k Keeper) emitSunsetEvent(ctx sdk.Context, hostZone types.HostZone) {
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sunset status: %s", hostZone.SunsetStatus))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHostZoneSunset,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(types.AttributeKeySunsetStatus, hostZone.SunsetStatus.String()),
+		),
+	)
+}
+
+// Begins winding down a host zone
+// Liquid stakes and redemptions are disabled from this point on, and the instant redemption buffer is
+// released into the current deposit record, so that it's swept to the redemption account with the other pending
+// deposits (and any rewards) and added to the sunset claimable balance instead of being staked
+func (k Keeper) StartHostZoneSunset(ctx sdk.Context, hostZone types.HostZone) error {
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+		return errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s is already being sunset (status: %s)", hostZone.ChainId, hostZone.SunsetStatus)
+	}
+
+	buffer := GetInstantRedemptionBuffer(hostZone)
+	if buffer.IsPositive() {
+		strideEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.STRIDE_EPOCH)
+		if !found {
+			return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.STRIDE_EPOCH)
+		}
+
+		depositRecord, found := k.RecordsKeeper.GetTransferDepositRecordByEpochAndChain(ctx, strideEpochTracker.EpochNumber, hostZone.ChainId)
+		if found {
+			depositRecord.Amount = depositRecord.Amount.Add(buffer)
+			k.RecordsKeeper.SetDepositRecord(ctx, *depositRecord)
+		} else {
+			k.RecordsKeeper.AppendDepositRecord(ctx, recordstypes.DepositRecord{
+				Amount:             buffer,
+				Denom:              hostZone.HostDenom,
+				HostZoneId:         hostZone.ChainId,
+				Status:             recordstypes.DepositRecord_TRANSFER_QUEUE,
+				DepositEpochNumber: strideEpochTracker.EpochNumber,
+			})
+		}
+		hostZone.InstantRedemptionBuffer = sdkmath.ZeroInt()
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Released %v%s from the instant redemption buffer into deposits",
+			buffer, hostZone.HostDenom))
+	}
+
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_UNBONDING
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+
+	return nil
+}
+
+// Advances the sunset of each host zone that is being wound down
+// Called in the day epoch, after the epoch unbonding record has been created
+func (k Keeper) ProcessAllHostZoneSunsets(ctx sdk.Context, epochNumber uint64) {
+	if err := k.FundCommunityPoolFromSunsetCollector(ctx); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("Unable to send swept sunset funds to the community pool, err: %s", err.Error()))
+	}
+
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		var err error
+		switch hostZone.SunsetStatus {
+		case types.SunsetStatus_SUNSET_UNBONDING:
+			err = k.ProcessSunsetUnbonding(ctx, hostZone, epochNumber)
+		case types.SunsetStatus_SUNSET_CLAIMABLE:
+			err = k.ProcessSunsetClaims(ctx, hostZone)
+		case types.SunsetStatus_SUNSET_ABORTING:
+			err = k.ProcessSunsetAbort(ctx, hostZone)
+		default:
+			continue
+		}
+		if err != nil {
+			k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId, "Unable to process sunset, err: %s", err.Error()))
+		}
+	}
+}
+
+// Returns the progress of a host zone's sunset
+func (k Keeper) GetSunsetProgress(ctx sdk.Context, hostZone types.HostZone) *types.QuerySunsetProgressResponse {
+	numValidatorsWithDelegations := uint64(0)
+	for _, validator := range hostZone.Validators {
+		if validator.DelegationAmt.IsPositive() {
+			numValidatorsWithDelegations++
+		}
+	}
+
+	// Any host zone unbonding that has not yet reached the redemption account is still unbonding
+	unbondingBalance := sdkmath.ZeroInt()
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if !found || hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_CLAIMABLE {
+			continue
+		}
+		unbondingBalance = unbondingBalance.Add(hostZoneUnbonding.NativeTokenAmount)
+	}
+
+	pendingDepositBalance := sdkmath.ZeroInt()
+	for _, depositRecord := range k.RecordsKeeper.GetAllDepositRecord(ctx) {
+		if depositRecord.HostZoneId == hostZone.ChainId {
+			pendingDepositBalance = pendingDepositBalance.Add(depositRecord.Amount)
+		}
+	}
+
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	return &types.QuerySunsetProgressResponse{
+		Status:                       hostZone.SunsetStatus,
+		StakedBalance:                hostZone.StakedBal,
+		NumValidatorsWithDelegations: numValidatorsWithDelegations,
+		UnbondingBalance:             unbondingBalance,
+		PendingDepositBalance:        pendingDepositBalance,
+		SunsetRedemptionRate:         GetSunsetRedemptionRate(hostZone),
+		ClaimableBalance:             GetSunsetClaimableBalance(hostZone),
+		StTokenSupply:                k.bankKeeper.GetSupply(ctx, stDenom).Amount,
+	}
+}
+
+// Unbonds the next batch of validators, or, once everything has been unbonded and swept to the
+// redemption account, moves the sunset to the claimable phase
+func (k Keeper) ProcessSunsetUnbonding(ctx sdk.Context, hostZone types.HostZone, epochNumber uint64) error {
+	progress := k.GetSunsetProgress(ctx, hostZone)
+	unbondingComplete := progress.StakedBalance.IsZero() &&
+		progress.NumValidatorsWithDelegations == 0 &&
+		progress.UnbondingBalance.IsZero() &&
+		progress.PendingDepositBalance.IsZero()
+	if unbondingComplete {
+		return k.MakeSunsetClaimable(ctx, hostZone)
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Sunset progress - Staked Balance: %v, Validators With Delegations: %d, Unbonding Balance: %v, Pending Deposits: %v",
+		progress.StakedBalance, progress.NumValidatorsWithDelegations, progress.UnbondingBalance, progress.PendingDepositBalance))
+
+	return k.UnbondSunsetBatch(ctx, hostZone, epochNumber)
+}
+
+// Fully unbonds the next batch of validators that still have delegations
+// The unbonding is recorded on the epoch's host zone unbonding (without any user redemption records),
+// so that it's processed by the regular undelegate callback and swept to the redemption account once it completes
+func (k Keeper) UnbondSunsetBatch(ctx sdk.Context, hostZone types.HostZone, epochNumber uint64) error {
+	chainId := hostZone.ChainId
+
+	// Only one batch is unbonded at a time, so that the batch is built from up to date delegation amounts
+	// Wait for any queued unbondings, as well as any unbondings that are awaiting an ack
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, chainId)
+		if !found {
+			continue
+		}
+		queued := hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_QUEUE && hostZoneUnbonding.NativeTokenAmount.IsPositive()
+		inProgress := hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+		if queued || inProgress {
+			k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Waiting for the unbonding in epoch %d (status: %s) before unbonding the next batch",
+				epochUnbondingRecord.EpochNumber, hostZoneUnbonding.Status))
+			return nil
+		}
+	}
+
+	delegationAccount := hostZone.DelegationAccount
+	if delegationAccount == nil || delegationAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrHostZoneICAAccountNotFound, "no delegation account found for %s", chainId)
+	}
+
+	msgs := []sdk.Msg{}
+	splitDelegations := []*types.SplitDelegation{}
+	totalAmountToUnbond := sdkmath.ZeroInt()
+	for _, validator := range hostZone.Validators {
+		if len(msgs) >= types.SunsetUnbondingBatchSize {
+			break
+		}
+		if !validator.DelegationAmt.IsPositive() {
+			continue
+		}
+
+		msgs = append(msgs, &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegationAccount.Address,
+			ValidatorAddress: validator.Address,
+			Amount:           sdk.NewCoin(hostZone.HostDenom, validator.DelegationAmt),
+		})
+		splitDelegations = append(splitDelegations, &types.SplitDelegation{
+			Validator: validator.Address,
+			Amount:    validator.DelegationAmt,
+		})
+		totalAmountToUnbond = totalAmountToUnbond.Add(validator.DelegationAmt)
+	}
+
+	if len(msgs) == 0 {
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "No delegations left to unbond"))
+		return nil
+	}
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+	if !found {
+		return errorsmod.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound, "host zone unbonding not found for epoch %d", epochNumber)
+	}
+
+	undelegateCallback := types.UndelegateCallback{
+		HostZoneId:              chainId,
+		SplitDelegations:        splitDelegations,
+		EpochUnbondingRecordIds: []uint64{epochNumber},
+	}
+	marshalledCallbackArgs, err := k.MarshalUndelegateCallbackArgs(ctx, undelegateCallback)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to marshal undelegate callback args: %s", err.Error())
+	}
+
+	if err := k.SubmitHostZoneUnbondingMsg(ctx, msgs, totalAmountToUnbond, marshalledCallbackArgs, hostZone); err != nil {
+		return err
+	}
+
+	// Record the batch on the host zone unbonding, so that the tokens are swept to the redemption account once unbonded
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(totalAmountToUnbond)
+	hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS
+	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochNumber, chainId, hostZoneUnbonding)
+	if !success {
+		return errorsmod.Wrapf(recordstypes.ErrAddingHostZone, "unable to update host zone unbonding for epoch %d", epochNumber)
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Unbonding %v%s from %d validators for sunset",
+		totalAmountToUnbond, hostZone.HostDenom, len(msgs)))
+
+	return nil
+}
+
+// Sends a deposit that landed in the delegation account of a sunset host zone to the redemption account,
+// where it's added to the sunset claimable balance instead of being staked (see SunsetDepositCallback)
+func (k Keeper) SweepSunsetDeposit(ctx sdk.Context, hostZone types.HostZone, depositRecord recordstypes.DepositRecord) error {
+	if !CanAddToSunsetClaimableBalance(hostZone) {
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Waiting for the sunset claimable balance to settle before sweeping deposit record %d (status: %s)",
+			depositRecord.Id, hostZone.SunsetStatus))
+		return nil
+	}
+
+	delegationAccount := hostZone.DelegationAccount
+	if delegationAccount == nil || delegationAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrHostZoneICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+	redemptionAccount, found := k.GetRedemptionAccount(ctx, hostZone)
+	if !found {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", hostZone.ChainId)
+	}
+
+	depositCoin := sdk.NewCoin(hostZone.HostDenom, depositRecord.Amount)
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: delegationAccount.Address,
+			ToAddress:   redemptionAccount.Address,
+			Amount:      sdk.NewCoins(depositCoin),
+		},
+	}
+
+	callbackArgsBz, err := k.MarshalSunsetCallbackArgs(ctx, types.SunsetCallback{
+		HostZoneId:      hostZone.ChainId,
+		Amount:          depositCoin,
+		DepositRecordId: depositRecord.Id,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to marshal sunset callback args: %s", err.Error())
+	}
+
+	if _, err := k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *delegationAccount, ICACallbackID_SunsetDeposit, callbackArgsBz); err != nil {
+		return errorsmod.Wrapf(types.ErrICATxFailed, "Failed to SubmitTxs, Messages: %v, err: %s", msgs, err.Error())
+	}
+
+	depositRecord.Status = recordstypes.DepositRecord_DELEGATION_IN_PROGRESS
+	k.RecordsKeeper.SetDepositRecord(ctx, depositRecord)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sweeping deposit record %d (%v) to the redemption account for sunset",
+		depositRecord.Id, depositCoin))
+
+	return nil
+}
+
+// Returns the portion of a host zone unbonding that is not attributed to a user redemption record
+// (i.e. the tokens that were unbonded for the sunset)
+func (k Keeper) getUnattributedUnbondingAmount(ctx sdk.Context, hostZoneUnbonding *recordstypes.HostZoneUnbonding) sdkmath.Int {
+	attributedAmount := sdkmath.ZeroInt()
+	for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+		userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId)
+		if found {
+			attributedAmount = attributedAmount.Add(userRedemptionRecord.Amount)
+		}
+	}
+	return hostZoneUnbonding.NativeTokenAmount.Sub(attributedAmount)
+}
+
+// Removes the unattributed tokens from each of the host zone's claimable unbondings, and returns the total amount removed
+func (k Keeper) releaseUnattributedUnbondings(ctx sdk.Context, chainId string) (releasedAmount sdkmath.Int, err error) {
+	releasedAmount = sdkmath.ZeroInt()
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, chainId)
+		if !found || hostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_CLAIMABLE {
+			continue
+		}
+
+		unattributedAmount := k.getUnattributedUnbondingAmount(ctx, hostZoneUnbonding)
+		if !unattributedAmount.IsPositive() {
+			continue
+		}
+
+		releasedAmount = releasedAmount.Add(unattributedAmount)
+		hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Sub(unattributedAmount)
+		updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochUnbondingRecord.EpochNumber, chainId, hostZoneUnbonding)
+		if !success {
+			return releasedAmount, errorsmod.Wrapf(recordstypes.ErrAddingHostZone, "unable to update host zone unbonding for epoch %d", epochUnbondingRecord.EpochNumber)
+		}
+		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+	}
+	return releasedAmount, nil
+}
+
+// Fixes the final sunset redemption rate once all funds have been swept to the redemption account
+// The unbonded tokens that are not attributed to a user redemption record are removed from the host zone
+// unbondings and instead tracked as the host zone's sunset claimable balance (alongside any deposits and
+// rewards that were swept during the sunset), which backs the remaining stTokens
+func (k Keeper) MakeSunsetClaimable(ctx sdk.Context, hostZone types.HostZone) error {
+	releasedAmount, err := k.releaseUnattributedUnbondings(ctx, hostZone.ChainId)
+	if err != nil {
+		return err
+	}
+	claimableBalance := GetSunsetClaimableBalance(hostZone).Add(releasedAmount)
+
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+	sunsetRedemptionRate := sdk.ZeroDec()
+	if stSupply.IsPositive() {
+		sunsetRedemptionRate = sdk.NewDecFromInt(claimableBalance).Quo(sdk.NewDecFromInt(stSupply))
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sunset unbonding complete - Claimable Balance: %v%s, st%s Supply: %v, Redemption Rate: %v",
+		claimableBalance, hostZone.HostDenom, hostZone.HostDenom, stSupply, sunsetRedemptionRate))
+
+	hostZone.SunsetRedemptionRate = sunsetRedemptionRate
+	hostZone.SunsetClaimableBalance = claimableBalance
+	hostZone.SunsetClaimableTime = uint64(ctx.BlockTime().UnixNano())
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_CLAIMABLE
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+
+	return nil
+}
+
+// Redeems stTokens at the final sunset redemption rate
+// The stTokens are burned immediately, and since the native tokens are already in the redemption account,
+// the redemption is added to the epoch's host zone unbonding as CLAIMABLE so that it's paid out with the regular claims
+func (k Keeper) RedeemSunsetStake(ctx sdk.Context, hostZone types.HostZone, sender sdk.AccAddress, msg *types.MsgRedeemStake) error {
+	chainId := hostZone.ChainId
+	nativeAmount := sdk.NewDecFromInt(msg.Amount).Mul(GetSunsetRedemptionRate(hostZone)).TruncateInt()
+	if !nativeAmount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "redemption of %v st%s would return no tokens", msg.Amount, hostZone.HostDenom)
+	}
+	if !msg.MinNativeOut.IsNil() && nativeAmount.LT(msg.MinNativeOut) {
+		return errorsmod.Wrapf(types.ErrMinAmountOutNotMet,
+			"redemption would return %v%s, minimum specified: %v", nativeAmount, hostZone.HostDenom, msg.MinNativeOut)
+	}
+	claimableBalance := GetSunsetClaimableBalance(hostZone)
+	if nativeAmount.GT(claimableBalance) {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "redemption of %v%s exceeds the sunset claimable balance of %v%s",
+			nativeAmount, hostZone.HostDenom, claimableBalance, hostZone.HostDenom)
+	}
+
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.DAY_EPOCH)
+	}
+	epochNumber := dayEpochTracker.EpochNumber
+
+	hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidHostZone, "host zone not found in unbondings: %s", chainId)
+	}
+
+	// If the user already redeemed this epoch, the new redemption is merged into the existing record
+	// (unless the existing record is already being claimed, since the claim would only pay out the original amount)
+	redemptionId := recordstypes.UserRedemptionRecordKeyFormatter(chainId, epochNumber, sender.String())
+	userRedemptionRecord, redemptionExists := k.RecordsKeeper.GetUserRedemptionRecord(ctx, redemptionId)
+	if redemptionExists {
+		if userRedemptionRecord.Receiver != msg.Receiver {
+			return errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+				"user already redeemed this epoch to a different receiver (%s): %s", userRedemptionRecord.Receiver, redemptionId)
+		}
+		if userRedemptionRecord.ClaimIsPending {
+			return errorsmod.Wrapf(recordstypes.ErrRedemptionAlreadyExists,
+				"user's redemption this epoch is already being claimed: %s", redemptionId)
+		}
+		userRedemptionRecord.Amount = userRedemptionRecord.Amount.Add(nativeAmount)
+		if !userRedemptionRecord.StTokenAmount.IsNil() {
+			userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Add(msg.Amount)
+		}
+	} else {
+		userRedemptionRecord = recordstypes.UserRedemptionRecord{
+			Id:             redemptionId,
+			Sender:         sender.String(),
+			Receiver:       msg.Receiver,
+			Amount:         nativeAmount,
+			StTokenAmount:  msg.Amount,
+			Denom:          hostZone.HostDenom,
+			HostZoneId:     chainId,
+			EpochNumber:    epochNumber,
+			ClaimIsPending: false,
+		}
+		hostZoneUnbonding.UserRedemptionRecords = append(hostZoneUnbonding.UserRedemptionRecords, redemptionId)
+	}
+
+	// Burn the stTokens
+	stCoins := sdk.NewCoins(sdk.NewCoin(types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom), msg.Amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, stCoins); err != nil {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't send %v to module account. err: %s", stCoins, err.Error())
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, stCoins); err != nil {
+		return errorsmod.Wrapf(types.ErrInsufficientFunds, "couldn't burn %v. err: %s", stCoins, err.Error())
+	}
+
+	// The stTokens have already been burned, so they are not added to the host zone unbonding
+	hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Add(nativeAmount)
+	hostZoneUnbonding.Status = recordstypes.HostZoneUnbonding_CLAIMABLE
+	updatedEpochUnbondingRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochNumber, chainId, hostZoneUnbonding)
+	if !success {
+		return errorsmod.Wrapf(recordstypes.ErrAddingHostZone, "unable to update host zone unbonding for epoch %d", epochNumber)
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedEpochUnbondingRecord)
+	k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+
+	hostZone.SunsetClaimableBalance = claimableBalance.Sub(nativeAmount)
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Redeemed %v st%s for %v%s at the sunset redemption rate",
+		msg.Amount, hostZone.HostDenom, nativeAmount, hostZone.HostDenom))
+
+	return nil
+}
+
+// Returns the time after which a sunset host zone's outstanding stTokens can no longer be redeemed
+func (k Keeper) GetSunsetClaimDeadline(ctx sdk.Context, hostZone types.HostZone) time.Time {
+	claimPeriodDays := k.GetParam(ctx, types.KeySunsetClaimPeriodDays)
+	claimableTime := time.Unix(0, int64(hostZone.SunsetClaimableTime))
+	return claimableTime.Add(time.Duration(claimPeriodDays) * 24 * time.Hour)
+}
+
+// Completes the sunset once every redemption has been claimed, and either every stToken has been redeemed
+// or the claim period has passed (since stTokens that are escrowed on other chains or lost may never be redeemed)
+func (k Keeper) ProcessSunsetClaims(ctx sdk.Context, hostZone types.HostZone) error {
+	stDenom := types.StAssetDenomFromHostZoneDenom(hostZone.HostDenom)
+	stSupply := k.bankKeeper.GetSupply(ctx, stDenom).Amount
+	if stSupply.IsPositive() {
+		claimDeadline := k.GetSunsetClaimDeadline(ctx, hostZone)
+		if ctx.BlockTime().Before(claimDeadline) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sunset claimable - %v %s left to redeem before %s",
+				stSupply, stDenom, claimDeadline))
+			return nil
+		}
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sunset claim period ended at %s - %v %s were not redeemed",
+			claimDeadline, stSupply, stDenom))
+	}
+
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, hostZone.ChainId)
+		if found && hostZoneUnbonding.NativeTokenAmount.IsPositive() {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sunset claimable - waiting for claims in epoch %d",
+				epochUnbondingRecord.EpochNumber))
+			return nil
+		}
+	}
+
+	return k.CompleteHostZoneSunset(ctx, hostZone)
+}
+
+// Sweeps any leftover funds (e.g. from rounding, or backing stTokens that were not redeemed before the claim deadline)
+// from the redemption account to the sunset collector on stride, from which they're sent to the community pool
+// The host zone is only halted once the sweep has been acknowledged (see SunsetSweepCallback)
+func (k Keeper) CompleteHostZoneSunset(ctx sdk.Context, hostZone types.HostZone) error {
+	leftoverBalance := GetSunsetClaimableBalance(hostZone)
+	if !leftoverBalance.IsPositive() {
+		k.FinalizeHostZoneSunset(ctx, hostZone)
+		return nil
+	}
+
+	if err := k.SweepSunsetLeftovers(ctx, hostZone, leftoverBalance); err != nil {
+		return err
+	}
+
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_SWEEPING
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+
+	return nil
+}
+
+// Sends the leftover funds that were swept from sunset host zones to the community pool
+// This is done from the day epoch rather than the sweep callback, since the ICA acknowledgement
+// can be relayed before the transfer itself has landed on stride
+func (k Keeper) FundCommunityPoolFromSunsetCollector(ctx sdk.Context) error {
+	sunsetCollectorAddress := k.accountKeeper.GetModuleAccount(ctx, types.SunsetCollectorName).GetAddress()
+	sweptFunds := k.bankKeeper.GetAllBalances(ctx, sunsetCollectorAddress)
+	if sweptFunds.IsZero() {
+		return nil
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("Sending %v of swept sunset funds to the community pool", sweptFunds))
+	return k.DistributionKeeper.FundCommunityPool(ctx, sweptFunds, sunsetCollectorAddress)
+}
+
+// Marks the sunset as complete and halts the host zone
+// ICA channels cannot be closed by the controller, so the accounts are left idle once the host zone is halted
+func (k Keeper) FinalizeHostZoneSunset(ctx sdk.Context, hostZone types.HostZone) {
+	hostZone.SunsetClaimableBalance = sdkmath.ZeroInt()
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_COMPLETE
+	hostZone.Halted = true
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+}
+
+// Transfers leftover funds from the redemption account to the sunset collector on stride
+// The reward collector is not used, since any host tokens it holds would be liquid staked into the (now halted) host zone
+func (k Keeper) SweepSunsetLeftovers(ctx sdk.Context, hostZone types.HostZone, leftoverBalance sdkmath.Int) error {
+	redemptionAccount, found := k.GetRedemptionAccount(ctx, hostZone)
+	if !found {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", hostZone.ChainId)
+	}
+
+	timeout, err := k.GetICATimeoutNanos(ctx, epochtypes.DAY_EPOCH)
+	if err != nil {
+		return errorsmod.Wrapf(err, "Failed to get ICATimeout from %s epoch", epochtypes.DAY_EPOCH)
+	}
+
+	transferChannel, found := k.IBCKeeper.ChannelKeeper.GetChannel(ctx, transfertypes.PortID, hostZone.TransferChannelId)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "transfer channel %s not found", hostZone.TransferChannelId)
+	}
+	counterpartyChannelId := transferChannel.Counterparty.ChannelId
+
+	leftoverCoin := sdk.NewCoin(hostZone.HostDenom, leftoverBalance)
+	sunsetCollectorAddress := k.accountKeeper.GetModuleAccount(ctx, types.SunsetCollectorName).GetAddress()
+	msgs := []sdk.Msg{
+		ibctypes.NewMsgTransfer(
+			transfertypes.PortID,
+			counterpartyChannelId,
+			leftoverCoin,
+			redemptionAccount.Address,
+			sunsetCollectorAddress.String(),
+			clienttypes.Height{},
+			timeout,
+		),
+	}
+
+	callbackArgsBz, err := k.MarshalSunsetCallbackArgs(ctx, types.SunsetCallback{
+		HostZoneId: hostZone.ChainId,
+		Amount:     leftoverCoin,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to marshal sunset callback args: %s", err.Error())
+	}
+
+	if _, err := k.SubmitTxsDayEpoch(ctx, hostZone.ConnectionId, msgs, *redemptionAccount, ICACallbackID_SunsetSweep, callbackArgsBz); err != nil {
+		return errorsmod.Wrapf(types.ErrICATxFailed, "Failed to SubmitTxs, Messages: %v, err: %s", msgs, err.Error())
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Sweeping %v of leftover sunset funds to the sunset collector",
+		fmt.Sprint(leftoverCoin)))
+
+	return nil
+}
+
+// Aborts a host zone's sunset while its delegations are still being unbonded
+// The funds that were already unbonded are restaked once they've landed in the redemption account (see ProcessSunsetAbort)
+// Note: the instant redemption buffer that was released at the start of the sunset is not restored
+func (k Keeper) StartHostZoneSunsetAbort(ctx sdk.Context, hostZone types.HostZone) error {
+	if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_UNBONDING {
+		return errorsmod.Wrapf(types.ErrHostZoneSunset, "host zone %s sunset can only be aborted while unbonding (status: %s)",
+			hostZone.ChainId, hostZone.SunsetStatus)
+	}
+
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_ABORTING
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+
+	return nil
+}
+
+// Restakes the funds that were unbonded before a sunset was aborted
+// Waits until every sunset unbonding has been swept to the redemption account, and then sends the tokens that
+// are not attributed to a user redemption back to the delegation account, where they're staked with the next deposits
+func (k Keeper) ProcessSunsetAbort(ctx sdk.Context, hostZone types.HostZone) error {
+	chainId := hostZone.ChainId
+
+	for _, epochUnbondingRecord := range k.RecordsKeeper.GetAllEpochUnbondingRecord(ctx) {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochUnbondingRecord.EpochNumber, chainId)
+		if !found || hostZoneUnbonding.Status == recordstypes.HostZoneUnbonding_CLAIMABLE {
+			continue
+		}
+		if k.getUnattributedUnbondingAmount(ctx, hostZoneUnbonding).IsPositive() {
+			k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sunset aborting - waiting for the unbonding in epoch %d (status: %s)",
+				epochUnbondingRecord.EpochNumber, hostZoneUnbonding.Status))
+			return nil
+		}
+	}
+
+	releasedAmount, err := k.releaseUnattributedUnbondings(ctx, chainId)
+	if err != nil {
+		return err
+	}
+	restakeAmount := GetSunsetClaimableBalance(hostZone).Add(releasedAmount)
+
+	if !restakeAmount.IsPositive() {
+		hostZone.SunsetClaimableBalance = sdkmath.ZeroInt()
+		hostZone.SunsetStatus = types.SunsetStatus_SUNSET_NONE
+		k.SetHostZone(ctx, hostZone)
+		k.emitSunsetEvent(ctx, hostZone)
+		return nil
+	}
+
+	redemptionAccount, found := k.GetRedemptionAccount(ctx, hostZone)
+	if !found {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no redemption account found for %s", chainId)
+	}
+	delegationAccount := hostZone.DelegationAccount
+	if delegationAccount == nil || delegationAccount.Address == "" {
+		return errorsmod.Wrapf(types.ErrHostZoneICAAccountNotFound, "no delegation account found for %s", chainId)
+	}
+
+	restakeCoin := sdk.NewCoin(hostZone.HostDenom, restakeAmount)
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			FromAddress: redemptionAccount.Address,
+			ToAddress:   delegationAccount.Address,
+			Amount:      sdk.NewCoins(restakeCoin),
+		},
+	}
+
+	callbackArgsBz, err := k.MarshalSunsetCallbackArgs(ctx, types.SunsetCallback{
+		HostZoneId: chainId,
+		Amount:     restakeCoin,
+	})
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrMarshalFailure, "unable to marshal sunset callback args: %s", err.Error())
+	}
+
+	if _, err := k.SubmitTxsDayEpoch(ctx, hostZone.ConnectionId, msgs, *redemptionAccount, ICACallbackID_SunsetRestake, callbackArgsBz); err != nil {
+		return errorsmod.Wrapf(types.ErrICATxFailed, "Failed to SubmitTxs, Messages: %v, err: %s", msgs, err.Error())
+	}
+
+	// The released tokens are tracked on the host zone until the send is acknowledged, so that they're not lost if it fails
+	hostZone.SunsetClaimableBalance = restakeAmount
+	hostZone.SunsetStatus = types.SunsetStatus_SUNSET_ABORT_RESTAKING
+	k.SetHostZone(ctx, hostZone)
+	k.emitSunsetEvent(ctx, hostZone)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Sending %v back to the delegation account to be restaked", restakeCoin))
+
+	return nil
+}
@@ -0,0 +1,802 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icacallbacktypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+const sunsetEpochNumber = uint64(12)
+
+func (s *KeeperTestSuite) getSunsetHostZone() stakeibctypes.HostZone {
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	return hostZone
+}
+
+func (s *KeeperTestSuite) getSunsetHostZoneUnbonding(epochNumber uint64) recordtypes.HostZoneUnbonding {
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, HostChainId)
+	s.Require().True(found, "host zone unbonding found for epoch %d", epochNumber)
+	return *hostZoneUnbonding
+}
+
+func (s *KeeperTestSuite) setSunsetHostZoneUnbonding(epochNumber uint64, hostZoneUnbonding recordtypes.HostZoneUnbonding) {
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber:        epochNumber,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{&hostZoneUnbonding},
+	})
+}
+
+// Creates a host zone with the given number of validators (each with a delegation of 1000)
+// as well as an empty host zone unbonding for the current day epoch
+func (s *KeeperTestSuite) SetupSunset(numValidators int) stakeibctypes.HostZone {
+	validators := []*stakeibctypes.Validator{}
+	for i := 1; i <= numValidators; i++ {
+		validators = append(validators, &stakeibctypes.Validator{
+			Address:       fmt.Sprintf("val%d", i),
+			DelegationAmt: sdkmath.NewInt(1000),
+			Weight:        1,
+		})
+	}
+
+	hostZone := stakeibctypes.HostZone{
+		ChainId:                 HostChainId,
+		HostDenom:               Atom,
+		IbcDenom:                IbcAtom,
+		Bech32Prefix:            Bech32Prefix,
+		ConnectionId:            ibctesting.FirstConnectionID,
+		TransferChannelId:       ibctesting.FirstChannelID,
+		UnbondingFrequency:      3,
+		RedemptionRate:          sdk.OneDec(),
+		StakedBal:               sdkmath.NewInt(int64(1000 * numValidators)),
+		InstantRedemptionBuffer: sdkmath.ZeroInt(),
+		Validators:              validators,
+		DelegationAccount:       &stakeibctypes.ICAAccount{Address: "cosmos_DELEGATION", Target: stakeibctypes.ICAAccountType_DELEGATION},
+		RedemptionAccount:       &stakeibctypes.ICAAccount{Address: "cosmos_REDEMPTION", Target: stakeibctypes.ICAAccountType_REDEMPTION},
+		SunsetStatus:            stakeibctypes.SunsetStatus_SUNSET_UNBONDING,
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	for _, epochId := range []string{epochtypes.DAY_EPOCH, epochtypes.STRIDE_EPOCH} {
+		s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+			EpochIdentifier:    epochId,
+			EpochNumber:        sunsetEpochNumber,
+			NextEpochStartTime: uint64(2661750006000000000), // arbitrary time in the future
+			Duration:           uint64(1000000000000),
+		})
+	}
+
+	s.setSunsetHostZoneUnbonding(sunsetEpochNumber, recordtypes.HostZoneUnbonding{
+		HostZoneId:        HostChainId,
+		Denom:             Atom,
+		NativeTokenAmount: sdkmath.ZeroInt(),
+		StTokenAmount:     sdkmath.ZeroInt(),
+		Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+	})
+
+	return hostZone
+}
+
+func (s *KeeperTestSuite) TestStartHostZoneSunset_ReleasesBuffer() {
+	hostZone := s.SetupSunset(1)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_NONE
+	hostZone.InstantRedemptionBuffer = sdkmath.NewInt(300)
+
+	// Add a deposit record for the current epoch that the buffer should be merged into
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Amount:             sdkmath.NewInt(100),
+		Denom:              Atom,
+		HostZoneId:         HostChainId,
+		Status:             recordtypes.DepositRecord_TRANSFER_QUEUE,
+		DepositEpochNumber: sunsetEpochNumber,
+	})
+
+	err := s.App.StakeibcKeeper.StartHostZoneSunset(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when starting sunset")
+
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_UNBONDING, hostZone.SunsetStatus, "sunset status")
+	s.Require().Equal(sdkmath.ZeroInt(), hostZone.InstantRedemptionBuffer, "instant redemption buffer")
+
+	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
+	s.Require().Len(depositRecords, 1, "number of deposit records")
+	s.Require().Equal(sdkmath.NewInt(400), depositRecords[0].Amount, "deposit record amount")
+
+	// Starting the sunset again should fail
+	err = s.App.StakeibcKeeper.StartHostZoneSunset(s.Ctx, hostZone)
+	s.Require().ErrorContains(err, "host zone GAIA is already being sunset")
+}
+
+func (s *KeeperTestSuite) TestStartHostZoneSunset_NewDepositRecord() {
+	hostZone := s.SetupSunset(1)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_NONE
+	hostZone.InstantRedemptionBuffer = sdkmath.NewInt(300)
+
+	err := s.App.StakeibcKeeper.StartHostZoneSunset(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when starting sunset")
+
+	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
+	s.Require().Len(depositRecords, 1, "number of deposit records")
+	s.Require().Equal(sdkmath.NewInt(300), depositRecords[0].Amount, "deposit record amount")
+	s.Require().Equal(recordtypes.DepositRecord_TRANSFER_QUEUE, depositRecords[0].Status, "deposit record status")
+	s.Require().Equal(sunsetEpochNumber, depositRecords[0].DepositEpochNumber, "deposit record epoch")
+}
+
+func (s *KeeperTestSuite) TestUnbondSunsetBatch_Successful() {
+	s.CreateICAChannel("GAIA.DELEGATION")
+	hostZone := s.SetupSunset(stakeibctypes.SunsetUnbondingBatchSize + 2)
+
+	err := s.App.StakeibcKeeper.ProcessSunsetUnbonding(s.Ctx, hostZone, sunsetEpochNumber)
+	s.Require().NoError(err, "no error expected when unbonding batch")
+
+	// Only the first batch of validators should be unbonded, and the amount recorded on the host zone unbonding
+	hostZoneUnbonding := s.getSunsetHostZoneUnbonding(sunsetEpochNumber)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, hostZoneUnbonding.Status, "host zone unbonding status")
+	s.Require().Equal(sdkmath.NewInt(1000*stakeibctypes.SunsetUnbondingBatchSize), hostZoneUnbonding.NativeTokenAmount,
+		"host zone unbonding native amount")
+	s.Require().Empty(hostZoneUnbonding.UserRedemptionRecords, "no user redemption records")
+
+	// The status should be unchanged
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_UNBONDING, s.getSunsetHostZone().SunsetStatus, "sunset status")
+}
+
+func (s *KeeperTestSuite) TestUnbondSunsetBatch_WaitsForQueuedUnbonding() {
+	hostZone := s.SetupSunset(2)
+
+	// Add a queued unbonding from a previous epoch
+	s.setSunsetHostZoneUnbonding(sunsetEpochNumber-1, recordtypes.HostZoneUnbonding{
+		HostZoneId:        HostChainId,
+		Denom:             Atom,
+		NativeTokenAmount: sdkmath.NewInt(500),
+		Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+	})
+
+	// No ICA channel was created, so the batch would fail if it was submitted
+	err := s.App.StakeibcKeeper.UnbondSunsetBatch(s.Ctx, hostZone, sunsetEpochNumber)
+	s.Require().NoError(err, "no error expected while waiting")
+
+	hostZoneUnbonding := s.getSunsetHostZoneUnbonding(sunsetEpochNumber)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_QUEUE, hostZoneUnbonding.Status, "host zone unbonding status")
+	s.Require().Equal(sdkmath.ZeroInt(), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetUnbonding_Claimable() {
+	hostZone := s.SetupSunset(0)
+	hostZone.StakedBal = sdkmath.ZeroInt()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// 1000 tokens were swept to the redemption account, 200 of which belong to a user redemption
+	userRedemptionRecord := recordtypes.UserRedemptionRecord{
+		Id:         "GAIA.1.user",
+		Amount:     sdkmath.NewInt(200),
+		HostZoneId: HostChainId,
+	}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, userRedemptionRecord)
+	s.setSunsetHostZoneUnbonding(1, recordtypes.HostZoneUnbonding{
+		HostZoneId:            HostChainId,
+		Denom:                 Atom,
+		NativeTokenAmount:     sdkmath.NewInt(1000),
+		Status:                recordtypes.HostZoneUnbonding_CLAIMABLE,
+		UserRedemptionRecords: []string{userRedemptionRecord.Id},
+	})
+
+	// 400 stTokens remain in circulation
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 400))
+
+	err := s.App.StakeibcKeeper.ProcessSunsetUnbonding(s.Ctx, hostZone, sunsetEpochNumber)
+	s.Require().NoError(err, "no error expected when moving to claimable")
+
+	// The unattributed 800 tokens back the 400 stTokens
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE, hostZone.SunsetStatus, "sunset status")
+	s.Require().Equal(sdk.NewDec(2), hostZone.SunsetRedemptionRate, "sunset redemption rate")
+	s.Require().Equal(sdkmath.NewInt(800), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+	s.Require().Equal(uint64(s.Ctx.BlockTime().UnixNano()), hostZone.SunsetClaimableTime, "sunset claimable time")
+
+	// Only the user's redemption should remain on the host zone unbonding
+	s.Require().Equal(sdkmath.NewInt(200), s.getSunsetHostZoneUnbonding(1).NativeTokenAmount, "host zone unbonding native amount")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetUnbonding_WaitsForDeposits() {
+	hostZone := s.SetupSunset(0)
+	hostZone.StakedBal = sdkmath.ZeroInt()
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Amount:     sdkmath.NewInt(100),
+		HostZoneId: HostChainId,
+		Status:     recordtypes.DepositRecord_DELEGATION_QUEUE,
+	})
+
+	err := s.App.StakeibcKeeper.ProcessSunsetUnbonding(s.Ctx, hostZone, sunsetEpochNumber)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_UNBONDING, s.getSunsetHostZone().SunsetStatus, "sunset status")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetUnbonding_ClaimableIncludesSweptFunds() {
+	hostZone := s.SetupSunset(0)
+	hostZone.StakedBal = sdkmath.ZeroInt()
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(100) // deposits and rewards swept during the sunset
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.setSunsetHostZoneUnbonding(1, recordtypes.HostZoneUnbonding{
+		HostZoneId:        HostChainId,
+		Denom:             Atom,
+		NativeTokenAmount: sdkmath.NewInt(900),
+		Status:            recordtypes.HostZoneUnbonding_CLAIMABLE,
+	})
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 500))
+
+	err := s.App.StakeibcKeeper.ProcessSunsetUnbonding(s.Ctx, hostZone, sunsetEpochNumber)
+	s.Require().NoError(err, "no error expected when moving to claimable")
+
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE, hostZone.SunsetStatus, "sunset status")
+	s.Require().Equal(sdkmath.NewInt(1000), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+	s.Require().Equal(sdk.NewDec(2), hostZone.SunsetRedemptionRate, "sunset redemption rate")
+}
+
+func (s *KeeperTestSuite) TestSunsetEpochs_NoRestaking() {
+	s.CreateICAChannel("GAIA.DELEGATION")
+
+	// The sunset host zone has a weight policy, a jailed validator with a delegation, and a pending deposit
+	hostZone := s.SetupSunset(2)
+	hostZone.Validators[1].Jailed = true
+	hostZone.ValidatorWeightPolicy = &stakeibctypes.ValidatorWeightPolicy{
+		MaxCommissionRate:   sdk.OneDec(),
+		MaxVotingPowerShare: sdk.OneDec(),
+	}
+	hostZone.WithdrawalAccount = &stakeibctypes.ICAAccount{Address: "cosmos_WITHDRAWAL", Target: stakeibctypes.ICAAccountType_WITHDRAWAL}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	depositRecord := recordtypes.DepositRecord{
+		Amount:             sdkmath.NewInt(100),
+		Denom:              Atom,
+		HostZoneId:         HostChainId,
+		Status:             recordtypes.DepositRecord_DELEGATION_QUEUE,
+		DepositEpochNumber: sunsetEpochNumber,
+	}
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx, depositRecord)
+
+	// Run a stride epoch and a day epoch
+	for _, epochId := range []string{epochtypes.STRIDE_EPOCH, epochtypes.DAY_EPOCH} {
+		s.App.StakeibcKeeper.BeforeEpochStart(s.Ctx, epochtypes.EpochInfo{
+			Identifier:            epochId,
+			CurrentEpoch:          int64(sunsetEpochNumber + 1),
+			CurrentEpochStartTime: s.Ctx.BlockTime(),
+			Duration:              time.Hour,
+		})
+	}
+
+	// No delegations or redelegations should have been submitted
+	for _, callbackData := range s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx) {
+		s.Require().NotEqual(stakeibckeeper.ICACallbackID_Delegate, callbackData.CallbackId, "no delegation expected")
+		s.Require().NotEqual(stakeibckeeper.ICACallbackID_Rebalance, callbackData.CallbackId, "no redelegation expected")
+	}
+
+	// The deposit should instead be swept to the redemption account
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, depositRecord.Id)
+	s.Require().True(found, "deposit record found")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_IN_PROGRESS, depositRecord.Status, "deposit record status")
+
+	sweepSubmitted := false
+	for _, callbackData := range s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx) {
+		if callbackData.CallbackId == stakeibckeeper.ICACallbackID_SunsetDeposit {
+			sweepSubmitted = true
+		}
+	}
+	s.Require().True(sweepSubmitted, "deposit sweep should be submitted")
+
+	// The validator weights should not have changed
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(uint64(1), hostZone.Validators[0].Weight, "validator 1 weight")
+	s.Require().Equal(uint64(1), hostZone.Validators[1].Weight, "validator 2 weight")
+}
+
+func (s *KeeperTestSuite) TestSweepSunsetDeposit_WaitsForSweep() {
+	s.CreateICAChannel("GAIA.DELEGATION")
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_SWEEPING
+
+	depositRecord := recordtypes.DepositRecord{
+		Amount:     sdkmath.NewInt(100),
+		HostZoneId: HostChainId,
+		Status:     recordtypes.DepositRecord_DELEGATION_QUEUE,
+	}
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx, depositRecord)
+
+	// The deposit can't be added to the claimable balance while the balance is being swept
+	err := s.App.StakeibcKeeper.SweepSunsetDeposit(s.Ctx, hostZone, depositRecord)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+}
+
+func (s *KeeperTestSuite) setupSunsetDepositCallback() []byte {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(50)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.App.RecordsKeeper.SetDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Id:         1,
+		Amount:     sdkmath.NewInt(100),
+		HostZoneId: HostChainId,
+		Status:     recordtypes.DepositRecord_DELEGATION_IN_PROGRESS,
+	})
+
+	callbackArgsBz, err := s.App.StakeibcKeeper.MarshalSunsetCallbackArgs(s.Ctx, stakeibctypes.SunsetCallback{
+		HostZoneId:      HostChainId,
+		Amount:          sdk.NewInt64Coin(Atom, 100),
+		DepositRecordId: 1,
+	})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+	return callbackArgsBz
+}
+
+func (s *KeeperTestSuite) TestSunsetDepositCallback_Successful() {
+	callbackArgs := s.setupSunsetDepositCallback()
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err := stakeibckeeper.SunsetDepositCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, callbackArgs)
+	s.Require().NoError(err, "sunset deposit callback error")
+
+	_, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, 1)
+	s.Require().False(found, "deposit record should be removed")
+	s.Require().Equal(sdkmath.NewInt(150), s.getSunsetHostZone().SunsetClaimableBalance, "sunset claimable balance")
+}
+
+func (s *KeeperTestSuite) TestSunsetDepositCallback_Failure() {
+	callbackArgs := s.setupSunsetDepositCallback()
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.SunsetDepositCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, callbackArgs)
+	s.Require().NoError(err, "sunset deposit callback error")
+
+	// The deposit record should be reverted so that the sweep is retried
+	depositRecord, found := s.App.RecordsKeeper.GetDepositRecord(s.Ctx, 1)
+	s.Require().True(found, "deposit record found")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE, depositRecord.Status, "deposit record status")
+	s.Require().Equal(sdkmath.NewInt(50), s.getSunsetHostZone().SunsetClaimableBalance, "sunset claimable balance")
+}
+
+func (s *KeeperTestSuite) TestSunsetRewardsCallback_Failure() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(50)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// The rewards are still in the withdrawal account, so the claimable balance should not change
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.SunsetRewardsCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.getSunsetCallbackArgs(100))
+	s.Require().NoError(err, "sunset rewards callback error")
+	s.Require().Equal(sdkmath.NewInt(50), s.getSunsetHostZone().SunsetClaimableBalance, "sunset claimable balance")
+}
+
+func (s *KeeperTestSuite) SetupSunsetRedemption() (stakeibctypes.HostZone, stakeibctypes.MsgRedeemStake) {
+	hostZone := s.SetupSunset(0)
+	hostZone.StakedBal = sdkmath.ZeroInt()
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE
+	hostZone.SunsetRedemptionRate = sdk.NewDec(2)
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(800)
+	hostZone.SunsetClaimableTime = uint64(s.Ctx.BlockTime().UnixNano())
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 400))
+
+	return hostZone, stakeibctypes.MsgRedeemStake{
+		Creator:  s.TestAccs[0].String(),
+		Amount:   sdkmath.NewInt(100),
+		HostZone: HostChainId,
+		Receiver: "cosmos1g6qdx6kdhpf000afvvpte7hp0vnpzapuyxp8uf",
+	}
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_Sunset() {
+	_, msg := s.SetupSunsetRedemption()
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when redeeming")
+
+	// The stTokens should be burned immediately
+	s.Require().Equal(sdkmath.NewInt(300), s.App.BankKeeper.GetSupply(s.Ctx, StAtom).Amount, "stToken supply")
+
+	// The redemption should be immediately claimable at the sunset rate
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, sunsetEpochNumber, s.TestAccs[0].String())
+	userRedemptionRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().True(found, "user redemption record found")
+	s.Require().Equal(sdkmath.NewInt(200), userRedemptionRecord.Amount, "user redemption record amount")
+	s.Require().Equal(msg.Receiver, userRedemptionRecord.Receiver, "user redemption record receiver")
+
+	hostZoneUnbonding := s.getSunsetHostZoneUnbonding(sunsetEpochNumber)
+	s.Require().Equal(recordtypes.HostZoneUnbonding_CLAIMABLE, hostZoneUnbonding.Status, "host zone unbonding status")
+	s.Require().Equal(sdkmath.NewInt(200), hostZoneUnbonding.NativeTokenAmount, "host zone unbonding native amount")
+	s.Require().Equal([]string{redemptionId}, hostZoneUnbonding.UserRedemptionRecords, "host zone unbonding redemption records")
+
+	s.Require().Equal(sdkmath.NewInt(600), s.getSunsetHostZone().SunsetClaimableBalance, "sunset claimable balance")
+
+	// A second redemption in the same epoch should be merged into the same record
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when redeeming again")
+
+	userRedemptionRecord, _ = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().Equal(sdkmath.NewInt(400), userRedemptionRecord.Amount, "user redemption record amount after second redemption")
+	s.Require().Len(s.getSunsetHostZoneUnbonding(sunsetEpochNumber).UserRedemptionRecords, 1, "number of redemption records")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_SunsetMinNativeOutNotMet() {
+	_, msg := s.SetupSunsetRedemption()
+	msg.MinNativeOut = sdkmath.NewInt(201)
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "redemption would return 200uatom, minimum specified: 201")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_SunsetExceedsClaimableBalance() {
+	hostZone, msg := s.SetupSunsetRedemption()
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(199)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "redemption of 200uatom exceeds the sunset claimable balance of 199uatom")
+}
+
+func (s *KeeperTestSuite) TestRedeemStake_SunsetUnbonding() {
+	hostZone, msg := s.SetupSunsetRedemption()
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_UNBONDING
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "redemptions are paused while host zone GAIA is unbonding")
+}
+
+func (s *KeeperTestSuite) TestLiquidStake_Sunset() {
+	s.SetupSunset(1)
+
+	msg := stakeibctypes.MsgLiquidStake{
+		Creator:   s.TestAccs[0].String(),
+		Amount:    sdkmath.NewInt(100),
+		HostDenom: Atom,
+	}
+	_, err := s.GetMsgServer().LiquidStake(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone GAIA is being sunset")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetClaims_WaitsForRedemptions() {
+	hostZone, _ := s.SetupSunsetRedemption()
+
+	err := s.App.StakeibcKeeper.ProcessSunsetClaims(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE, s.getSunsetHostZone().SunsetStatus, "sunset status")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetClaims_ClaimDeadlinePassed() {
+	s.CreateICAChannel("GAIA.REDEMPTION")
+	hostZone, _ := s.SetupSunsetRedemption()
+
+	// Just before the end of the claim period, the sunset still waits for the outstanding stTokens
+	claimPeriod := time.Duration(stakeibctypes.DefaultSunsetClaimPeriodDays) * 24 * time.Hour
+	hostZone.SunsetClaimableTime = uint64(s.Ctx.BlockTime().Add(-claimPeriod + time.Second).UnixNano())
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.ProcessSunsetClaims(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected before the claim deadline")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE, s.getSunsetHostZone().SunsetStatus, "sunset status")
+
+	// Once the claim period has ended, the funds backing the outstanding stTokens are swept
+	hostZone.SunsetClaimableTime = uint64(s.Ctx.BlockTime().Add(-claimPeriod).UnixNano())
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err = s.App.StakeibcKeeper.ProcessSunsetClaims(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected after the claim deadline")
+
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_SWEEPING, hostZone.SunsetStatus, "sunset status")
+	s.Require().Equal(sdkmath.NewInt(800), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 1, "sweep tx should be submitted")
+}
+
+func (s *KeeperTestSuite) TestFundCommunityPoolFromSunsetCollector() {
+	// Nothing should happen if no funds have been swept
+	err := s.App.StakeibcKeeper.FundCommunityPoolFromSunsetCollector(s.Ctx)
+	s.Require().NoError(err, "no error expected without swept funds")
+
+	s.FundModuleAccount(stakeibctypes.SunsetCollectorName, sdk.NewInt64Coin(IbcAtom, 100))
+
+	err = s.App.StakeibcKeeper.FundCommunityPoolFromSunsetCollector(s.Ctx)
+	s.Require().NoError(err, "no error expected when funding the community pool")
+
+	s.checkModuleAccountBalance(stakeibctypes.SunsetCollectorName, IbcAtom, sdkmath.ZeroInt())
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+	s.Require().Equal(sdk.NewDec(100), communityPool.AmountOf(IbcAtom), "community pool ibc/uatom")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetClaims_WaitsForClaims() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.setSunsetHostZoneUnbonding(1, recordtypes.HostZoneUnbonding{
+		HostZoneId:        HostChainId,
+		NativeTokenAmount: sdkmath.NewInt(200),
+		Status:            recordtypes.HostZoneUnbonding_CLAIMABLE,
+	})
+
+	err := s.App.StakeibcKeeper.ProcessSunsetClaims(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE, s.getSunsetHostZone().SunsetStatus, "sunset status")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetClaims_Complete() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.ProcessSunsetClaims(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when completing sunset")
+
+	// With no leftovers to sweep, the sunset is completed immediately
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_COMPLETE, hostZone.SunsetStatus, "sunset status")
+	s.Require().True(hostZone.Halted, "host zone should be halted")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+
+	// A sunset host zone cannot be resumed
+	_, err = s.GetMsgServer().ResumeHostZone(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.MsgResumeHostZone{
+		Creator: s.TestAccs[0].String(),
+		ChainId: HostChainId,
+	})
+	s.Require().ErrorContains(err, "host zone GAIA has been sunset")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetClaims_SweepsLeftovers() {
+	s.CreateICAChannel("GAIA.REDEMPTION")
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(1)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.ProcessSunsetClaims(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when sweeping leftovers")
+
+	// The host zone should not be halted until the sweep is acknowledged
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_SWEEPING, hostZone.SunsetStatus, "sunset status")
+	s.Require().False(hostZone.Halted, "host zone should not be halted")
+	s.Require().Equal(sdkmath.NewInt(1), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+
+	callbackData := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbackData, 1, "sweep tx should be submitted")
+	s.Require().Equal(stakeibckeeper.ICACallbackID_SunsetSweep, callbackData[0].CallbackId, "callback id")
+}
+
+func (s *KeeperTestSuite) getSunsetCallbackArgs(amount int64) []byte {
+	callbackArgsBz, err := s.App.StakeibcKeeper.MarshalSunsetCallbackArgs(s.Ctx, stakeibctypes.SunsetCallback{
+		HostZoneId: HostChainId,
+		Amount:     sdk.NewInt64Coin(Atom, amount),
+	})
+	s.Require().NoError(err, "no error expected when marshalling callback args")
+	return callbackArgsBz
+}
+
+func (s *KeeperTestSuite) TestSunsetSweepCallback_Successful() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_SWEEPING
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(1)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err := stakeibckeeper.SunsetSweepCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.getSunsetCallbackArgs(1))
+	s.Require().NoError(err, "sunset sweep callback error")
+
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_COMPLETE, hostZone.SunsetStatus, "sunset status")
+	s.Require().True(hostZone.Halted, "host zone should be halted")
+	s.Require().True(hostZone.SunsetClaimableBalance.IsZero(), "sunset claimable balance")
+}
+
+func (s *KeeperTestSuite) TestSunsetSweepCallback_Failure() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_SWEEPING
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(1)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_TIMEOUT}
+	err := stakeibckeeper.SunsetSweepCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.getSunsetCallbackArgs(1))
+	s.Require().NoError(err, "sunset sweep callback error")
+
+	// The sunset should go back to the claimable phase so that the sweep is retried
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_CLAIMABLE, hostZone.SunsetStatus, "sunset status")
+	s.Require().False(hostZone.Halted, "host zone should not be halted")
+	s.Require().Equal(sdkmath.NewInt(1), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+}
+
+func (s *KeeperTestSuite) TestAbortHostZoneSunset() {
+	s.SetupSunset(1)
+
+	msg := stakeibctypes.MsgAbortHostZoneSunset{Creator: s.TestAccs[0].String(), ChainId: HostChainId}
+	_, err := s.GetMsgServer().AbortHostZoneSunset(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().NoError(err, "no error expected when aborting sunset")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_ABORTING, s.getSunsetHostZone().SunsetStatus, "sunset status")
+
+	// The sunset can only be aborted while unbonding
+	_, err = s.GetMsgServer().AbortHostZoneSunset(sdk.WrapSDKContext(s.Ctx), &msg)
+	s.Require().ErrorContains(err, "host zone GAIA sunset can only be aborted while unbonding (status: SUNSET_ABORTING)")
+
+	// Redemptions remain paused until the funds are restaked
+	_, redeemMsg := s.SetupSunsetRedemption()
+	hostZone := s.getSunsetHostZone()
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_ABORTING
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	_, err = s.GetMsgServer().RedeemStake(sdk.WrapSDKContext(s.Ctx), &redeemMsg)
+	s.Require().ErrorContains(err, "redemptions are paused while host zone GAIA is being sunset (status: SUNSET_ABORTING)")
+}
+
+func (s *KeeperTestSuite) TestSunsetProposals() {
+	hostZone := s.SetupSunset(1)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_NONE
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.SunsetHostZoneProposal(s.Ctx, &stakeibctypes.SunsetHostZoneProposal{
+		Title:       "Sunset host zone GAIA",
+		Description: "Proposal to wind down the GAIA host zone",
+		ChainId:     HostChainId,
+	})
+	s.Require().NoError(err, "no error expected when sunsetting through governance")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_UNBONDING, s.getSunsetHostZone().SunsetStatus, "sunset status after sunset")
+
+	abortProposal := stakeibctypes.AbortHostZoneSunsetProposal{
+		Title:       "Abort host zone GAIA sunset",
+		Description: "Proposal to abort the GAIA host zone sunset",
+		ChainId:     HostChainId,
+	}
+	err = s.App.StakeibcKeeper.AbortHostZoneSunsetProposal(s.Ctx, &abortProposal)
+	s.Require().NoError(err, "no error expected when aborting through governance")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_ABORTING, s.getSunsetHostZone().SunsetStatus, "sunset status after abort")
+
+	abortProposal.ChainId = "fake_host_zone"
+	err = s.App.StakeibcKeeper.AbortHostZoneSunsetProposal(s.Ctx, &abortProposal)
+	s.Require().ErrorContains(err, "host zone fake_host_zone not found")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetAbort_WaitsForUnbonding() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_ABORTING
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	s.setSunsetHostZoneUnbonding(1, recordtypes.HostZoneUnbonding{
+		HostZoneId:        HostChainId,
+		NativeTokenAmount: sdkmath.NewInt(1000),
+		Status:            recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
+	})
+
+	err := s.App.StakeibcKeeper.ProcessSunsetAbort(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_ABORTING, s.getSunsetHostZone().SunsetStatus, "sunset status")
+	s.Require().Len(s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx), 0, "no txs should be submitted")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetAbort_Restake() {
+	s.CreateICAChannel("GAIA.REDEMPTION")
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_ABORTING
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	// 1000 tokens were swept to the redemption account, 200 of which belong to a user redemption
+	userRedemptionRecord := recordtypes.UserRedemptionRecord{
+		Id:         "GAIA.1.user",
+		Amount:     sdkmath.NewInt(200),
+		HostZoneId: HostChainId,
+	}
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, userRedemptionRecord)
+	s.setSunsetHostZoneUnbonding(1, recordtypes.HostZoneUnbonding{
+		HostZoneId:            HostChainId,
+		Denom:                 Atom,
+		NativeTokenAmount:     sdkmath.NewInt(1000),
+		Status:                recordtypes.HostZoneUnbonding_CLAIMABLE,
+		UserRedemptionRecords: []string{userRedemptionRecord.Id},
+	})
+
+	err := s.App.StakeibcKeeper.ProcessSunsetAbort(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when restaking")
+
+	// The unattributed 800 tokens should be sent back to the delegation account
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_ABORT_RESTAKING, hostZone.SunsetStatus, "sunset status")
+	s.Require().Equal(sdkmath.NewInt(800), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+	s.Require().Equal(sdkmath.NewInt(200), s.getSunsetHostZoneUnbonding(1).NativeTokenAmount, "host zone unbonding native amount")
+
+	callbackData := s.App.IcacallbacksKeeper.GetAllCallbackData(s.Ctx)
+	s.Require().Len(callbackData, 1, "restake tx should be submitted")
+	s.Require().Equal(stakeibckeeper.ICACallbackID_SunsetRestake, callbackData[0].CallbackId, "callback id")
+}
+
+func (s *KeeperTestSuite) TestProcessSunsetAbort_NothingToRestake() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_ABORTING
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.ProcessSunsetAbort(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected")
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_NONE, s.getSunsetHostZone().SunsetStatus, "sunset status")
+}
+
+func (s *KeeperTestSuite) TestSunsetRestakeCallback_Successful() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_ABORT_RESTAKING
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(800)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_SUCCESS}
+	err := stakeibckeeper.SunsetRestakeCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.getSunsetCallbackArgs(800))
+	s.Require().NoError(err, "sunset restake callback error")
+
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_NONE, hostZone.SunsetStatus, "sunset status")
+	s.Require().True(hostZone.SunsetClaimableBalance.IsZero(), "sunset claimable balance")
+
+	// The funds should be queued for delegation
+	depositRecords := s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx)
+	s.Require().Len(depositRecords, 1, "number of deposit records")
+	s.Require().Equal(sdkmath.NewInt(800), depositRecords[0].Amount, "deposit record amount")
+	s.Require().Equal(recordtypes.DepositRecord_DELEGATION_QUEUE, depositRecords[0].Status, "deposit record status")
+	s.Require().Equal(sunsetEpochNumber, depositRecords[0].DepositEpochNumber, "deposit record epoch")
+}
+
+func (s *KeeperTestSuite) TestSunsetRestakeCallback_Failure() {
+	hostZone := s.SetupSunset(0)
+	hostZone.SunsetStatus = stakeibctypes.SunsetStatus_SUNSET_ABORT_RESTAKING
+	hostZone.SunsetClaimableBalance = sdkmath.NewInt(800)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	ackResponse := icacallbacktypes.AcknowledgementResponse{Status: icacallbacktypes.AckResponseStatus_FAILURE}
+	err := stakeibckeeper.SunsetRestakeCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, s.getSunsetCallbackArgs(800))
+	s.Require().NoError(err, "sunset restake callback error")
+
+	// The send should be retried in the next day epoch
+	hostZone = s.getSunsetHostZone()
+	s.Require().Equal(stakeibctypes.SunsetStatus_SUNSET_ABORTING, hostZone.SunsetStatus, "sunset status")
+	s.Require().Equal(sdkmath.NewInt(800), hostZone.SunsetClaimableBalance, "sunset claimable balance")
+	s.Require().Len(s.App.RecordsKeeper.GetAllDepositRecord(s.Ctx), 0, "no deposit records should be created")
+}
+
+func (s *KeeperTestSuite) TestGetSunsetProgress() {
+	hostZone := s.SetupSunset(2)
+	s.setSunsetHostZoneUnbonding(1, recordtypes.HostZoneUnbonding{
+		HostZoneId:        HostChainId,
+		NativeTokenAmount: sdkmath.NewInt(300),
+		Status:            recordtypes.HostZoneUnbonding_EXIT_TRANSFER_QUEUE,
+	})
+	s.App.RecordsKeeper.AppendDepositRecord(s.Ctx, recordtypes.DepositRecord{
+		Amount:     sdkmath.NewInt(100),
+		HostZoneId: HostChainId,
+	})
+	s.FundAccount(s.TestAccs[0], sdk.NewInt64Coin(StAtom, 400))
+
+	progress, err := s.App.StakeibcKeeper.SunsetProgress(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.QuerySunsetProgressRequest{ChainId: HostChainId})
+	s.Require().NoError(err, "no error expected when querying sunset progress")
+
+	expectedProgress := stakeibctypes.QuerySunsetProgressResponse{
+		Status:                       stakeibctypes.SunsetStatus_SUNSET_UNBONDING,
+		StakedBalance:                hostZone.StakedBal,
+		NumValidatorsWithDelegations: 2,
+		UnbondingBalance:             sdkmath.NewInt(300),
+		PendingDepositBalance:        sdkmath.NewInt(100),
+		SunsetRedemptionRate:         sdk.ZeroDec(),
+		ClaimableBalance:             sdkmath.ZeroInt(),
+		StTokenSupply:                sdkmath.NewInt(400),
+	}
+	s.Require().Equal(expectedProgress, *progress, "sunset progress")
+
+	_, err = s.App.StakeibcKeeper.SunsetProgress(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.QuerySunsetProgressRequest{ChainId: "fake"})
+	s.Require().ErrorContains(err, "host zone not found")
+}
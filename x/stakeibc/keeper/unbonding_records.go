@@ -20,9 +20,23 @@ import (
 func (k Keeper) CreateEpochUnbondingRecord(ctx sdk.Context, epochNumber uint64) bool {
 	k.Logger(ctx).Info(fmt.Sprintf("Creating Epoch Unbonding Records for Epoch %d", epochNumber))
 
-	hostZoneUnbondings := []*recordstypes.HostZoneUnbonding{}
+	// If the record already exists (e.g. an unbonding shortfall was rolled into this epoch), its host zone unbondings are preserved
+	epochUnbondingRecord, found := k.RecordsKeeper.GetEpochUnbondingRecord(ctx, epochNumber)
+	if !found {
+		epochUnbondingRecord = recordstypes.EpochUnbondingRecord{
+			EpochNumber:        cast.ToUint64(epochNumber),
+			HostZoneUnbondings: []*recordstypes.HostZoneUnbonding{},
+		}
+	}
+	existingHostZoneUnbondings := map[string]bool{}
+	for _, hostZoneUnbonding := range epochUnbondingRecord.HostZoneUnbondings {
+		existingHostZoneUnbondings[hostZoneUnbonding.HostZoneId] = true
+	}
 
 	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if existingHostZoneUnbondings[hostZone.ChainId] {
+			continue
+		}
 		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Creating Epoch Unbonding Record"))
 
 		hostZoneUnbonding := recordstypes.HostZoneUnbonding{
@@ -32,13 +46,9 @@ func (k Keeper) CreateEpochUnbondingRecord(ctx sdk.Context, epochNumber uint64)
 			HostZoneId:        hostZone.ChainId,
 			Status:            recordstypes.HostZoneUnbonding_UNBONDING_QUEUE,
 		}
-		hostZoneUnbondings = append(hostZoneUnbondings, &hostZoneUnbonding)
+		epochUnbondingRecord.HostZoneUnbondings = append(epochUnbondingRecord.HostZoneUnbondings, &hostZoneUnbonding)
 	}
 
-	epochUnbondingRecord := recordstypes.EpochUnbondingRecord{
-		EpochNumber:        cast.ToUint64(epochNumber),
-		HostZoneUnbondings: hostZoneUnbondings,
-	}
 	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, epochUnbondingRecord)
 	return true
 }
@@ -76,8 +86,8 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 		return nil, sdkmath.ZeroInt(), nil, nil, nil
 	}
 
-	// Determine the desired unbonding amount for each validator based based on our target weights
-	targetUnbondingsByValidator, err := k.GetTargetValAmtsForHostZone(ctx, hostZone, totalAmountToUnbond)
+	// Determine the desired unbonding amount for each validator based on the host zone's unbonding strategy
+	targetUnbondingsByValidator, err := k.GetTargetUnbondingsByValidator(ctx, hostZone, totalAmountToUnbond)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error getting target val amts for host zone %s %v: %s", hostZone.ChainId, totalAmountToUnbond, err)
 		k.Logger(ctx).Error(errMsg)
@@ -129,12 +139,49 @@ func (k Keeper) GetHostZoneUnbondingMsgs(ctx sdk.Context, hostZone types.HostZon
 		}
 	}
 
-	// If after re-allocating, we still can't cover the overflow, something is very wrong
+	// If after re-allocating, we still can't cover the overflow, the validators don't have enough delegations to cover
+	//  the full unbonding. Rather than blocking all redemptions, unbond as much as possible and roll the remainder
+	//  into the next unbonding cycle
 	if overflowAmount.GT(sdkmath.ZeroInt()) {
-		errMsg := fmt.Sprintf("Could not unbond %v on Host Zone %s, unable to balance the unbond amount across validators",
-			totalAmountToUnbond, hostZone.ChainId)
-		k.Logger(ctx).Error(errMsg)
-		return nil, sdkmath.ZeroInt(), nil, nil, errorsmod.Wrap(sdkerrors.ErrNotFound, errMsg)
+		unbondableAmount := totalAmountToUnbond.Sub(overflowAmount)
+		if !unbondableAmount.IsPositive() {
+			errMsg := fmt.Sprintf("Could not unbond %v on Host Zone %s, unable to balance the unbond amount across validators",
+				totalAmountToUnbond, hostZone.ChainId)
+			k.Logger(ctx).Error(errMsg)
+			return nil, sdkmath.ZeroInt(), nil, nil, errorsmod.Wrap(sdkerrors.ErrNotFound, errMsg)
+		}
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+			"Unable to unbond the full %v%s, partially unbonding %v%s", totalAmountToUnbond, hostZone.HostDenom, unbondableAmount, hostZone.HostDenom))
+
+		// The rollover is applied in a cached context so that a failure doesn't leave the records partially split
+		cacheCtx, writeCache := ctx.CacheContext()
+		filledEpochUnbondingRecordIds, filledAmount, err := k.RollOverUnbondingShortfall(cacheCtx, hostZone, epochUnbondingRecordIds, unbondableAmount, totalAmountToUnbond)
+		if err != nil {
+			errMsg := fmt.Sprintf("Could not unbond %v on Host Zone %s, unable to roll over the unbonding shortfall: %s",
+				totalAmountToUnbond, hostZone.ChainId, err.Error())
+			k.Logger(ctx).Error(errMsg)
+			return nil, sdkmath.ZeroInt(), nil, nil, errorsmod.Wrap(sdkerrors.ErrNotFound, errMsg)
+		}
+		writeCache()
+		if filledAmount.IsZero() {
+			return nil, sdkmath.ZeroInt(), nil, nil, nil
+		}
+
+		// Since the records are split with rounding, the filled amount can be slightly less than the unbondable amount
+		// Trim the difference from the validator unbondings so that they sum to the amount recorded on the records
+		excessAmount := unbondableAmount.Sub(filledAmount)
+		for _, validator := range hostZone.Validators {
+			if excessAmount.IsZero() {
+				break
+			}
+			trimAmount := sdkmath.MinInt(finalUnbondingsByValidator[validator.Address], excessAmount)
+			finalUnbondingsByValidator[validator.Address] = finalUnbondingsByValidator[validator.Address].Sub(trimAmount)
+			excessAmount = excessAmount.Sub(trimAmount)
+		}
+
+		totalAmountToUnbond = filledAmount
+		epochUnbondingRecordIds = filledEpochUnbondingRecordIds
 	}
 
 	// Get the delegation account
@@ -237,6 +284,14 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 			continue
 		}
 
+		// If a previous unbonding failed to submit, it's retried here along with the latest records (on the
+		// host's unbonding days only), unless it has failed too many times and is waiting to be resolved by an admin
+		icaRetry, hasRetry := k.GetICARetry(ctx, hostZone.ChainId, types.ICARetry_UNDELEGATE, 0)
+		if hasRetry && icaRetry.Status == types.ICARetry_DEAD_LETTER {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Unbonding is in the retry dead letter queue"))
+			continue
+		}
+
 		// Get host zone unbonding message by summing up the unbonding records
 		msgs, totalAmountToUnbond, marshalledCallbackArgs, epochUnbondingRecordIds, err := k.GetHostZoneUnbondingMsgs(ctx, hostZone)
 		if err != nil {
@@ -248,6 +303,7 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 
 		// If there's nothing to unbond, move on to the next host
 		if totalAmountToUnbond.IsZero() {
+			k.RemoveICARetry(ctx, hostZone.ChainId, types.ICARetry_UNDELEGATE, 0)
 			continue
 		}
 
@@ -256,6 +312,7 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 		if err != nil {
 			errMsg := fmt.Sprintf("Error submitting unbonding tx for host zone %s: %s", hostZone.ChainId, err.Error())
 			k.Logger(ctx).Error(errMsg)
+			k.RecordFailedICAOperation(ctx, hostZone.ChainId, types.ICARetry_UNDELEGATE, 0, err)
 			success = false
 			failedUnbondings = append(failedUnbondings, hostZone.ChainId)
 			continue
@@ -268,6 +325,10 @@ func (k Keeper) InitiateAllHostZoneUnbondings(ctx sdk.Context, dayNumber uint64)
 			success = false
 			continue
 		}
+		if hasRetry {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId, "Retried unbonding after %d failed attempts", icaRetry.Attempts))
+			k.RemoveICARetry(ctx, hostZone.ChainId, types.ICARetry_UNDELEGATE, 0)
+		}
 		successfulUnbondings = append(successfulUnbondings, hostZone.ChainId)
 	}
 
@@ -154,15 +154,80 @@ func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_NoneSuccessful() {
 	s.Require().Len(failed_unbondings, 0, "initiating no unbondings returns 0 failed unbondings")
 }
 
+func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_QueuedForRetry() {
+	// Tests that if Gaia's unbonding is in the retry queue, it's only retried on Gaia's next unbonding day,
+	// and that the retry picks up every record that's been closed by then
+	s.SetupInitiateAllHostZoneUnbondings()
+	s.SetICARecoveryEpoch(40)
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, stakeibc.ICARetry{ChainId: HostChainId, Operation: stakeibc.ICARetry_UNDELEGATE, Attempts: 1})
+
+	// Redemptions are still being added to the current day's record (epoch 12) during the day
+	currentDayRecord := recordtypes.EpochUnbondingRecord{
+		EpochNumber: 12,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			HostZoneId:        HostChainId,
+			StTokenAmount:     sdkmath.NewInt(900_000),
+			NativeTokenAmount: sdkmath.NewInt(1_000_000),
+			Denom:             Atom,
+			Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		}},
+	}
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, currentDayRecord)
+
+	checkRecordStatus := func(epochNumber uint64, expectedStatus recordtypes.HostZoneUnbonding_Status) {
+		hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, HostChainId)
+		s.Require().True(found, "host zone unbonding found for epoch %d", epochNumber)
+		s.Require().Equal(expectedStatus, hostZoneUnbonding.Status, "host zone unbonding status for epoch %d", epochNumber)
+	}
+
+	// The retry should not be submitted from the stride epoch
+	s.App.StakeibcKeeper.ProcessICARetries(s.Ctx)
+	s.Require().True(s.App.StakeibcKeeper.HasICARetry(s.Ctx, HostChainId, stakeibc.ICARetry_UNDELEGATE, 0), "retry still queued after stride epoch")
+	checkRecordStatus(5, recordtypes.HostZoneUnbonding_UNBONDING_QUEUE)
+	checkRecordStatus(12, recordtypes.HostZoneUnbonding_UNBONDING_QUEUE)
+
+	// Day 13 is not an unbonding day for either host
+	_, successfulUnbondings, _ := s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 13)
+	s.Require().Empty(successfulUnbondings, "no unbondings on day 13")
+	s.Require().True(s.App.StakeibcKeeper.HasICARetry(s.Ctx, HostChainId, stakeibc.ICARetry_UNDELEGATE, 0), "retry still queued after day 13")
+
+	// On Gaia's next unbonding day, both closed records should be unbonded and the retry should be removed
+	success, successfulUnbondings, failedUnbondings := s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 15)
+	s.Require().True(success, "initiating unbondings returns true")
+	s.Require().Equal([]string{HostChainId}, successfulUnbondings, "initiating unbondings returns only gaia")
+	s.Require().Len(failedUnbondings, 0, "initiating unbondings returns 0 failed unbondings")
+
+	s.Require().False(s.App.StakeibcKeeper.HasICARetry(s.Ctx, HostChainId, stakeibc.ICARetry_UNDELEGATE, 0), "retry removed")
+	checkRecordStatus(5, recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS)
+	checkRecordStatus(12, recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS)
+}
+
+func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_DeadLetterRetry() {
+	// Tests that if Gaia's unbonding has failed too many times, it's skipped until it's resolved by an admin
+	s.SetupInitiateAllHostZoneUnbondings()
+	s.App.StakeibcKeeper.SetICARetry(s.Ctx, stakeibc.ICARetry{
+		ChainId:   HostChainId,
+		Operation: stakeibc.ICARetry_UNDELEGATE,
+		Status:    stakeibc.ICARetry_DEAD_LETTER,
+		Attempts:  stakeibc.ICARetryMaxAttempts,
+	})
+
+	success, successful_unbondings, failed_unbondings := s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 12)
+	s.Require().True(success, "initiating unbondings returns true")
+	s.Require().Equal([]string{"OSMO"}, successful_unbondings, "initiating unbondings returns only osmo")
+	s.Require().Len(failed_unbondings, 0, "initiating unbondings returns 0 failed unbondings")
+	s.Require().True(s.App.StakeibcKeeper.HasICARetry(s.Ctx, HostChainId, stakeibc.ICARetry_UNDELEGATE, 0), "retry still queued")
+}
+
 func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_Failed() {
-	// Tests that if Gaia doesn't have enough delegated stake to unbond, it fails
+	// Tests that if Gaia doesn't have any delegated stake to unbond, it fails
 	// but Osmo does and is successful
 	s.SetupInitiateAllHostZoneUnbondings()
 	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
 	hostZone.Validators = []*stakeibc.Validator{
 		{
 			Address:       "cosmos_VALIDATOR",
-			DelegationAmt: sdkmath.NewInt(1_000_000),
+			DelegationAmt: sdkmath.ZeroInt(),
 			Weight:        uint64(10),
 		},
 	}
@@ -175,3 +240,36 @@ func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_Failed() {
 	s.Require().Equal("OSMO", successful_unbondings[0], "initiating bad unbondings succeeds on osmo")
 	s.Require().Equal("GAIA", failed_unbondings[0], "initiating bad unbondings fails on gaia")
 }
+
+func (s *KeeperTestSuite) TestInitiateAllHostZoneUnbondings_PartialUnbonding() {
+	// Tests that if Gaia doesn't have enough delegated stake to unbond, it unbonds as much as possible
+	// and rolls the remainder into the current epoch's unbonding record
+	s.SetupInitiateAllHostZoneUnbondings()
+	hostZone, _ := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	hostZone.Validators = []*stakeibc.Validator{
+		{
+			Address:       "cosmos_VALIDATOR",
+			DelegationAmt: sdkmath.NewInt(1_000_000),
+			Weight:        uint64(10),
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	success, successful_unbondings, failed_unbondings := s.App.StakeibcKeeper.InitiateAllHostZoneUnbondings(s.Ctx, 12)
+	s.Require().True(success, "initiating partial unbondings returns true")
+	s.Require().Len(successful_unbondings, 2, "initiating partial unbondings has 2 successes")
+	s.Require().Len(failed_unbondings, 0, "initiating partial unbondings has 0 failures")
+
+	// Half of the original unbonding should be in progress
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 5, HostChainId)
+	s.Require().True(found, "original host zone unbonding found")
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_IN_PROGRESS, hostZoneUnbonding.Status, "original unbonding status")
+	s.Require().Equal(sdkmath.NewInt(1_000_000), hostZoneUnbonding.NativeTokenAmount, "original unbonding native amount")
+	s.Require().Equal(sdkmath.NewInt(950_000), hostZoneUnbonding.StTokenAmount, "original unbonding sttoken amount")
+
+	// And the other half should be queued in the current epoch
+	rolloverUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 12, HostChainId)
+	s.Require().True(found, "rollover host zone unbonding found")
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_QUEUE, rolloverUnbonding.Status, "rollover unbonding status")
+	s.Require().Equal(sdkmath.NewInt(1_000_000), rolloverUnbonding.NativeTokenAmount, "rollover unbonding native amount")
+	s.Require().Equal(sdkmath.NewInt(950_000), rolloverUnbonding.StTokenAmount, "rollover unbonding sttoken amount")
+}
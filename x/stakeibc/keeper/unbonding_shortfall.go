@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordstypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Handles an unbonding shortfall (i.e. the validators do not have enough delegations to cover the queued unbondings)
+// by partially filling each of the queued host zone unbondings, and rolling the remainder into the current epoch's
+// unbonding record so that it is picked up by the next unbonding cycle
+//
+// Each user redemption record is split with the same fill ratio, so that every redemption is partially filled. The portion
+// that is rolled forward is moved to the user's redemption record for the current epoch (which is merged with any
+// redemption the user has already made to the same receiver, or kept in a separate record keyed by the receiver otherwise).
+// Records that are fully rolled forward are removed from the original epoch
+//
+// Returns the ids of the epoch unbonding records that still have an amount to unbond, as well as the total amount left to unbond
+func (k Keeper) RollOverUnbondingShortfall(
+	ctx sdk.Context,
+	hostZone types.HostZone,
+	epochUnbondingRecordIds []uint64,
+	unbondableAmount sdkmath.Int,
+	totalAmountToUnbond sdkmath.Int,
+) (filledEpochUnbondingRecordIds []uint64, filledAmount sdkmath.Int, err error) {
+	chainId := hostZone.ChainId
+
+	// The remainder is rolled into the unbonding record for the current day epoch
+	dayEpochTracker, found := k.GetEpochTracker(ctx, epochtypes.DAY_EPOCH)
+	if !found {
+		return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrEpochNotFound, "epoch tracker (%s) not found", epochtypes.DAY_EPOCH)
+	}
+	rolloverEpoch := dayEpochTracker.EpochNumber
+	for _, epochNumber := range epochUnbondingRecordIds {
+		if epochNumber == rolloverEpoch {
+			return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidEpoch,
+				"unable to roll unbonding shortfall into epoch %d since it is being unbonded", rolloverEpoch)
+		}
+	}
+
+	rolloverHostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, rolloverEpoch, chainId)
+	if !found {
+		rolloverHostZoneUnbonding = &recordstypes.HostZoneUnbonding{
+			NativeTokenAmount: sdkmath.ZeroInt(),
+			StTokenAmount:     sdkmath.ZeroInt(),
+			Denom:             hostZone.HostDenom,
+			HostZoneId:        chainId,
+			Status:            recordstypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		}
+	}
+	if rolloverHostZoneUnbonding.Status != recordstypes.HostZoneUnbonding_UNBONDING_QUEUE {
+		return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidEpoch,
+			"host zone unbonding for epoch %d is not in the unbonding queue (status: %s)", rolloverEpoch, rolloverHostZoneUnbonding.Status)
+	}
+
+	// Confirm the user redemption records don't exceed their host zone unbonding before any records are modified
+	// Since each rolled amount is at most the amount it was split from, this ensures that the amount rolled out of
+	// each host zone unbonding never exceeds its native token amount
+	for _, epochNumber := range epochUnbondingRecordIds {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+		if !found {
+			return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound,
+				"host zone unbonding not found for epoch %d", epochNumber)
+		}
+		attributedNativeAmount := sdkmath.ZeroInt()
+		for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+			userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId)
+			if !found {
+				return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrRecordNotFound,
+					"user redemption record not found %s", userRedemptionRecordId)
+			}
+			attributedNativeAmount = attributedNativeAmount.Add(userRedemptionRecord.Amount)
+		}
+		if attributedNativeAmount.GT(hostZoneUnbonding.NativeTokenAmount) {
+			return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrInvalidAmount,
+				"user redemption records for epoch %d (%v) exceed the host zone unbonding amount (%v)",
+				epochNumber, attributedNativeAmount, hostZoneUnbonding.NativeTokenAmount)
+		}
+	}
+
+	fillRatio := sdk.NewDecFromInt(unbondableAmount).Quo(sdk.NewDecFromInt(totalAmountToUnbond))
+	rolloverAmount := func(amount sdkmath.Int) sdkmath.Int {
+		return amount.Sub(sdk.NewDecFromInt(amount).Mul(fillRatio).TruncateInt())
+	}
+
+	filledAmount = sdkmath.ZeroInt()
+	for _, epochNumber := range epochUnbondingRecordIds {
+		hostZoneUnbonding, found := k.RecordsKeeper.GetHostZoneUnbondingByChainId(ctx, epochNumber, chainId)
+		if !found {
+			return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrEpochUnbondingRecordNotFound,
+				"host zone unbonding not found for epoch %d", epochNumber)
+		}
+
+		// Split each user redemption record, moving the remainder to the user's record in the rollover epoch
+		rolledNativeAmount := sdkmath.ZeroInt()
+		attributedNativeAmount := sdkmath.ZeroInt()
+		remainingRecordIds := []string{}
+		for _, userRedemptionRecordId := range hostZoneUnbonding.UserRedemptionRecords {
+			userRedemptionRecord, found := k.RecordsKeeper.GetUserRedemptionRecord(ctx, userRedemptionRecordId)
+			if !found {
+				return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(types.ErrRecordNotFound,
+					"user redemption record not found %s", userRedemptionRecordId)
+			}
+			attributedNativeAmount = attributedNativeAmount.Add(userRedemptionRecord.Amount)
+
+			recordRolledNativeAmount := rolloverAmount(userRedemptionRecord.Amount)
+			if recordRolledNativeAmount.IsZero() {
+				remainingRecordIds = append(remainingRecordIds, userRedemptionRecordId)
+				continue
+			}
+			// Records created before the stToken amount was tracked are left without it
+			recordRolledStTokenAmount := sdkmath.Int{}
+			if !userRedemptionRecord.StTokenAmount.IsNil() {
+				recordRolledStTokenAmount = rolloverAmount(userRedemptionRecord.StTokenAmount)
+			}
+
+			// If the user already has a record in the rollover epoch, the remainder is merged into it
+			// Since a record can only be claimed to a single address, if the user's record in the rollover epoch has a
+			// different receiver, the remainder is moved to a separate record keyed by the receiver instead
+			rolloverRecordId := recordstypes.UserRedemptionRecordKeyFormatter(chainId, rolloverEpoch, userRedemptionRecord.Sender)
+			rolloverRecord, rolloverRecordExists := k.RecordsKeeper.GetUserRedemptionRecord(ctx, rolloverRecordId)
+			if rolloverRecordExists && rolloverRecord.Receiver != userRedemptionRecord.Receiver {
+				rolloverRecordId = recordstypes.UserRedemptionRecordReceiverKeyFormatter(chainId, rolloverEpoch, userRedemptionRecord.Sender, userRedemptionRecord.Receiver)
+				rolloverRecord, rolloverRecordExists = k.RecordsKeeper.GetUserRedemptionRecord(ctx, rolloverRecordId)
+			}
+			if rolloverRecordExists {
+				rolloverRecord.Amount = rolloverRecord.Amount.Add(recordRolledNativeAmount)
+				if !rolloverRecord.StTokenAmount.IsNil() && !recordRolledStTokenAmount.IsNil() {
+					rolloverRecord.StTokenAmount = rolloverRecord.StTokenAmount.Add(recordRolledStTokenAmount)
+				}
+			} else {
+				rolloverRecord = recordstypes.UserRedemptionRecord{
+					Id:             rolloverRecordId,
+					Sender:         userRedemptionRecord.Sender,
+					Receiver:       userRedemptionRecord.Receiver,
+					Amount:         recordRolledNativeAmount,
+					StTokenAmount:  recordRolledStTokenAmount,
+					Denom:          userRedemptionRecord.Denom,
+					HostZoneId:     chainId,
+					EpochNumber:    rolloverEpoch,
+					ClaimIsPending: false,
+				}
+				rolloverHostZoneUnbonding.UserRedemptionRecords = append(rolloverHostZoneUnbonding.UserRedemptionRecords, rolloverRecordId)
+			}
+			k.RecordsKeeper.SetUserRedemptionRecord(ctx, rolloverRecord)
+
+			// Keep the filled portion in the original record, or remove the record if nothing was filled
+			userRedemptionRecord.Amount = userRedemptionRecord.Amount.Sub(recordRolledNativeAmount)
+			if !recordRolledStTokenAmount.IsNil() {
+				userRedemptionRecord.StTokenAmount = userRedemptionRecord.StTokenAmount.Sub(recordRolledStTokenAmount)
+			}
+			if userRedemptionRecord.Amount.IsZero() {
+				k.RecordsKeeper.RemoveUserRedemptionRecord(ctx, userRedemptionRecordId)
+			} else {
+				k.RecordsKeeper.SetUserRedemptionRecord(ctx, userRedemptionRecord)
+				remainingRecordIds = append(remainingRecordIds, userRedemptionRecordId)
+			}
+
+			rolledNativeAmount = rolledNativeAmount.Add(recordRolledNativeAmount)
+		}
+
+		// Any amount on the host zone unbonding that is not attributed to a user redemption record is split with the same ratio
+		if unattributedNativeAmount := hostZoneUnbonding.NativeTokenAmount.Sub(attributedNativeAmount); unattributedNativeAmount.IsPositive() {
+			rolledNativeAmount = rolledNativeAmount.Add(rolloverAmount(unattributedNativeAmount))
+		}
+		rolledStTokenAmount := sdkmath.ZeroInt()
+		if !hostZoneUnbonding.StTokenAmount.IsNil() {
+			rolledStTokenAmount = rolloverAmount(hostZoneUnbonding.StTokenAmount)
+			hostZoneUnbonding.StTokenAmount = hostZoneUnbonding.StTokenAmount.Sub(rolledStTokenAmount)
+		}
+
+		hostZoneUnbonding.NativeTokenAmount = hostZoneUnbonding.NativeTokenAmount.Sub(rolledNativeAmount)
+		hostZoneUnbonding.UserRedemptionRecords = remainingRecordIds
+		rolloverHostZoneUnbonding.NativeTokenAmount = rolloverHostZoneUnbonding.NativeTokenAmount.Add(rolledNativeAmount)
+		rolloverHostZoneUnbonding.StTokenAmount = rolloverHostZoneUnbonding.StTokenAmount.Add(rolledStTokenAmount)
+
+		updatedRecord, success := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, epochNumber, chainId, hostZoneUnbonding)
+		if !success {
+			return nil, sdkmath.ZeroInt(), errorsmod.Wrapf(recordstypes.ErrAddingHostZone,
+				"unable to update host zone unbonding for epoch %d", epochNumber)
+		}
+		k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *updatedRecord)
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Epoch %d - Unbonding %v%s, rolling %v%s into epoch %d",
+			epochNumber, hostZoneUnbonding.NativeTokenAmount, hostZone.HostDenom, rolledNativeAmount, hostZone.HostDenom, rolloverEpoch))
+
+		// Records that were fully rolled over are left in the queue (with a zero amount) so that they can be cleaned up
+		if hostZoneUnbonding.NativeTokenAmount.IsPositive() {
+			filledEpochUnbondingRecordIds = append(filledEpochUnbondingRecordIds, epochNumber)
+			filledAmount = filledAmount.Add(hostZoneUnbonding.NativeTokenAmount)
+		}
+	}
+
+	// Save the rollover host zone unbonding, creating the epoch unbonding record if it does not exist yet
+	rolloverRecord, found := k.RecordsKeeper.AddHostZoneToEpochUnbondingRecord(ctx, rolloverEpoch, chainId, rolloverHostZoneUnbonding)
+	if !found {
+		rolloverRecord = &recordstypes.EpochUnbondingRecord{
+			EpochNumber:        rolloverEpoch,
+			HostZoneUnbondings: []*recordstypes.HostZoneUnbonding{rolloverHostZoneUnbonding},
+		}
+	}
+	k.RecordsKeeper.SetEpochUnbondingRecord(ctx, *rolloverRecord)
+
+	shortfallAmount := totalAmountToUnbond.Sub(filledAmount)
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId, "Unbonding shortfall - unbonding %v%s, rolled %v%s into epoch %d",
+		filledAmount, hostZone.HostDenom, shortfallAmount, hostZone.HostDenom, rolloverEpoch))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondingShortfall,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyUnbondAmount, filledAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyShortfallAmount, shortfallAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyRolloverEpoch, fmt.Sprintf("%d", rolloverEpoch)),
+		),
+	)
+
+	return filledEpochUnbondingRecordIds, filledAmount, nil
+}
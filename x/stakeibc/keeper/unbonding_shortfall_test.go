@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	_ "github.com/stretchr/testify/suite"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+const (
+	ShortfallRedeemerA = "redeemerA"
+	ShortfallRedeemerB = "redeemerB"
+	ShortfallReceiver  = "receiver"
+)
+
+// Sets up a host zone with 2,000,000 queued for unbonding (across epochs 0 and 1), but only 1,500,000 delegated
+// Epoch 0's unbonding is attributed to two user redemptions, while epoch 1's is not attributed to any users
+// The shortfall is rolled into epoch 2, which already has an unbonding record for a different host
+func (s *KeeperTestSuite) SetupUnbondingShortfall() GetHostZoneUnbondingMsgsTestCase {
+	tc := s.SetupGetHostZoneUnbondingMsgs()
+
+	tc.hostZone.Validators[0].DelegationAmt = sdkmath.NewInt(500_000)
+	tc.hostZone.Validators[1].DelegationAmt = sdkmath.NewInt(500_000)
+	tc.hostZone.Validators[2].DelegationAmt = sdkmath.NewInt(500_000)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier: epochtypes.DAY_EPOCH,
+		EpochNumber:     2,
+	})
+
+	// Attribute epoch 0's unbonding to two users
+	redemptionIdA := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 0, ShortfallRedeemerA)
+	redemptionIdB := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 0, ShortfallRedeemerB)
+	for _, record := range []recordtypes.UserRedemptionRecord{
+		{Id: redemptionIdA, Sender: ShortfallRedeemerA, Amount: sdkmath.NewInt(600_000), StTokenAmount: sdkmath.NewInt(600_000)},
+		{Id: redemptionIdB, Sender: ShortfallRedeemerB, Amount: sdkmath.NewInt(400_000), StTokenAmount: sdkmath.NewInt(400_000)},
+	} {
+		record.Receiver = ShortfallReceiver
+		record.HostZoneId = HostChainId
+		record.Denom = tc.hostZone.HostDenom
+		s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+	}
+
+	for _, epochNumber := range []uint64{0, 1} {
+		epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx, epochNumber)
+		s.Require().True(found, "epoch unbonding record %d found", epochNumber)
+		hostZoneUnbonding := epochUnbondingRecord.HostZoneUnbondings[0]
+		hostZoneUnbonding.StTokenAmount = tc.amtToUnbond
+		if epochNumber == 0 {
+			hostZoneUnbonding.UserRedemptionRecords = []string{redemptionIdA, redemptionIdB}
+		}
+		s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+	}
+
+	// The rollover epoch's record has already been created by another host zone's shortfall
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 2,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			NativeTokenAmount: sdkmath.NewInt(10),
+			StTokenAmount:     sdkmath.NewInt(10),
+			HostZoneId:        OsmoChainId,
+			Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		}},
+	})
+
+	return tc
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_PartialUnbonding() {
+	tc := s.SetupUnbondingShortfall()
+
+	msgs, totalAmountToUnbond, callbackArgsBz, epochUnbondingRecordIds, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected when unbonding with a shortfall")
+
+	// Only the delegated amount should be unbonded, and each validator should be fully unbonded
+	s.Require().Equal(sdkmath.NewInt(1_500_000), totalAmountToUnbond, "total amount to unbond")
+	s.Require().Len(msgs, 3, "number of undelegate messages")
+	s.Require().Equal([]uint64{0, 1}, epochUnbondingRecordIds, "epoch unbonding record ids")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalUndelegateCallbackArgs(s.Ctx, callbackArgsBz)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+	for _, splitDelegation := range callbackArgs.SplitDelegations {
+		s.Require().Equal(sdkmath.NewInt(500_000), splitDelegation.Amount, "%s undelegation amount", splitDelegation.Validator)
+	}
+
+	// 75% of each unbonding should remain in the original epochs
+	for _, epochNumber := range []uint64{0, 1} {
+		hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, HostChainId)
+		s.Require().True(found, "host zone unbonding for epoch %d found", epochNumber)
+		s.Require().Equal(sdkmath.NewInt(750_000), hostZoneUnbonding.NativeTokenAmount, "epoch %d native amount", epochNumber)
+		s.Require().Equal(sdkmath.NewInt(750_000), hostZoneUnbonding.StTokenAmount, "epoch %d sttoken amount", epochNumber)
+	}
+
+	expectedRecordAmounts := map[string]int64{
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 0, ShortfallRedeemerA): 450_000,
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 0, ShortfallRedeemerB): 300_000,
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 2, ShortfallRedeemerA): 150_000,
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 2, ShortfallRedeemerB): 100_000,
+	}
+	for redemptionId, expectedAmount := range expectedRecordAmounts {
+		record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+		s.Require().True(found, "user redemption record %s found", redemptionId)
+		s.Require().Equal(sdkmath.NewInt(expectedAmount), record.Amount, "%s native amount", redemptionId)
+		s.Require().Equal(sdkmath.NewInt(expectedAmount), record.StTokenAmount, "%s sttoken amount", redemptionId)
+		s.Require().Equal(ShortfallReceiver, record.Receiver, "%s receiver", redemptionId)
+	}
+
+	// The remainder should be queued in the rollover epoch, alongside the other host's unbonding
+	rolloverUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, HostChainId)
+	s.Require().True(found, "rollover host zone unbonding found")
+	s.Require().Equal(sdkmath.NewInt(500_000), rolloverUnbonding.NativeTokenAmount, "rollover native amount")
+	s.Require().Equal(sdkmath.NewInt(500_000), rolloverUnbonding.StTokenAmount, "rollover sttoken amount")
+	s.Require().Equal(recordtypes.HostZoneUnbonding_UNBONDING_QUEUE, rolloverUnbonding.Status, "rollover status")
+	s.Require().Equal([]string{
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 2, ShortfallRedeemerA),
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 2, ShortfallRedeemerB),
+	}, rolloverUnbonding.UserRedemptionRecords, "rollover user redemption records")
+
+	// A shortfall event should be emitted
+	shortfallEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeUnbondingShortfall {
+			shortfallEvents++
+		}
+	}
+	s.Require().Equal(1, shortfallEvents, "number of shortfall events")
+
+	otherHostUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, OsmoChainId)
+	s.Require().True(found, "other host zone unbonding found")
+	s.Require().Equal(sdkmath.NewInt(10), otherHostUnbonding.NativeTokenAmount, "other host native amount")
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_PartialUnbonding_NewRolloverRecord() {
+	tc := s.SetupUnbondingShortfall()
+	s.App.RecordsKeeper.RemoveEpochUnbondingRecord(s.Ctx, 2)
+
+	_, totalAmountToUnbond, _, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected when unbonding with a shortfall")
+	s.Require().Equal(sdkmath.NewInt(1_500_000), totalAmountToUnbond, "total amount to unbond")
+
+	// The rollover record should be created
+	rolloverUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, HostChainId)
+	s.Require().True(found, "rollover host zone unbonding found")
+	s.Require().Equal(sdkmath.NewInt(500_000), rolloverUnbonding.NativeTokenAmount, "rollover native amount")
+	s.Require().Equal(tc.hostZone.HostDenom, rolloverUnbonding.Denom, "rollover denom")
+
+	// Once the epoch's record is created at the end of the day epoch, the rolled over unbonding should be preserved
+	s.App.StakeibcKeeper.CreateEpochUnbondingRecord(s.Ctx, 2)
+	rolloverUnbonding, found = s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, HostChainId)
+	s.Require().True(found, "rollover host zone unbonding found after record creation")
+	s.Require().Equal(sdkmath.NewInt(500_000), rolloverUnbonding.NativeTokenAmount, "rollover native amount after record creation")
+	s.Require().Len(rolloverUnbonding.UserRedemptionRecords, 2, "rollover user redemption records after record creation")
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_PartialUnbonding_DifferentReceiver() {
+	tc := s.SetupUnbondingShortfall()
+
+	// Redeemer A also redeemed in epoch 1, to a different receiver
+	epoch1RedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 1, ShortfallRedeemerA)
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, recordtypes.UserRedemptionRecord{
+		Id:            epoch1RedemptionId,
+		Sender:        ShortfallRedeemerA,
+		Receiver:      "other_receiver",
+		Amount:        sdkmath.NewInt(200_000),
+		StTokenAmount: sdkmath.NewInt(200_000),
+		HostZoneId:    HostChainId,
+		EpochNumber:   1,
+	})
+	epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx, 1)
+	s.Require().True(found, "epoch unbonding record found")
+	epochUnbondingRecord.HostZoneUnbondings[0].UserRedemptionRecords = []string{epoch1RedemptionId}
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, epochUnbondingRecord)
+
+	_, totalAmountToUnbond, _, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected when rolling over to a different receiver")
+	s.Require().Equal(sdkmath.NewInt(1_500_000), totalAmountToUnbond, "total amount to unbond")
+
+	// Epoch 0's remainder is rolled into the sender's record, while epoch 1's is moved to a record keyed by its receiver
+	senderRedemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 2, ShortfallRedeemerA)
+	senderRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, senderRedemptionId)
+	s.Require().True(found, "sender rollover record found")
+	s.Require().Equal(sdkmath.NewInt(150_000), senderRecord.Amount, "sender rollover record amount")
+	s.Require().Equal(ShortfallReceiver, senderRecord.Receiver, "sender rollover record receiver")
+
+	receiverRedemptionId := recordtypes.UserRedemptionRecordReceiverKeyFormatter(HostChainId, 2, ShortfallRedeemerA, "other_receiver")
+	receiverRecord, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, receiverRedemptionId)
+	s.Require().True(found, "receiver rollover record found")
+	s.Require().Equal(sdkmath.NewInt(50_000), receiverRecord.Amount, "receiver rollover record amount")
+	s.Require().Equal("other_receiver", receiverRecord.Receiver, "receiver rollover record receiver")
+
+	// Every rollover record should be referenced by the rollover host zone unbonding
+	rolloverUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 2, HostChainId)
+	s.Require().True(found, "rollover host zone unbonding found")
+	s.Require().Equal(sdkmath.NewInt(500_000), rolloverUnbonding.NativeTokenAmount, "rollover native amount")
+	s.Require().Equal([]string{
+		senderRedemptionId,
+		recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 2, ShortfallRedeemerB),
+		receiverRedemptionId,
+	}, rolloverUnbonding.UserRedemptionRecords, "rollover user redemption records")
+
+	msg, broken := stakeibckeeper.UserRedemptionRecordsInvariant(s.App.StakeibcKeeper)(s.Ctx)
+	s.Require().False(broken, "user redemption records invariant should hold: %s", msg)
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_PartialUnbonding_RolloverEpochBeingUnbonded() {
+	tc := s.SetupUnbondingShortfall()
+
+	// If the current epoch is one of the epochs being unbonded, the remainder cannot be rolled into it
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier: epochtypes.DAY_EPOCH,
+		EpochNumber:     1,
+	})
+
+	_, _, _, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().ErrorContains(err, "unable to roll unbonding shortfall into epoch 1 since it is being unbonded")
+
+	// The records should be untouched
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 0, HostChainId)
+	s.Require().True(found, "host zone unbonding found")
+	s.Require().Equal(tc.amtToUnbond, hostZoneUnbonding.NativeTokenAmount, "native amount")
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_PartialUnbonding_RecordsExceedUnbonding() {
+	tc := s.SetupUnbondingShortfall()
+
+	// If the user redemption records exceed the host zone unbonding, the rolled amount could exceed the unbonding
+	redemptionId := recordtypes.UserRedemptionRecordKeyFormatter(HostChainId, 0, ShortfallRedeemerA)
+	record, found := s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().True(found, "user redemption record found")
+	record.Amount = tc.amtToUnbond
+	s.App.RecordsKeeper.SetUserRedemptionRecord(s.Ctx, record)
+
+	_, _, _, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().ErrorContains(err, "user redemption records for epoch 0 (1400000) exceed the host zone unbonding amount (1000000)")
+
+	// The records should be untouched
+	hostZoneUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 0, HostChainId)
+	s.Require().True(found, "host zone unbonding found")
+	s.Require().Equal(tc.amtToUnbond, hostZoneUnbonding.NativeTokenAmount, "native amount")
+	record, found = s.App.RecordsKeeper.GetUserRedemptionRecord(s.Ctx, redemptionId)
+	s.Require().True(found, "user redemption record found")
+	s.Require().Equal(tc.amtToUnbond, record.Amount, "user redemption record amount")
+}
+
+func (s *KeeperTestSuite) TestCreateEpochUnbondingRecord() {
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: HostChainId, HostDenom: Atom})
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, stakeibctypes.HostZone{ChainId: OsmoChainId, HostDenom: Osmo})
+
+	// Epoch 1 already has a queued unbonding for the first host
+	s.App.RecordsKeeper.SetEpochUnbondingRecord(s.Ctx, recordtypes.EpochUnbondingRecord{
+		EpochNumber: 1,
+		HostZoneUnbondings: []*recordtypes.HostZoneUnbonding{{
+			NativeTokenAmount: sdkmath.NewInt(100),
+			StTokenAmount:     sdkmath.NewInt(100),
+			HostZoneId:        HostChainId,
+			Status:            recordtypes.HostZoneUnbonding_UNBONDING_QUEUE,
+		}},
+	})
+
+	s.App.StakeibcKeeper.CreateEpochUnbondingRecord(s.Ctx, 1)
+	s.App.StakeibcKeeper.CreateEpochUnbondingRecord(s.Ctx, 2)
+
+	// The existing unbonding should be preserved, and the missing host should be added
+	existingUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, 1, HostChainId)
+	s.Require().True(found, "existing host zone unbonding found")
+	s.Require().Equal(sdkmath.NewInt(100), existingUnbonding.NativeTokenAmount, "existing host zone unbonding amount")
+
+	for _, epochNumber := range []uint64{1, 2} {
+		epochUnbondingRecord, found := s.App.RecordsKeeper.GetEpochUnbondingRecord(s.Ctx, epochNumber)
+		s.Require().True(found, "epoch unbonding record %d found", epochNumber)
+		s.Require().Len(epochUnbondingRecord.HostZoneUnbondings, 2, "epoch %d host zone unbondings", epochNumber)
+
+		newUnbonding, found := s.App.RecordsKeeper.GetHostZoneUnbondingByChainId(s.Ctx, epochNumber, OsmoChainId)
+		s.Require().True(found, "epoch %d new host zone unbonding found", epochNumber)
+		s.Require().Equal(sdkmath.ZeroInt(), newUnbonding.NativeTokenAmount, "epoch %d new host zone unbonding amount", epochNumber)
+		s.Require().Equal(Osmo, newUnbonding.Denom, "epoch %d new host zone unbonding denom", epochNumber)
+	}
+}
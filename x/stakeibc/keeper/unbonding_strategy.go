@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Determines how much should be unbonded from each validator, based on the host zone's unbonding strategy
+func (k Keeper) GetTargetUnbondingsByValidator(ctx sdk.Context, hostZone types.HostZone, totalAmountToUnbond sdkmath.Int) (map[string]sdkmath.Int, error) {
+	switch hostZone.UnbondingStrategy {
+	case types.UnbondingStrategy_REBALANCE:
+		return k.GetRebalancingUnbondingsByValidator(ctx, hostZone, totalAmountToUnbond)
+	default:
+		return k.GetWeightedValAmtsForHostZone(ctx, hostZone, totalAmountToUnbond)
+	}
+}
+
+// Splits the unbonding across validators such that it also moves the validator set towards its target weights
+//
+// Validators with more than their target delegation (as given by GetValidatorDelegationAmtDifferences) unbond first,
+// in proportion to their excess. If the unbonding is larger than the total excess, each overweight validator unbonds
+// its full excess and the remainder is split across all validators by target weight
+func (k Keeper) GetRebalancingUnbondingsByValidator(ctx sdk.Context, hostZone types.HostZone, totalAmountToUnbond sdkmath.Int) (map[string]sdkmath.Int, error) {
+	delegationDifferences, err := k.GetValidatorDelegationAmtDifferences(ctx, hostZone)
+	if err != nil {
+		return nil, err
+	}
+
+	// A negative difference indicates the validator has more than its target delegation
+	excessByValidator := map[string]sdkmath.Int{}
+	totalExcess := sdkmath.ZeroInt()
+	for _, address := range utils.StringMapKeys(delegationDifferences) { // DO NOT REMOVE: StringMapKeys fixes non-deterministic map iteration
+		if difference := delegationDifferences[address]; difference.IsNegative() {
+			excessByValidator[address] = difference.Neg()
+			totalExcess = totalExcess.Add(difference.Neg())
+		}
+	}
+
+	unbondingsByValidator := map[string]sdkmath.Int{}
+	for _, validator := range hostZone.Validators {
+		unbondingsByValidator[validator.Address] = sdkmath.ZeroInt()
+	}
+
+	// If the excess covers the full unbonding, split it across the overweight validators in proportion to their excess
+	// Any rounding remainder is assigned to the validator with the largest excess
+	if totalExcess.GTE(totalAmountToUnbond) {
+		totalAllocated := sdkmath.ZeroInt()
+		largestExcessValidator := ""
+		for _, address := range utils.StringMapKeys(excessByValidator) {
+			excess := excessByValidator[address]
+			unbondAmount := excess.Mul(totalAmountToUnbond).Quo(totalExcess)
+			unbondingsByValidator[address] = unbondAmount
+			totalAllocated = totalAllocated.Add(unbondAmount)
+
+			if largestExcessValidator == "" || excess.GT(excessByValidator[largestExcessValidator]) {
+				largestExcessValidator = address
+			}
+		}
+		unbondingsByValidator[largestExcessValidator] = unbondingsByValidator[largestExcessValidator].Add(totalAmountToUnbond.Sub(totalAllocated))
+		return unbondingsByValidator, nil
+	}
+
+	// Otherwise, unbond the full excess from each overweight validator and split the remainder by target weight
+	for _, address := range utils.StringMapKeys(excessByValidator) {
+		unbondingsByValidator[address] = excessByValidator[address]
+	}
+	remainingAmountToUnbond := totalAmountToUnbond.Sub(totalExcess)
+	targetUnbondingsByValidator, err := k.GetWeightedValAmtsForHostZone(ctx, hostZone, remainingAmountToUnbond)
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range utils.StringMapKeys(targetUnbondingsByValidator) {
+		unbondingsByValidator[address] = unbondingsByValidator[address].Add(targetUnbondingsByValidator[address])
+	}
+
+	return unbondingsByValidator, nil
+}
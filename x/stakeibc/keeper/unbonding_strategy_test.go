@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	_ "github.com/stretchr/testify/suite"
+
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type UnbondingStrategyTestCase struct {
+	name               string
+	delegations        []int64
+	amountToUnbond     int64
+	expectedUnbondings []int64
+}
+
+// Builds a host zone with three validators with weights 1, 1, and 2, and the specified delegations
+func (s *KeeperTestSuite) unbondingStrategyHostZone(strategy stakeibctypes.UnbondingStrategy, delegations []int64) stakeibctypes.HostZone {
+	weights := []uint64{1, 1, 2}
+	validators := []*stakeibctypes.Validator{}
+	for i, address := range []string{"val1", "val2", "val3"} {
+		validators = append(validators, &stakeibctypes.Validator{
+			Address:       address,
+			Weight:        weights[i],
+			DelegationAmt: sdkmath.NewInt(delegations[i]),
+		})
+	}
+	return stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		Validators:        validators,
+		UnbondingStrategy: strategy,
+	}
+}
+
+func (s *KeeperTestSuite) TestGetRebalancingUnbondingsByValidator() {
+	testCases := []UnbondingStrategyTestCase{
+		{
+			// Targets: 250, 250, 500 - val1 has an excess of 250
+			name:               "unbonding less than the excess",
+			delegations:        []int64{500, 100, 400},
+			amountToUnbond:     100,
+			expectedUnbondings: []int64{100, 0, 0},
+		},
+		{
+			name:               "unbonding exactly the excess",
+			delegations:        []int64{500, 100, 400},
+			amountToUnbond:     250,
+			expectedUnbondings: []int64{250, 0, 0},
+		},
+		{
+			// The 400 remaining after the excess is split 1:1:2
+			name:               "unbonding more than the excess",
+			delegations:        []int64{500, 100, 400},
+			amountToUnbond:     650,
+			expectedUnbondings: []int64{350, 100, 200},
+		},
+		{
+			// Targets: 250, 250, 500 - val1 and val2 each have an excess of 150
+			// The rounding remainder goes to val1 (ties are broken by address)
+			name:               "split across multiple overweight validators",
+			delegations:        []int64{400, 400, 200},
+			amountToUnbond:     101,
+			expectedUnbondings: []int64{51, 50, 0},
+		},
+		{
+			name:               "no overweight validators",
+			delegations:        []int64{250, 250, 500},
+			amountToUnbond:     100,
+			expectedUnbondings: []int64{25, 25, 50},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			hostZone := s.unbondingStrategyHostZone(stakeibctypes.UnbondingStrategy_REBALANCE, tc.delegations)
+
+			unbondings, err := s.App.StakeibcKeeper.GetTargetUnbondingsByValidator(s.Ctx, hostZone, sdkmath.NewInt(tc.amountToUnbond))
+			s.Require().NoError(err, "no error expected when getting unbondings")
+
+			totalUnbonded := sdkmath.ZeroInt()
+			for i, address := range []string{"val1", "val2", "val3"} {
+				s.Require().Equal(sdkmath.NewInt(tc.expectedUnbondings[i]), unbondings[address], "%s unbonding", address)
+				totalUnbonded = totalUnbonded.Add(unbondings[address])
+			}
+			s.Require().Equal(sdkmath.NewInt(tc.amountToUnbond), totalUnbonded, "total unbonded")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetTargetUnbondingsByValidator_TargetWeight() {
+	// With the target weight strategy, the current delegations are not considered
+	hostZone := s.unbondingStrategyHostZone(stakeibctypes.UnbondingStrategy_TARGET_WEIGHT, []int64{500, 100, 400})
+
+	unbondings, err := s.App.StakeibcKeeper.GetTargetUnbondingsByValidator(s.Ctx, hostZone, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected when getting unbondings")
+
+	s.Require().Equal(sdkmath.NewInt(25), unbondings["val1"], "val1 unbonding")
+	s.Require().Equal(sdkmath.NewInt(25), unbondings["val2"], "val2 unbonding")
+	s.Require().Equal(sdkmath.NewInt(50), unbondings["val3"], "val3 unbonding")
+}
+
+func (s *KeeperTestSuite) TestGetRebalancingUnbondingsByValidator_NoDelegations() {
+	hostZone := s.unbondingStrategyHostZone(stakeibctypes.UnbondingStrategy_REBALANCE, []int64{0, 0, 0})
+
+	_, err := s.App.StakeibcKeeper.GetTargetUnbondingsByValidator(s.Ctx, hostZone, sdkmath.NewInt(100))
+	s.Require().ErrorIs(err, stakeibctypes.ErrNoValidatorWeights)
+}
+
+func (s *KeeperTestSuite) TestGetHostZoneUnbondingMsgs_RebalanceStrategy() {
+	tc := s.SetupGetHostZoneUnbondingMsgs()
+
+	// With 7M delegated, the targets are 1.4M, 2.8M, 2.8M, and 0, leaving the first validator with a 1.6M excess
+	// The remaining 400k of the 2M unbonding is split 1:2:2
+	tc.hostZone.UnbondingStrategy = stakeibctypes.UnbondingStrategy_REBALANCE
+	tc.hostZone.Validators[0].DelegationAmt = sdkmath.NewInt(3_000_000)
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, tc.hostZone)
+
+	_, totalAmountToUnbond, callbackArgsBz, _, err := s.App.StakeibcKeeper.GetHostZoneUnbondingMsgs(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected when getting unbonding msgs")
+	s.Require().Equal(sdkmath.NewInt(2_000_000), totalAmountToUnbond, "total amount to unbond")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalUndelegateCallbackArgs(s.Ctx, callbackArgsBz)
+	s.Require().NoError(err, "no error expected when unmarshalling callback args")
+
+	expectedUnbondings := map[string]sdkmath.Int{
+		"cosmos_VALIDATOR_1": sdkmath.NewInt(1_680_000),
+		"cosmos_VALIDATOR_2": sdkmath.NewInt(160_000),
+		"cosmos_VALIDATOR_3": sdkmath.NewInt(160_000),
+	}
+	s.Require().Len(callbackArgs.SplitDelegations, len(expectedUnbondings), "number of split delegations")
+	for _, splitDelegation := range callbackArgs.SplitDelegations {
+		s.Require().Equal(expectedUnbondings[splitDelegation.Validator], splitDelegation.Amount, "%s unbonding", splitDelegation.Validator)
+	}
+}
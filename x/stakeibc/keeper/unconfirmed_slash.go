@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// SetUnconfirmedSlash set a specific unconfirmedSlash in the store from its index
+func (k Keeper) SetUnconfirmedSlash(ctx sdk.Context, unconfirmedSlash types.UnconfirmedSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnconfirmedSlashKeyPrefix))
+	b := k.cdc.MustMarshal(&unconfirmedSlash)
+	store.Set(types.UnconfirmedSlashKey(unconfirmedSlash.ChainId, unconfirmedSlash.ValidatorAddress), b)
+}
+
+// GetUnconfirmedSlash returns an unconfirmedSlash from its index
+func (k Keeper) GetUnconfirmedSlash(ctx sdk.Context, chainId string, validatorAddress string) (val types.UnconfirmedSlash, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnconfirmedSlashKeyPrefix))
+
+	b := store.Get(types.UnconfirmedSlashKey(chainId, validatorAddress))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveUnconfirmedSlash removes an unconfirmedSlash from the store
+func (k Keeper) RemoveUnconfirmedSlash(ctx sdk.Context, chainId string, validatorAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnconfirmedSlashKeyPrefix))
+	store.Delete(types.UnconfirmedSlashKey(chainId, validatorAddress))
+}
+
+// GetAllUnconfirmedSlashes returns all unconfirmedSlashes
+func (k Keeper) GetAllUnconfirmedSlashes(ctx sdk.Context) (list []types.UnconfirmedSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnconfirmedSlashKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UnconfirmedSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllHostZoneUnconfirmedSlashes returns all of a host zone's unconfirmedSlashes
+func (k Keeper) GetAllHostZoneUnconfirmedSlashes(ctx sdk.Context, chainId string) (list []types.UnconfirmedSlash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnconfirmedSlashKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.HostZoneKeyPrefix(chainId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UnconfirmedSlash
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Flags a delegation discrepancy as quarantined so that it can be investigated off-chain
+// The host zone's records are left untouched
+func (k Keeper) QuarantineSlash(ctx sdk.Context, unconfirmedSlash types.UnconfirmedSlash, reason string) {
+	k.Logger(ctx).Error(utils.LogWithHostZone(unconfirmedSlash.ChainId,
+		"Quarantining unconfirmed slash for validator %s (delegation in state: %v, delegation from ICQ: %v), reason: %s",
+		unconfirmedSlash.ValidatorAddress, unconfirmedSlash.DelegationAmt, unconfirmedSlash.QueriedDelegationAmt, reason))
+
+	unconfirmedSlash.Status = types.UnconfirmedSlash_QUARANTINED
+	k.SetUnconfirmedSlash(ctx, unconfirmedSlash)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashQuarantined,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, unconfirmedSlash.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, unconfirmedSlash.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, unconfirmedSlash.DelegationAmt.Sub(unconfirmedSlash.QueriedDelegationAmt).String()),
+			sdk.NewAttribute(types.AttributeKeySlashReason, reason),
+		),
+	)
+}
+
+// Updates the validator's weight and delegation, as well as the host zone's staked balance, to reflect a confirmed slash
+// The weight is reduced proportionally to the decrease in delegation
+func (k Keeper) ApplyValidatorSlash(ctx sdk.Context, hostZone types.HostZone, valIndex int64, delegatedTokens sdk.Int) error {
+	validator := *hostZone.Validators[valIndex]
+	slashAmount := validator.DelegationAmt.Sub(delegatedTokens)
+
+	weight, err := cast.ToInt64E(validator.Weight)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrIntCast, "unable to convert validator weight to int64, err: %s", err.Error())
+	}
+	deactivatedWeight, err := cast.ToInt64E(validator.DeactivatedWeight)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrIntCast, "unable to convert validator deactivated weight to int64, err: %s", err.Error())
+	}
+	weightAdjustment := sdk.NewDecFromInt(delegatedTokens).Quo(sdk.NewDecFromInt(validator.DelegationAmt))
+
+	validator.Weight = sdk.NewDec(weight).Mul(weightAdjustment).TruncateInt().Uint64()
+	validator.DeactivatedWeight = sdk.NewDec(deactivatedWeight).Mul(weightAdjustment).TruncateInt().Uint64()
+	validator.DelegationAmt = validator.DelegationAmt.Sub(slashAmount)
+
+	// Update the validator on the host zone
+	hostZone.StakedBal = hostZone.StakedBal.Sub(slashAmount)
+	hostZone.Validators[valIndex] = &validator
+	k.SetHostZone(ctx, hostZone)
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Delegation to %s updated to: %v, Weight updated to: %v", validator.Address, validator.DelegationAmt, validator.Weight))
+
+	return nil
+}
+
+// Applies a confirmed slash to the host zone's records, removes it from the store and emits a confirm_slash event
+func (k Keeper) ConfirmSlash(ctx sdk.Context, hostZone types.HostZone, valIndex int64, unconfirmedSlash types.UnconfirmedSlash) error {
+	if err := k.ApplyValidatorSlash(ctx, hostZone, valIndex, unconfirmedSlash.QueriedDelegationAmt); err != nil {
+		return err
+	}
+	k.RemoveUnconfirmedSlash(ctx, unconfirmedSlash.ChainId, unconfirmedSlash.ValidatorAddress)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashConfirmed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, unconfirmedSlash.ChainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, unconfirmedSlash.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeySlashAmount, unconfirmedSlash.DelegationAmt.Sub(unconfirmedSlash.QueriedDelegationAmt).String()),
+		),
+	)
+
+	return nil
+}
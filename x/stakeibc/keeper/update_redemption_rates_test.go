@@ -10,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/stretchr/testify/suite"
 
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
 	recordtypes "github.com/Stride-Labs/stride/v9/x/records/types"
 
 	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
@@ -79,6 +80,13 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 	// sanity check on inputs (check redemptionRate at genesis is 1)
 	s.Require().Equal(initialRedemptionRate, sdk.NewDec(1), "t0 rr")
 
+	// set the stride epoch so that the redemption rate is recorded in the history
+	strideEpochNumber := uint64(4)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier: epochtypes.STRIDE_EPOCH,
+		EpochNumber:     strideEpochNumber,
+	})
+
 	records := tc.allRecords
 	s.App.StakeibcKeeper.UpdateRedemptionRates(s.Ctx, records)
 
@@ -88,6 +96,10 @@ func (s *KeeperTestSuite) TestUpdateRedemptionRatesSuccessful() {
 
 	expectedNewRate := sdk.NewDec(5 + 3 + 3).Quo(sdk.NewDec(10))
 	s.Require().Equal(rrNew, expectedNewRate, "rr as expected")
+
+	rrRecord, found := s.App.StakeibcKeeper.GetRedemptionRateRecord(s.Ctx, tc.hostZone.ChainId, strideEpochNumber)
+	s.Require().True(found, "redemption rate record found")
+	s.Require().Equal(expectedNewRate, rrRecord.RedemptionRate, "redemption rate record")
 }
 
 func (s *KeeperTestSuite) TestUpdateRedemptionRatesRandomized() {
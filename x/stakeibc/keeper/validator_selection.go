@@ -33,8 +33,80 @@ func (k Keeper) GetValidatorDelegationAmtDifferences(ctx sdk.Context, hostZone t
 
 // This will get the target validator delegation for the given hostZone
 // such that the total validator delegation is equal to the finalDelegation
+// The liquid staked amount that users directed to each validator is added on top of the weight-based split
+// jailed or tombstoned validators are excluded and assigned a target of zero
 // output key is ADDRESS not NAME
 func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.HostZone, finalDelegation sdkmath.Int) (map[string]sdkmath.Int, error) {
+	directedAmts := make(map[string]sdkmath.Int)
+	for _, validator := range hostZone.Validators {
+		if validator.IsActive() {
+			directedAmts[validator.Address] = validator.GetDirectedDelegationAmt()
+		}
+	}
+	return k.addDirectedValAmts(ctx, hostZone, finalDelegation, directedAmts)
+}
+
+// Splits a new delegation across the host zone's validators
+// The directed amounts that have not yet been delegated are delegated first, and the remainder is split by weight
+func (k Keeper) GetDelegationValAmtsForHostZone(ctx sdk.Context, hostZone types.HostZone, delegationAmount sdkmath.Int) (map[string]sdkmath.Int, error) {
+	undelegatedDirectedAmts := make(map[string]sdkmath.Int)
+	for _, validator := range hostZone.Validators {
+		if validator.IsActive() {
+			undelegatedDirectedAmts[validator.Address] = validator.GetUndelegatedDirectedAmt()
+		}
+	}
+	return k.addDirectedValAmts(ctx, hostZone, delegationAmount, undelegatedDirectedAmts)
+}
+
+// Allocates each validator its directed amount and splits the remainder of the finalDelegation by weight
+// If the directed amounts exceed the finalDelegation, it's split in proportion to the directed amounts instead
+// (with any rounding remainder assigned to the validator with the largest directed amount)
+func (k Keeper) addDirectedValAmts(ctx sdk.Context, hostZone types.HostZone, finalDelegation sdkmath.Int, directedAmts map[string]sdkmath.Int) (map[string]sdkmath.Int, error) {
+	totalDirected := sdkmath.ZeroInt()
+	for _, address := range utils.StringMapKeys(directedAmts) { // DO NOT REMOVE: StringMapKeys fixes non-deterministic map iteration
+		totalDirected = totalDirected.Add(directedAmts[address])
+	}
+	if totalDirected.IsZero() || finalDelegation.IsZero() {
+		return k.GetWeightedValAmtsForHostZone(ctx, hostZone, finalDelegation)
+	}
+
+	if totalDirected.LT(finalDelegation) {
+		targetAmts, err := k.GetWeightedValAmtsForHostZone(ctx, hostZone, finalDelegation.Sub(totalDirected))
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range utils.StringMapKeys(directedAmts) {
+			targetAmts[address] = targetAmts[address].Add(directedAmts[address])
+		}
+		return targetAmts, nil
+	}
+
+	targetAmts := make(map[string]sdkmath.Int)
+	for _, validator := range hostZone.Validators {
+		targetAmts[validator.Address] = sdkmath.ZeroInt()
+	}
+	totalAllocated := sdkmath.ZeroInt()
+	largestDirectedValidator := ""
+	for _, address := range utils.StringMapKeys(directedAmts) {
+		directedAmt := directedAmts[address]
+		targetAmt := directedAmt.Mul(finalDelegation).Quo(totalDirected)
+		targetAmts[address] = targetAmt
+		totalAllocated = totalAllocated.Add(targetAmt)
+
+		if largestDirectedValidator == "" || directedAmt.GT(directedAmts[largestDirectedValidator]) {
+			largestDirectedValidator = address
+		}
+	}
+	targetAmts[largestDirectedValidator] = targetAmts[largestDirectedValidator].Add(finalDelegation.Sub(totalAllocated))
+
+	return targetAmts, nil
+}
+
+// Splits the finalDelegation across the host zone's validators by weight only, ignoring any directed amounts
+// This is used for amounts that are not tied to a liquid stake preference (e.g. unbondings)
+// jailed or tombstoned validators are excluded and assigned a target of zero
+// output key is ADDRESS not NAME
+func (k Keeper) GetWeightedValAmtsForHostZone(ctx sdk.Context, hostZone types.HostZone, finalDelegation sdkmath.Int) (map[string]sdkmath.Int, error) {
 	// Confirm the expected delegation amount is greater than 0
 	if finalDelegation.Equal(sdkmath.ZeroInt()) {
 		k.Logger(ctx).Error(fmt.Sprintf("Cannot calculate target delegation if final amount is 0 %s", hostZone.ChainId))
@@ -57,7 +129,7 @@ func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.Host
 	}
 
 	sort.SliceStable(validators, func(i, j int) bool { // Do not use `Slice` here, it is stochastic
-		return validators[i].Weight < validators[j].Weight
+		return validators[i].GetActiveWeight() < validators[j].GetActiveWeight()
 	})
 
 	// Assign each validator their portion of the delegation (and give any overflow to the last validator)
@@ -68,7 +140,7 @@ func (k Keeper) GetTargetValAmtsForHostZone(ctx sdk.Context, hostZone types.Host
 		if i == len(validators)-1 {
 			targetUnbondingsByValidator[validator.Address] = finalDelegation.Sub(totalAllocated)
 		} else {
-			delegateAmt := sdkmath.NewIntFromUint64(validator.Weight).Mul(finalDelegation).Quo(sdkmath.NewIntFromUint64(totalWeight))
+			delegateAmt := sdkmath.NewIntFromUint64(validator.GetActiveWeight()).Mul(finalDelegation).Quo(sdkmath.NewIntFromUint64(totalWeight))
 			totalAllocated = totalAllocated.Add(delegateAmt)
 			targetUnbondingsByValidator[validator.Address] = delegateAmt
 		}
@@ -90,7 +162,7 @@ func (k Keeper) GetTotalValidatorWeight(hostZone types.HostZone) uint64 {
 	validators := hostZone.GetValidators()
 	total_weight := uint64(0)
 	for _, validator := range validators {
-		total_weight += validator.Weight
+		total_weight += validator.GetActiveWeight()
 	}
 	return total_weight
 }
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+// Updates a validator's bond status, jailed flag, commission and tokens from the validator record queried on the host
+// If the validator was newly jailed, it is deactivated, and if it was unjailed (and not tombstoned), it is reactivated
+func (k Keeper) UpdateValidatorStatus(ctx sdk.Context, chainId string, validator *types.Validator, queriedValidator stakingtypes.Validator) {
+	wasJailed := validator.Jailed
+
+	commissionRate := queriedValidator.Commission.Rate
+	if commissionRate.IsNil() {
+		commissionRate = sdk.ZeroDec()
+	}
+
+	validator.Status = types.BondStatusFromHost(queriedValidator.Status)
+	validator.Jailed = queriedValidator.Jailed
+	validator.CommissionRate = &commissionRate
+	validator.Tokens = queriedValidator.Tokens
+
+	if validator.Jailed && !wasJailed {
+		validator.JailedCount++
+		k.DeactivateValidator(ctx, chainId, validator)
+	}
+	if !validator.Jailed && wasJailed && !validator.Tombstoned {
+		k.ReactivateValidator(ctx, chainId, validator)
+	}
+}
+
+// Deactivates a jailed or tombstoned validator by setting its weight to zero, so that it does not receive delegations
+// and its existing delegation is redelegated to the active validators at the start of the next stride epoch
+// The previous weight is stored on the validator so that it can be restored if the validator is unjailed
+func (k Keeper) DeactivateValidator(ctx sdk.Context, chainId string, validator *types.Validator) {
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+		"Deactivating validator %s (jailed: %v, tombstoned: %v), weight: %d", validator.Address, validator.Jailed, validator.Tombstoned, validator.Weight))
+
+	// If the validator was already deactivated (e.g. it was jailed before it was tombstoned), its weight is already stored
+	if validator.Weight > 0 {
+		validator.DeactivatedWeight = validator.Weight
+		validator.Weight = 0
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorInactive,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.Address),
+			sdk.NewAttribute(types.AttributeKeyValidatorStatus, validator.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(validator.Jailed)),
+			sdk.NewAttribute(types.AttributeKeyTombstoned, strconv.FormatBool(validator.Tombstoned)),
+		),
+	)
+}
+
+// Restores the weight that a validator had before it was deactivated, once it's been unjailed
+// The stored weight still counts towards the max number of validators while the validator is inactive,
+// so restoring it can't exceed the limit
+func (k Keeper) ReactivateValidator(ctx sdk.Context, chainId string, validator *types.Validator) {
+	k.Logger(ctx).Info(utils.LogWithHostZone(chainId,
+		"Reactivating validator %s, weight: %d", validator.Address, validator.DeactivatedWeight))
+
+	validator.Weight = validator.DeactivatedWeight
+	validator.DeactivatedWeight = 0
+}
+
+// Submits an ICQ for the validator record of each validator on each host zone, to keep their status up to date
+// If the host zone has a weight policy, each validator's signing info is also queried to track its uptime
+func (k Keeper) QueryAllValidatorStatuses(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		for _, validator := range hostZone.Validators {
+			if err := k.QueryValidatorStatusIcq(ctx, hostZone, validator.Address); err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+					"Unable to submit validator status ICQ for %s, err: %s", validator.Address, err.Error()))
+			}
+
+			if hostZone.ValidatorWeightPolicy == nil || validator.ConsensusAddress == "" {
+				continue
+			}
+			if err := k.QuerySigningInfoIcq(ctx, hostZone, validator.ConsensusAddress, ICQCallbackID_ValidatorUptime); err != nil {
+				k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+					"Unable to submit validator uptime ICQ for %s, err: %s", validator.Address, err.Error()))
+			}
+		}
+	}
+}
+
+// Redelegates the full delegation of each jailed or tombstoned validator to the active validators, proportionally to their weights
+func (k Keeper) RedelegateFromInactiveValidators(ctx sdk.Context, hostZone types.HostZone) error {
+	// Collect the inactive validators before building the redelegations, since determining the
+	// target amounts sorts the host zone's validators in place
+	// Validators that have an incoming redelegation pending on the host can't be redelegated from until it matures
+	// Validators with a redelegation that is still awaiting its ack are also skipped, so that it's not resubmitted
+	constraints := NewRedelegationConstraints(k.GetActivePendingRedelegations(ctx, hostZone.ChainId))
+	inactiveValidators := []types.Validator{}
+	for _, validator := range hostZone.Validators {
+		if validator.IsActive() || !validator.DelegationAmt.IsPositive() {
+			continue
+		}
+		if k.HasInFlightRedelegation(ctx, hostZone.ChainId, validator.Address) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Skipping redelegation from inactive validator %s until its previous redelegation is acknowledged", validator.Address))
+			continue
+		}
+		if !constraints.CanRedelegateFrom(validator.Address) {
+			k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+				"Skipping redelegation from inactive validator %s until its incoming redelegations complete", validator.Address))
+			continue
+		}
+		inactiveValidators = append(inactiveValidators, *validator)
+	}
+	if len(inactiveValidators) == 0 {
+		return nil
+	}
+
+	delegationIca := hostZone.DelegationAccount
+	if delegationIca == nil || delegationIca.Address == "" {
+		return errorsmod.Wrapf(types.ErrICAAccountNotFound, "no delegation account found for %s", hostZone.ChainId)
+	}
+
+	msgs := []sdk.Msg{}
+	rebalanceCallback := types.RebalanceCallback{
+		HostZoneId:   hostZone.ChainId,
+		Rebalancings: []*types.Rebalancing{},
+	}
+	totalRedelegated := sdkmath.ZeroInt()
+	for _, srcValidator := range inactiveValidators {
+		targetAmts, err := k.GetWeightedValAmtsForHostZone(ctx, hostZone, srcValidator.DelegationAmt)
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to determine redelegation targets for validator %s", srcValidator.Address)
+		}
+
+		// DO NOT REMOVE: StringMapKeys fixes non-deterministic map iteration
+		for _, dstValidatorAddress := range utils.StringMapKeys(targetAmts) {
+			amount := targetAmts[dstValidatorAddress]
+			if amount.IsZero() {
+				continue
+			}
+			if !constraints.CanRedelegate(srcValidator.Address, dstValidatorAddress) {
+				k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+					"Skipping redelegation from %s to %s, max pending redelegation entries reached", srcValidator.Address, dstValidatorAddress))
+				continue
+			}
+			msgs = append(msgs, &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    delegationIca.Address,
+				ValidatorSrcAddress: srcValidator.Address,
+				ValidatorDstAddress: dstValidatorAddress,
+				Amount:              sdk.NewCoin(hostZone.HostDenom, amount),
+			})
+			rebalanceCallback.Rebalancings = append(rebalanceCallback.Rebalancings, &types.Rebalancing{
+				SrcValidator: srcValidator.Address,
+				DstValidator: dstValidatorAddress,
+				Amt:          amount,
+			})
+			totalRedelegated = totalRedelegated.Add(amount)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	marshalledCallbackArgs, err := k.MarshalRebalanceCallbackArgs(ctx, rebalanceCallback)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+		"Redelegating %v%s from %d inactive validators", totalRedelegated, hostZone.HostDenom, len(inactiveValidators)))
+	if _, err := k.SubmitTxsStrideEpoch(ctx, hostZone.ConnectionId, msgs, *delegationIca, ICACallbackID_Rebalance, marshalledCallbackArgs); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "Failed to SubmitTxs for %s, %s, %s", hostZone.ConnectionId, hostZone.ChainId, err.Error())
+	}
+	k.RecordInFlightRedelegations(ctx, hostZone.ChainId, rebalanceCallback.Rebalancings)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRebalanceInactive,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, hostZone.ChainId),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRedelegated.String()),
+		),
+	)
+
+	return nil
+}
+
+// Redelegates out of any jailed or tombstoned validators on each host zone
+// Host zones that are being sunset are skipped, since their delegations are being unbonded
+func (k Keeper) RedelegateFromAllInactiveValidators(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+			continue
+		}
+		if err := k.RedelegateFromInactiveValidators(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to redelegate from inactive validators for host zone %s, err: %s", hostZone.ChainId, err.Error()))
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icatypes "github.com/cosmos/ibc-go/v5/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	epochtypes "github.com/Stride-Labs/stride/v9/x/epochs/types"
+	icacallbackstypes "github.com/Stride-Labs/stride/v9/x/icacallbacks/types"
+	icqtypes "github.com/Stride-Labs/stride/v9/x/interchainquery/types"
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func (s *KeeperTestSuite) checkValidatorDeactivatedEvent(validatorAddress string) {
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != stakeibctypes.EventTypeValidatorInactive {
+			continue
+		}
+		numEvents++
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == stakeibctypes.AttributeKeyValidator {
+				s.Require().Equal(validatorAddress, string(attribute.Value), "deactivated validator")
+			}
+		}
+	}
+	s.Require().Equal(1, numEvents, "number of validator deactivated events")
+}
+
+func (s *KeeperTestSuite) SetupValidatorStatusCallback() stakeibctypes.HostZone {
+	hostZone := stakeibctypes.HostZone{
+		ChainId: HostChainId,
+		Validators: []*stakeibctypes.Validator{
+			{Address: "valoper1", Weight: 10, DelegationAmt: sdkmath.NewInt(1000)},
+			{Address: "valoper2", Weight: 20, DelegationAmt: sdkmath.NewInt(2000)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	return hostZone
+}
+
+func (s *KeeperTestSuite) CreateValidatorStatusQueryResponse(address string, jailed bool, status stakingtypes.BondStatus) []byte {
+	validator := stakingtypes.Validator{
+		OperatorAddress: address,
+		Jailed:          jailed,
+		Status:          status,
+		Tokens:          sdkmath.NewInt(1000),
+		DelegatorShares: sdk.NewDec(1000),
+		Commission:      stakingtypes.NewCommission(sdk.MustNewDecFromStr("0.05"), sdk.OneDec(), sdk.OneDec()),
+	}
+	return s.App.RecordsKeeper.Cdc.MustMarshal(&validator)
+}
+
+func (s *KeeperTestSuite) TestValidatorStatusCallback_Jailed() {
+	s.SetupValidatorStatusCallback()
+
+	callbackArgs := s.CreateValidatorStatusQueryResponse("valoper2", true, stakingtypes.Unbonding)
+	err := stakeibckeeper.ValidatorStatusCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "validator status callback error")
+
+	// The jailed validator should be inactive (with its weight stored), while the other validator is untouched
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+
+	jailedValidator := hostZone.Validators[1]
+	s.Require().True(jailedValidator.Jailed, "validator jailed")
+	s.Require().Equal(stakeibctypes.Validator_UNBONDING, jailedValidator.Status, "validator status")
+	s.Require().Equal(uint64(0), jailedValidator.Weight, "jailed validator weight")
+	s.Require().Equal(uint64(20), jailedValidator.DeactivatedWeight, "jailed validator deactivated weight")
+	s.Require().Equal(int64(2000), jailedValidator.DelegationAmt.Int64(), "jailed validator delegation should not change")
+	s.Require().Equal(uint64(10), hostZone.Validators[0].Weight, "other validator weight")
+
+	// The validator's commission and tokens should be stored, and the jailing counted
+	s.Require().Equal("0.050000000000000000", jailedValidator.CommissionRate.String(), "validator commission")
+	s.Require().Equal(int64(1000), jailedValidator.Tokens.Int64(), "validator tokens")
+	s.Require().Equal(uint64(1), jailedValidator.JailedCount, "validator jailed count")
+
+	s.checkValidatorDeactivatedEvent("valoper2")
+}
+
+func (s *KeeperTestSuite) TestValidatorStatusCallback_Unjailed() {
+	hostZone := s.SetupValidatorStatusCallback()
+
+	// Mark the validator as previously jailed, with its weight stored
+	hostZone.Validators[1].Jailed = true
+	hostZone.Validators[1].Weight = 0
+	hostZone.Validators[1].DeactivatedWeight = 20
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	callbackArgs := s.CreateValidatorStatusQueryResponse("valoper2", false, stakingtypes.Bonded)
+	err := stakeibckeeper.ValidatorStatusCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "validator status callback error")
+
+	// The jailed flag should be cleared, which restores the validator's weight
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().False(hostZone.Validators[1].Jailed, "validator jailed")
+	s.Require().Equal(stakeibctypes.Validator_BONDED, hostZone.Validators[1].Status, "validator status")
+	s.Require().Equal(uint64(20), hostZone.Validators[1].Weight, "validator weight")
+	s.Require().Equal(uint64(0), hostZone.Validators[1].DeactivatedWeight, "validator deactivated weight")
+	s.Require().Equal(uint64(0), hostZone.Validators[1].JailedCount, "jailed count should only increase when jailed")
+
+	for _, event := range s.Ctx.EventManager().Events() {
+		s.Require().NotEqual(stakeibctypes.EventTypeValidatorInactive, event.Type, "no deactivation event expected")
+	}
+}
+
+func (s *KeeperTestSuite) TestValidatorStatusCallback_UnjailedWhileTombstoned() {
+	hostZone := s.SetupValidatorStatusCallback()
+
+	// Mark the validator as previously jailed and tombstoned
+	hostZone.Validators[1].Jailed = true
+	hostZone.Validators[1].Tombstoned = true
+	hostZone.Validators[1].Weight = 0
+	hostZone.Validators[1].DeactivatedWeight = 20
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	callbackArgs := s.CreateValidatorStatusQueryResponse("valoper2", false, stakingtypes.Unbonded)
+	err := stakeibckeeper.ValidatorStatusCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "validator status callback error")
+
+	// A tombstoned validator can't rejoin the active set, so its weight should not be restored
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().False(hostZone.Validators[1].Jailed, "validator jailed")
+	s.Require().Equal(uint64(0), hostZone.Validators[1].Weight, "validator weight")
+	s.Require().Equal(uint64(20), hostZone.Validators[1].DeactivatedWeight, "validator deactivated weight")
+}
+
+func (s *KeeperTestSuite) TestChangeValidatorWeight_InactiveValidator() {
+	hostZone := s.SetupValidatorStatusCallback()
+
+	// Deactivate the second validator
+	hostZone.Validators[1].Jailed = true
+	hostZone.Validators[1].Weight = 0
+	hostZone.Validators[1].DeactivatedWeight = 20
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	_, err := s.GetMsgServer().ChangeValidatorWeight(sdk.WrapSDKContext(s.Ctx), &stakeibctypes.MsgChangeValidatorWeight{
+		HostZone: HostChainId,
+		ValAddr:  "valoper2",
+		Weight:   30,
+	})
+	s.Require().NoError(err, "no error expected when changing weight")
+
+	// The new weight should only be applied once the validator is reactivated
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(0), hostZone.Validators[1].Weight, "validator weight")
+	s.Require().Equal(uint64(30), hostZone.Validators[1].DeactivatedWeight, "validator deactivated weight")
+
+	callbackArgs := s.CreateValidatorStatusQueryResponse("valoper2", false, stakingtypes.Bonded)
+	err = stakeibckeeper.ValidatorStatusCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().NoError(err, "validator status callback error")
+
+	hostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(30), hostZone.Validators[1].Weight, "validator weight after unjailing")
+	s.Require().Equal(uint64(0), hostZone.Validators[1].DeactivatedWeight, "validator deactivated weight after unjailing")
+}
+
+func (s *KeeperTestSuite) TestValidatorStatusCallback_ValidatorNotFound() {
+	s.SetupValidatorStatusCallback()
+
+	callbackArgs := s.CreateValidatorStatusQueryResponse("fake_val", true, stakingtypes.Unbonding)
+	err := stakeibckeeper.ValidatorStatusCallback(s.App.StakeibcKeeper, s.Ctx, callbackArgs, icqtypes.Query{ChainId: HostChainId})
+	s.Require().EqualError(err, "no registered validator for address (fake_val): validator not found")
+}
+
+func (s *KeeperTestSuite) TestGetTargetValAmtsForHostZone_ExcludesInactiveValidators() {
+	hostZone := stakeibctypes.HostZone{
+		ChainId: HostChainId,
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", Weight: 1},
+			{Address: "val2", Weight: 100, Jailed: true},
+			{Address: "val3", Weight: 3},
+			{Address: "val4", Weight: 100, Tombstoned: true},
+		},
+	}
+
+	targets, err := s.App.StakeibcKeeper.GetTargetValAmtsForHostZone(s.Ctx, hostZone, sdkmath.NewInt(100))
+	s.Require().NoError(err, "no error expected when getting targets")
+
+	s.Require().Equal(int64(25), targets["val1"].Int64(), "val1 target")
+	s.Require().Equal(int64(0), targets["val2"].Int64(), "val2 target")
+	s.Require().Equal(int64(75), targets["val3"].Int64(), "val3 target")
+	s.Require().Equal(int64(0), targets["val4"].Int64(), "val4 target")
+}
+
+func (s *KeeperTestSuite) TestRedelegateFromInactiveValidators() {
+	tc := s.SetupRebalanceValidators()
+
+	// Jail val2, which has a delegation of 500
+	hostZone := tc.hostZone
+	hostZone.Validators[1].Jailed = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before redelegation")
+
+	err := s.App.StakeibcKeeper.RedelegateFromInactiveValidators(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when redelegating")
+
+	// Confirm the full delegation is redelegated from val2 to each of the other validators
+	callbackKey := icacallbackstypes.PacketID(portId, tc.delegationChannel, startSequence)
+	callbackData, found := s.App.StakeibcKeeper.ICACallbacksKeeper.GetCallbackData(s.Ctx, callbackKey)
+	s.Require().True(found, "callback should exist")
+	s.Require().Equal(stakeibckeeper.ICACallbackID_Rebalance, callbackData.CallbackId, "callback ID")
+
+	callbackArgs, err := s.App.StakeibcKeeper.UnmarshalRebalanceCallbackArgs(s.Ctx, callbackData.CallbackArgs)
+	s.Require().NoError(err, "unmarshalling callback args error")
+	s.Require().Len(callbackArgs.Rebalancings, 4, "one redelegation to each active validator")
+
+	totalRedelegated := sdkmath.ZeroInt()
+	for _, rebalancing := range callbackArgs.Rebalancings {
+		s.Require().Equal("stride_VAL2", rebalancing.SrcValidator, "source validator")
+		s.Require().NotEqual("stride_VAL2", rebalancing.DstValidator, "destination validator")
+		totalRedelegated = totalRedelegated.Add(rebalancing.Amt)
+	}
+	s.Require().Equal(int64(500), totalRedelegated.Int64(), "total redelegated")
+
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeRebalanceInactive {
+			numEvents++
+		}
+	}
+	s.Require().Equal(1, numEvents, "number of rebalance inactive events")
+
+	// The redelegations should be tracked as in flight until they're acknowledged
+	s.Require().True(s.App.StakeibcKeeper.HasInFlightRedelegation(s.Ctx, HostChainId, "stride_VAL2"), "redelegation in flight")
+
+	// The redelegation should not be resubmitted in the next epoch while it's awaiting its ack
+	err = s.App.StakeibcKeeper.RedelegateFromInactiveValidators(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when redelegating again")
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found after redelegation")
+	s.Require().Equal(startSequence+1, endSequence, "only one redelegation tx should be submitted")
+
+	// Once the redelegation times out, it's no longer in flight and can be retried
+	ackResponse := icacallbackstypes.AcknowledgementResponse{Status: icacallbackstypes.AckResponseStatus_TIMEOUT}
+	err = stakeibckeeper.RebalanceCallback(s.App.StakeibcKeeper, s.Ctx, channeltypes.Packet{}, &ackResponse, callbackData.CallbackArgs)
+	s.Require().NoError(err, "rebalance callback error")
+	s.Require().False(s.App.StakeibcKeeper.HasInFlightRedelegation(s.Ctx, HostChainId, "stride_VAL2"), "redelegation no longer in flight")
+	s.Require().Len(s.App.StakeibcKeeper.GetAllPendingRedelegations(s.Ctx), 0, "no pending redelegations")
+}
+
+func (s *KeeperTestSuite) TestRedelegateFromInactiveValidators_NoInactiveValidators() {
+	tc := s.SetupRebalanceValidators()
+
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before redelegation")
+
+	err := s.App.StakeibcKeeper.RedelegateFromInactiveValidators(s.Ctx, tc.hostZone)
+	s.Require().NoError(err, "no error expected when redelegating")
+
+	// No ICA should have been submitted
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found after redelegation")
+	s.Require().Equal(startSequence, endSequence, "sequence number should not change")
+}
+
+func (s *KeeperTestSuite) TestQueryAllValidatorStatuses() {
+	s.CreateTransferChannel(HostChainId)
+
+	// These must be valid addresses, otherwise the bech decoding will fail
+	hostZone := stakeibctypes.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: ibctesting.FirstConnectionID,
+		Validators: []*stakeibctypes.Validator{
+			{Address: "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrdt795p"},
+			{Address: "cosmosvaloper133lfs9gcpxqj6er3kx605e3v9lqp2pg5syhvsz"},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000),
+	})
+
+	s.App.StakeibcKeeper.QueryAllValidatorStatuses(s.Ctx)
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 2, "one query per validator")
+	for _, query := range queries {
+		s.Require().Equal(stakeibckeeper.ICQCallbackID_ValidatorStatus, query.CallbackId, "query callback ID")
+		s.Require().Equal(icqtypes.STAKING_STORE_QUERY_WITH_PROOF, query.QueryType, "query type")
+	}
+}
+
+func (s *KeeperTestSuite) TestQueryAllValidatorStatuses_WithWeightPolicy() {
+	s.CreateTransferChannel(HostChainId)
+
+	// Only the validator with a known consensus address should have its uptime queried
+	hostZone := stakeibctypes.HostZone{
+		ChainId:      HostChainId,
+		ConnectionId: ibctesting.FirstConnectionID,
+		Validators: []*stakeibctypes.Validator{
+			{Address: "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrdt795p", ConsensusAddress: s.CreateConsensusAddress("val1")},
+			{Address: "cosmosvaloper133lfs9gcpxqj6er3kx605e3v9lqp2pg5syhvsz"},
+		},
+		ValidatorWeightPolicy: &stakeibctypes.ValidatorWeightPolicy{
+			MaxCommissionRate:   sdk.OneDec(),
+			MaxVotingPowerShare: sdk.OneDec(),
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.App.StakeibcKeeper.SetEpochTracker(s.Ctx, stakeibctypes.EpochTracker{
+		EpochIdentifier:    epochtypes.STRIDE_EPOCH,
+		EpochNumber:        1,
+		NextEpochStartTime: uint64(s.Coordinator.CurrentTime.UnixNano() + 30_000_000_000),
+	})
+
+	s.App.StakeibcKeeper.QueryAllValidatorStatuses(s.Ctx)
+
+	queries := s.App.InterchainqueryKeeper.AllQueries(s.Ctx)
+	s.Require().Len(queries, 3, "one status query per validator and one uptime query")
+
+	uptimeQueries := 0
+	for _, query := range queries {
+		if query.CallbackId == stakeibckeeper.ICQCallbackID_ValidatorUptime {
+			s.Require().Equal(icqtypes.SLASHING_STORE_QUERY_WITH_PROOF, query.QueryType, "query type")
+			uptimeQueries++
+		}
+	}
+	s.Require().Equal(1, uptimeQueries, "number of uptime queries")
+}
+
+func (s *KeeperTestSuite) TestRedelegateFromInactiveValidators_IncomingRedelegationPending() {
+	tc := s.SetupRebalanceValidators()
+
+	// Jail val2, which has an incoming redelegation that has not yet matured
+	hostZone := tc.hostZone
+	hostZone.Validators[1].Jailed = true
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+	s.AddPendingRedelegation("stride_VAL1", "stride_VAL2", s.Ctx.BlockTime().Add(time.Hour))
+
+	portId := icatypes.PortPrefix + "GAIA.DELEGATION"
+	startSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found before redelegation")
+
+	err := s.App.StakeibcKeeper.RedelegateFromInactiveValidators(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when redelegating")
+
+	// The redelegation should be deferred until the incoming redelegation completes
+	endSequence, found := s.App.IBCKeeper.ChannelKeeper.GetNextSequenceSend(s.Ctx, portId, tc.delegationChannel)
+	s.Require().True(found, "sequence number not found after redelegation")
+	s.Require().Equal(startSequence, endSequence, "sequence number should not change")
+}
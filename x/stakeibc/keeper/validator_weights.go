@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+const (
+	WeightReasonInactive           = "validator is jailed or tombstoned"
+	WeightReasonAwaitingData       = "awaiting validator data from host"
+	WeightReasonCommission         = "commission rate exceeds maximum"
+	WeightReasonVotingPower        = "voting power share exceeds maximum"
+	WeightReasonJailedCount        = "validator has been jailed too many times"
+	WeightReasonMissedBlocks       = "missed blocks exceed maximum"
+	WeightReasonValidatorSetFull   = "validator set is full"
+	WeightReasonCommissionAdjusted = "weight derived from commission rate"
+)
+
+// The weight given to a validator with zero commission, which decreases linearly as commission increases
+const MaxPolicyValidatorWeight = 100
+
+// Derives each validator's weight from the host zone's weight policy, using the validator data queried from the host
+//
+// Validators that are inactive, or that breach one of the policy's thresholds, are excluded and their weight is
+// zeroed immediately. The remaining validators target a weight proportional to the share of rewards they pass on
+// to delegators (i.e. 1 - commission), and move towards that target by at most the policy's max weight change each epoch.
+// If more validators are eligible than the safety max number of validators, those with the lowest targets are excluded
+func (k Keeper) ComputeValidatorWeights(ctx sdk.Context, hostZone types.HostZone) ([]types.ValidatorWeightExplanation, error) {
+	policy := hostZone.ValidatorWeightPolicy
+	if policy == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidValidatorWeightPolicy, "no validator weight policy for host zone %s", hostZone.ChainId)
+	}
+
+	// Voting power is measured as a share of the total tokens bonded on the host (queried from the host's bonded pool)
+	var bondedTokens *sdkmath.Int
+	if hostZone.HostStakingParams != nil && hostZone.HostStakingParams.BondedTokens != nil && hostZone.HostStakingParams.BondedTokens.IsPositive() {
+		bondedTokens = hostZone.HostStakingParams.BondedTokens
+	}
+
+	explanations := make([]types.ValidatorWeightExplanation, len(hostZone.Validators))
+	eligible := []int{}
+	for i, validator := range hostZone.Validators {
+		votingPowerShare := sdk.ZeroDec()
+		if bondedTokens != nil && !validator.Tokens.IsNil() {
+			votingPowerShare = sdk.NewDecFromInt(validator.Tokens).Quo(sdk.NewDecFromInt(*bondedTokens))
+		}
+
+		explanation := types.ValidatorWeightExplanation{
+			ValidatorAddress:    validator.Address,
+			CurrentWeight:       validator.Weight,
+			CommissionRate:      validator.CommissionRate,
+			VotingPowerShare:    votingPowerShare,
+			JailedCount:         validator.JailedCount,
+			MissedBlocksCounter: validator.MissedBlocksCounter,
+		}
+
+		switch {
+		case !validator.IsActive():
+			explanation.Excluded = true
+			explanation.Reason = WeightReasonInactive
+		case validator.CommissionRate == nil:
+			// Until the validator has been queried, its weight is left unchanged
+			explanation.TargetWeight = validator.Weight
+			explanation.Reason = WeightReasonAwaitingData
+		case validator.CommissionRate.GT(policy.MaxCommissionRate):
+			explanation.Excluded = true
+			explanation.Reason = WeightReasonCommission
+		case validator.JailedCount > policy.MaxJailedCount:
+			explanation.Excluded = true
+			explanation.Reason = WeightReasonJailedCount
+		case validator.MissedBlocksCounter > policy.MaxMissedBlocks:
+			explanation.Excluded = true
+			explanation.Reason = WeightReasonMissedBlocks
+		case bondedTokens == nil:
+			// Until the host's bonded tokens have been queried, the voting power share can't be checked
+			explanation.TargetWeight = validator.Weight
+			explanation.Reason = WeightReasonAwaitingData
+		case votingPowerShare.GT(policy.MaxVotingPowerShare):
+			explanation.Excluded = true
+			explanation.Reason = WeightReasonVotingPower
+		default:
+			explanation.TargetWeight = sdk.OneDec().Sub(*validator.CommissionRate).MulInt64(MaxPolicyValidatorWeight).TruncateInt().Uint64()
+			explanation.Reason = WeightReasonCommissionAdjusted
+		}
+
+		if !explanation.Excluded && explanation.TargetWeight > 0 {
+			eligible = append(eligible, i)
+		}
+		explanations[i] = explanation
+	}
+
+	// If there are more eligible validators than allowed, exclude those with the lowest target weight
+	// Ties are broken by address so that the selection is deterministic
+	maxNumValidators := k.GetParam(ctx, types.KeySafetyNumValidators)
+	if uint64(len(eligible)) > maxNumValidators {
+		sort.SliceStable(eligible, func(i, j int) bool {
+			left, right := explanations[eligible[i]], explanations[eligible[j]]
+			if left.TargetWeight != right.TargetWeight {
+				return left.TargetWeight > right.TargetWeight
+			}
+			return left.ValidatorAddress < right.ValidatorAddress
+		})
+		for _, i := range eligible[maxNumValidators:] {
+			explanations[i].Excluded = true
+			explanations[i].TargetWeight = 0
+			explanations[i].Reason = WeightReasonValidatorSetFull
+		}
+	}
+
+	// Excluded validators are removed immediately, while the rest move towards their target by at most the max change
+	for i, explanation := range explanations {
+		if explanation.Excluded {
+			explanations[i].TargetWeight = 0
+			explanations[i].NextWeight = 0
+			continue
+		}
+		explanations[i].NextWeight = boundWeightChange(explanation.CurrentWeight, explanation.TargetWeight, policy.MaxWeightChange)
+	}
+
+	return explanations, nil
+}
+
+// Moves the current weight towards the target by at most maxChange (where 0 indicates no bound)
+func boundWeightChange(current, target, maxChange uint64) uint64 {
+	if maxChange == 0 {
+		return target
+	}
+	if target > current && target-current > maxChange {
+		return current + maxChange
+	}
+	if current > target && current-target > maxChange {
+		return current - maxChange
+	}
+	return target
+}
+
+// Applies the weights derived from the host zone's weight policy to each validator
+// If every validator would be left with zero weight, the previous weights are kept, since
+// there would otherwise be no validator to delegate to
+func (k Keeper) UpdateValidatorWeights(ctx sdk.Context, hostZone types.HostZone) error {
+	explanations, err := k.ComputeValidatorWeights(ctx, hostZone)
+	if err != nil {
+		return err
+	}
+
+	totalNextWeight := uint64(0)
+	for _, explanation := range explanations {
+		totalNextWeight += explanation.NextWeight
+	}
+	if len(explanations) > 0 && totalNextWeight == 0 {
+		k.Logger(ctx).Error(utils.LogWithHostZone(hostZone.ChainId,
+			"Weight policy would set every validator's weight to zero, keeping the previous weights"))
+		return nil
+	}
+
+	for i, explanation := range explanations {
+		validator := hostZone.Validators[i]
+		if validator.Weight == explanation.NextWeight {
+			continue
+		}
+
+		k.Logger(ctx).Info(utils.LogWithHostZone(hostZone.ChainId,
+			"Updating weight of validator %s from %d to %d (target: %d), reason: %s",
+			validator.Address, validator.Weight, explanation.NextWeight, explanation.TargetWeight, explanation.Reason))
+		k.emitValidatorWeightEvent(ctx, hostZone.ChainId, *validator, explanation.NextWeight, explanation.Reason)
+
+		validator.Weight = explanation.NextWeight
+	}
+
+	k.SetHostZone(ctx, hostZone)
+	return nil
+}
+
+// Recomputes the validator weights of each host zone that has a weight policy
+// Host zones that are being sunset are skipped, since their delegations are being unbonded
+func (k Keeper) UpdateAllValidatorWeights(ctx sdk.Context) {
+	for _, hostZone := range k.GetAllActiveHostZone(ctx) {
+		if hostZone.ValidatorWeightPolicy == nil || hostZone.SunsetStatus != types.SunsetStatus_SUNSET_NONE {
+			continue
+		}
+		if err := k.UpdateValidatorWeights(ctx, hostZone); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("Unable to update validator weights for host zone %s, err: %s", hostZone.ChainId, err.Error()))
+		}
+	}
+}
+
+// Emits an event for a change in a validator's weight
+func (k Keeper) emitValidatorWeightEvent(ctx sdk.Context, chainId string, validator types.Validator, weight uint64, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorWeight,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyHostZone, chainId),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.Address),
+			sdk.NewAttribute(types.AttributeKeyPreviousWeight, strconv.FormatUint(validator.Weight, 10)),
+			sdk.NewAttribute(types.AttributeKeyWeight, strconv.FormatUint(weight, 10)),
+			sdk.NewAttribute(types.AttributeKeyWeightReason, reason),
+		),
+	)
+}
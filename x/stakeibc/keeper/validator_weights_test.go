@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stakeibckeeper "github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
+	stakeibctypes "github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+type ExpectedValidatorWeight struct {
+	target   uint64
+	next     uint64
+	excluded bool
+	reason   string
+}
+
+func commissionRate(rate string) *sdk.Dec {
+	dec := sdk.MustNewDecFromStr(rate)
+	return &dec
+}
+
+func hostBondedTokens(amount int64) *stakeibctypes.HostStakingParams {
+	bondedTokens := sdkmath.NewInt(amount)
+	return &stakeibctypes.HostStakingParams{BondedTokens: &bondedTokens}
+}
+
+func (s *KeeperTestSuite) SetupValidatorWeightPolicy() (stakeibctypes.HostZone, map[string]ExpectedValidatorWeight) {
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostStakingParams: hostBondedTokens(19000),
+		ValidatorWeightPolicy: &stakeibctypes.ValidatorWeightPolicy{
+			MaxCommissionRate:   sdk.MustNewDecFromStr("0.10"),
+			MaxVotingPowerShare: sdk.MustNewDecFromStr("0.5"),
+			MaxJailedCount:      2,
+			MaxMissedBlocks:     500,
+			MaxWeightChange:     10,
+		},
+		Validators: []*stakeibctypes.Validator{
+			// Eligible, but can only increase by the max weight change
+			{Address: "val1", Weight: 50, CommissionRate: commissionRate("0.05"), Tokens: sdkmath.NewInt(1000)},
+			// Commission is above the max
+			{Address: "val2", Weight: 40, CommissionRate: commissionRate("0.20"), Tokens: sdkmath.NewInt(1000)},
+			// Holds 10,000 of the 19,000 tokens bonded on the host
+			{Address: "val3", Weight: 40, CommissionRate: commissionRate("0.05"), Tokens: sdkmath.NewInt(10000)},
+			// Jailed too many times
+			{Address: "val4", Weight: 40, CommissionRate: commissionRate("0.05"), Tokens: sdkmath.NewInt(1000), JailedCount: 3},
+			// Missed too many blocks
+			{Address: "val5", Weight: 40, CommissionRate: commissionRate("0.05"), Tokens: sdkmath.NewInt(1000), MissedBlocksCounter: 600},
+			// Currently jailed
+			{Address: "val6", Weight: 40, CommissionRate: commissionRate("0.05"), Tokens: sdkmath.NewInt(1000), Jailed: true},
+			// Not yet queried
+			{Address: "val7", Weight: 30, Tokens: sdkmath.NewInt(1000)},
+			// Commission at the max, within the max weight change of its target
+			{Address: "val8", Weight: 95, CommissionRate: commissionRate("0.10"), Tokens: sdkmath.NewInt(1000)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	expectedWeights := map[string]ExpectedValidatorWeight{
+		"val1": {target: 95, next: 60, reason: stakeibckeeper.WeightReasonCommissionAdjusted},
+		"val2": {excluded: true, reason: stakeibckeeper.WeightReasonCommission},
+		"val3": {excluded: true, reason: stakeibckeeper.WeightReasonVotingPower},
+		"val4": {excluded: true, reason: stakeibckeeper.WeightReasonJailedCount},
+		"val5": {excluded: true, reason: stakeibckeeper.WeightReasonMissedBlocks},
+		"val6": {excluded: true, reason: stakeibckeeper.WeightReasonInactive},
+		"val7": {target: 30, next: 30, reason: stakeibckeeper.WeightReasonAwaitingData},
+		"val8": {target: 90, next: 90, reason: stakeibckeeper.WeightReasonCommissionAdjusted},
+	}
+
+	return hostZone, expectedWeights
+}
+
+func (s *KeeperTestSuite) TestComputeValidatorWeights() {
+	hostZone, expectedWeights := s.SetupValidatorWeightPolicy()
+
+	explanations, err := s.App.StakeibcKeeper.ComputeValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when computing weights")
+	s.Require().Len(explanations, len(hostZone.Validators), "number of explanations")
+
+	for i, explanation := range explanations {
+		validator := hostZone.Validators[i]
+		expected := expectedWeights[validator.Address]
+
+		s.Require().Equal(validator.Address, explanation.ValidatorAddress, "validator address")
+		s.Require().Equal(validator.Weight, explanation.CurrentWeight, "%s current weight", validator.Address)
+		s.Require().Equal(expected.target, explanation.TargetWeight, "%s target weight", validator.Address)
+		s.Require().Equal(expected.next, explanation.NextWeight, "%s next weight", validator.Address)
+		s.Require().Equal(expected.excluded, explanation.Excluded, "%s excluded", validator.Address)
+		s.Require().Equal(expected.reason, explanation.Reason, "%s reason", validator.Address)
+	}
+
+	// Confirm the inputs used to derive the weights are included in the explanation
+	s.Require().Equal("0.050000000000000000", explanations[0].CommissionRate.String(), "val1 commission")
+	s.Require().Equal(sdk.NewDec(1000).Quo(sdk.NewDec(19000)).String(), explanations[0].VotingPowerShare.String(), "val1 voting power")
+	s.Require().Nil(explanations[6].CommissionRate, "val7 commission")
+}
+
+func (s *KeeperTestSuite) TestComputeValidatorWeights_Unbounded() {
+	hostZone, _ := s.SetupValidatorWeightPolicy()
+	hostZone.ValidatorWeightPolicy.MaxWeightChange = 0
+
+	explanations, err := s.App.StakeibcKeeper.ComputeValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when computing weights")
+
+	// Without a bound, val1 should move straight to its target
+	s.Require().Equal(uint64(95), explanations[0].NextWeight, "val1 next weight")
+}
+
+func (s *KeeperTestSuite) TestComputeValidatorWeights_ValidatorSetFull() {
+	params := s.App.StakeibcKeeper.GetParams(s.Ctx)
+	params.SafetyNumValidators = 2
+	s.App.StakeibcKeeper.SetParams(s.Ctx, params)
+
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostStakingParams: hostBondedTokens(3000),
+		ValidatorWeightPolicy: &stakeibctypes.ValidatorWeightPolicy{
+			MaxCommissionRate:   sdk.OneDec(),
+			MaxVotingPowerShare: sdk.OneDec(),
+		},
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", Weight: 10, CommissionRate: commissionRate("0.03"), Tokens: sdkmath.NewInt(1000)},
+			{Address: "val2", Weight: 10, CommissionRate: commissionRate("0.01"), Tokens: sdkmath.NewInt(1000)},
+			{Address: "val3", Weight: 10, CommissionRate: commissionRate("0.02"), Tokens: sdkmath.NewInt(1000)},
+		},
+	}
+
+	explanations, err := s.App.StakeibcKeeper.ComputeValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when computing weights")
+
+	// The validator with the highest commission should be dropped
+	s.Require().True(explanations[0].Excluded, "val1 excluded")
+	s.Require().Equal(stakeibckeeper.WeightReasonValidatorSetFull, explanations[0].Reason, "val1 reason")
+	s.Require().Equal(uint64(0), explanations[0].NextWeight, "val1 next weight")
+	s.Require().Equal(uint64(99), explanations[1].NextWeight, "val2 next weight")
+	s.Require().Equal(uint64(98), explanations[2].NextWeight, "val3 next weight")
+}
+
+func (s *KeeperTestSuite) TestComputeValidatorWeights_AwaitingBondedTokens() {
+	hostZone, expectedWeights := s.SetupValidatorWeightPolicy()
+	hostZone.HostStakingParams = nil
+
+	explanations, err := s.App.StakeibcKeeper.ComputeValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when computing weights")
+
+	// Validators that are excluded regardless of voting power should still be excluded,
+	// while the others should be left unchanged until the host's bonded tokens are known
+	for i, explanation := range explanations {
+		validator := hostZone.Validators[i]
+		expected := expectedWeights[validator.Address]
+		if expected.excluded && expected.reason != stakeibckeeper.WeightReasonVotingPower {
+			s.Require().True(explanation.Excluded, "%s excluded", validator.Address)
+			s.Require().Equal(expected.reason, explanation.Reason, "%s reason", validator.Address)
+			continue
+		}
+		s.Require().False(explanation.Excluded, "%s excluded", validator.Address)
+		s.Require().Equal(validator.Weight, explanation.NextWeight, "%s next weight", validator.Address)
+		s.Require().Equal(stakeibckeeper.WeightReasonAwaitingData, explanation.Reason, "%s reason", validator.Address)
+		s.Require().True(explanation.VotingPowerShare.IsZero(), "%s voting power", validator.Address)
+	}
+}
+
+func (s *KeeperTestSuite) TestComputeValidatorWeights_NoPolicy() {
+	hostZone, _ := s.SetupValidatorWeightPolicy()
+	hostZone.ValidatorWeightPolicy = nil
+
+	_, err := s.App.StakeibcKeeper.ComputeValidatorWeights(s.Ctx, hostZone)
+	s.Require().EqualError(err, "no validator weight policy for host zone GAIA: invalid validator weight policy")
+}
+
+func (s *KeeperTestSuite) TestUpdateAllValidatorWeights() {
+	_, expectedWeights := s.SetupValidatorWeightPolicy()
+
+	// A host zone without a policy should be untouched
+	manualHostZone := stakeibctypes.HostZone{
+		ChainId: "manual",
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", Weight: 50, CommissionRate: commissionRate("0.50"), Tokens: sdkmath.NewInt(1000)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, manualHostZone)
+
+	s.App.StakeibcKeeper.UpdateAllValidatorWeights(s.Ctx)
+
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	for _, validator := range hostZone.Validators {
+		s.Require().Equal(expectedWeights[validator.Address].next, validator.Weight, "%s weight", validator.Address)
+	}
+
+	manualHostZone, found = s.App.StakeibcKeeper.GetHostZone(s.Ctx, "manual")
+	s.Require().True(found, "manual host zone found")
+	s.Require().Equal(uint64(50), manualHostZone.Validators[0].Weight, "manual validator weight")
+
+	// An event should be emitted for each validator whose weight changed (val1, val2, val3, val4, val5, val6, val8)
+	numEvents := 0
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type == stakeibctypes.EventTypeValidatorWeight {
+			numEvents++
+		}
+	}
+	s.Require().Equal(7, numEvents, "number of validator weight events")
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorWeights_AllZero() {
+	// Every validator breaches the policy, so applying it would leave no validator to delegate to
+	hostZone := stakeibctypes.HostZone{
+		ChainId:           HostChainId,
+		HostStakingParams: hostBondedTokens(2000),
+		ValidatorWeightPolicy: &stakeibctypes.ValidatorWeightPolicy{
+			MaxCommissionRate:   sdk.MustNewDecFromStr("0.10"),
+			MaxVotingPowerShare: sdk.OneDec(),
+		},
+		Validators: []*stakeibctypes.Validator{
+			{Address: "val1", Weight: 10, CommissionRate: commissionRate("0.20"), Tokens: sdkmath.NewInt(1000)},
+			{Address: "val2", Weight: 20, CommissionRate: commissionRate("0.30"), Tokens: sdkmath.NewInt(1000)},
+		},
+	}
+	s.App.StakeibcKeeper.SetHostZone(s.Ctx, hostZone)
+
+	err := s.App.StakeibcKeeper.UpdateValidatorWeights(s.Ctx, hostZone)
+	s.Require().NoError(err, "no error expected when updating weights")
+
+	// The previous weights should be kept
+	hostZone, found := s.App.StakeibcKeeper.GetHostZone(s.Ctx, HostChainId)
+	s.Require().True(found, "host zone found")
+	s.Require().Equal(uint64(10), hostZone.Validators[0].Weight, "val1 weight")
+	s.Require().Equal(uint64(20), hostZone.Validators[1].Weight, "val2 weight")
+}
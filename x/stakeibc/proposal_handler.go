@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/Stride-Labs/stride/v9/x/stakeibc/keeper"
 
@@ -17,8 +18,58 @@ func NewStakeibcProposalHandler(k keeper.Keeper) govtypes.Handler {
 		case *types.AddValidatorsProposal:
 			return k.AddValidatorsProposal(ctx, c)
 
+		case *types.UpdateHostZoneProposal:
+			return k.UpdateHostZoneProposal(ctx, c)
+
+		case *types.SunsetHostZoneProposal:
+			return k.SunsetHostZoneProposal(ctx, c)
+
+		case *types.AbortHostZoneSunsetProposal:
+			return k.AbortHostZoneSunsetProposal(ctx, c)
+
+		case *types.UpdateFeeRecipientsProposal:
+			return k.UpdateFeeRecipientsProposal(ctx, c)
+
+		case *types.ResumeHostZoneProposal:
+			return k.ResumeHostZoneProposal(ctx, c)
+
 		default:
 			return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized stakeibc proposal content type: %T", c)
 		}
 	}
 }
+
+// Wraps the params module's proposal handler to reject a change to SafetyNumValidators that would leave
+// a host zone with more weighted validators than the new max
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if !changesSafetyNumValidators(content) {
+			return paramsHandler(ctx, content)
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := paramsHandler(cacheCtx, content); err != nil {
+			return err
+		}
+		if err := k.ConfirmValSetsWithinLimit(cacheCtx); err != nil {
+			return errorsmod.Wrapf(err, "cannot change %s", string(types.KeySafetyNumValidators))
+		}
+		writeCache()
+
+		return nil
+	}
+}
+
+// Returns true if the proposal changes the stakeibc SafetyNumValidators param
+func changesSafetyNumValidators(content govtypes.Content) bool {
+	proposal, ok := content.(*paramproposal.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	for _, change := range proposal.Changes {
+		if change.Subspace == types.ModuleName && change.Key == string(types.KeySafetyNumValidators) {
+			return true
+		}
+	}
+	return false
+}
@@ -463,6 +463,113 @@ func (m *RebalanceCallback) GetRebalancings() []*Rebalancing {
 	return nil
 }
 
+// ---------------------- LSM Liquid Stake Callbacks ---------------------- //
+type LSMLiquidStakeCallback struct {
+	Deposit *LSMTokenDeposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (m *LSMLiquidStakeCallback) Reset()         { *m = LSMLiquidStakeCallback{} }
+func (m *LSMLiquidStakeCallback) String() string { return proto.CompactTextString(m) }
+func (*LSMLiquidStakeCallback) ProtoMessage()    {}
+func (*LSMLiquidStakeCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41c99b09b96a5ac, []int{8}
+}
+func (m *LSMLiquidStakeCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LSMLiquidStakeCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LSMLiquidStakeCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LSMLiquidStakeCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LSMLiquidStakeCallback.Merge(m, src)
+}
+func (m *LSMLiquidStakeCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *LSMLiquidStakeCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_LSMLiquidStakeCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LSMLiquidStakeCallback proto.InternalMessageInfo
+
+func (m *LSMLiquidStakeCallback) GetDeposit() *LSMTokenDeposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+// ---------------------- Sunset Callbacks ---------------------- //
+type SunsetCallback struct {
+	HostZoneId string     `protobuf:"bytes,1,opt,name=host_zone_id,json=hostZoneId,proto3" json:"host_zone_id,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// set when a pending deposit is swept to the redemption account
+	DepositRecordId uint64 `protobuf:"varint,3,opt,name=deposit_record_id,json=depositRecordId,proto3" json:"deposit_record_id,omitempty"`
+}
+
+func (m *SunsetCallback) Reset()         { *m = SunsetCallback{} }
+func (m *SunsetCallback) String() string { return proto.CompactTextString(m) }
+func (*SunsetCallback) ProtoMessage()    {}
+func (*SunsetCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41c99b09b96a5ac, []int{9}
+}
+func (m *SunsetCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SunsetCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SunsetCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SunsetCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SunsetCallback.Merge(m, src)
+}
+func (m *SunsetCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *SunsetCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_SunsetCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SunsetCallback proto.InternalMessageInfo
+
+func (m *SunsetCallback) GetHostZoneId() string {
+	if m != nil {
+		return m.HostZoneId
+	}
+	return ""
+}
+
+func (m *SunsetCallback) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *SunsetCallback) GetDepositRecordId() uint64 {
+	if m != nil {
+		return m.DepositRecordId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*SplitDelegation)(nil), "stride.stakeibc.SplitDelegation")
 	proto.RegisterType((*DelegateCallback)(nil), "stride.stakeibc.DelegateCallback")
@@ -472,52 +579,58 @@ func init() {
 	proto.RegisterType((*RedemptionCallback)(nil), "stride.stakeibc.RedemptionCallback")
 	proto.RegisterType((*Rebalancing)(nil), "stride.stakeibc.Rebalancing")
 	proto.RegisterType((*RebalanceCallback)(nil), "stride.stakeibc.RebalanceCallback")
+	proto.RegisterType((*LSMLiquidStakeCallback)(nil), "stride.stakeibc.LSMLiquidStakeCallback")
+	proto.RegisterType((*SunsetCallback)(nil), "stride.stakeibc.SunsetCallback")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/callbacks.proto", fileDescriptor_f41c99b09b96a5ac) }
 
 var fileDescriptor_f41c99b09b96a5ac = []byte{
-	// 629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x4f, 0x13, 0x41,
-	0x18, 0xc6, 0xbb, 0x94, 0xa0, 0xbc, 0x2d, 0x16, 0x36, 0x46, 0x0b, 0x21, 0xdb, 0xba, 0x24, 0x4a,
-	0x4c, 0xd8, 0x0d, 0x78, 0x22, 0x5e, 0x10, 0x8c, 0x49, 0xe3, 0x9f, 0xc3, 0x12, 0x3c, 0x70, 0xd9,
-	0xcc, 0xee, 0x4c, 0xda, 0x09, 0xbb, 0x33, 0xcd, 0xbe, 0xd3, 0xa2, 0x7e, 0x02, 0x8f, 0x5e, 0xfd,
-	0x08, 0x7a, 0xf1, 0x3b, 0x78, 0xe2, 0xc8, 0xd1, 0x78, 0x40, 0x43, 0xbf, 0x88, 0x99, 0xfd, 0xd3,
-	0x2d, 0xc5, 0x10, 0xeb, 0xa9, 0xed, 0xfb, 0x3e, 0xd3, 0xf7, 0x79, 0xe6, 0x37, 0x33, 0xd0, 0x42,
-	0x95, 0x70, 0xca, 0x5c, 0x54, 0xe4, 0x84, 0xf1, 0x20, 0x74, 0x43, 0x12, 0x45, 0x01, 0x09, 0x4f,
-	0xd0, 0xe9, 0x27, 0x52, 0x49, 0xb3, 0x91, 0x09, 0x9c, 0x42, 0xb0, 0x76, 0xb7, 0x2b, 0xbb, 0x32,
-	0xed, 0xb9, 0xfa, 0x5b, 0x26, 0x5b, 0xb3, 0x42, 0x89, 0xb1, 0x44, 0x37, 0x20, 0xc8, 0xdc, 0xe1,
-	0x76, 0xc0, 0x14, 0xd9, 0x76, 0x43, 0xc9, 0x45, 0xd6, 0xb7, 0x4f, 0xa1, 0x71, 0xd8, 0x8f, 0xb8,
-	0x7a, 0xce, 0x22, 0xd6, 0x25, 0x8a, 0x4b, 0x61, 0xae, 0xc3, 0xe2, 0x90, 0x44, 0x9c, 0x12, 0x25,
-	0x93, 0xa6, 0xd1, 0x36, 0x36, 0x17, 0xbd, 0xb2, 0x60, 0xbe, 0x80, 0x05, 0x12, 0xcb, 0x81, 0x50,
-	0xcd, 0x39, 0xdd, 0xda, 0x77, 0xce, 0x2e, 0x5a, 0x95, 0x9f, 0x17, 0xad, 0x87, 0x5d, 0xae, 0x7a,
-	0x83, 0xc0, 0x09, 0x65, 0xec, 0xe6, 0x33, 0xb3, 0x8f, 0x2d, 0xa4, 0x27, 0xae, 0x7a, 0xdf, 0x67,
-	0xe8, 0x74, 0x84, 0xf2, 0xf2, 0xd5, 0xf6, 0x37, 0x03, 0x96, 0xf3, 0xa1, 0xec, 0x20, 0xcf, 0x66,
-	0xb6, 0xa1, 0xde, 0x93, 0xa8, 0xfc, 0x0f, 0x52, 0x30, 0x9f, 0xd3, 0x7c, 0x3a, 0xe8, 0xda, 0xb1,
-	0x14, 0xac, 0x43, 0xcd, 0xc7, 0xb0, 0x42, 0x59, 0x5f, 0x22, 0x57, 0x7e, 0xc2, 0x42, 0x99, 0x50,
-	0x2d, 0xd3, 0x4e, 0xe6, 0xbd, 0x46, 0xde, 0xf0, 0xd2, 0x7a, 0x87, 0x9a, 0xaf, 0x61, 0x05, 0x75,
-	0x36, 0x9f, 0x8e, 0xc3, 0x61, 0xb3, 0xda, 0xae, 0x6e, 0xd6, 0x76, 0xda, 0xce, 0xd4, 0xf6, 0x39,
-	0x53, 0xbb, 0xe0, 0x2d, 0xe3, 0xd5, 0x02, 0xda, 0x1f, 0x0d, 0x58, 0x3a, 0x88, 0x08, 0x8f, 0xc7,
-	0x76, 0x77, 0x61, 0x75, 0x80, 0x2c, 0xf1, 0x13, 0x46, 0x59, 0xdc, 0xd7, 0xaa, 0x09, 0x53, 0x99,
-	0xf7, 0x7b, 0x5a, 0xe0, 0x8d, 0xfb, 0x63, 0x6f, 0xab, 0x70, 0x3b, 0xec, 0x11, 0x2e, 0x0a, 0xfb,
-	0x8b, 0xde, 0xad, 0xf4, 0x77, 0x87, 0x9a, 0x0f, 0xa0, 0xce, 0xfa, 0x32, 0xec, 0xf9, 0x62, 0x10,
-	0x07, 0x2c, 0x69, 0x56, 0xd3, 0x74, 0xb5, 0xb4, 0xf6, 0x26, 0x2d, 0xd9, 0x5f, 0x0c, 0x58, 0xf6,
-	0x18, 0x17, 0x43, 0x86, 0x6a, 0xec, 0x06, 0xa1, 0x91, 0xe4, 0x35, 0x3f, 0x47, 0xa4, 0x3d, 0xd4,
-	0x76, 0x56, 0x9d, 0x8c, 0x84, 0xa3, 0x0f, 0x81, 0x93, 0x1f, 0x02, 0xe7, 0x40, 0x72, 0xb1, 0xef,
-	0x6a, 0x7a, 0x5f, 0x7f, 0xb5, 0x1e, 0xfd, 0x03, 0x3d, 0xbd, 0xc0, 0xbb, 0x53, 0x8c, 0x78, 0x96,
-	0x4e, 0xb8, 0x46, 0xac, 0x3a, 0x4d, 0xcc, 0xfe, 0x6e, 0x80, 0x79, 0x24, 0xe8, 0xec, 0xa8, 0xff,
-	0x8a, 0x6f, 0xee, 0x7f, 0xf1, 0x99, 0x4f, 0x61, 0x2d, 0xdb, 0xd6, 0x81, 0x08, 0xa4, 0xa0, 0x5c,
-	0x74, 0x4b, 0x58, 0xd9, 0xb1, 0x98, 0xf7, 0xee, 0xa7, 0x8a, 0xa3, 0x42, 0x50, 0xd0, 0x42, 0x1b,
-	0xc1, 0x2c, 0x21, 0xce, 0x90, 0xe1, 0xe6, 0xa1, 0x73, 0x37, 0x0f, 0xfd, 0x6c, 0x40, 0xcd, 0x63,
-	0x01, 0x89, 0x88, 0x08, 0xb9, 0xe8, 0x9a, 0x1b, 0xb0, 0x84, 0x49, 0xe8, 0x4f, 0x5f, 0xce, 0x3a,
-	0x26, 0xe1, 0xdb, 0xf1, 0xfd, 0xdc, 0x80, 0x25, 0x8a, 0x6a, 0x42, 0x94, 0x9d, 0xae, 0x3a, 0x45,
-	0x55, 0x8a, 0xf6, 0xa0, 0x4a, 0x62, 0x95, 0xc1, 0x9a, 0xf9, 0x06, 0xeb, 0xa5, 0xf6, 0x29, 0xac,
-	0x14, 0xd6, 0x66, 0x61, 0xba, 0x07, 0xf5, 0xa4, 0x4c, 0x54, 0xe0, 0x5c, 0xbf, 0x86, 0x73, 0x22,
-	0xb6, 0x77, 0x65, 0xc5, 0xfe, 0xcb, 0xb3, 0x4b, 0xcb, 0x38, 0xbf, 0xb4, 0x8c, 0xdf, 0x97, 0x96,
-	0xf1, 0x69, 0x64, 0x55, 0xce, 0x47, 0x56, 0xe5, 0xc7, 0xc8, 0xaa, 0x1c, 0x6f, 0x4f, 0xf8, 0x3f,
-	0x4c, 0xff, 0x6f, 0xeb, 0x15, 0x09, 0xd0, 0xcd, 0x5f, 0xd2, 0xe1, 0xae, 0xfb, 0xae, 0x7c, 0x4e,
-	0xd3, 0x38, 0xc1, 0x42, 0xfa, 0x08, 0x3e, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x46, 0x65, 0x26,
-	0xfb, 0x6e, 0x05, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x31, 0x82, 0xc7, 0x24, 0x10, 0xb0, 0x9e, 0x78, 0x09, 0x42, 0x4e, 0x9e, 0x91, 0xde,
+	0x43, 0x95, 0xb0, 0x05, 0x5d, 0xd1, 0x6e, 0x28, 0xa0, 0x4a, 0x51, 0x43, 0x17, 0x0e, 0x74, 0xc1,
+	0xc6, 0x1a, 0x7b, 0x46, 0xc9, 0x28, 0xf6, 0x4c, 0xea, 0x19, 0x87, 0xb6, 0x5f, 0xd0, 0x65, 0xb7,
+	0xfd, 0x84, 0x76, 0xd3, 0x7f, 0xe8, 0x8a, 0x25, 0xcb, 0xaa, 0x0b, 0x5a, 0xc1, 0x8f, 0x54, 0x63,
+	0x8f, 0x9d, 0x10, 0x10, 0x6a, 0x50, 0x57, 0x49, 0xee, 0x3d, 0x37, 0xe7, 0xdc, 0x39, 0x77, 0xee,
+	0x80, 0x06, 0x17, 0x31, 0x41, 0xd8, 0xe1, 0x02, 0xf6, 0x31, 0xf1, 0x03, 0x27, 0x80, 0x61, 0xe8,
+	0xc3, 0xa0, 0xcf, 0xed, 0x41, 0xcc, 0x04, 0x33, 0xaa, 0x19, 0xc0, 0xce, 0x01, 0x6b, 0x7f, 0x77,
+	0x59, 0x97, 0xa5, 0x39, 0x47, 0x7e, 0xcb, 0x60, 0x6b, 0x66, 0xc0, 0x78, 0xc4, 0xb8, 0xe3, 0x43,
+	0x8e, 0x9d, 0xe1, 0xb6, 0x8f, 0x05, 0xdc, 0x76, 0x02, 0x46, 0xa8, 0xca, 0xd7, 0x27, 0x79, 0x42,
+	0x1e, 0x65, 0x29, 0xeb, 0x0c, 0x54, 0x3b, 0x83, 0x90, 0x88, 0x43, 0x1c, 0xe2, 0x2e, 0x14, 0x84,
+	0x51, 0x63, 0x1d, 0x2c, 0x0c, 0x61, 0x48, 0x10, 0x14, 0x2c, 0xae, 0x69, 0x4d, 0x6d, 0x73, 0xc1,
+	0x1d, 0x05, 0x8c, 0xe7, 0x60, 0x0e, 0x46, 0x2c, 0xa1, 0xa2, 0x36, 0x23, 0x53, 0xfb, 0xf6, 0xf9,
+	0x65, 0xa3, 0xf4, 0xfd, 0xb2, 0xf1, 0x5f, 0x97, 0x88, 0x5e, 0xe2, 0xdb, 0x01, 0x8b, 0x1c, 0x25,
+	0x27, 0xfb, 0xd8, 0xe2, 0xa8, 0xef, 0x88, 0xb7, 0x03, 0xcc, 0xed, 0x16, 0x15, 0xae, 0xaa, 0xb6,
+	0xbe, 0x68, 0x60, 0x59, 0x91, 0xe2, 0x03, 0xd5, 0xb6, 0xd1, 0x04, 0x95, 0x1e, 0xe3, 0xc2, 0x7b,
+	0xc7, 0x28, 0xf6, 0x08, 0x52, 0xec, 0x40, 0xc6, 0x4e, 0x19, 0xc5, 0x2d, 0x64, 0x3c, 0x02, 0x2b,
+	0x08, 0x0f, 0x18, 0x27, 0xc2, 0x8b, 0x71, 0xc0, 0x62, 0x24, 0x61, 0x52, 0xc9, 0xac, 0x5b, 0x55,
+	0x09, 0x37, 0x8d, 0xb7, 0x90, 0x71, 0x04, 0x56, 0xb8, 0xec, 0xcd, 0x43, 0x45, 0x73, 0xbc, 0xa6,
+	0x37, 0xf5, 0xcd, 0xf2, 0x4e, 0xd3, 0x9e, 0x38, 0x59, 0x7b, 0xe2, 0x14, 0xdc, 0x65, 0x7e, 0x33,
+	0xc0, 0xad, 0xf7, 0x1a, 0x58, 0x3c, 0x08, 0x21, 0x89, 0x0a, 0xb9, 0xbb, 0xa0, 0x9e, 0x70, 0x1c,
+	0x7b, 0x31, 0x46, 0x38, 0x1a, 0x48, 0xd4, 0x98, 0xa8, 0x4c, 0xfb, 0xaa, 0x04, 0xb8, 0x45, 0xbe,
+	0xd0, 0x56, 0x07, 0x7f, 0x05, 0x3d, 0x48, 0x68, 0x2e, 0x7f, 0xc1, 0x9d, 0x4f, 0x7f, 0xb7, 0x90,
+	0xf1, 0x2f, 0xa8, 0xe0, 0x01, 0x0b, 0x7a, 0x1e, 0x4d, 0x22, 0x1f, 0xc7, 0x35, 0x3d, 0xed, 0xae,
+	0x9c, 0xc6, 0x5e, 0xa6, 0x21, 0xeb, 0x93, 0x06, 0x96, 0x5d, 0x4c, 0xe8, 0x10, 0x73, 0x51, 0xa8,
+	0xe1, 0xa0, 0x1a, 0xab, 0x98, 0xa7, 0x2c, 0x92, 0x1a, 0xca, 0x3b, 0x75, 0x3b, 0x73, 0xc2, 0x96,
+	0xf3, 0x61, 0xab, 0xf9, 0xb0, 0x0f, 0x18, 0xa1, 0xfb, 0x8e, 0x74, 0xef, 0xf3, 0x8f, 0xc6, 0xff,
+	0xbf, 0xe1, 0x9e, 0x2c, 0x70, 0x97, 0x72, 0x8a, 0x67, 0x29, 0xc3, 0x2d, 0xc7, 0xf4, 0x49, 0xc7,
+	0xac, 0xaf, 0x1a, 0x30, 0x4e, 0x28, 0x9a, 0xde, 0xea, 0x3b, 0xed, 0x9b, 0x79, 0xa8, 0x7d, 0xc6,
+	0x53, 0xb0, 0x96, 0x1d, 0x6b, 0x42, 0x7d, 0x46, 0x11, 0xa1, 0xdd, 0x91, 0x59, 0xd9, 0x58, 0xcc,
+	0xba, 0xff, 0xa4, 0x88, 0x93, 0x1c, 0x90, 0xbb, 0xc5, 0x2d, 0x0e, 0x8c, 0x91, 0x89, 0x53, 0xf4,
+	0x70, 0x3f, 0xe9, 0xcc, 0xfd, 0xa4, 0x1f, 0x35, 0x50, 0x76, 0xb1, 0x0f, 0x43, 0x48, 0x03, 0x42,
+	0xbb, 0xc6, 0x06, 0x58, 0xe4, 0x71, 0xe0, 0x4d, 0x5e, 0xce, 0x0a, 0x8f, 0x83, 0x57, 0xc5, 0xfd,
+	0xdc, 0x00, 0x8b, 0x88, 0x8b, 0x31, 0x50, 0x36, 0x5d, 0x15, 0xc4, 0xc5, 0x08, 0xb4, 0x07, 0x74,
+	0x18, 0x89, 0x9a, 0xfe, 0xa0, 0x1b, 0x2c, 0x4b, 0xad, 0x33, 0xb0, 0x92, 0x4b, 0x9b, 0xc6, 0xd3,
+	0x3d, 0x50, 0x89, 0x47, 0x1d, 0xe5, 0x76, 0xae, 0xdf, 0xb2, 0x73, 0xac, 0x6d, 0xf7, 0x46, 0x85,
+	0x75, 0x0c, 0x56, 0xdb, 0x9d, 0xa3, 0x36, 0x79, 0x9d, 0x10, 0xd4, 0x91, 0xf0, 0x82, 0xfd, 0x09,
+	0x98, 0x57, 0x1b, 0x40, 0xcd, 0xfd, 0xed, 0x29, 0x69, 0x77, 0x8e, 0x8e, 0x59, 0x1f, 0xd3, 0x43,
+	0xb5, 0x29, 0xf2, 0x02, 0x39, 0xa4, 0x4b, 0x9d, 0x84, 0x72, 0x2c, 0xa6, 0x68, 0xc6, 0xbf, 0xb1,
+	0x0a, 0xff, 0xec, 0x3d, 0x53, 0xff, 0x7c, 0xf7, 0xbe, 0xd3, 0xef, 0xdc, 0x77, 0xfb, 0x2f, 0xce,
+	0xaf, 0x4c, 0xed, 0xe2, 0xca, 0xd4, 0x7e, 0x5e, 0x99, 0xda, 0x87, 0x6b, 0xb3, 0x74, 0x71, 0x6d,
+	0x96, 0xbe, 0x5d, 0x9b, 0xa5, 0xd3, 0xed, 0x31, 0xda, 0x4e, 0x7a, 0x26, 0x5b, 0x6d, 0xe8, 0x73,
+	0x47, 0xbd, 0x0b, 0xc3, 0x5d, 0xe7, 0xcd, 0xe8, 0x71, 0x48, 0x55, 0xf8, 0x73, 0xe9, 0xfb, 0xf0,
+	0xf8, 0xd7, 0x00, 0x8b, 0x92, 0x12, 0xb6, 0xa4, 0x06, 0x00, 0x00,
 }
 
 func (m *SplitDelegation) Marshal() (dAtA []byte, err error) {
@@ -892,6 +1005,86 @@ func (m *RebalanceCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LSMLiquidStakeCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LSMLiquidStakeCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LSMLiquidStakeCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCallbacks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SunsetCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SunsetCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SunsetCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DepositRecordId != 0 {
+		i = encodeVarintCallbacks(dAtA, i, uint64(m.DepositRecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCallbacks(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.HostZoneId) > 0 {
+		i -= len(m.HostZoneId)
+		copy(dAtA[i:], m.HostZoneId)
+		i = encodeVarintCallbacks(dAtA, i, uint64(len(m.HostZoneId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCallbacks(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCallbacks(v)
 	base := offset
@@ -1059,6 +1252,37 @@ func (m *RebalanceCallback) Size() (n int) {
 	return n
 }
 
+func (m *LSMLiquidStakeCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	return n
+}
+
+func (m *SunsetCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostZoneId)
+	if l > 0 {
+		n += 1 + l + sovCallbacks(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCallbacks(uint64(l))
+	if m.DepositRecordId != 0 {
+		n += 1 + sovCallbacks(uint64(m.DepositRecordId))
+	}
+	return n
+}
+
 func sovCallbacks(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2178,6 +2402,226 @@ func (m *RebalanceCallback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LSMLiquidStakeCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LSMLiquidStakeCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LSMLiquidStakeCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &LSMTokenDeposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SunsetCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCallbacks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SunsetCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SunsetCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZoneId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZoneId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecordId", wireType)
+			}
+			m.DepositRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCallbacks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCallbacks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCallbacks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCallbacks(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
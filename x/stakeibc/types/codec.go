@@ -21,6 +21,22 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&AddValidatorsProposal{}, "stakeibc/AddValidatorsProposal", nil)
 	cdc.RegisterConcrete(&MsgRestoreInterchainAccount{}, "stakeibc/RestoreInterchainAccount", nil)
 	cdc.RegisterConcrete(&MsgUpdateValidatorSharesExchRate{}, "stakeibc/UpdateValidatorSharesExchRate", nil)
+	cdc.RegisterConcrete(&MsgResumeHostZone{}, "stakeibc/ResumeHostZone", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "stakeibc/CancelRedemption", nil)
+	cdc.RegisterConcrete(&MsgUpdateHostZone{}, "stakeibc/UpdateHostZone", nil)
+	cdc.RegisterConcrete(&MsgUpdateFeeRecipients{}, "stakeibc/UpdateFeeRecipients", nil)
+	cdc.RegisterConcrete(&MsgSetValidatorWeightPolicy{}, "stakeibc/SetValidatorWeightPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetUnbondingStrategy{}, "stakeibc/SetUnbondingStrategy", nil)
+	cdc.RegisterConcrete(&MsgSunsetHostZone{}, "stakeibc/SunsetHostZone", nil)
+	cdc.RegisterConcrete(&MsgAbortHostZoneSunset{}, "stakeibc/AbortHostZoneSunset", nil)
+	cdc.RegisterConcrete(&MsgResolveICARetry{}, "stakeibc/ResolveICARetry", nil)
+	cdc.RegisterConcrete(&MsgLSMLiquidStake{}, "stakeibc/LSMLiquidStake", nil)
+	cdc.RegisterConcrete(&MsgResolveUnconfirmedSlash{}, "stakeibc/ResolveUnconfirmedSlash", nil)
+	cdc.RegisterConcrete(&UpdateHostZoneProposal{}, "stakeibc/UpdateHostZoneProposal", nil)
+	cdc.RegisterConcrete(&SunsetHostZoneProposal{}, "stakeibc/SunsetHostZoneProposal", nil)
+	cdc.RegisterConcrete(&AbortHostZoneSunsetProposal{}, "stakeibc/AbortHostZoneSunsetProposal", nil)
+	cdc.RegisterConcrete(&UpdateFeeRecipientsProposal{}, "stakeibc/UpdateFeeRecipientsProposal", nil)
+	cdc.RegisterConcrete(&ResumeHostZoneProposal{}, "stakeibc/ResumeHostZoneProposal", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -37,10 +53,26 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgDeleteValidator{},
 		&MsgRestoreInterchainAccount{},
 		&MsgUpdateValidatorSharesExchRate{},
+		&MsgResumeHostZone{},
+		&MsgCancelRedemption{},
+		&MsgUpdateHostZone{},
+		&MsgUpdateFeeRecipients{},
+		&MsgSetValidatorWeightPolicy{},
+		&MsgSetUnbondingStrategy{},
+		&MsgSunsetHostZone{},
+		&MsgAbortHostZoneSunset{},
+		&MsgResolveICARetry{},
+		&MsgLSMLiquidStake{},
+		&MsgResolveUnconfirmedSlash{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddValidatorsProposal{},
+		&UpdateHostZoneProposal{},
+		&SunsetHostZoneProposal{},
+		&AbortHostZoneSunsetProposal{},
+		&UpdateFeeRecipientsProposal{},
+		&ResumeHostZoneProposal{},
 	)
 
 	// this line is used by starport scaffolding # 3
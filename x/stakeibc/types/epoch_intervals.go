@@ -0,0 +1,32 @@
+package types
+
+import "bytes"
+
+// Names of the epochly tasks returned by the NextScheduledRuns query
+const (
+	ScheduledTaskDeposit        = "deposit"
+	ScheduledTaskDelegate       = "delegate"
+	ScheduledTaskReinvest       = "reinvest"
+	ScheduledTaskRedemptionRate = "redemption_rate"
+	ScheduledTaskUnbonding      = "unbonding"
+)
+
+// Returns the host zone's override of the interval stored under the given param key,
+// or zero if the interval is not overridden
+func (i *EpochIntervals) GetInterval(intervalKey []byte) uint64 {
+	if i == nil {
+		return 0
+	}
+	switch {
+	case bytes.Equal(intervalKey, KeyDepositInterval):
+		return i.DepositInterval
+	case bytes.Equal(intervalKey, KeyDelegateInterval):
+		return i.DelegateInterval
+	case bytes.Equal(intervalKey, KeyReinvestInterval):
+		return i.ReinvestInterval
+	case bytes.Equal(intervalKey, KeyRedemptionRateInterval):
+		return i.RedemptionRateInterval
+	default:
+		return 0
+	}
+}
@@ -49,4 +49,21 @@ var (
 	ErrRewardCollectorAccountNotFound    = errorsmod.Register(ModuleName, 1541, "Reward Collector account not found")
 	ErrHaltedHostZone                    = errorsmod.Register(ModuleName, 1542, "Halted host zone found")
 	ErrInsufficientLiquidStake           = errorsmod.Register(ModuleName, 1543, "Liquid staked amount is too small")
+	ErrHostZoneNotHalted                 = errorsmod.Register(ModuleName, 1544, "host zone is not halted")
+	ErrRedemptionRateHistoryNotFound     = errorsmod.Register(ModuleName, 1545, "redemption rate history not found")
+	ErrRedemptionNotCancellable          = errorsmod.Register(ModuleName, 1546, "redemption cannot be cancelled")
+	ErrMinAmountOutNotMet                = errorsmod.Register(ModuleName, 1547, "amount out is less than the specified minimum")
+	ErrInvalidFeeRecipient               = errorsmod.Register(ModuleName, 1548, "invalid fee recipient")
+	ErrUnconfirmedSlashNotFound          = errorsmod.Register(ModuleName, 1549, "unconfirmed slash not found")
+	ErrValidatorWeightsManaged           = errorsmod.Register(ModuleName, 1550, "validator weights are managed by the host zone's weight policy")
+	ErrInvalidValidatorWeightPolicy      = errorsmod.Register(ModuleName, 1551, "invalid validator weight policy")
+	ErrInvalidUnbondingFrequency         = errorsmod.Register(ModuleName, 1552, "invalid unbonding frequency")
+	ErrHostZoneSunset                    = errorsmod.Register(ModuleName, 1553, "host zone is being sunset")
+	ErrInvalidHostZoneUpdate             = errorsmod.Register(ModuleName, 1554, "invalid host zone update")
+	ErrICARetryNotFound                  = errorsmod.Register(ModuleName, 1555, "ICA retry not found")
+	ErrInvalidLSMToken                   = errorsmod.Register(ModuleName, 1556, "invalid LSM token")
+	ErrLSMTokenDepositNotFound           = errorsmod.Register(ModuleName, 1557, "LSM token deposit not found")
+	ErrLSMLiquidStakeInProgress          = errorsmod.Register(ModuleName, 1558, "LSM liquid stake already in progress")
+	ErrValidatorNotActive                = errorsmod.Register(ModuleName, 1559, "validator is not in the active set")
+	ErrInvalidStTokenMetadata            = errorsmod.Register(ModuleName, 1560, "invalid stToken metadata")
 )
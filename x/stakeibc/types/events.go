@@ -15,6 +15,21 @@ const (
 	EventTypeRedemptionRequest  = "request_redemption"
 	EventTypeLiquidStakeRequest = "liquid_stake"
 	EventTypeHostZoneHalt       = "halt_zone"
+	EventTypeHostZoneResume     = "resume_zone"
+	EventTypeInstantRedemption  = "instant_redemption"
+	EventTypeRedemptionCancel   = "cancel_redemption"
+	EventTypeHostZoneUpdate     = "update_zone"
+	EventTypeFeeDistribution    = "distribute_fees"
+	EventTypeSlashConfirmed     = "confirm_slash"
+	EventTypeSlashQuarantined   = "quarantine_slash"
+	EventTypeValidatorInactive  = "deactivate_validator"
+	EventTypeRebalanceInactive  = "rebalance_inactive_validators"
+	EventTypeValidatorWeight    = "update_validator_weight"
+	EventTypeUnbondingShortfall = "unbonding_shortfall"
+	EventTypeHostParamsMismatch = "host_staking_params_mismatch"
+	EventTypeHostZoneSunset     = "sunset_zone"
+	EventTypeICARecovery        = "recover_ica"
+	EventTypeLSMLiquidStake     = "lsm_liquid_stake"
 
 	AttributeKeyHostZone         = "host_zone"
 	AttributeKeyConnectionId     = "connection_id"
@@ -23,8 +38,37 @@ const (
 	AttributeKeyBurnAmount       = "burn_amount"
 	AttributeKeyRedeemAmount     = "redeem_amount"
 	AttributeKeySourceAddress    = "source"
+	AttributeKeyFeeAmount        = "fee_amount"
+	AttributeKeyStrideCommission = "stride_commission"
+	AttributeKeyFeeRecipient     = "fee_recipient"
+	AttributeKeyValidator        = "validator"
+	AttributeKeySlashAmount      = "slash_amount"
+	AttributeKeySlashReason      = "reason"
+	AttributeKeyValidatorStatus  = "status"
+	AttributeKeyJailed           = "jailed"
+	AttributeKeyTombstoned       = "tombstoned"
+	AttributeKeyPreviousWeight   = "previous_weight"
+	AttributeKeyWeight           = "weight"
+	AttributeKeyWeightReason     = "reason"
+	AttributeKeyUnbondAmount     = "unbond_amount"
+	AttributeKeyShortfallAmount  = "shortfall_amount"
+	AttributeKeyRolloverEpoch    = "rollover_epoch"
+	AttributeKeyMismatchReason   = "reason"
+	AttributeKeySunsetStatus     = "sunset_status"
+	AttributeKeyICAAccountType   = "account_type"
+	AttributeKeyICAChannelId     = "channel_id"
+	AttributeKeyICAAttempts      = "attempts"
+	AttributeKeyICARecovery      = "recovery_status"
+	AttributeKeyLSMTokenDenom    = "lsm_token_denom"
+	AttributeKeyLSMTokenAmount   = "lsm_token_amount"
+	AttributeKeyLSMStatus        = "lsm_status"
 
-	AttributeKeyRedemptionRate = "redemption_rate"
+	AttributeKeyRedemptionRate     = "redemption_rate"
+	AttributeKeyMinRedemptionRate  = "min_redemption_rate"
+	AttributeKeyMaxRedemptionRate  = "max_redemption_rate"
+	AttributeKeyUnbondingFrequency = "unbonding_frequency"
+	AttributeKeyTransferChannelId  = "transfer_channel_id"
+	AttributeKeyBech32Prefix       = "bech32_prefix"
 
 	AttributeKeyLiquidStaker    = "liquid_staker"
 	AttributeKeyNativeBaseDenom = "native_base_denom"
@@ -33,4 +77,11 @@ const (
 	AttributeKeyStTokenAmount   = "sttoken_amount"
 
 	AttributeValueCategory = ModuleName
+
+	AttributeValueICAReregistered = "reregistered"
+	AttributeValueICARecovered    = "recovered"
+
+	AttributeValueLSMRequested  = "requested"
+	AttributeValueLSMCompleted  = "completed"
+	AttributeValueLSMRolledBack = "rolled_back"
 )
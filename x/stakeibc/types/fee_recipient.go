@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validates a list of fee recipients
+// Names must be unique, weights must be positive, and the address must match the recipient type
+func ValidateFeeRecipients(feeRecipients []FeeRecipient) error {
+	names := map[string]bool{}
+	for _, recipient := range feeRecipients {
+		if recipient.Name == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee recipient name is required")
+		}
+		if names[recipient.Name] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate fee recipient name (%s)", recipient.Name)
+		}
+		names[recipient.Name] = true
+
+		if recipient.Weight == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "fee recipient %s must have a positive weight", recipient.Name)
+		}
+
+		switch recipient.Type {
+		case FeeRecipientType_ACCOUNT:
+			if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address for fee recipient %s (%s)", recipient.Name, err)
+			}
+		case FeeRecipientType_MODULE_ACCOUNT:
+			if recipient.Address == "" {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "module name is required for fee recipient %s", recipient.Name)
+			}
+		case FeeRecipientType_COMMUNITY_POOL:
+			if recipient.Address != "" {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "address should not be specified for community pool fee recipient %s", recipient.Name)
+			}
+		default:
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid type for fee recipient %s", recipient.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,724 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/fee_recipient.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FeeRecipientType int32
+
+const (
+	// a regular account, identified by its bech32 address
+	FeeRecipientType_ACCOUNT FeeRecipientType = 0
+	// a module account, identified by its module name
+	FeeRecipientType_MODULE_ACCOUNT FeeRecipientType = 1
+	// the community pool (no address required)
+	FeeRecipientType_COMMUNITY_POOL FeeRecipientType = 2
+)
+
+var FeeRecipientType_name = map[int32]string{
+	0: "ACCOUNT",
+	1: "MODULE_ACCOUNT",
+	2: "COMMUNITY_POOL",
+}
+
+var FeeRecipientType_value = map[string]int32{
+	"ACCOUNT":        0,
+	"MODULE_ACCOUNT": 1,
+	"COMMUNITY_POOL": 2,
+}
+
+func (x FeeRecipientType) String() string {
+	return proto.EnumName(FeeRecipientType_name, int32(x))
+}
+
+func (FeeRecipientType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_970c7e1bf4deb94f, []int{0}
+}
+
+// A recipient of protocol revenue, which receives a share of the stTokens
+// swept from the reward collector proportional to its weight
+type FeeRecipient struct {
+	// unique name used to identify the recipient in events and queries
+	Name string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type FeeRecipientType `protobuf:"varint,2,opt,name=type,proto3,enum=stride.stakeibc.FeeRecipientType" json:"type,omitempty"`
+	// bech32 address for accounts, module name for module accounts
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Weight  uint64 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *FeeRecipient) Reset()         { *m = FeeRecipient{} }
+func (m *FeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipient) ProtoMessage()    {}
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_970c7e1bf4deb94f, []int{0}
+}
+func (m *FeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipient.Merge(m, src)
+}
+func (m *FeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipient proto.InternalMessageInfo
+
+func (m *FeeRecipient) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeeRecipient) GetType() FeeRecipientType {
+	if m != nil {
+		return m.Type
+	}
+	return FeeRecipientType_ACCOUNT
+}
+
+func (m *FeeRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FeeRecipient) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// Cumulative protocol revenue distributed to a fee recipient
+type FeeRecipientRevenue struct {
+	Name         string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TotalRevenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_revenue,json=totalRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_revenue"`
+}
+
+func (m *FeeRecipientRevenue) Reset()         { *m = FeeRecipientRevenue{} }
+func (m *FeeRecipientRevenue) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipientRevenue) ProtoMessage()    {}
+func (*FeeRecipientRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_970c7e1bf4deb94f, []int{1}
+}
+func (m *FeeRecipientRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipientRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipientRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipientRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipientRevenue.Merge(m, src)
+}
+func (m *FeeRecipientRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipientRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipientRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipientRevenue proto.InternalMessageInfo
+
+func (m *FeeRecipientRevenue) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeeRecipientRevenue) GetTotalRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRevenue
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.FeeRecipientType", FeeRecipientType_name, FeeRecipientType_value)
+	proto.RegisterType((*FeeRecipient)(nil), "stride.stakeibc.FeeRecipient")
+	proto.RegisterType((*FeeRecipientRevenue)(nil), "stride.stakeibc.FeeRecipientRevenue")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/fee_recipient.proto", fileDescriptor_970c7e1bf4deb94f)
+}
+
+var fileDescriptor_970c7e1bf4deb94f = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xbb, 0x8e, 0xd3, 0x40,
+	0x14, 0xf5, 0x64, 0xad, 0x5d, 0x31, 0xbb, 0x2c, 0xd1, 0x80, 0x90, 0xd9, 0xc2, 0x6b, 0x96, 0xc6,
+	0x42, 0xda, 0x19, 0xb2, 0x88, 0x82, 0x92, 0x35, 0x0f, 0x21, 0x92, 0x18, 0x99, 0xa4, 0x80, 0xc6,
+	0xf2, 0xe3, 0xe2, 0x8c, 0x42, 0x3c, 0x96, 0x67, 0x12, 0xc8, 0x37, 0xd0, 0xd0, 0xf3, 0x07, 0x7c,
+	0x49, 0xca, 0x94, 0x54, 0x80, 0x92, 0x1f, 0x41, 0x1e, 0xdb, 0x22, 0x8a, 0xa8, 0x7c, 0x1f, 0xc7,
+	0xe7, 0x9e, 0x73, 0xe7, 0xe2, 0x07, 0x52, 0x95, 0x3c, 0x05, 0x26, 0x55, 0x34, 0x05, 0x1e, 0x27,
+	0xec, 0x23, 0x40, 0x58, 0x42, 0xc2, 0x0b, 0x0e, 0xb9, 0xa2, 0x45, 0x29, 0x94, 0x20, 0xb7, 0x6a,
+	0x10, 0x6d, 0x41, 0x67, 0x77, 0x32, 0x91, 0x09, 0xdd, 0x63, 0x55, 0x54, 0xc3, 0xce, 0xec, 0x44,
+	0xc8, 0x99, 0x90, 0x2c, 0x8e, 0x24, 0xb0, 0x45, 0x2f, 0x06, 0x15, 0xf5, 0x58, 0x22, 0x78, 0x5e,
+	0xf7, 0x2f, 0xbe, 0x22, 0x7c, 0xf2, 0x12, 0x20, 0x68, 0xd9, 0x09, 0xc1, 0x66, 0x1e, 0xcd, 0xc0,
+	0x42, 0x0e, 0x72, 0x6f, 0x04, 0x3a, 0x26, 0x4f, 0xb0, 0xa9, 0x96, 0x05, 0x58, 0x1d, 0x07, 0xb9,
+	0xa7, 0x57, 0xf7, 0xe9, 0xde, 0x68, 0xba, 0x4b, 0x30, 0x5a, 0x16, 0x10, 0x68, 0x38, 0xb1, 0xf0,
+	0x51, 0x94, 0xa6, 0x25, 0x48, 0x69, 0x1d, 0x68, 0xb6, 0x36, 0x25, 0x77, 0xf1, 0xe1, 0x67, 0xe0,
+	0xd9, 0x44, 0x59, 0xa6, 0x83, 0x5c, 0x33, 0x68, 0xb2, 0x8b, 0xef, 0x08, 0xdf, 0xde, 0x25, 0x0b,
+	0x60, 0x01, 0xf9, 0x1c, 0xfe, 0x2b, 0xaa, 0xc0, 0x37, 0x95, 0x50, 0xd1, 0xa7, 0xb0, 0xac, 0x41,
+	0x56, 0xc7, 0x39, 0x70, 0x8f, 0xaf, 0xee, 0xd1, 0xda, 0x31, 0xad, 0x1c, 0xd3, 0xc6, 0x31, 0xf5,
+	0x04, 0xcf, 0xaf, 0x1f, 0xad, 0x7e, 0x9d, 0x1b, 0x3f, 0x7e, 0x9f, 0xbb, 0x19, 0x57, 0x93, 0x79,
+	0x4c, 0x13, 0x31, 0x63, 0xcd, 0x7a, 0xea, 0xcf, 0xa5, 0x4c, 0xa7, 0xac, 0x92, 0x2e, 0xf5, 0x0f,
+	0x32, 0x38, 0xd1, 0x13, 0x1a, 0x15, 0x0f, 0x5f, 0xe1, 0xee, 0xbe, 0x53, 0x72, 0x8c, 0x8f, 0x9e,
+	0x79, 0x9e, 0x3f, 0x1e, 0x8e, 0xba, 0x06, 0x21, 0xf8, 0x74, 0xe0, 0x3f, 0x1f, 0xf7, 0x5f, 0x84,
+	0x6d, 0x0d, 0x55, 0x35, 0xcf, 0x1f, 0x0c, 0xc6, 0xc3, 0xd7, 0xa3, 0xf7, 0xe1, 0x5b, 0xdf, 0xef,
+	0x77, 0x3b, 0xd7, 0x6f, 0x56, 0x1b, 0x1b, 0xad, 0x37, 0x36, 0xfa, 0xb3, 0xb1, 0xd1, 0xb7, 0xad,
+	0x6d, 0xac, 0xb7, 0xb6, 0xf1, 0x73, 0x6b, 0x1b, 0x1f, 0x7a, 0x3b, 0xd2, 0xde, 0xe9, 0x2d, 0x5f,
+	0xf6, 0xa3, 0x58, 0xb2, 0xe6, 0x22, 0x16, 0x4f, 0xd9, 0x97, 0x7f, 0x67, 0xa1, 0x95, 0xc6, 0x87,
+	0xfa, 0x21, 0x1f, 0xff, 0x1d, 0x00, 0x36, 0x4f, 0x3c, 0x9d, 0x36, 0x02, 0x00, 0x00,
+}
+
+func (m *FeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintFeeRecipient(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintFeeRecipient(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintFeeRecipient(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeeRecipient(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRecipientRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipientRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipientRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRevenue) > 0 {
+		for iNdEx := len(m.TotalRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeRecipient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintFeeRecipient(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeRecipient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeRecipient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeeRecipient(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovFeeRecipient(uint64(m.Type))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovFeeRecipient(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovFeeRecipient(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *FeeRecipientRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFeeRecipient(uint64(l))
+	}
+	if len(m.TotalRevenue) > 0 {
+		for _, e := range m.TotalRevenue {
+			l = e.Size()
+			n += 1 + l + sovFeeRecipient(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeeRecipient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeRecipient(x uint64) (n int) {
+	return sovFeeRecipient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeRecipient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FeeRecipientType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeRecipient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRecipientRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeRecipient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipientRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipientRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRevenue = append(m.TotalRevenue, types.Coin{})
+			if err := m.TotalRevenue[len(m.TotalRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeRecipient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeRecipient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeRecipient(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeRecipient
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeRecipient
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeRecipient
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeRecipient
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeRecipient
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeRecipient        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeRecipient          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeRecipient = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,10 +12,18 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		HostZoneList:     []HostZone{},
-		EpochTrackerList: []EpochTracker{},
-		Params:           DefaultParams(),
-		PortId:           PortID,
+		HostZoneList:          []HostZone{},
+		EpochTrackerList:      []EpochTracker{},
+		Params:                DefaultParams(),
+		PortId:                PortID,
+		RedemptionRateRecords: []RedemptionRateRecord{},
+		FeeRecipients:         []FeeRecipient{},
+		FeeRecipientRevenue:   []FeeRecipientRevenue{},
+		UnconfirmedSlashes:    []UnconfirmedSlash{},
+		PendingRedelegations:  []PendingRedelegation{},
+		IcaRecoveries:         []ICARecovery{},
+		IcaRetries:            []ICARetry{},
+		LsmTokenDeposits:      []LSMTokenDeposit{},
 	}
 }
 
@@ -46,5 +54,79 @@ func (gs GenesisState) Validate() error {
 		epochTrackerIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated index in redemptionRateRecords
+	redemptionRateRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RedemptionRateRecords {
+		index := string(RedemptionRateRecordKey(elem.ChainId, elem.EpochNumber))
+		if _, ok := redemptionRateRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for redemptionRateRecord: %s epoch %d", elem.ChainId, elem.EpochNumber)
+		}
+		redemptionRateRecordIndexMap[index] = struct{}{}
+	}
+
+	// Fee recipient names must be unique
+	if err := ValidateFeeRecipients(gs.FeeRecipients); err != nil {
+		return err
+	}
+
+	// Check for duplicated index in feeRecipientRevenue
+	feeRecipientRevenueIndexMap := make(map[string]struct{})
+	for _, elem := range gs.FeeRecipientRevenue {
+		if _, ok := feeRecipientRevenueIndexMap[elem.Name]; ok {
+			return fmt.Errorf("duplicated index for feeRecipientRevenue: %s", elem.Name)
+		}
+		feeRecipientRevenueIndexMap[elem.Name] = struct{}{}
+	}
+
+	// Check for duplicated index in unconfirmedSlashes
+	unconfirmedSlashIndexMap := make(map[string]struct{})
+	for _, elem := range gs.UnconfirmedSlashes {
+		index := string(UnconfirmedSlashKey(elem.ChainId, elem.ValidatorAddress))
+		if _, ok := unconfirmedSlashIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for unconfirmedSlash: %s %s", elem.ChainId, elem.ValidatorAddress)
+		}
+		unconfirmedSlashIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in pendingRedelegations
+	pendingRedelegationIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingRedelegations {
+		index := string(PendingRedelegationKey(elem.ChainId, elem.SrcValidator, elem.DstValidator, elem.CompletionTime))
+		if _, ok := pendingRedelegationIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for pendingRedelegation: %s %s -> %s", elem.ChainId, elem.SrcValidator, elem.DstValidator)
+		}
+		pendingRedelegationIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in icaRecoveries
+	icaRecoveryIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IcaRecoveries {
+		index := string(ICARecoveryKey(elem.ChainId, elem.AccountType))
+		if _, ok := icaRecoveryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for icaRecovery: %s %s", elem.ChainId, elem.AccountType)
+		}
+		icaRecoveryIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in icaRetries
+	icaRetryIndexMap := make(map[string]struct{})
+	for _, elem := range gs.IcaRetries {
+		index := string(ICARetryKey(elem.ChainId, elem.Operation, elem.RecordId))
+		if _, ok := icaRetryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for icaRetry: %s %s %d", elem.ChainId, elem.Operation, elem.RecordId)
+		}
+		icaRetryIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in lsmTokenDeposits
+	lsmTokenDepositIndexMap := make(map[string]struct{})
+	for _, elem := range gs.LsmTokenDeposits {
+		index := string(LSMTokenDepositKey(elem.ChainId, elem.Denom, elem.StakerAddress))
+		if _, ok := lsmTokenDepositIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for lsmTokenDeposit: %s %s %s", elem.ChainId, elem.Denom, elem.StakerAddress)
+		}
+		lsmTokenDepositIndexMap[index] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
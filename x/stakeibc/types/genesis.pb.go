@@ -28,8 +28,16 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
 	// list of zones that are registered by the protocol
-	HostZoneList     []HostZone     `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
-	EpochTrackerList []EpochTracker `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
+	HostZoneList          []HostZone             `protobuf:"bytes,5,rep,name=host_zone_list,json=hostZoneList,proto3" json:"host_zone_list"`
+	EpochTrackerList      []EpochTracker         `protobuf:"bytes,10,rep,name=epoch_tracker_list,json=epochTrackerList,proto3" json:"epoch_tracker_list"`
+	RedemptionRateRecords []RedemptionRateRecord `protobuf:"bytes,12,rep,name=redemption_rate_records,json=redemptionRateRecords,proto3" json:"redemption_rate_records"`
+	FeeRecipients         []FeeRecipient         `protobuf:"bytes,13,rep,name=fee_recipients,json=feeRecipients,proto3" json:"fee_recipients"`
+	FeeRecipientRevenue   []FeeRecipientRevenue  `protobuf:"bytes,14,rep,name=fee_recipient_revenue,json=feeRecipientRevenue,proto3" json:"fee_recipient_revenue"`
+	UnconfirmedSlashes    []UnconfirmedSlash     `protobuf:"bytes,15,rep,name=unconfirmed_slashes,json=unconfirmedSlashes,proto3" json:"unconfirmed_slashes"`
+	PendingRedelegations  []PendingRedelegation  `protobuf:"bytes,16,rep,name=pending_redelegations,json=pendingRedelegations,proto3" json:"pending_redelegations"`
+	IcaRecoveries         []ICARecovery          `protobuf:"bytes,17,rep,name=ica_recoveries,json=icaRecoveries,proto3" json:"ica_recoveries"`
+	IcaRetries            []ICARetry             `protobuf:"bytes,18,rep,name=ica_retries,json=icaRetries,proto3" json:"ica_retries"`
+	LsmTokenDeposits      []LSMTokenDeposit      `protobuf:"bytes,19,rep,name=lsm_token_deposits,json=lsmTokenDeposits,proto3" json:"lsm_token_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -93,6 +101,62 @@ func (m *GenesisState) GetEpochTrackerList() []EpochTracker {
 	return nil
 }
 
+func (m *GenesisState) GetRedemptionRateRecords() []RedemptionRateRecord {
+	if m != nil {
+		return m.RedemptionRateRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeRecipients() []FeeRecipient {
+	if m != nil {
+		return m.FeeRecipients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeRecipientRevenue() []FeeRecipientRevenue {
+	if m != nil {
+		return m.FeeRecipientRevenue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnconfirmedSlashes() []UnconfirmedSlash {
+	if m != nil {
+		return m.UnconfirmedSlashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRedelegations() []PendingRedelegation {
+	if m != nil {
+		return m.PendingRedelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaRecoveries() []ICARecovery {
+	if m != nil {
+		return m.IcaRecoveries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaRetries() []ICARetry {
+	if m != nil {
+		return m.IcaRetries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLsmTokenDeposits() []LSMTokenDeposit {
+	if m != nil {
+		return m.LsmTokenDeposits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "stride.stakeibc.GenesisState")
 }
@@ -100,29 +164,47 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/genesis.proto", fileDescriptor_dea81129ed6fb77a) }
 
 var fileDescriptor_dea81129ed6fb77a = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0xc6, 0x5b, 0x38, 0x4a, 0x39, 0x88, 0x36, 0x8d, 0x09, 0x48, 0xa4, 0x10, 0x5d, 0x58, 0x6c,
-	0x23, 0xc6, 0xc1, 0x95, 0x84, 0xa8, 0x95, 0x41, 0xc1, 0x89, 0xa5, 0x69, 0xcb, 0xa5, 0x6d, 0x10,
-	0xae, 0xe9, 0xbd, 0x1a, 0xf5, 0x53, 0x38, 0xf9, 0x99, 0x18, 0x19, 0x9d, 0x8c, 0x81, 0x2f, 0x62,
-	0xda, 0x3b, 0xff, 0x95, 0xed, 0xde, 0x7b, 0x7e, 0xf9, 0xbd, 0x4f, 0x5e, 0xdc, 0x62, 0x90, 0x44,
-	0x53, 0x62, 0x31, 0x70, 0x67, 0x24, 0xf2, 0x7c, 0x2b, 0x20, 0x0b, 0xc2, 0x22, 0x66, 0xc6, 0x09,
-	0x05, 0xaa, 0xef, 0xf2, 0xd8, 0xfc, 0x8e, 0x9b, 0x7b, 0x01, 0x0d, 0x68, 0x96, 0x59, 0xe9, 0x8b,
-	0x63, 0xcd, 0x83, 0xbc, 0x25, 0x76, 0x13, 0x77, 0x2e, 0x24, 0xcd, 0x76, 0x3e, 0x0d, 0x29, 0x03,
-	0xe7, 0x85, 0x2e, 0x88, 0x00, 0x8e, 0xf2, 0x00, 0x89, 0xa9, 0x1f, 0x3a, 0x90, 0xb8, 0xfe, 0x8c,
-	0x24, 0x1c, 0x3a, 0x7c, 0x2b, 0xe0, 0xda, 0x05, 0x2f, 0x37, 0x06, 0x17, 0x88, 0x7e, 0x86, 0x15,
-	0xbe, 0xa6, 0x21, 0x77, 0xe4, 0x6e, 0xb5, 0x57, 0x37, 0x73, 0x65, 0xcd, 0x9b, 0x2c, 0xee, 0xa3,
-	0xe5, 0x47, 0x5b, 0x1a, 0x09, 0x58, 0xaf, 0xe3, 0x72, 0x4c, 0x13, 0x70, 0xa2, 0x69, 0xa3, 0xd0,
-	0x91, 0xbb, 0x95, 0x91, 0x92, 0x8e, 0x57, 0x53, 0x7d, 0x80, 0x77, 0x7e, 0x8a, 0x39, 0xf7, 0x11,
-	0x83, 0x46, 0xa9, 0x53, 0xec, 0x56, 0x7b, 0xfb, 0x5b, 0xde, 0x4b, 0xca, 0x60, 0x42, 0x17, 0x44,
-	0x98, 0x6b, 0xa1, 0x98, 0x87, 0x11, 0x03, 0xfd, 0x16, 0xeb, 0xff, 0xea, 0x73, 0x15, 0xce, 0x54,
-	0xad, 0x2d, 0xd5, 0x20, 0x45, 0xef, 0x38, 0x29, 0x74, 0x1a, 0xf9, 0xf3, 0x97, 0x2a, 0x6d, 0xa4,
-	0x16, 0x35, 0x64, 0x23, 0x15, 0x69, 0x25, 0x1b, 0xa9, 0x8a, 0x56, 0xb6, 0x91, 0x5a, 0xd1, 0xb0,
-	0x8d, 0xd4, 0xaa, 0x56, 0xeb, 0x5f, 0x2f, 0xd7, 0x86, 0xbc, 0x5a, 0x1b, 0xf2, 0xe7, 0xda, 0x90,
-	0x5f, 0x37, 0x86, 0xb4, 0xda, 0x18, 0xd2, 0xfb, 0xc6, 0x90, 0x26, 0x27, 0x41, 0x04, 0xe1, 0x83,
-	0x67, 0xfa, 0x74, 0x6e, 0x8d, 0xb3, 0xc5, 0xc7, 0x43, 0xd7, 0x63, 0x96, 0x38, 0xf7, 0xe3, 0xb9,
-	0xf5, 0xf4, 0x7b, 0x73, 0x78, 0x8e, 0x09, 0xf3, 0x94, 0xec, 0xd8, 0xa7, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xc9, 0x91, 0x92, 0xe3, 0x18, 0x02, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x4e, 0xdb, 0x4c,
+	0x10, 0xc7, 0x93, 0x0f, 0x13, 0xc2, 0x26, 0x04, 0x7f, 0x0b, 0x08, 0x43, 0x21, 0xa4, 0xb4, 0x48,
+	0x5c, 0x9a, 0xa8, 0x54, 0x3d, 0xf4, 0xd6, 0xd2, 0xd2, 0x96, 0x94, 0x4a, 0xd4, 0xa1, 0x52, 0xc5,
+	0xa1, 0x96, 0x63, 0x4f, 0x92, 0x15, 0xb1, 0xd7, 0xda, 0xd9, 0xa0, 0xd2, 0xa7, 0xe8, 0x4b, 0x55,
+	0xe2, 0xc8, 0xb1, 0xa7, 0xaa, 0x82, 0x17, 0xa9, 0xbc, 0xde, 0x84, 0x60, 0xe3, 0xde, 0xe2, 0xfd,
+	0xff, 0xfc, 0x1b, 0x67, 0x67, 0x76, 0xc9, 0x26, 0x4a, 0xc1, 0x7c, 0x68, 0xa1, 0x74, 0xcf, 0x80,
+	0x75, 0xbd, 0x56, 0x1f, 0x42, 0x40, 0x86, 0xcd, 0x48, 0x70, 0xc9, 0xe9, 0x62, 0x12, 0x37, 0xc7,
+	0xf1, 0xfa, 0x72, 0x9f, 0xf7, 0xb9, 0xca, 0x5a, 0xf1, 0xaf, 0x04, 0x5b, 0xdf, 0x48, 0x5b, 0x22,
+	0x57, 0xb8, 0x81, 0x96, 0xac, 0x6f, 0xa5, 0xd3, 0x01, 0x47, 0xe9, 0x7c, 0xe7, 0x21, 0x68, 0xe0,
+	0x51, 0x1a, 0x80, 0x88, 0x7b, 0x03, 0x47, 0x0a, 0xd7, 0x3b, 0x03, 0xa1, 0xa1, 0x9d, 0x34, 0x24,
+	0xc0, 0x87, 0x20, 0x92, 0x8c, 0x87, 0x8e, 0x70, 0x65, 0xae, 0xab, 0x07, 0xe0, 0x08, 0xf0, 0x58,
+	0xc4, 0x20, 0x94, 0x1a, 0x7a, 0x90, 0x86, 0x70, 0xe8, 0xe2, 0x40, 0x87, 0xdb, 0xf7, 0x15, 0x1a,
+	0x42, 0xdf, 0x8d, 0x4b, 0xe5, 0x31, 0xcc, 0x73, 0xe3, 0x2a, 0xfc, 0x1c, 0xc4, 0x45, 0xde, 0xdf,
+	0x4e, 0x18, 0x39, 0x01, 0xd6, 0xd2, 0xc0, 0x10, 0x83, 0x24, 0xda, 0xfe, 0x39, 0x47, 0xaa, 0xef,
+	0x92, 0x4e, 0x74, 0xa4, 0x2b, 0x81, 0x3e, 0x27, 0xa5, 0x64, 0x4f, 0xad, 0x62, 0xa3, 0xb8, 0x5b,
+	0xd9, 0x5b, 0x6d, 0xa6, 0x3a, 0xd3, 0x3c, 0x56, 0xf1, 0xbe, 0x71, 0xf9, 0x7b, 0xab, 0x60, 0x6b,
+	0x98, 0xae, 0x92, 0xb9, 0x88, 0x0b, 0xe9, 0x30, 0xdf, 0xfa, 0xaf, 0x51, 0xdc, 0x9d, 0xb7, 0x4b,
+	0xf1, 0xe3, 0xa1, 0x4f, 0x0f, 0x48, 0x6d, 0xd2, 0x05, 0x67, 0xc8, 0x50, 0x5a, 0xb3, 0x8d, 0x99,
+	0xdd, 0xca, 0xde, 0x5a, 0xc6, 0xfb, 0x9e, 0xa3, 0x3c, 0xe5, 0x21, 0x68, 0x73, 0x75, 0xa0, 0x9f,
+	0x8f, 0x18, 0x4a, 0xfa, 0x89, 0xd0, 0x3b, 0xbd, 0x4a, 0x54, 0x44, 0xa9, 0x36, 0x33, 0xaa, 0x83,
+	0x18, 0x3d, 0x49, 0x48, 0xad, 0x33, 0x61, 0x6a, 0x4d, 0x29, 0x3d, 0xb2, 0x9a, 0xea, 0xac, 0xda,
+	0x58, 0xe1, 0xa3, 0x55, 0x55, 0xde, 0x9d, 0x8c, 0xd7, 0x9e, 0xf0, 0xb6, 0x2b, 0xc1, 0x56, 0xb4,
+	0xf6, 0xaf, 0x88, 0x7b, 0x32, 0xa4, 0x6d, 0x52, 0xbb, 0x33, 0x17, 0x68, 0x2d, 0xe4, 0x7c, 0xf3,
+	0x5b, 0x00, 0x7b, 0x4c, 0x69, 0xe7, 0x42, 0x6f, 0x6a, 0x0d, 0xe9, 0x57, 0xb2, 0x72, 0xc7, 0xe5,
+	0x08, 0x38, 0x87, 0x70, 0x04, 0x56, 0x4d, 0x29, 0x1f, 0xff, 0x53, 0x69, 0x27, 0xac, 0x36, 0x2f,
+	0xf5, 0xb2, 0x11, 0xfd, 0x42, 0x96, 0x46, 0xa1, 0xc7, 0xc3, 0x1e, 0x13, 0x01, 0xf8, 0x8e, 0x1a,
+	0x55, 0x40, 0x6b, 0x51, 0xd9, 0x1f, 0x66, 0xec, 0x9f, 0x6f, 0xd9, 0x4e, 0x8c, 0x6a, 0x35, 0x1d,
+	0xa5, 0xd6, 0x01, 0xa9, 0x43, 0x56, 0x22, 0x08, 0x7d, 0x16, 0xf6, 0x9d, 0xe9, 0x19, 0x47, 0xcb,
+	0xcc, 0xf9, 0xf2, 0xe3, 0x84, 0xb6, 0xa7, 0x60, 0xad, 0x5f, 0x8e, 0xb2, 0x11, 0xd2, 0x43, 0x52,
+	0x9b, 0x3a, 0x18, 0x0c, 0xd0, 0xfa, 0x5f, 0x99, 0x37, 0x32, 0xe6, 0xc3, 0xd7, 0xaf, 0x6c, 0x7d,
+	0x7c, 0xc6, 0xbb, 0xcc, 0x3c, 0xd7, 0x9e, 0xbc, 0x48, 0x5f, 0x92, 0xca, 0xf8, 0xfc, 0xc4, 0x1e,
+	0x9a, 0x33, 0xad, 0xca, 0x23, 0x27, 0x12, 0xa2, 0x24, 0xea, 0x15, 0x7a, 0x42, 0xe8, 0x10, 0x03,
+	0x47, 0xf2, 0x33, 0x08, 0x1d, 0x1f, 0x22, 0x8e, 0x4c, 0xa2, 0xb5, 0xa4, 0x44, 0x8d, 0x8c, 0xe8,
+	0xa8, 0xf3, 0xf1, 0x24, 0x26, 0xdf, 0x24, 0xe0, 0x78, 0x5c, 0x87, 0x18, 0x4c, 0x2f, 0x63, 0xdb,
+	0x28, 0xcf, 0x98, 0x46, 0xdb, 0x28, 0x1b, 0xe6, 0x6c, 0xdb, 0x28, 0x97, 0xcc, 0xb9, 0xb6, 0x51,
+	0x9e, 0x37, 0x49, 0xdb, 0x28, 0x57, 0xcc, 0xea, 0xfe, 0x87, 0xcb, 0xeb, 0x7a, 0xf1, 0xea, 0xba,
+	0x5e, 0xfc, 0x73, 0x5d, 0x2f, 0xfe, 0xb8, 0xa9, 0x17, 0xae, 0x6e, 0xea, 0x85, 0x5f, 0x37, 0xf5,
+	0xc2, 0xe9, 0xd3, 0x3e, 0x93, 0x83, 0x51, 0xb7, 0xe9, 0xf1, 0xa0, 0xd5, 0x51, 0xb5, 0x9f, 0x1c,
+	0xb9, 0x5d, 0x6c, 0xe9, 0x3b, 0xe1, 0xfc, 0x45, 0xeb, 0xdb, 0xed, 0xc5, 0x20, 0x2f, 0x22, 0xc0,
+	0x6e, 0x49, 0xdd, 0x0d, 0xcf, 0xfe, 0x0e, 0x00, 0xa1, 0xdd, 0x7b, 0x5e, 0xb4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +227,126 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LsmTokenDeposits) > 0 {
+		for iNdEx := len(m.LsmTokenDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LsmTokenDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.IcaRetries) > 0 {
+		for iNdEx := len(m.IcaRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.IcaRecoveries) > 0 {
+		for iNdEx := len(m.IcaRecoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PendingRedelegations) > 0 {
+		for iNdEx := len(m.PendingRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.UnconfirmedSlashes) > 0 {
+		for iNdEx := len(m.UnconfirmedSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnconfirmedSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.FeeRecipientRevenue) > 0 {
+		for iNdEx := len(m.FeeRecipientRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRecipientRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FeeRecipients) > 0 {
+		for iNdEx := len(m.FeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.RedemptionRateRecords) > 0 {
+		for iNdEx := len(m.RedemptionRateRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.EpochTrackerList) > 0 {
 		for iNdEx := len(m.EpochTrackerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -228,6 +430,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RedemptionRateRecords) > 0 {
+		for _, e := range m.RedemptionRateRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRecipients) > 0 {
+		for _, e := range m.FeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRecipientRevenue) > 0 {
+		for _, e := range m.FeeRecipientRevenue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnconfirmedSlashes) > 0 {
+		for _, e := range m.UnconfirmedSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRedelegations) > 0 {
+		for _, e := range m.PendingRedelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaRecoveries) > 0 {
+		for _, e := range m.IcaRecoveries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaRetries) > 0 {
+		for _, e := range m.IcaRetries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LsmTokenDeposits) > 0 {
+		for _, e := range m.LsmTokenDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -399,6 +649,278 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateRecords = append(m.RedemptionRateRecords, RedemptionRateRecord{})
+			if err := m.RedemptionRateRecords[len(m.RedemptionRateRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipients = append(m.FeeRecipients, FeeRecipient{})
+			if err := m.FeeRecipients[len(m.FeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipientRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipientRevenue = append(m.FeeRecipientRevenue, FeeRecipientRevenue{})
+			if err := m.FeeRecipientRevenue[len(m.FeeRecipientRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnconfirmedSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnconfirmedSlashes = append(m.UnconfirmedSlashes, UnconfirmedSlash{})
+			if err := m.UnconfirmedSlashes[len(m.UnconfirmedSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRedelegations = append(m.PendingRedelegations, PendingRedelegation{})
+			if err := m.PendingRedelegations[len(m.PendingRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaRecoveries = append(m.IcaRecoveries, ICARecovery{})
+			if err := m.IcaRecoveries[len(m.IcaRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRetries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaRetries = append(m.IcaRetries, ICARetry{})
+			if err := m.IcaRetries[len(m.IcaRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmTokenDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LsmTokenDeposits = append(m.LsmTokenDeposits, LSMTokenDeposit{})
+			if err := m.LsmTokenDeposits[len(m.LsmTokenDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -35,6 +35,89 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated redemption rate record",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				RedemptionRateRecords: []types.RedemptionRateRecord{
+					{ChainId: "0", EpochNumber: 1},
+					{ChainId: "0", EpochNumber: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid fee recipient",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				FeeRecipients: []types.FeeRecipient{
+					{Name: "community", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 0},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated unconfirmed slash",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				UnconfirmedSlashes: []types.UnconfirmedSlash{
+					{ChainId: "0", ValidatorAddress: "val1"},
+					{ChainId: "0", ValidatorAddress: "val1"},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pending redelegation",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				PendingRedelegations: []types.PendingRedelegation{
+					{ChainId: "0", SrcValidator: "val1", DstValidator: "val2", CompletionTime: 1},
+					{ChainId: "0", SrcValidator: "val1", DstValidator: "val2", CompletionTime: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ica recovery",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaRecoveries: []types.ICARecovery{
+					{ChainId: "0", AccountType: types.ICAAccountType_DELEGATION},
+					{ChainId: "0", AccountType: types.ICAAccountType_DELEGATION},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated ica retry",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				IcaRetries: []types.ICARetry{
+					{ChainId: "0", Operation: types.ICARetry_DELEGATE, RecordId: 1},
+					{ChainId: "0", Operation: types.ICARetry_DELEGATE, RecordId: 1},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated lsm token deposit",
+			genState: &types.GenesisState{
+				PortId: types.PortID,
+				LsmTokenDeposits: []types.LSMTokenDeposit{
+					{ChainId: "0", Denom: "val1/1", StakerAddress: "staker"},
+					{ChainId: "0", Denom: "val1/1", StakerAddress: "staker"},
+				},
+				Params: types.DefaultParams(),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -10,15 +10,30 @@ import (
 )
 
 const (
-	ProposalTypeAddValidators = "AddValidators"
+	ProposalTypeAddValidators       = "AddValidators"
+	ProposalTypeUpdateHostZone      = "UpdateHostZone"
+	ProposalTypeSunsetHostZone      = "SunsetHostZone"
+	ProposalTypeAbortHostZoneSunset = "AbortHostZoneSunset"
+	ProposalTypeUpdateFeeRecipients = "UpdateFeeRecipients"
+	ProposalTypeResumeHostZone      = "ResumeHostZone"
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeAddValidators)
+	govtypes.RegisterProposalType(ProposalTypeUpdateHostZone)
+	govtypes.RegisterProposalType(ProposalTypeSunsetHostZone)
+	govtypes.RegisterProposalType(ProposalTypeAbortHostZoneSunset)
+	govtypes.RegisterProposalType(ProposalTypeUpdateFeeRecipients)
+	govtypes.RegisterProposalType(ProposalTypeResumeHostZone)
 }
 
 var (
 	_ govtypes.Content = &AddValidatorsProposal{}
+	_ govtypes.Content = &UpdateHostZoneProposal{}
+	_ govtypes.Content = &SunsetHostZoneProposal{}
+	_ govtypes.Content = &AbortHostZoneSunsetProposal{}
+	_ govtypes.Content = &UpdateFeeRecipientsProposal{}
+	_ govtypes.Content = &ResumeHostZoneProposal{}
 )
 
 func NewAddValidatorsProposal(title, description, hostZone string, validators []*Validator) govtypes.Content {
@@ -71,6 +86,187 @@ func (p AddValidatorsProposal) String() string {
   `, p.Title, p.Description, p.HostZone, p.Validators)
 }
 
+func (p *UpdateHostZoneProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateHostZoneProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateHostZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateHostZoneProposal) ProposalType() string {
+	return ProposalTypeUpdateHostZone
+}
+
+// Returns the host zone update specified by the proposal
+// The creator is left empty since the update is authorized by governance
+func (p *UpdateHostZoneProposal) GetHostZoneUpdate() *MsgUpdateHostZone {
+	return &MsgUpdateHostZone{
+		ChainId:            p.ChainId,
+		StrideCommission:   p.StrideCommission,
+		UnbondingFrequency: p.UnbondingFrequency,
+		MinRedemptionRate:  p.MinRedemptionRate,
+		MaxRedemptionRate:  p.MaxRedemptionRate,
+		TransferChannelId:  p.TransferChannelId,
+		Bech32Prefix:       p.Bech32Prefix,
+		EpochIntervals:     p.EpochIntervals,
+		StTokenDisplayName: p.StTokenDisplayName,
+		StTokenSymbol:      p.StTokenSymbol,
+		StTokenExponent:    p.StTokenExponent,
+	}
+}
+
+func (p *UpdateHostZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
+	}
+
+	return ValidateHostZoneUpdate(p.GetHostZoneUpdate())
+}
+
+func (p UpdateHostZoneProposal) String() string {
+	return fmt.Sprintf(`Update Host Zone Proposal:
+	Title:              %s
+	Description:        %s
+	ChainId:            %s
+	StrideCommission:   %v
+	UnbondingFrequency: %d
+	MinRedemptionRate:  %v
+	MaxRedemptionRate:  %v
+	TransferChannelId:  %s
+	Bech32Prefix:       %s
+	EpochIntervals:     %v
+	StTokenDisplayName: %s
+	StTokenSymbol:      %s
+	StTokenExponent:    %d
+  `, p.Title, p.Description, p.ChainId, p.StrideCommission, p.UnbondingFrequency,
+		p.MinRedemptionRate, p.MaxRedemptionRate, p.TransferChannelId, p.Bech32Prefix, p.EpochIntervals,
+		p.StTokenDisplayName, p.StTokenSymbol, p.StTokenExponent)
+}
+
+func (p *SunsetHostZoneProposal) GetTitle() string { return p.Title }
+
+func (p *SunsetHostZoneProposal) GetDescription() string { return p.Description }
+
+func (p *SunsetHostZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SunsetHostZoneProposal) ProposalType() string {
+	return ProposalTypeSunsetHostZone
+}
+
+func (p *SunsetHostZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
+	}
+
+	return nil
+}
+
+func (p SunsetHostZoneProposal) String() string {
+	return fmt.Sprintf(`Sunset Host Zone Proposal:
+	Title:            %s
+	Description:      %s
+	ChainId:          %s
+  `, p.Title, p.Description, p.ChainId)
+}
+
+func (p *AbortHostZoneSunsetProposal) GetTitle() string { return p.Title }
+
+func (p *AbortHostZoneSunsetProposal) GetDescription() string { return p.Description }
+
+func (p *AbortHostZoneSunsetProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AbortHostZoneSunsetProposal) ProposalType() string {
+	return ProposalTypeAbortHostZoneSunset
+}
+
+func (p *AbortHostZoneSunsetProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
+	}
+
+	return nil
+}
+
+func (p AbortHostZoneSunsetProposal) String() string {
+	return fmt.Sprintf(`Abort Host Zone Sunset Proposal:
+	Title:            %s
+	Description:      %s
+	ChainId:          %s
+  `, p.Title, p.Description, p.ChainId)
+}
+
+func (p *UpdateFeeRecipientsProposal) GetTitle() string { return p.Title }
+
+func (p *UpdateFeeRecipientsProposal) GetDescription() string { return p.Description }
+
+func (p *UpdateFeeRecipientsProposal) ProposalRoute() string { return RouterKey }
+
+func (p *UpdateFeeRecipientsProposal) ProposalType() string {
+	return ProposalTypeUpdateFeeRecipients
+}
+
+func (p *UpdateFeeRecipientsProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return ValidateFeeRecipients(p.FeeRecipients)
+}
+
+func (p UpdateFeeRecipientsProposal) String() string {
+	return fmt.Sprintf(`Update Fee Recipients Proposal:
+	Title:            %s
+	Description:      %s
+	FeeRecipients:    %+v
+  `, p.Title, p.Description, p.FeeRecipients)
+}
+
+func (p *ResumeHostZoneProposal) GetTitle() string { return p.Title }
+
+func (p *ResumeHostZoneProposal) GetDescription() string { return p.Description }
+
+func (p *ResumeHostZoneProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ResumeHostZoneProposal) ProposalType() string {
+	return ProposalTypeResumeHostZone
+}
+
+func (p *ResumeHostZoneProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if len(p.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
+	}
+
+	return nil
+}
+
+func (p ResumeHostZoneProposal) String() string {
+	return fmt.Sprintf(`Resume Host Zone Proposal:
+	Title:            %s
+	Description:      %s
+	ChainId:          %s
+  `, p.Title, p.Description, p.ChainId)
+}
+
 func (v *Validator) Equal(other *Validator) bool {
 	if v == nil || other == nil {
 		return false
@@ -83,3 +279,13 @@ func (v *Validator) Equal(other *Validator) bool {
 	}
 	return true
 }
+
+func (r *FeeRecipient) Equal(other *FeeRecipient) bool {
+	if r == nil || other == nil {
+		return false
+	}
+	return r.Name == other.Name &&
+		r.Type == other.Type &&
+		r.Address == other.Address &&
+		r.Weight == other.Weight
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// The maximum number of unbonding entries between a delegator and validator on the host,
+// as enforced by the host's staking module (the default MaxEntries staking param)
+const MaxUnbondingEntries = 7
+
+// Returns the key of a staking param in the host's params store
+// Params are stored under the module's subspace name, followed by the param key (e.g. "staking/UnbondingTime")
+func GetHostStakingParamKey(paramKey []byte) []byte {
+	return append([]byte(stakingtypes.ModuleName+"/"), paramKey...)
+}
+
+// Returns the key of a slashing param in the host's params store (e.g. "slashing/SlashFractionDowntime")
+func GetHostSlashingParamKey(paramKey []byte) []byte {
+	return append([]byte(slashingtypes.ModuleName+"/"), paramKey...)
+}
+
+// Returns the lowest unbonding frequency (in days) that keeps the number of concurrent unbondings
+// from the delegation account within the host's max unbonding entries
+// With an unbonding every N days, floor(unbondingDays / N) + 1 unbondings can be in progress at once (since an
+// unbonding that completes on the day of the next unbonding is still counted). Keeping that at or below
+// MaxUnbondingEntries requires N > unbondingDays / MaxUnbondingEntries, so the minimum frequency is
+// floor(unbondingDays / MaxUnbondingEntries) + 1
+// e.g. a 21 day unbonding period requires a frequency of 4, a 28 day period requires 5, and a 48 day period requires 7
+func GetMinUnbondingFrequency(unbondingPeriod time.Duration) uint64 {
+	day := 24 * time.Hour
+	unbondingDays := uint64((unbondingPeriod + day - 1) / day)
+	return (unbondingDays + MaxUnbondingEntries) / MaxUnbondingEntries
+}
+
+// Confirms the unbonding frequency is high enough for the host's unbonding period
+func (p HostStakingParams) ValidateUnbondingFrequency(unbondingFrequency uint64) error {
+	minUnbondingFrequency := GetMinUnbondingFrequency(time.Duration(p.UnbondingPeriod))
+	if unbondingFrequency < minUnbondingFrequency {
+		return errorsmod.Wrapf(ErrInvalidUnbondingFrequency, "unbonding frequency %d is below the minimum of %d for an unbonding period of %s",
+			unbondingFrequency, minUnbondingFrequency, time.Duration(p.UnbondingPeriod))
+	}
+	return nil
+}
+
+// Returns the largest fraction of a validator's stake that the host could have slashed, given whether the
+// validator was tombstoned, or false if the host's slash fractions have not been queried yet
+// A tombstoned validator may have also been slashed for downtime before the double sign was processed
+func (p HostStakingParams) GetMaxSlashFraction(tombstoned bool) (sdk.Dec, bool) {
+	if p.SlashFractionDoubleSign == nil || p.SlashFractionDowntime == nil {
+		return sdk.ZeroDec(), false
+	}
+	if tombstoned {
+		return p.SlashFractionDoubleSign.Add(*p.SlashFractionDowntime), true
+	}
+	return *p.SlashFractionDowntime, true
+}
@@ -25,7 +25,235 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// next id: 22
+// Determines how a host zone's unbondings are split across its validators
+type UnbondingStrategy int32
+
+const (
+	// each validator unbonds a share of the total proportional to its weight
+	UnbondingStrategy_TARGET_WEIGHT UnbondingStrategy = 0
+	// validators above their target delegation unbond first, so that the
+	// unbonding also moves the validator set towards its target weights
+	UnbondingStrategy_REBALANCE UnbondingStrategy = 1
+)
+
+var UnbondingStrategy_name = map[int32]string{
+	0: "TARGET_WEIGHT",
+	1: "REBALANCE",
+}
+
+var UnbondingStrategy_value = map[string]int32{
+	"TARGET_WEIGHT": 0,
+	"REBALANCE":     1,
+}
+
+func (x UnbondingStrategy) String() string {
+	return proto.EnumName(UnbondingStrategy_name, int32(x))
+}
+
+func (UnbondingStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{0}
+}
+
+// Progress of a host zone's sunset (wind down), advanced in the day epoch
+type SunsetStatus int32
+
+const (
+	// the host zone is not being sunset
+	SunsetStatus_SUNSET_NONE SunsetStatus = 0
+	// liquid stakes and redemptions are disabled, and the delegations are
+	// unbonded in batches of validators
+	SunsetStatus_SUNSET_UNBONDING SunsetStatus = 1
+	// all funds have been unbonded to the redemption account, and stTokens are
+	// redeemed at the final sunset redemption rate
+	SunsetStatus_SUNSET_CLAIMABLE SunsetStatus = 2
+	// all stTokens have been redeemed, the leftover funds have been swept and
+	// the host zone is halted
+	SunsetStatus_SUNSET_COMPLETE SunsetStatus = 3
+	// all stTokens have been redeemed, and the leftover funds are being swept
+	// to stride (the sunset completes once the transfer is acknowledged)
+	SunsetStatus_SUNSET_SWEEPING SunsetStatus = 4
+	// the sunset was aborted while unbonding, and the funds that were already
+	// unbonded are waiting to land in the redemption account
+	SunsetStatus_SUNSET_ABORTING SunsetStatus = 5
+	// the sunset was aborted, and the funds that were already unbonded are
+	// being sent back to the delegation account to be restaked
+	SunsetStatus_SUNSET_ABORT_RESTAKING SunsetStatus = 6
+)
+
+var SunsetStatus_name = map[int32]string{
+	0: "SUNSET_NONE",
+	1: "SUNSET_UNBONDING",
+	2: "SUNSET_CLAIMABLE",
+	3: "SUNSET_COMPLETE",
+	4: "SUNSET_SWEEPING",
+	5: "SUNSET_ABORTING",
+	6: "SUNSET_ABORT_RESTAKING",
+}
+
+var SunsetStatus_value = map[string]int32{
+	"SUNSET_NONE":            0,
+	"SUNSET_UNBONDING":       1,
+	"SUNSET_CLAIMABLE":       2,
+	"SUNSET_COMPLETE":        3,
+	"SUNSET_SWEEPING":        4,
+	"SUNSET_ABORTING":        5,
+	"SUNSET_ABORT_RESTAKING": 6,
+}
+
+func (x SunsetStatus) String() string {
+	return proto.EnumName(SunsetStatus_name, int32(x))
+}
+
+func (SunsetStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{1}
+}
+
+// Staking params queried from the host via ICQ
+type HostStakingParams struct {
+	// duration of the host's unbonding period, in nanoseconds
+	UnbondingPeriod uint64 `protobuf:"varint,1,opt,name=unbonding_period,json=unbondingPeriod,proto3" json:"unbonding_period,omitempty"`
+	// maximum number of validators in the host's active set
+	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+	// denom of the host's staking token
+	BondDenom string `protobuf:"bytes,3,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// total tokens bonded on the host (the balance of the staking module's
+	// bonded pool), unset until the first query returns
+	BondedTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens,omitempty"`
+	// fractions of a validator's stake that are slashed on the host for a
+	// double sign or for downtime, unset until the first query returns
+	SlashFractionDoubleSign *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign,omitempty"`
+	SlashFractionDowntime   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime,omitempty"`
+}
+
+func (m *HostStakingParams) Reset()         { *m = HostStakingParams{} }
+func (m *HostStakingParams) String() string { return proto.CompactTextString(m) }
+func (*HostStakingParams) ProtoMessage()    {}
+func (*HostStakingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{0}
+}
+func (m *HostStakingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostStakingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostStakingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostStakingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostStakingParams.Merge(m, src)
+}
+func (m *HostStakingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostStakingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostStakingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostStakingParams proto.InternalMessageInfo
+
+func (m *HostStakingParams) GetUnbondingPeriod() uint64 {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+func (m *HostStakingParams) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+func (m *HostStakingParams) GetBondDenom() string {
+	if m != nil {
+		return m.BondDenom
+	}
+	return ""
+}
+
+// Per-host-zone overrides of the stride epoch intervals (in stride epochs)
+// Any interval left at zero falls back to the module-wide param
+type EpochIntervals struct {
+	// interval between transfers of deposits to the host
+	DepositInterval uint64 `protobuf:"varint,1,opt,name=deposit_interval,json=depositInterval,proto3" json:"deposit_interval,omitempty"`
+	// interval between delegations of transferred deposits
+	DelegateInterval uint64 `protobuf:"varint,2,opt,name=delegate_interval,json=delegateInterval,proto3" json:"delegate_interval,omitempty"`
+	// interval between reinvestments of staking rewards
+	ReinvestInterval uint64 `protobuf:"varint,3,opt,name=reinvest_interval,json=reinvestInterval,proto3" json:"reinvest_interval,omitempty"`
+	// interval between redemption rate updates
+	RedemptionRateInterval uint64 `protobuf:"varint,4,opt,name=redemption_rate_interval,json=redemptionRateInterval,proto3" json:"redemption_rate_interval,omitempty"`
+}
+
+func (m *EpochIntervals) Reset()         { *m = EpochIntervals{} }
+func (m *EpochIntervals) String() string { return proto.CompactTextString(m) }
+func (*EpochIntervals) ProtoMessage()    {}
+func (*EpochIntervals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f81bf5b42c61245a, []int{1}
+}
+func (m *EpochIntervals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochIntervals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochIntervals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochIntervals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochIntervals.Merge(m, src)
+}
+func (m *EpochIntervals) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochIntervals) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochIntervals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochIntervals proto.InternalMessageInfo
+
+func (m *EpochIntervals) GetDepositInterval() uint64 {
+	if m != nil {
+		return m.DepositInterval
+	}
+	return 0
+}
+
+func (m *EpochIntervals) GetDelegateInterval() uint64 {
+	if m != nil {
+		return m.DelegateInterval
+	}
+	return 0
+}
+
+func (m *EpochIntervals) GetReinvestInterval() uint64 {
+	if m != nil {
+		return m.ReinvestInterval
+	}
+	return 0
+}
+
+func (m *EpochIntervals) GetRedemptionRateInterval() uint64 {
+	if m != nil {
+		return m.RedemptionRateInterval
+	}
+	return 0
+}
+
+// next id: 32
 type HostZone struct {
 	ChainId               string       `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	ConnectionId          string       `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -41,8 +269,8 @@ type HostZone struct {
 	IbcDenom string `protobuf:"bytes,8,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
 	// native denom on host zone
 	HostDenom string `protobuf:"bytes,9,opt,name=host_denom,json=hostDenom,proto3" json:"host_denom,omitempty"`
-	// TODO(TEST-68): Should we make this an array and store the last n redemption
-	// rates then calculate a TWARR?
+	// the full history of recent redemption rates is stored separately as
+	// RedemptionRateRecords
 	LastRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=last_redemption_rate,json=lastRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_redemption_rate"`
 	RedemptionRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=redemption_rate,json=redemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"redemption_rate"`
 	// stores how many days we should wait before issuing unbondings
@@ -53,13 +281,42 @@ type HostZone struct {
 	Halted            bool                                   `protobuf:"varint,19,opt,name=halted,proto3" json:"halted,omitempty"`
 	MinRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=min_redemption_rate,json=minRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_redemption_rate"`
 	MaxRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=max_redemption_rate,json=maxRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_redemption_rate"`
+	// native tokens held back from deposits on stride to serve instant
+	// redemptions
+	InstantRedemptionBuffer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,22,opt,name=instant_redemption_buffer,json=instantRedemptionBuffer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"instant_redemption_buffer"`
+	// fraction of staking rewards taken as the stride commission (e.g. 0.1 =
+	// 10%), falls back to the stride_commission param if unset
+	// updated by the admin with MsgUpdateHostZone or by governance with an
+	// UpdateHostZoneProposal
+	StrideCommission *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=stride_commission,json=strideCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stride_commission,omitempty"`
+	// if set, validator weights are derived automatically from queried
+	// validator data rather than set by hand
+	ValidatorWeightPolicy *ValidatorWeightPolicy `protobuf:"bytes,24,opt,name=validator_weight_policy,json=validatorWeightPolicy,proto3" json:"validator_weight_policy,omitempty"`
+	// how unbondings are split across validators
+	UnbondingStrategy UnbondingStrategy `protobuf:"varint,25,opt,name=unbonding_strategy,json=unbondingStrategy,proto3,enum=stride.stakeibc.UnbondingStrategy" json:"unbonding_strategy,omitempty"`
+	// staking params queried from the host, unset until the first query returns
+	HostStakingParams *HostStakingParams `protobuf:"bytes,26,opt,name=host_staking_params,json=hostStakingParams,proto3" json:"host_staking_params,omitempty"`
+	// progress of the host zone's sunset, if one has been initiated
+	SunsetStatus SunsetStatus `protobuf:"varint,27,opt,name=sunset_status,json=sunsetStatus,proto3,enum=stride.stakeibc.SunsetStatus" json:"sunset_status,omitempty"`
+	// rate at which stTokens are redeemed once the sunset is claimable
+	SunsetRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,28,opt,name=sunset_redemption_rate,json=sunsetRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sunset_redemption_rate"`
+	// native tokens in the redemption account that are still reserved for
+	// sunset redemptions (or, if the sunset was aborted, that still need to be
+	// restaked)
+	SunsetClaimableBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,29,opt,name=sunset_claimable_balance,json=sunsetClaimableBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sunset_claimable_balance"`
+	// overrides of the module-wide stride epoch intervals, unset if the host
+	// zone follows the params
+	EpochIntervals *EpochIntervals `protobuf:"bytes,30,opt,name=epoch_intervals,json=epochIntervals,proto3" json:"epoch_intervals,omitempty"`
+	// time (in unix nanoseconds) at which the sunset became claimable, which
+	// starts the sunset claim period
+	SunsetClaimableTime uint64 `protobuf:"varint,31,opt,name=sunset_claimable_time,json=sunsetClaimableTime,proto3" json:"sunset_claimable_time,omitempty"`
 }
 
 func (m *HostZone) Reset()         { *m = HostZone{} }
 func (m *HostZone) String() string { return proto.CompactTextString(m) }
 func (*HostZone) ProtoMessage()    {}
 func (*HostZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f81bf5b42c61245a, []int{0}
+	return fileDescriptor_f81bf5b42c61245a, []int{2}
 }
 func (m *HostZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -193,56 +450,294 @@ func (m *HostZone) GetHalted() bool {
 	return false
 }
 
+func (m *HostZone) GetValidatorWeightPolicy() *ValidatorWeightPolicy {
+	if m != nil {
+		return m.ValidatorWeightPolicy
+	}
+	return nil
+}
+
+func (m *HostZone) GetUnbondingStrategy() UnbondingStrategy {
+	if m != nil {
+		return m.UnbondingStrategy
+	}
+	return UnbondingStrategy_TARGET_WEIGHT
+}
+
+func (m *HostZone) GetHostStakingParams() *HostStakingParams {
+	if m != nil {
+		return m.HostStakingParams
+	}
+	return nil
+}
+
+func (m *HostZone) GetSunsetStatus() SunsetStatus {
+	if m != nil {
+		return m.SunsetStatus
+	}
+	return SunsetStatus_SUNSET_NONE
+}
+
+func (m *HostZone) GetEpochIntervals() *EpochIntervals {
+	if m != nil {
+		return m.EpochIntervals
+	}
+	return nil
+}
+
+func (m *HostZone) GetSunsetClaimableTime() uint64 {
+	if m != nil {
+		return m.SunsetClaimableTime
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("stride.stakeibc.UnbondingStrategy", UnbondingStrategy_name, UnbondingStrategy_value)
+	proto.RegisterEnum("stride.stakeibc.SunsetStatus", SunsetStatus_name, SunsetStatus_value)
+	proto.RegisterType((*HostStakingParams)(nil), "stride.stakeibc.HostStakingParams")
+	proto.RegisterType((*EpochIntervals)(nil), "stride.stakeibc.EpochIntervals")
 	proto.RegisterType((*HostZone)(nil), "stride.stakeibc.HostZone")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/host_zone.proto", fileDescriptor_f81bf5b42c61245a) }
 
 var fileDescriptor_f81bf5b42c61245a = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0xdb, 0x30,
-	0x18, 0xc6, 0x9b, 0xc1, 0xa0, 0x75, 0xf9, 0x57, 0x17, 0x50, 0x00, 0xad, 0xed, 0x3a, 0x69, 0xea,
-	0x61, 0xa4, 0x1a, 0x9c, 0x86, 0xb8, 0xf0, 0x47, 0xd3, 0xca, 0xb6, 0xc3, 0x32, 0x89, 0x03, 0x97,
-	0xc8, 0xb1, 0xdf, 0xb6, 0x16, 0xa9, 0xdd, 0xc5, 0x2e, 0x94, 0x7d, 0x84, 0x9d, 0xf6, 0x61, 0xf6,
-	0x21, 0x38, 0xa2, 0x9d, 0xa6, 0x1d, 0xd0, 0x04, 0xdf, 0x60, 0x9f, 0x60, 0xaa, 0x93, 0xb4, 0xa1,
-	0x3d, 0xc0, 0x24, 0x4e, 0x89, 0xdf, 0xe7, 0x79, 0x7f, 0x8f, 0xf5, 0x3a, 0x31, 0x2a, 0x2b, 0x1d,
-	0x72, 0x06, 0x75, 0xa5, 0xc9, 0x29, 0x70, 0x9f, 0xd6, 0xdb, 0x52, 0x69, 0xef, 0xab, 0x14, 0xe0,
-	0x74, 0x43, 0xa9, 0x25, 0x5e, 0x8c, 0x0c, 0x4e, 0x62, 0x58, 0x9f, 0xe8, 0x38, 0x23, 0x01, 0x67,
-	0x44, 0xcb, 0x30, 0xea, 0x58, 0x7f, 0x3e, 0x6e, 0xe0, 0x94, 0x78, 0x84, 0x52, 0xd9, 0x13, 0x3a,
-	0xb6, 0x2c, 0xb7, 0x64, 0x4b, 0x9a, 0xd7, 0xfa, 0xe0, 0x2d, 0xae, 0xae, 0x51, 0xa9, 0x3a, 0x52,
-	0x79, 0x91, 0x10, 0x2d, 0x22, 0xa9, 0xfa, 0x0d, 0xa1, 0xec, 0x3b, 0xa9, 0xf4, 0x89, 0x14, 0x80,
-	0xd7, 0x50, 0x96, 0xb6, 0x09, 0x17, 0x1e, 0x67, 0xb6, 0x55, 0xb1, 0x6a, 0x39, 0x77, 0xd6, 0xac,
-	0x1b, 0x0c, 0xbf, 0x40, 0xf3, 0x54, 0x0a, 0x01, 0x54, 0x73, 0x69, 0xf4, 0x27, 0x46, 0x9f, 0x1b,
-	0x15, 0x1b, 0x0c, 0x57, 0xd1, 0x9c, 0x0f, 0xb4, 0xbd, 0xbd, 0xd5, 0x0d, 0xa1, 0xc9, 0xfb, 0x76,
-	0x21, 0xf2, 0xa4, 0x6b, 0xd8, 0x41, 0x45, 0x1d, 0x12, 0xa1, 0x9a, 0x10, 0x7a, 0xb4, 0x4d, 0x84,
-	0x80, 0x60, 0x80, 0x9b, 0x33, 0xd6, 0x42, 0x22, 0x1d, 0x44, 0x4a, 0x83, 0xe1, 0x1d, 0x84, 0x86,
-	0x73, 0x50, 0xf6, 0x54, 0x65, 0xaa, 0x96, 0xdf, 0x5a, 0x77, 0xc6, 0x66, 0xe7, 0x1c, 0x27, 0x16,
-	0x37, 0xe5, 0xc6, 0x9f, 0xd0, 0xaa, 0x1f, 0x10, 0x7a, 0x1a, 0x70, 0xa5, 0x81, 0x79, 0x29, 0xce,
-	0xf4, 0xbd, 0x9c, 0x95, 0x54, 0xe7, 0xf1, 0x08, 0x79, 0x84, 0xf0, 0x39, 0xd7, 0x6d, 0x16, 0x92,
-	0x73, 0x12, 0x24, 0xc3, 0xb7, 0x9f, 0x56, 0xac, 0x5a, 0x7e, 0x6b, 0x63, 0x02, 0xd7, 0x38, 0xd8,
-	0xdb, 0x8b, 0x2c, 0x6e, 0x61, 0xd4, 0x16, 0x97, 0xf0, 0x2e, 0xca, 0x37, 0x01, 0x86, 0x90, 0x99,
-	0xfb, 0x21, 0xa8, 0x09, 0x90, 0x74, 0x1f, 0x21, 0xcc, 0x20, 0x80, 0x16, 0x31, 0x27, 0x92, 0x40,
-	0x66, 0x1f, 0xb0, 0x93, 0x51, 0x5b, 0x8a, 0x15, 0x02, 0x83, 0x4e, 0xf7, 0x0e, 0x6b, 0xe9, 0x01,
-	0xac, 0x51, 0x5b, 0xc2, 0xda, 0x40, 0x39, 0xee, 0x53, 0x8f, 0x81, 0x90, 0x1d, 0x3b, 0x6b, 0x8e,
-	0x35, 0xcb, 0x7d, 0x7a, 0x38, 0x58, 0xe3, 0x67, 0x08, 0x99, 0xff, 0x20, 0x52, 0x73, 0x46, 0xcd,
-	0x0d, 0x2a, 0x91, 0x2c, 0xd0, 0x72, 0x40, 0x94, 0xf6, 0x52, 0x9b, 0x09, 0x89, 0x06, 0x1b, 0x0d,
-	0x8c, 0xfb, 0xbb, 0x97, 0xd7, 0xe5, 0xcc, 0xef, 0xeb, 0xf2, 0xcb, 0x16, 0xd7, 0xed, 0x9e, 0xef,
-	0x50, 0xd9, 0x89, 0x3f, 0xe6, 0xf8, 0xb1, 0xa9, 0xd8, 0x69, 0x5d, 0x5f, 0x74, 0x41, 0x39, 0x87,
-	0x40, 0x7f, 0xfe, 0xd8, 0x44, 0xf1, 0xb7, 0x7e, 0x08, 0xd4, 0xc5, 0x03, 0xb2, 0x3b, 0x04, 0xbb,
-	0x44, 0x03, 0x06, 0xb4, 0x38, 0x1e, 0x95, 0x7f, 0x84, 0xa8, 0x85, 0xf0, 0x6e, 0x4c, 0x1d, 0x15,
-	0x7b, 0xc2, 0x97, 0x82, 0x71, 0xd1, 0xf2, 0x9a, 0x21, 0x7c, 0xe9, 0x81, 0xa0, 0x17, 0xf6, 0x42,
-	0xc5, 0xaa, 0x4d, 0xbb, 0x78, 0x28, 0xbd, 0x4d, 0x14, 0xfc, 0x11, 0x21, 0x33, 0x6d, 0xe6, 0xf9,
-	0x24, 0xb0, 0xe7, 0xcd, 0x96, 0x9c, 0xff, 0xd8, 0x52, 0x43, 0x68, 0x37, 0x17, 0x11, 0xf6, 0x49,
-	0x80, 0x5f, 0xa1, 0x59, 0xc2, 0x58, 0x08, 0x4a, 0xd9, 0xd8, 0xb0, 0xf0, 0xdf, 0xeb, 0xf2, 0xc2,
-	0x05, 0xe9, 0x04, 0x3b, 0xd5, 0x58, 0xa8, 0xba, 0x89, 0x05, 0xaf, 0xa2, 0x99, 0x36, 0x09, 0x34,
-	0x30, 0xbb, 0x58, 0xb1, 0x6a, 0x59, 0x37, 0x5e, 0xe1, 0x00, 0x15, 0x3b, 0x5c, 0x4c, 0x9c, 0xcd,
-	0xf2, 0x23, 0x0c, 0xac, 0xd0, 0xe1, 0x62, 0xec, 0x68, 0x06, 0x69, 0xa4, 0x3f, 0x91, 0xb6, 0xf2,
-	0x28, 0x69, 0xa4, 0x7f, 0x37, 0xed, 0x68, 0x3a, 0xbb, 0xb8, 0xb4, 0xb4, 0xff, 0xfe, 0xf2, 0xa6,
-	0x64, 0x5d, 0xdd, 0x94, 0xac, 0x3f, 0x37, 0x25, 0xeb, 0xfb, 0x6d, 0x29, 0x73, 0x75, 0x5b, 0xca,
-	0xfc, 0xba, 0x2d, 0x65, 0x4e, 0x5e, 0xa7, 0x82, 0x3e, 0x9b, 0xdf, 0x61, 0xf3, 0x03, 0xf1, 0x55,
-	0x3d, 0xbe, 0x91, 0xcf, 0xde, 0xd4, 0xfb, 0xa3, 0x6b, 0xd9, 0xe4, 0xfa, 0x33, 0xe6, 0x82, 0xdd,
-	0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x95, 0x33, 0x36, 0x09, 0x06, 0x00, 0x00,
+	// 1297 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0x63, 0xc5, 0xb1, 0xae, 0xad, 0xd7, 0xc8, 0x0f, 0xda, 0xa9, 0x25, 0x57, 0x45, 0x03,
+	0x37, 0x6d, 0x24, 0xd4, 0x41, 0x81, 0x36, 0xc8, 0x46, 0xb2, 0x99, 0x44, 0x89, 0x23, 0x3b, 0x94,
+	0xd2, 0x00, 0x59, 0x94, 0x18, 0x92, 0x23, 0x69, 0x6a, 0x72, 0xa8, 0x72, 0x46, 0x7e, 0xf4, 0x0b,
+	0xba, 0xec, 0x27, 0x74, 0xd5, 0x2f, 0xe8, 0x47, 0x04, 0x5d, 0x05, 0x05, 0x0a, 0x14, 0x5d, 0x04,
+	0x45, 0xb2, 0xe9, 0xba, 0x5f, 0x50, 0x70, 0x48, 0x4a, 0xb4, 0xd4, 0x22, 0x89, 0xe1, 0x55, 0x34,
+	0xe7, 0x9e, 0x7b, 0xee, 0xe4, 0xbe, 0x3c, 0x84, 0x0a, 0x17, 0x3e, 0xb5, 0x49, 0x9d, 0x0b, 0x7c,
+	0x44, 0xa8, 0x69, 0xd5, 0x07, 0x1e, 0x17, 0xc6, 0xf7, 0x1e, 0x23, 0xb5, 0xa1, 0xef, 0x09, 0x0f,
+	0xe5, 0x43, 0x42, 0x2d, 0x26, 0x6c, 0xcc, 0x78, 0x1c, 0x63, 0x87, 0xda, 0x58, 0x78, 0x7e, 0xe8,
+	0xb1, 0xf1, 0xe1, 0x34, 0x81, 0x5a, 0xd8, 0xc0, 0x96, 0xe5, 0x8d, 0x98, 0x88, 0x28, 0xcb, 0x7d,
+	0xaf, 0xef, 0xc9, 0x9f, 0xf5, 0xe0, 0x57, 0x84, 0xae, 0x5b, 0x1e, 0x77, 0x3d, 0x6e, 0x84, 0x86,
+	0xf0, 0x10, 0x9a, 0xaa, 0xbf, 0xce, 0x41, 0xf1, 0x81, 0xc7, 0x45, 0x47, 0xe0, 0x23, 0xca, 0xfa,
+	0x87, 0xd8, 0xc7, 0x2e, 0x47, 0x9f, 0x40, 0x61, 0xc4, 0x4c, 0x8f, 0xd9, 0x94, 0xf5, 0x8d, 0x21,
+	0xf1, 0xa9, 0x67, 0xab, 0xca, 0x96, 0xb2, 0x9d, 0xd6, 0xf3, 0x63, 0xfc, 0x50, 0xc2, 0xe8, 0x63,
+	0xc8, 0xb9, 0xf8, 0xd4, 0x18, 0xdf, 0x95, 0xab, 0x57, 0xb6, 0x94, 0xed, 0xac, 0x9e, 0x75, 0xf1,
+	0xe9, 0xd7, 0x63, 0x10, 0x6d, 0x02, 0x04, 0x7e, 0x86, 0x4d, 0x98, 0xe7, 0xaa, 0x73, 0x5b, 0xca,
+	0x76, 0x46, 0xcf, 0x04, 0xc8, 0x5e, 0x00, 0xa0, 0x0e, 0x64, 0x83, 0x03, 0xb1, 0x0d, 0xe1, 0x1d,
+	0x11, 0xc6, 0xd5, 0x74, 0xc0, 0x68, 0xd6, 0x5e, 0xbc, 0xaa, 0x28, 0x7f, 0xbe, 0xaa, 0xdc, 0xe8,
+	0x53, 0x31, 0x18, 0x99, 0x35, 0xcb, 0x73, 0xa3, 0xeb, 0x47, 0xff, 0xdc, 0xe2, 0xf6, 0x51, 0x5d,
+	0x9c, 0x0d, 0x09, 0xaf, 0xb5, 0x98, 0xd0, 0x97, 0x42, 0x91, 0xae, 0xd4, 0x40, 0x67, 0xb0, 0xc1,
+	0x1d, 0xcc, 0x07, 0x46, 0xcf, 0xc7, 0x96, 0xa0, 0x1e, 0x33, 0x6c, 0x6f, 0x64, 0x3a, 0xc4, 0xe0,
+	0xb4, 0xcf, 0xd4, 0xab, 0x32, 0xc2, 0xdd, 0xf7, 0x88, 0xb0, 0x47, 0xac, 0xdf, 0x7e, 0xb9, 0x05,
+	0x51, 0xfe, 0xf6, 0x88, 0xa5, 0xaf, 0x49, 0xfd, 0x7b, 0x91, 0xfc, 0x9e, 0x54, 0xef, 0xd0, 0x3e,
+	0x43, 0x02, 0xd6, 0x66, 0x42, 0x9f, 0x30, 0x41, 0x5d, 0xa2, 0xce, 0x5f, 0x42, 0xdc, 0x95, 0xa9,
+	0xb8, 0xa1, 0x74, 0xf5, 0x77, 0x05, 0x72, 0xda, 0xd0, 0xb3, 0x06, 0x2d, 0x26, 0x88, 0x7f, 0x8c,
+	0x1d, 0x59, 0x49, 0x9b, 0x0c, 0x3d, 0x4e, 0x85, 0x41, 0x23, 0x30, 0xae, 0x64, 0x84, 0xc7, 0x5c,
+	0xf4, 0x29, 0x14, 0x6d, 0xe2, 0x90, 0x3e, 0x16, 0x64, 0xc2, 0xbd, 0x22, 0xb9, 0x85, 0xd8, 0x90,
+	0x24, 0xfb, 0x84, 0xb2, 0x63, 0xc2, 0x13, 0xc2, 0x73, 0x21, 0x39, 0x36, 0x8c, 0xc9, 0x5f, 0x82,
+	0xea, 0x13, 0x9b, 0xb8, 0x43, 0x99, 0x09, 0xff, 0x5c, 0x80, 0xb4, 0xf4, 0x59, 0x9d, 0xd8, 0xf5,
+	0x44, 0x98, 0x3b, 0xe9, 0xbf, 0x7f, 0xaa, 0x28, 0xd5, 0x1f, 0x8a, 0xb0, 0x10, 0x34, 0xe9, 0x73,
+	0x8f, 0x11, 0xb4, 0x0e, 0x0b, 0xd6, 0x00, 0x53, 0x66, 0xd0, 0xb0, 0x27, 0x33, 0xfa, 0x35, 0x79,
+	0x6e, 0xd9, 0xe8, 0x23, 0xc8, 0x5a, 0x1e, 0x63, 0x24, 0xcc, 0x38, 0xb5, 0xe5, 0xed, 0x33, 0xfa,
+	0xd2, 0x04, 0x6c, 0xd9, 0xa8, 0x0a, 0x4b, 0x26, 0xb1, 0x06, 0xb7, 0x77, 0x86, 0x3e, 0xe9, 0xd1,
+	0x53, 0xb5, 0x18, 0x72, 0x92, 0x18, 0xaa, 0x41, 0x49, 0xf8, 0x98, 0xf1, 0x1e, 0xf1, 0x0d, 0x6b,
+	0x80, 0x19, 0x23, 0x4e, 0x20, 0xb7, 0x24, 0xa9, 0xc5, 0xd8, 0xb4, 0x1b, 0x5a, 0x5a, 0x36, 0xba,
+	0x03, 0x90, 0x18, 0x80, 0xb9, 0xad, 0xb9, 0xed, 0xc5, 0x9d, 0x8d, 0xda, 0xd4, 0x80, 0xd7, 0xc6,
+	0xe3, 0xa0, 0x27, 0xd8, 0xe8, 0x09, 0xac, 0x9a, 0x0e, 0xb6, 0x8e, 0x1c, 0xca, 0x05, 0xb1, 0x93,
+	0x83, 0x94, 0x7e, 0xab, 0xce, 0x4a, 0xc2, 0x33, 0x31, 0x6c, 0x0f, 0x01, 0x9d, 0x50, 0x31, 0xb0,
+	0x7d, 0x7c, 0x82, 0x9d, 0x78, 0x43, 0xc8, 0x86, 0x5f, 0xdc, 0xb9, 0x3e, 0x23, 0xd7, 0xda, 0x6d,
+	0x34, 0x42, 0x8a, 0x5e, 0x9c, 0xb8, 0x45, 0x10, 0xba, 0x0b, 0x8b, 0x3d, 0x42, 0xc6, 0x22, 0xf3,
+	0x6f, 0x17, 0x81, 0x1e, 0x21, 0xb1, 0xf7, 0x43, 0x40, 0x51, 0xeb, 0x04, 0x15, 0x89, 0x45, 0xae,
+	0xbd, 0xc3, 0x4d, 0x26, 0x6e, 0x09, 0xad, 0x44, 0x17, 0xc5, 0x5a, 0x85, 0x77, 0xd0, 0x9a, 0xb8,
+	0xc5, 0x5a, 0xd7, 0x21, 0x43, 0x4d, 0x2b, 0xda, 0x46, 0x0b, 0xb2, 0xac, 0x0b, 0xd4, 0xb4, 0xc2,
+	0x65, 0xb4, 0x09, 0x20, 0x97, 0x75, 0x68, 0xcd, 0x84, 0xbb, 0x2a, 0x40, 0x42, 0x33, 0x83, 0x65,
+	0x07, 0x73, 0x61, 0x4c, 0xb5, 0xb4, 0x0a, 0xe3, 0xc1, 0x4e, 0x5d, 0x78, 0xb0, 0x51, 0xa0, 0xac,
+	0x9f, 0x9b, 0x05, 0x44, 0x20, 0x3f, 0x1d, 0x6a, 0xf1, 0x12, 0x42, 0xe5, 0xce, 0x8f, 0x1c, 0xaa,
+	0x43, 0x69, 0xb2, 0xf3, 0x7b, 0x3e, 0xf9, 0x6e, 0x44, 0x98, 0x75, 0xa6, 0xe6, 0xe4, 0x7c, 0xa2,
+	0xb1, 0xe9, 0x5e, 0x6c, 0x41, 0x8f, 0x01, 0x64, 0xb6, 0x6d, 0xc3, 0xc4, 0x8e, 0x9a, 0x1d, 0x2f,
+	0xec, 0xd4, 0x7b, 0x2c, 0xec, 0x4c, 0xa8, 0xd0, 0xc4, 0x0e, 0xfa, 0x0c, 0xae, 0x61, 0xdb, 0xf6,
+	0x09, 0xe7, 0x2a, 0x92, 0x5a, 0xe8, 0x9f, 0x57, 0x95, 0xdc, 0x19, 0x76, 0x9d, 0x3b, 0xd5, 0xc8,
+	0x50, 0xd5, 0x63, 0x0a, 0x5a, 0x85, 0xf9, 0x01, 0x76, 0x04, 0xb1, 0xd5, 0xd2, 0x96, 0xb2, 0xbd,
+	0xa0, 0x47, 0x27, 0xe4, 0x40, 0xc9, 0xa5, 0x6c, 0xa6, 0x36, 0xcb, 0x97, 0x90, 0xb0, 0xa2, 0x4b,
+	0xd9, 0x54, 0x69, 0x82, 0x68, 0xf8, 0x74, 0x26, 0xda, 0xca, 0xa5, 0x44, 0xc3, 0xa7, 0x53, 0xd1,
+	0xbe, 0x85, 0x75, 0xca, 0xb8, 0xc0, 0xec, 0x5c, 0xef, 0x99, 0xa3, 0x5e, 0x8f, 0xf8, 0xea, 0xea,
+	0x85, 0xf2, 0xbf, 0x16, 0x09, 0x4e, 0x22, 0x35, 0xa5, 0x1c, 0xa2, 0x50, 0x0c, 0x27, 0xca, 0xb0,
+	0x3c, 0xd7, 0xa5, 0x9c, 0x53, 0x8f, 0xa9, 0x6b, 0x97, 0xf0, 0xa7, 0xab, 0x10, 0xca, 0xee, 0x8e,
+	0x55, 0xd1, 0x37, 0xb0, 0x36, 0x5e, 0x7a, 0xc6, 0x09, 0xa1, 0xfd, 0x81, 0x30, 0x86, 0x9e, 0x43,
+	0xad, 0x33, 0x55, 0x95, 0xc3, 0x7d, 0xe3, 0xff, 0x37, 0xe0, 0x33, 0x49, 0x3f, 0x94, 0x6c, 0x7d,
+	0xe5, 0xf8, 0xbf, 0x60, 0xf4, 0x04, 0x26, 0xdd, 0x6b, 0x70, 0x11, 0x54, 0xa8, 0x7f, 0xa6, 0xae,
+	0x6f, 0x29, 0xdb, 0xb9, 0x9d, 0xea, 0x8c, 0xf4, 0xd3, 0x98, 0xda, 0x89, 0x98, 0x7a, 0x71, 0x34,
+	0x0d, 0x21, 0x1d, 0x4a, 0x72, 0x43, 0xf0, 0xf0, 0xd5, 0x64, 0x0c, 0xe5, 0xb3, 0x49, 0xdd, 0x90,
+	0xd7, 0x9d, 0xd5, 0x9c, 0x79, 0x60, 0xe9, 0xc5, 0xc1, 0x34, 0x84, 0x9a, 0x90, 0xe5, 0x23, 0xc6,
+	0x89, 0x54, 0x15, 0x23, 0xae, 0x5e, 0x97, 0x37, 0xdc, 0x9c, 0x51, 0xeb, 0x48, 0x56, 0x47, 0x92,
+	0xf4, 0x25, 0x9e, 0x38, 0x21, 0x1f, 0x56, 0x23, 0x8d, 0xe9, 0x96, 0xfc, 0xe0, 0x12, 0x5a, 0x72,
+	0x39, 0xd4, 0x9e, 0xea, 0xca, 0x01, 0xa8, 0x51, 0x4c, 0xcb, 0xc1, 0xd4, 0xc5, 0xc1, 0x03, 0xcb,
+	0xc4, 0x0e, 0x66, 0x16, 0x51, 0x37, 0x2f, 0xd4, 0x94, 0xd1, 0xff, 0x61, 0x37, 0x96, 0x6b, 0x86,
+	0x6a, 0xe8, 0x01, 0xe4, 0x49, 0xf0, 0xba, 0x19, 0x3f, 0x1e, 0xb8, 0x5a, 0x96, 0x19, 0xaf, 0xcc,
+	0xe4, 0xe8, 0xfc, 0x2b, 0x48, 0xcf, 0x91, 0x73, 0x67, 0xb4, 0x03, 0x2b, 0x33, 0x77, 0x96, 0x8f,
+	0xb3, 0x8a, 0xdc, 0x76, 0xa5, 0xa9, 0x0b, 0x74, 0xa9, 0x4b, 0x1e, 0xa6, 0x17, 0xf2, 0x85, 0xc2,
+	0xcd, 0x2f, 0xa0, 0x38, 0xd3, 0x21, 0xa8, 0x08, 0xd9, 0x6e, 0x43, 0xbf, 0xaf, 0x75, 0x8d, 0x67,
+	0x5a, 0xeb, 0xfe, 0x83, 0x6e, 0x21, 0x85, 0xb2, 0x90, 0xd1, 0xb5, 0x66, 0x63, 0xbf, 0xd1, 0xde,
+	0xd5, 0x0a, 0xca, 0xcd, 0x9f, 0x15, 0x58, 0x4a, 0xd6, 0x0d, 0xe5, 0x61, 0xb1, 0xf3, 0xb4, 0xdd,
+	0xd1, 0xba, 0x46, 0xfb, 0xa0, 0xad, 0x15, 0x52, 0x68, 0x19, 0x0a, 0x11, 0xf0, 0xb4, 0xdd, 0x3c,
+	0x68, 0xef, 0xb5, 0xda, 0xf7, 0x0b, 0x4a, 0x02, 0xdd, 0xdd, 0x6f, 0xb4, 0x1e, 0x37, 0x9a, 0xfb,
+	0x5a, 0xe1, 0x0a, 0x2a, 0x41, 0x3e, 0x46, 0x0f, 0x1e, 0x1f, 0xee, 0x6b, 0x5d, 0xad, 0x30, 0x97,
+	0x00, 0x3b, 0xcf, 0x34, 0xed, 0x30, 0xf0, 0x4f, 0x27, 0xc0, 0x46, 0xf3, 0x40, 0xef, 0x06, 0xe0,
+	0x55, 0xb4, 0x01, 0xab, 0x49, 0xd0, 0xd0, 0xb5, 0x4e, 0xb7, 0xf1, 0x28, 0xb0, 0xcd, 0x37, 0x1f,
+	0xbd, 0x78, 0x5d, 0x56, 0x5e, 0xbe, 0x2e, 0x2b, 0x7f, 0xbd, 0x2e, 0x2b, 0x3f, 0xbe, 0x29, 0xa7,
+	0x5e, 0xbe, 0x29, 0xa7, 0xfe, 0x78, 0x53, 0x4e, 0x3d, 0xff, 0x3c, 0x51, 0xbd, 0x8e, 0x4c, 0xf7,
+	0xad, 0x7d, 0x6c, 0xf2, 0x7a, 0xf4, 0x51, 0x72, 0xfc, 0x55, 0xfd, 0x74, 0xf2, 0x65, 0x22, 0x8b,
+	0x69, 0xce, 0xcb, 0x6f, 0x8c, 0xdb, 0xff, 0x0e, 0x00, 0x24, 0xaf, 0x83, 0x7f, 0x0c, 0x0d, 0x00,
+	0x00,
+}
+
+func (this *EpochIntervals) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochIntervals)
+	if !ok {
+		that2, ok := that.(EpochIntervals)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DepositInterval != that1.DepositInterval {
+		return false
+	}
+	if this.DelegateInterval != that1.DelegateInterval {
+		return false
+	}
+	if this.ReinvestInterval != that1.ReinvestInterval {
+		return false
+	}
+	if this.RedemptionRateInterval != that1.RedemptionRateInterval {
+		return false
+	}
+	return true
+}
+func (m *HostStakingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostStakingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostStakingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashFractionDowntime != nil {
+		{
+			size := m.SlashFractionDowntime.Size()
+			i -= size
+			if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SlashFractionDoubleSign != nil {
+		{
+			size := m.SlashFractionDoubleSign.Size()
+			i -= size
+			if _, err := m.SlashFractionDoubleSign.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BondedTokens != nil {
+		{
+			size := m.BondedTokens.Size()
+			i -= size
+			if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintHostZone(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxValidators != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UnbondingPeriod != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.UnbondingPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochIntervals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochIntervals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochIntervals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionRateInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.RedemptionRateInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReinvestInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.ReinvestInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelegateInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.DelegateInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DepositInterval != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.DepositInterval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *HostZone) Marshal() (dAtA []byte, err error) {
@@ -265,10 +760,31 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SunsetClaimableTime != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.SunsetClaimableTime))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.EpochIntervals != nil {
+		{
+			size, err := m.EpochIntervals.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	{
-		size := m.MaxRedemptionRate.Size()
+		size := m.SunsetClaimableBalance.Size()
 		i -= size
-		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SunsetClaimableBalance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
@@ -276,11 +792,11 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xaa
+	dAtA[i] = 0xea
 	{
-		size := m.MinRedemptionRate.Size()
+		size := m.SunsetRedemptionRate.Size()
 		i -= size
-		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.SunsetRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintHostZone(dAtA, i, uint64(size))
@@ -288,13 +804,105 @@ func (m *HostZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1
 	i--
-	dAtA[i] = 0xa2
-	if m.Halted {
+	dAtA[i] = 0xe2
+	if m.SunsetStatus != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.SunsetStatus))
 		i--
-		if m.Halted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.HostStakingParams != nil {
+		{
+			size, err := m.HostStakingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.UnbondingStrategy != 0 {
+		i = encodeVarintHostZone(dAtA, i, uint64(m.UnbondingStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ValidatorWeightPolicy != nil {
+		{
+			size, err := m.ValidatorWeightPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.StrideCommission != nil {
+		{
+			size := m.StrideCommission.Size()
+			i -= size
+			if _, err := m.StrideCommission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintHostZone(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	{
+		size := m.InstantRedemptionBuffer.Size()
+		i -= size
+		if _, err := m.InstantRedemptionBuffer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.MaxRedemptionRate.Size()
+		i -= size
+		if _, err := m.MaxRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MinRedemptionRate.Size()
+		i -= size
+		if _, err := m.MinRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHostZone(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x1
@@ -481,6 +1089,58 @@ func encodeVarintHostZone(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *HostStakingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnbondingPeriod != 0 {
+		n += 1 + sovHostZone(uint64(m.UnbondingPeriod))
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovHostZone(uint64(m.MaxValidators))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if m.BondedTokens != nil {
+		l = m.BondedTokens.Size()
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if m.SlashFractionDoubleSign != nil {
+		l = m.SlashFractionDoubleSign.Size()
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	if m.SlashFractionDowntime != nil {
+		l = m.SlashFractionDowntime.Size()
+		n += 1 + l + sovHostZone(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochIntervals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.DepositInterval))
+	}
+	if m.DelegateInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.DelegateInterval))
+	}
+	if m.ReinvestInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.ReinvestInterval))
+	}
+	if m.RedemptionRateInterval != 0 {
+		n += 1 + sovHostZone(uint64(m.RedemptionRateInterval))
+	}
+	return n
+}
+
 func (m *HostZone) Size() (n int) {
 	if m == nil {
 		return 0
@@ -559,6 +1219,37 @@ func (m *HostZone) Size() (n int) {
 	n += 2 + l + sovHostZone(uint64(l))
 	l = m.MaxRedemptionRate.Size()
 	n += 2 + l + sovHostZone(uint64(l))
+	l = m.InstantRedemptionBuffer.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	if m.StrideCommission != nil {
+		l = m.StrideCommission.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.ValidatorWeightPolicy != nil {
+		l = m.ValidatorWeightPolicy.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.UnbondingStrategy != 0 {
+		n += 2 + sovHostZone(uint64(m.UnbondingStrategy))
+	}
+	if m.HostStakingParams != nil {
+		l = m.HostStakingParams.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.SunsetStatus != 0 {
+		n += 2 + sovHostZone(uint64(m.SunsetStatus))
+	}
+	l = m.SunsetRedemptionRate.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	l = m.SunsetClaimableBalance.Size()
+	n += 2 + l + sovHostZone(uint64(l))
+	if m.EpochIntervals != nil {
+		l = m.EpochIntervals.Size()
+		n += 2 + l + sovHostZone(uint64(l))
+	}
+	if m.SunsetClaimableTime != 0 {
+		n += 2 + sovHostZone(uint64(m.SunsetClaimableTime))
+	}
 	return n
 }
 
@@ -568,7 +1259,7 @@ func sovHostZone(x uint64) (n int) {
 func sozHostZone(x uint64) (n int) {
 	return sovHostZone(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *HostZone) Unmarshal(dAtA []byte) error {
+func (m *HostStakingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -591,15 +1282,641 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostStakingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostStakingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			m.UnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BondedTokens = &v
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SlashFractionDoubleSign = &v
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SlashFractionDowntime = &v
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochIntervals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochIntervals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochIntervals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInterval", wireType)
+			}
+			m.DepositInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateInterval", wireType)
+			}
+			m.DelegateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReinvestInterval", wireType)
+			}
+			m.ReinvestInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReinvestInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateInterval", wireType)
+			}
+			m.RedemptionRateInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionRateInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostZone(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostZone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostZone
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostZone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostZone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedValidators = append(m.BlacklistedValidators, &Validator{})
+			if err := m.BlacklistedValidators[len(m.BlacklistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithdrawalAccount == nil {
+				m.WithdrawalAccount = &ICAAccount{}
+			}
+			if err := m.WithdrawalAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeAccount == nil {
+				m.FeeAccount = &ICAAccount{}
+			}
+			if err := m.FeeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAccount == nil {
+				m.DelegationAccount = &ICAAccount{}
+			}
+			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostZone
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -627,11 +1944,11 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -659,13 +1976,15 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -675,31 +1994,31 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -709,31 +2028,29 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistedValidators = append(m.BlacklistedValidators, &Validator{})
-			if err := m.BlacklistedValidators[len(m.BlacklistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedBal", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -743,31 +2060,48 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithdrawalAccount == nil {
-				m.WithdrawalAccount = &ICAAccount{}
-			}
-			if err := m.WithdrawalAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakedBal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
+			}
+			m.UnbondingFrequency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFrequency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -794,18 +2128,18 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeAccount == nil {
-				m.FeeAccount = &ICAAccount{}
+			if m.RedemptionAccount == nil {
+				m.RedemptionAccount = &ICAAccount{}
 			}
-			if err := m.FeeAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RedemptionAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -815,31 +2149,27 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationAccount == nil {
-				m.DelegationAccount = &ICAAccount{}
-			}
-			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -867,11 +2197,31 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -899,11 +2249,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -931,13 +2283,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBuffer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -965,13 +2317,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InstantRedemptionBuffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrideCommission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,13 +2351,17 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferChannelId = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StrideCommission = &v
+			if err := m.StrideCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakedBal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorWeightPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1015,31 +2371,33 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakedBal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ValidatorWeightPolicy == nil {
+				m.ValidatorWeightPolicy = &ValidatorWeightPolicy{}
+			}
+			if err := m.ValidatorWeightPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingStrategy", wireType)
 			}
-			m.UnbondingFrequency = 0
+			m.UnbondingStrategy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1049,14 +2407,14 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingFrequency |= uint64(b&0x7F) << shift
+				m.UnbondingStrategy |= UnbondingStrategy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostStakingParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1083,18 +2441,18 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RedemptionAccount == nil {
-				m.RedemptionAccount = &ICAAccount{}
+			if m.HostStakingParams == nil {
+				m.HostStakingParams = &HostStakingParams{}
 			}
-			if err := m.RedemptionAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HostStakingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bech32Prefix", wireType)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetStatus", wireType)
 			}
-			var stringLen uint64
+			m.SunsetStatus = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1104,27 +2462,14 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SunsetStatus |= SunsetStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthHostZone
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Bech32Prefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1152,31 +2497,13 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowHostZone
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.SunsetRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Halted = bool(v != 0)
-		case 20:
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetClaimableBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1204,15 +2531,15 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SunsetClaimableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedemptionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIntervals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHostZone
@@ -1222,26 +2549,47 @@ func (m *HostZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthHostZone
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthHostZone
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EpochIntervals == nil {
+				m.EpochIntervals = &EpochIntervals{}
+			}
+			if err := m.EpochIntervals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetClaimableTime", wireType)
+			}
+			m.SunsetClaimableTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostZone
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetClaimableTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHostZone(dAtA[iNdEx:])
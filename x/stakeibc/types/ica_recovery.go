@@ -0,0 +1,34 @@
+package types
+
+// The number of stride epochs to wait between re-registrations of an interchain account
+// whose channel was closed. The wait doubles after each attempt, up to the max
+const (
+	ICARecoveryInitialBackoffEpochs uint64 = 1
+	ICARecoveryMaxBackoffEpochs     uint64 = 64
+)
+
+// The interchain accounts that are registered for each host zone
+var HostZoneICAAccountTypes = []ICAAccountType{
+	ICAAccountType_DELEGATION,
+	ICAAccountType_FEE,
+	ICAAccountType_WITHDRAWAL,
+	ICAAccountType_REDEMPTION,
+}
+
+// Returns the number of stride epochs to wait before the next re-registration,
+// given the number of attempts made so far
+func GetICARecoveryBackoff(attempts uint64) uint64 {
+	return getExponentialBackoff(attempts, ICARecoveryInitialBackoffEpochs, ICARecoveryMaxBackoffEpochs)
+}
+
+// Returns the initial backoff doubled for each attempt after the first, capped at the max backoff
+func getExponentialBackoff(attempts uint64, initialBackoff uint64, maxBackoff uint64) uint64 {
+	backoff := initialBackoff
+	for i := uint64(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return backoff
+}
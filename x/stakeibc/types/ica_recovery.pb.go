@@ -0,0 +1,524 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/ica_recovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// An interchain account whose controller channel was closed and is being
+// automatically re-registered. Records are keyed by chain ID and account type,
+// and are removed once the account's new channel is open
+type ICARecovery struct {
+	ChainId     string         `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	AccountType ICAAccountType `protobuf:"varint,2,opt,name=account_type,json=accountType,proto3,enum=stride.stakeibc.ICAAccountType" json:"account_type,omitempty"`
+	// the closed channel that triggered the recovery
+	ClosedChannelId string `protobuf:"bytes,3,opt,name=closed_channel_id,json=closedChannelId,proto3" json:"closed_channel_id,omitempty"`
+	// the stride epoch in which the closed channel was detected
+	DetectedEpoch uint64 `protobuf:"varint,4,opt,name=detected_epoch,json=detectedEpoch,proto3" json:"detected_epoch,omitempty"`
+	// the number of times the account has been re-registered
+	Attempts uint64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the first stride epoch in which the account can be re-registered again
+	NextAttemptEpoch uint64 `protobuf:"varint,6,opt,name=next_attempt_epoch,json=nextAttemptEpoch,proto3" json:"next_attempt_epoch,omitempty"`
+}
+
+func (m *ICARecovery) Reset()         { *m = ICARecovery{} }
+func (m *ICARecovery) String() string { return proto.CompactTextString(m) }
+func (*ICARecovery) ProtoMessage()    {}
+func (*ICARecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae3eb695f4a61e71, []int{0}
+}
+func (m *ICARecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICARecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICARecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICARecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICARecovery.Merge(m, src)
+}
+func (m *ICARecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICARecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICARecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICARecovery proto.InternalMessageInfo
+
+func (m *ICARecovery) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ICARecovery) GetAccountType() ICAAccountType {
+	if m != nil {
+		return m.AccountType
+	}
+	return ICAAccountType_DELEGATION
+}
+
+func (m *ICARecovery) GetClosedChannelId() string {
+	if m != nil {
+		return m.ClosedChannelId
+	}
+	return ""
+}
+
+func (m *ICARecovery) GetDetectedEpoch() uint64 {
+	if m != nil {
+		return m.DetectedEpoch
+	}
+	return 0
+}
+
+func (m *ICARecovery) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ICARecovery) GetNextAttemptEpoch() uint64 {
+	if m != nil {
+		return m.NextAttemptEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ICARecovery)(nil), "stride.stakeibc.ICARecovery")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/ica_recovery.proto", fileDescriptor_ae3eb695f4a61e71)
+}
+
+var fileDescriptor_ae3eb695f4a61e71 = []byte{
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0x3f, 0x4f, 0xc2, 0x40,
+	0x14, 0xe7, 0x10, 0x11, 0x0f, 0x05, 0xbd, 0xa9, 0x32, 0x54, 0x24, 0x31, 0x21, 0x46, 0xdb, 0xa8,
+	0x93, 0x63, 0x21, 0x0e, 0x8d, 0x4e, 0xd5, 0xc9, 0xa5, 0xb9, 0xde, 0xbd, 0xd8, 0x46, 0xe8, 0x35,
+	0xed, 0x83, 0xc0, 0x47, 0x70, 0xf3, 0x63, 0x39, 0x32, 0x3a, 0x1a, 0xfa, 0x45, 0x4c, 0xaf, 0x45,
+	0x12, 0xe3, 0xf8, 0x7e, 0x7f, 0xf3, 0x7e, 0x74, 0x90, 0x61, 0x1a, 0x49, 0xb0, 0x33, 0xe4, 0x6f,
+	0x10, 0x05, 0xc2, 0x8e, 0x04, 0xf7, 0x53, 0x10, 0x6a, 0x0e, 0xe9, 0xd2, 0x4a, 0x52, 0x85, 0x8a,
+	0x75, 0x4b, 0x8d, 0xb5, 0xd1, 0xf4, 0xce, 0xfe, 0x33, 0x71, 0x21, 0xd4, 0x2c, 0xc6, 0xd2, 0x33,
+	0x78, 0xaf, 0xd3, 0xb6, 0x3b, 0x76, 0xbc, 0x2a, 0x89, 0x9d, 0xd0, 0x96, 0x08, 0x79, 0x14, 0xfb,
+	0x91, 0x34, 0x48, 0x9f, 0x0c, 0xf7, 0xbd, 0x3d, 0x7d, 0xbb, 0x92, 0x8d, 0xe8, 0x41, 0xe5, 0xf5,
+	0x71, 0x99, 0x80, 0x51, 0xef, 0x93, 0x61, 0xe7, 0xe6, 0xd4, 0xfa, 0xd3, 0x6a, 0xb9, 0x63, 0xc7,
+	0x29, 0x75, 0xcf, 0xcb, 0x04, 0xbc, 0x36, 0xdf, 0x1e, 0xec, 0x82, 0x1e, 0x8b, 0x89, 0xca, 0x40,
+	0xfa, 0x22, 0xe4, 0x71, 0x0c, 0x93, 0xa2, 0x67, 0x47, 0xf7, 0x74, 0x4b, 0x62, 0x5c, 0xe2, 0xae,
+	0x64, 0xe7, 0xb4, 0x23, 0x01, 0x41, 0x20, 0x48, 0x1f, 0x12, 0x25, 0x42, 0xa3, 0xd1, 0x27, 0xc3,
+	0x86, 0x77, 0xb8, 0x41, 0xef, 0x0b, 0x90, 0xf5, 0x68, 0x8b, 0x23, 0xc2, 0x34, 0xc1, 0xcc, 0xd8,
+	0xd5, 0x82, 0xdf, 0x9b, 0x5d, 0x52, 0x16, 0xc3, 0x02, 0xfd, 0x0a, 0xa8, 0x62, 0x9a, 0x5a, 0x75,
+	0x54, 0x30, 0x4e, 0x49, 0xe8, 0xa4, 0xd1, 0xc3, 0xe7, 0xda, 0x24, 0xab, 0xb5, 0x49, 0xbe, 0xd7,
+	0x26, 0xf9, 0xc8, 0xcd, 0xda, 0x2a, 0x37, 0x6b, 0x5f, 0xb9, 0x59, 0x7b, 0xb9, 0x7e, 0x8d, 0x30,
+	0x9c, 0x05, 0x96, 0x50, 0x53, 0xfb, 0x49, 0xbf, 0x7b, 0xf5, 0xc8, 0x83, 0xcc, 0xae, 0xf6, 0x9d,
+	0xdf, 0xd9, 0x8b, 0xed, 0xc8, 0xc5, 0x38, 0x59, 0xd0, 0xd4, 0xfb, 0xde, 0xfe, 0x0c, 0x00, 0xc1,
+	0xeb, 0xe8, 0xb3, 0xb9, 0x01, 0x00, 0x00,
+}
+
+func (m *ICARecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICARecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICARecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextAttemptEpoch != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.NextAttemptEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DetectedEpoch != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.DetectedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClosedChannelId) > 0 {
+		i -= len(m.ClosedChannelId)
+		copy(dAtA[i:], m.ClosedChannelId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ClosedChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AccountType != 0 {
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(m.AccountType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaRecovery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaRecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaRecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ICARecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	if m.AccountType != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.AccountType))
+	}
+	l = len(m.ClosedChannelId)
+	if l > 0 {
+		n += 1 + l + sovIcaRecovery(uint64(l))
+	}
+	if m.DetectedEpoch != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.DetectedEpoch))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.Attempts))
+	}
+	if m.NextAttemptEpoch != 0 {
+		n += 1 + sovIcaRecovery(uint64(m.NextAttemptEpoch))
+	}
+	return n
+}
+
+func sovIcaRecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaRecovery(x uint64) (n int) {
+	return sovIcaRecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ICARecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICARecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICARecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountType", wireType)
+			}
+			m.AccountType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountType |= ICAAccountType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClosedChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedEpoch", wireType)
+			}
+			m.DetectedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptEpoch", wireType)
+			}
+			m.NextAttemptEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaRecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaRecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaRecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaRecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaRecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaRecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaRecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaRecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaRecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaRecovery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,15 @@
+package types
+
+// Failed ICA operations are retried after a backoff (in stride epochs) that doubles after each
+// failed attempt, up to the max. Once an operation has failed ICARetryMaxAttempts times, it's
+// moved to the dead letter state and is no longer retried automatically
+const (
+	ICARetryInitialBackoffEpochs uint64 = 1
+	ICARetryMaxBackoffEpochs     uint64 = 16
+	ICARetryMaxAttempts          uint64 = 6
+)
+
+// Returns the number of stride epochs to wait before the next retry, given the number of failed attempts
+func GetICARetryBackoff(attempts uint64) uint64 {
+	return getExponentialBackoff(attempts, ICARetryInitialBackoffEpochs, ICARetryMaxBackoffEpochs)
+}
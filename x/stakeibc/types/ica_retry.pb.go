@@ -0,0 +1,622 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/ica_retry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ICARetry_Operation int32
+
+const (
+	// delegation of a deposit record (the record ID is the deposit record ID)
+	ICARetry_DELEGATE ICARetry_Operation = 0
+	// undelegation of the host zone's queued unbonding records
+	ICARetry_UNDELEGATE ICARetry_Operation = 1
+	// reinvestment of the withdrawal account's balance
+	ICARetry_REINVEST ICARetry_Operation = 2
+)
+
+var ICARetry_Operation_name = map[int32]string{
+	0: "DELEGATE",
+	1: "UNDELEGATE",
+	2: "REINVEST",
+}
+
+var ICARetry_Operation_value = map[string]int32{
+	"DELEGATE":   0,
+	"UNDELEGATE": 1,
+	"REINVEST":   2,
+}
+
+func (x ICARetry_Operation) String() string {
+	return proto.EnumName(ICARetry_Operation_name, int32(x))
+}
+
+func (ICARetry_Operation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5f620be1719e6134, []int{0, 0}
+}
+
+type ICARetry_Status int32
+
+const (
+	// the operation will be retried automatically once the backoff elapses
+	ICARetry_PENDING ICARetry_Status = 0
+	// the operation is no longer retried automatically
+	ICARetry_DEAD_LETTER ICARetry_Status = 1
+)
+
+var ICARetry_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "DEAD_LETTER",
+}
+
+var ICARetry_Status_value = map[string]int32{
+	"PENDING":     0,
+	"DEAD_LETTER": 1,
+}
+
+func (x ICARetry_Status) String() string {
+	return proto.EnumName(ICARetry_Status_name, int32(x))
+}
+
+func (ICARetry_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5f620be1719e6134, []int{0, 1}
+}
+
+// An ICA operation that failed to submit (or timed out) and is retried at the
+// start of each stride epoch with an exponential backoff. Records are keyed by
+// chain ID, operation and record ID, and are removed once the operation's tx
+// is submitted. After too many failed attempts, the retry is moved to the dead
+// letter state and must be forced or dropped by an admin
+type ICARetry struct {
+	ChainId   string             `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Operation ICARetry_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=stride.stakeibc.ICARetry_Operation" json:"operation,omitempty"`
+	RecordId  uint64             `protobuf:"varint,3,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Status    ICARetry_Status    `protobuf:"varint,4,opt,name=status,proto3,enum=stride.stakeibc.ICARetry_Status" json:"status,omitempty"`
+	// the number of failed attempts
+	Attempts uint64 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the first stride epoch in which the operation can be retried
+	NextAttemptEpoch uint64 `protobuf:"varint,6,opt,name=next_attempt_epoch,json=nextAttemptEpoch,proto3" json:"next_attempt_epoch,omitempty"`
+	// the error from the most recent failed attempt
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *ICARetry) Reset()         { *m = ICARetry{} }
+func (m *ICARetry) String() string { return proto.CompactTextString(m) }
+func (*ICARetry) ProtoMessage()    {}
+func (*ICARetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f620be1719e6134, []int{0}
+}
+func (m *ICARetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICARetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICARetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ICARetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICARetry.Merge(m, src)
+}
+func (m *ICARetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICARetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICARetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICARetry proto.InternalMessageInfo
+
+func (m *ICARetry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ICARetry) GetOperation() ICARetry_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return ICARetry_DELEGATE
+}
+
+func (m *ICARetry) GetRecordId() uint64 {
+	if m != nil {
+		return m.RecordId
+	}
+	return 0
+}
+
+func (m *ICARetry) GetStatus() ICARetry_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ICARetry_PENDING
+}
+
+func (m *ICARetry) GetAttempts() uint64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ICARetry) GetNextAttemptEpoch() uint64 {
+	if m != nil {
+		return m.NextAttemptEpoch
+	}
+	return 0
+}
+
+func (m *ICARetry) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.ICARetry_Operation", ICARetry_Operation_name, ICARetry_Operation_value)
+	proto.RegisterEnum("stride.stakeibc.ICARetry_Status", ICARetry_Status_name, ICARetry_Status_value)
+	proto.RegisterType((*ICARetry)(nil), "stride.stakeibc.ICARetry")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/ica_retry.proto", fileDescriptor_5f620be1719e6134) }
+
+var fileDescriptor_5f620be1719e6134 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0xae, 0x93, 0x40,
+	0x14, 0x86, 0x99, 0x7b, 0xaf, 0x14, 0x4e, 0x4d, 0x4b, 0x66, 0x85, 0x1a, 0x91, 0xd4, 0xc4, 0x74,
+	0xa1, 0x10, 0x75, 0xa1, 0x2e, 0x51, 0x26, 0x0d, 0xb1, 0x41, 0x43, 0xd1, 0x85, 0x1b, 0x32, 0xc0,
+	0xc4, 0x12, 0x6d, 0x87, 0xcc, 0x4c, 0x4d, 0xfb, 0x08, 0xee, 0x7c, 0x2c, 0x97, 0x5d, 0xba, 0x34,
+	0xed, 0x8b, 0x18, 0xa0, 0xb4, 0x89, 0xc9, 0x5d, 0x9e, 0xff, 0x7c, 0x7c, 0x61, 0xce, 0x0f, 0x8f,
+	0xa4, 0x12, 0x55, 0xc9, 0x7c, 0xa9, 0xe8, 0x37, 0x56, 0xe5, 0x85, 0x5f, 0x15, 0x34, 0x13, 0x4c,
+	0x89, 0x9d, 0x57, 0x0b, 0xae, 0x38, 0x1e, 0x77, 0x80, 0xd7, 0x03, 0x93, 0x9f, 0xd7, 0x60, 0x44,
+	0xef, 0x82, 0xa4, 0x61, 0xf0, 0x3d, 0x30, 0x8a, 0x25, 0xad, 0xd6, 0x59, 0x55, 0xda, 0xc8, 0x45,
+	0x53, 0x33, 0x19, 0xb4, 0x73, 0x54, 0xe2, 0x00, 0x4c, 0x5e, 0x33, 0x41, 0x55, 0xc5, 0xd7, 0xf6,
+	0x95, 0x8b, 0xa6, 0xa3, 0x17, 0x8f, 0xbd, 0xff, 0x64, 0x5e, 0x2f, 0xf2, 0x3e, 0xf4, 0x68, 0x72,
+	0xf9, 0x0a, 0x3f, 0x00, 0x53, 0xb0, 0x82, 0x8b, 0xb2, 0xd1, 0x5f, 0xbb, 0x68, 0x7a, 0x93, 0x18,
+	0x5d, 0x10, 0x95, 0xf8, 0x35, 0xe8, 0x52, 0x51, 0xb5, 0x91, 0xf6, 0x4d, 0x2b, 0x77, 0x6f, 0x97,
+	0x2f, 0x5a, 0x2e, 0x39, 0xf1, 0xf8, 0x3e, 0x18, 0x54, 0x29, 0xb6, 0xaa, 0x95, 0xb4, 0xef, 0x74,
+	0xd6, 0x7e, 0xc6, 0x4f, 0x01, 0xaf, 0xd9, 0x56, 0x65, 0xa7, 0x20, 0x63, 0x35, 0x2f, 0x96, 0xb6,
+	0xde, 0x52, 0x56, 0xb3, 0x09, 0xba, 0x05, 0x69, 0x72, 0xfc, 0x10, 0xe0, 0x3b, 0x95, 0x2a, 0x63,
+	0x42, 0x70, 0x61, 0x0f, 0xda, 0x03, 0x98, 0x4d, 0x42, 0x9a, 0x60, 0xf2, 0x0a, 0xcc, 0xf3, 0xbb,
+	0xf0, 0x5d, 0x30, 0x42, 0x32, 0x27, 0xb3, 0x20, 0x25, 0x96, 0x86, 0x47, 0x00, 0x9f, 0xe2, 0xf3,
+	0x8c, 0x9a, 0x6d, 0x42, 0xa2, 0xf8, 0x33, 0x59, 0xa4, 0xd6, 0xd5, 0xe4, 0x09, 0xe8, 0xdd, 0x3f,
+	0xe3, 0x21, 0x0c, 0x3e, 0x92, 0x38, 0x8c, 0xe2, 0x99, 0xa5, 0xe1, 0x31, 0x0c, 0x43, 0x12, 0x84,
+	0xd9, 0x9c, 0xa4, 0x29, 0x49, 0x2c, 0xf4, 0xf6, 0xfd, 0xef, 0x83, 0x83, 0xf6, 0x07, 0x07, 0xfd,
+	0x3d, 0x38, 0xe8, 0xd7, 0xd1, 0xd1, 0xf6, 0x47, 0x47, 0xfb, 0x73, 0x74, 0xb4, 0x2f, 0xcf, 0xbf,
+	0x56, 0x6a, 0xb9, 0xc9, 0xbd, 0x82, 0xaf, 0xfc, 0x45, 0x7b, 0x97, 0x67, 0x73, 0x9a, 0x4b, 0xff,
+	0x54, 0xf7, 0x8f, 0x37, 0xfe, 0xf6, 0xd2, 0xb9, 0xda, 0xd5, 0x4c, 0xe6, 0x7a, 0x5b, 0xf8, 0xcb,
+	0x7f, 0x03, 0x00, 0xcb, 0x50, 0x73, 0x11, 0x13, 0x02, 0x00, 0x00,
+}
+
+func (m *ICARetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICARetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICARetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintIcaRetry(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.NextAttemptEpoch != 0 {
+		i = encodeVarintIcaRetry(dAtA, i, uint64(m.NextAttemptEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintIcaRetry(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintIcaRetry(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RecordId != 0 {
+		i = encodeVarintIcaRetry(dAtA, i, uint64(m.RecordId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Operation != 0 {
+		i = encodeVarintIcaRetry(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintIcaRetry(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIcaRetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIcaRetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ICARetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovIcaRetry(uint64(l))
+	}
+	if m.Operation != 0 {
+		n += 1 + sovIcaRetry(uint64(m.Operation))
+	}
+	if m.RecordId != 0 {
+		n += 1 + sovIcaRetry(uint64(m.RecordId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovIcaRetry(uint64(m.Status))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovIcaRetry(uint64(m.Attempts))
+	}
+	if m.NextAttemptEpoch != 0 {
+		n += 1 + sovIcaRetry(uint64(m.NextAttemptEpoch))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovIcaRetry(uint64(l))
+	}
+	return n
+}
+
+func sovIcaRetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIcaRetry(x uint64) (n int) {
+	return sovIcaRetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ICARetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIcaRetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICARetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICARetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ICARetry_Operation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			m.RecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ICARetry_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptEpoch", wireType)
+			}
+			m.NextAttemptEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIcaRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIcaRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIcaRetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIcaRetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIcaRetry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIcaRetry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIcaRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIcaRetry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIcaRetry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIcaRetry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIcaRetry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIcaRetry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIcaRetry = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "stakeibc"
@@ -26,6 +30,9 @@ const (
 	FeeAccount = "stride1czvrk3jkvtj8m27kqsqu2yrkhw3h3ykwj3rxh6"
 
 	RewardCollectorName = "reward_collector"
+
+	// holds the leftover funds swept from a sunset host zone until they're sent to the community pool
+	SunsetCollectorName = "sunset_collector"
 )
 
 // PortKey defines the key to store the port ID in store
@@ -47,10 +54,89 @@ func EpochTrackerKey(epochIdentifier string) []byte {
 	return key
 }
 
+// HostZoneKeyPrefix returns the store prefix for all of a host zone's records, in each store that's keyed by host zone
+// (e.g. RedemptionRateRecords, UnconfirmedSlashes, PendingRedelegations, ICARecoveries, ICARetries and LSMTokenDeposits)
+func HostZoneKeyPrefix(chainId string) []byte {
+	var key []byte
+
+	key = append(key, []byte(chainId)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RedemptionRateRecordKey returns the store key to retrieve a RedemptionRateRecord from the index fields
+// The epoch number is big endian encoded so that records are iterated in epoch order
+func RedemptionRateRecordKey(chainId string, epochNumber uint64) []byte {
+	return append(HostZoneKeyPrefix(chainId), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// UnconfirmedSlashKey returns the store key to retrieve an UnconfirmedSlash from the index fields
+func UnconfirmedSlashKey(chainId string, validatorAddress string) []byte {
+	return append(HostZoneKeyPrefix(chainId), []byte(validatorAddress)...)
+}
+
+// PendingRedelegationKey returns the store key to retrieve a PendingRedelegation from the index fields
+func PendingRedelegationKey(chainId string, srcValidator string, dstValidator string, completionTime uint64) []byte {
+	key := HostZoneKeyPrefix(chainId)
+	key = append(key, []byte(srcValidator)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(dstValidator)...)
+	key = append(key, []byte("/")...)
+	key = append(key, sdk.Uint64ToBigEndian(completionTime)...)
+	return key
+}
+
+// ICARecoveryKey returns the store key to retrieve an ICARecovery from the index fields
+func ICARecoveryKey(chainId string, accountType ICAAccountType) []byte {
+	return append(HostZoneKeyPrefix(chainId), sdk.Uint64ToBigEndian(uint64(accountType))...)
+}
+
+// ICARetryKey returns the store key to retrieve an ICARetry from the index fields
+func ICARetryKey(chainId string, operation ICARetry_Operation, recordId uint64) []byte {
+	key := HostZoneKeyPrefix(chainId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(operation))...)
+	key = append(key, sdk.Uint64ToBigEndian(recordId)...)
+	return key
+}
+
+// LSMTokenDepositKey returns the store key to retrieve an LSMTokenDeposit from the index fields
+func LSMTokenDepositKey(chainId, denom, stakerAddress string) []byte {
+	key := HostZoneKeyPrefix(chainId)
+	key = append(key, []byte(denom)...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(stakerAddress)...)
+	return key
+}
+
 const (
 	// Host zone keys prefix the HostZone structs
 	HostZoneKey = "HostZone-value-"
 
 	// EpochTrackerKeyPrefix is the prefix to retrieve all EpochTracker
 	EpochTrackerKeyPrefix = "EpochTracker/value/"
+
+	// RedemptionRateRecordKeyPrefix is the prefix to retrieve all RedemptionRateRecords
+	RedemptionRateRecordKeyPrefix = "RedemptionRateRecord/value/"
+
+	// FeeRecipientKeyPrefix is the prefix to retrieve all FeeRecipients
+	FeeRecipientKeyPrefix = "FeeRecipient/value/"
+
+	// FeeRecipientRevenueKeyPrefix is the prefix to retrieve all FeeRecipientRevenues
+	FeeRecipientRevenueKeyPrefix = "FeeRecipientRevenue/value/"
+
+	// UnconfirmedSlashKeyPrefix is the prefix to retrieve all UnconfirmedSlashes
+	UnconfirmedSlashKeyPrefix = "UnconfirmedSlash/value/"
+
+	// PendingRedelegationKeyPrefix is the prefix to retrieve all PendingRedelegations
+	PendingRedelegationKeyPrefix = "PendingRedelegation/value/"
+
+	// ICARecoveryKeyPrefix is the prefix to retrieve all ICARecoveries
+	ICARecoveryKeyPrefix = "ICARecovery/value/"
+
+	// ICARetryKeyPrefix is the prefix to retrieve all ICARetries
+	ICARetryKeyPrefix = "ICARetry/value/"
+
+	// LSMTokenDepositKeyPrefix is the prefix to retrieve all LSMTokenDeposits
+	LSMTokenDepositKeyPrefix = "LSMTokenDeposit/value/"
 )
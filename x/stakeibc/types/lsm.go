@@ -0,0 +1,48 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRedeemTokensForShares{}
+
+// Parses the denom of an LSM token on the host (e.g. cosmosvaloper1xxx/12) into the address
+// of the validator whose shares were tokenized and the tokenize share record ID
+func ParseLSMTokenDenom(denom string) (validatorAddress string, recordId uint64, err error) {
+	denomParts := strings.Split(denom, "/")
+	if len(denomParts) != 2 {
+		return "", 0, errorsmod.Wrapf(ErrInvalidLSMToken, "LSM token denom must be of the form {validator_address}/{record_id} (%s)", denom)
+	}
+
+	validatorAddress = denomParts[0]
+	recordId, err = strconv.ParseUint(denomParts[1], 10, 64)
+	if err != nil {
+		return "", 0, errorsmod.Wrapf(ErrInvalidLSMToken, "invalid tokenize share record id (%s)", denomParts[1])
+	}
+
+	return validatorAddress, recordId, nil
+}
+
+// MsgRedeemTokensForShares is only ever submitted to the host through an ICA, so it's never routed on stride
+func (msg *MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg *MsgRedeemTokensForShares) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "delegator address is required")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid shares amount (%s)", msg.Amount)
+	}
+	return nil
+}
@@ -0,0 +1,814 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/lsm.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LSMTokenDeposit_Status int32
+
+const (
+	// waiting on the ICQ that values the shares
+	LSMTokenDeposit_VALUATION_IN_PROGRESS LSMTokenDeposit_Status = 0
+	// LSM tokens are being transferred to the delegation ICA
+	LSMTokenDeposit_TRANSFER_IN_PROGRESS LSMTokenDeposit_Status = 1
+	// LSM tokens are on the host, waiting for the redemption ICA to be
+	// (re)submitted
+	LSMTokenDeposit_DETOKENIZATION_QUEUE LSMTokenDeposit_Status = 2
+	// waiting on the redemption ICA
+	LSMTokenDeposit_DETOKENIZATION_IN_PROGRESS LSMTokenDeposit_Status = 3
+	// the redemption failed and the LSM tokens are waiting to be returned to
+	// the staker
+	LSMTokenDeposit_RETURN_QUEUE LSMTokenDeposit_Status = 4
+	// the transfer to the host failed or timed out, and the LSM tokens are
+	// waiting to be refunded to the staker once the transfer module has
+	// returned them to the host zone account
+	LSMTokenDeposit_REFUND_QUEUE LSMTokenDeposit_Status = 5
+	// waiting on the ICA that returns the LSM tokens to the staker
+	LSMTokenDeposit_RETURN_IN_PROGRESS LSMTokenDeposit_Status = 6
+)
+
+var LSMTokenDeposit_Status_name = map[int32]string{
+	0: "VALUATION_IN_PROGRESS",
+	1: "TRANSFER_IN_PROGRESS",
+	2: "DETOKENIZATION_QUEUE",
+	3: "DETOKENIZATION_IN_PROGRESS",
+	4: "RETURN_QUEUE",
+	5: "REFUND_QUEUE",
+	6: "RETURN_IN_PROGRESS",
+}
+
+var LSMTokenDeposit_Status_value = map[string]int32{
+	"VALUATION_IN_PROGRESS":      0,
+	"TRANSFER_IN_PROGRESS":       1,
+	"DETOKENIZATION_QUEUE":       2,
+	"DETOKENIZATION_IN_PROGRESS": 3,
+	"RETURN_QUEUE":               4,
+	"REFUND_QUEUE":               5,
+	"RETURN_IN_PROGRESS":         6,
+}
+
+func (x LSMTokenDeposit_Status) String() string {
+	return proto.EnumName(LSMTokenDeposit_Status_name, int32(x))
+}
+
+func (LSMTokenDeposit_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7c113a3a09a2a77, []int{0, 0}
+}
+
+// Tracks an LSM liquid stake from the time the LSM tokens are received until
+// the shares are redeemed into a native delegation by the delegation ICA
+type LSMTokenDeposit struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// LSM token denom on the host (e.g. cosmosvaloper1xxx/12)
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// LSM token denom on stride (e.g. ibc/xxx)
+	IbcDenom         string `protobuf:"bytes,3,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
+	StakerAddress    string `protobuf:"bytes,4,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,5,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// number of LSM tokens (equal to the number of tokenized shares)
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// value of the shares in native tokens, set once the shares are valued
+	NativeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=native_amount,json=nativeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"native_amount"`
+	// stTokens to mint once the shares are redeemed, determined by the
+	// redemption rate at the time the shares are valued
+	StTokenAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=st_token_amount,json=stTokenAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st_token_amount"`
+	Status        LSMTokenDeposit_Status                 `protobuf:"varint,9,opt,name=status,proto3,enum=stride.stakeibc.LSMTokenDeposit_Status" json:"status,omitempty"`
+	// stride epoch in which the liquid stake was submitted
+	EpochNumber uint64 `protobuf:"varint,10,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+}
+
+func (m *LSMTokenDeposit) Reset()         { *m = LSMTokenDeposit{} }
+func (m *LSMTokenDeposit) String() string { return proto.CompactTextString(m) }
+func (*LSMTokenDeposit) ProtoMessage()    {}
+func (*LSMTokenDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7c113a3a09a2a77, []int{0}
+}
+func (m *LSMTokenDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LSMTokenDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LSMTokenDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LSMTokenDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LSMTokenDeposit.Merge(m, src)
+}
+func (m *LSMTokenDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LSMTokenDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LSMTokenDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LSMTokenDeposit proto.InternalMessageInfo
+
+func (m *LSMTokenDeposit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *LSMTokenDeposit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *LSMTokenDeposit) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
+	}
+	return ""
+}
+
+func (m *LSMTokenDeposit) GetStakerAddress() string {
+	if m != nil {
+		return m.StakerAddress
+	}
+	return ""
+}
+
+func (m *LSMTokenDeposit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *LSMTokenDeposit) GetStatus() LSMTokenDeposit_Status {
+	if m != nil {
+		return m.Status
+	}
+	return LSMTokenDeposit_VALUATION_IN_PROGRESS
+}
+
+func (m *LSMTokenDeposit) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.LSMTokenDeposit_Status", LSMTokenDeposit_Status_name, LSMTokenDeposit_Status_value)
+	proto.RegisterType((*LSMTokenDeposit)(nil), "stride.stakeibc.LSMTokenDeposit")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/lsm.proto", fileDescriptor_e7c113a3a09a2a77) }
+
+var fileDescriptor_e7c113a3a09a2a77 = []byte{
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xda, 0x40,
+	0x14, 0x85, 0x71, 0x12, 0x1c, 0x98, 0x42, 0xa0, 0x23, 0x5a, 0x99, 0x54, 0x72, 0x68, 0xa4, 0xb6,
+	0x48, 0x55, 0x6c, 0xb5, 0x5d, 0x75, 0x55, 0x11, 0x61, 0x2a, 0x2b, 0xd4, 0xb4, 0x63, 0x3b, 0x8b,
+	0x6c, 0x2c, 0xff, 0x8c, 0x60, 0x44, 0xec, 0x41, 0x9e, 0x01, 0xb5, 0x6f, 0xd1, 0x77, 0xe9, 0x1b,
+	0x74, 0x95, 0x65, 0x96, 0x55, 0x17, 0x51, 0x05, 0x2f, 0x52, 0x31, 0x63, 0x1a, 0xca, 0x32, 0x2b,
+	0x98, 0xf3, 0x9d, 0x73, 0x64, 0xdd, 0xab, 0x0b, 0xda, 0x8c, 0xe7, 0x24, 0xc1, 0x26, 0xe3, 0xe1,
+	0x14, 0x93, 0x28, 0x36, 0xaf, 0x59, 0x6a, 0xcc, 0x72, 0xca, 0x29, 0x6c, 0x48, 0x64, 0x6c, 0xd0,
+	0x71, 0x6b, 0x4c, 0xc7, 0x54, 0x30, 0x73, 0xfd, 0x4f, 0xda, 0x4e, 0x7f, 0x96, 0x41, 0x63, 0xe8,
+	0x7e, 0xf2, 0xe8, 0x14, 0x67, 0x7d, 0x3c, 0xa3, 0x8c, 0x70, 0xd8, 0x06, 0x95, 0x78, 0x12, 0x92,
+	0x2c, 0x20, 0x89, 0xa6, 0x74, 0x94, 0x6e, 0x15, 0x1d, 0x8a, 0xb7, 0x9d, 0xc0, 0x16, 0x28, 0x27,
+	0x38, 0xa3, 0xa9, 0xb6, 0x27, 0x74, 0xf9, 0x80, 0xcf, 0x40, 0x95, 0x44, 0x71, 0x20, 0xc9, 0xbe,
+	0x20, 0x15, 0x12, 0xc5, 0x7d, 0x01, 0x5f, 0x80, 0x23, 0xf1, 0x0d, 0x79, 0x10, 0x26, 0x49, 0x8e,
+	0x19, 0xd3, 0x0e, 0x84, 0xa3, 0x2e, 0xd5, 0x9e, 0x14, 0xe1, 0x6b, 0xf0, 0x78, 0x11, 0x5e, 0x93,
+	0x24, 0xe4, 0xf4, 0xde, 0x59, 0x16, 0xce, 0xe6, 0x3f, 0xb0, 0x31, 0x0f, 0x80, 0x1a, 0xa6, 0x74,
+	0x9e, 0x71, 0x4d, 0x5d, 0x3b, 0xce, 0x8d, 0x9b, 0xbb, 0x93, 0xd2, 0xef, 0xbb, 0x93, 0x97, 0x63,
+	0xc2, 0x27, 0xf3, 0xc8, 0x88, 0x69, 0x6a, 0xc6, 0x94, 0xa5, 0x94, 0x15, 0x3f, 0x67, 0x2c, 0x99,
+	0x9a, 0xfc, 0xdb, 0x0c, 0x33, 0xc3, 0xce, 0x38, 0x2a, 0xd2, 0xd0, 0x05, 0xf5, 0x2c, 0xe4, 0x64,
+	0x81, 0x83, 0xa2, 0xee, 0xf0, 0x41, 0x75, 0x35, 0x59, 0xd2, 0x93, 0xa5, 0x97, 0xa0, 0xc1, 0x78,
+	0xc0, 0xd7, 0x13, 0xdd, 0xd4, 0x56, 0x1e, 0x54, 0x5b, 0x67, 0x5c, 0xec, 0xa5, 0xe8, 0xfd, 0x00,
+	0x54, 0xc6, 0x43, 0x3e, 0x67, 0x5a, 0xb5, 0xa3, 0x74, 0x8f, 0xde, 0xbe, 0x32, 0x76, 0x56, 0x6c,
+	0xec, 0x2c, 0xd2, 0x70, 0x85, 0x1d, 0x15, 0x31, 0xf8, 0x1c, 0xd4, 0xf0, 0x8c, 0xc6, 0x93, 0x20,
+	0x9b, 0xa7, 0x11, 0xce, 0x35, 0xd0, 0x51, 0xba, 0x07, 0xe8, 0x91, 0xd0, 0x1c, 0x21, 0x9d, 0xfe,
+	0x50, 0x80, 0x2a, 0x53, 0xb0, 0x0d, 0x9e, 0x5c, 0xf6, 0x86, 0x7e, 0xcf, 0xb3, 0x47, 0x4e, 0x60,
+	0x3b, 0xc1, 0x67, 0x34, 0xfa, 0x88, 0x2c, 0xd7, 0x6d, 0x96, 0xa0, 0x06, 0x5a, 0x1e, 0xea, 0x39,
+	0xee, 0xc0, 0x42, 0xff, 0x11, 0x65, 0x4d, 0xfa, 0x96, 0x37, 0xba, 0xb0, 0x1c, 0xfb, 0x4a, 0x26,
+	0xbf, 0xf8, 0x96, 0x6f, 0x35, 0xf7, 0xa0, 0x0e, 0x8e, 0x77, 0xc8, 0x76, 0x72, 0x1f, 0x36, 0x41,
+	0x0d, 0x59, 0x9e, 0x8f, 0x36, 0x89, 0x03, 0xa9, 0x0c, 0x7c, 0xa7, 0x5f, 0x28, 0x65, 0xf8, 0x14,
+	0xc0, 0xc2, 0xb3, 0x9d, 0x55, 0xcf, 0x2f, 0x6e, 0x96, 0xba, 0x72, 0xbb, 0xd4, 0x95, 0x3f, 0x4b,
+	0x5d, 0xf9, 0xbe, 0xd2, 0x4b, 0xb7, 0x2b, 0xbd, 0xf4, 0x6b, 0xa5, 0x97, 0xae, 0xde, 0x6c, 0x8d,
+	0xda, 0x15, 0xd3, 0x3a, 0x1b, 0x86, 0x11, 0x33, 0x8b, 0xbb, 0x59, 0xbc, 0x37, 0xbf, 0xde, 0x1f,
+	0x8f, 0x98, 0x7c, 0xa4, 0x8a, 0xc3, 0x78, 0xf7, 0x77, 0x00, 0x1d, 0x67, 0x64, 0xa6, 0x5c, 0x03,
+	0x00, 0x00,
+}
+
+func (m *LSMTokenDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LSMTokenDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LSMTokenDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		i = encodeVarintLsm(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintLsm(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.StTokenAmount.Size()
+		i -= size
+		if _, err := m.StTokenAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLsm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.NativeAmount.Size()
+		i -= size
+		if _, err := m.NativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLsm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLsm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLsm(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLsm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLsm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LSMTokenDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLsm(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	l = m.NativeAmount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	l = m.StTokenAmount.Size()
+	n += 1 + l + sovLsm(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLsm(uint64(m.Status))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLsm(uint64(m.EpochNumber))
+	}
+	return n
+}
+
+func sovLsm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLsm(x uint64) (n int) {
+	return sovLsm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LSMTokenDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLsm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LSMTokenDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LSMTokenDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLsm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StTokenAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= LSMTokenDeposit_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLsm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLsm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLsm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLsm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLsm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLsm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLsm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLsm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLsm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLsm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLsm = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgAbortHostZoneSunset = "abort_host_zone_sunset"
+
+var _ sdk.Msg = &MsgAbortHostZoneSunset{}
+
+func NewMsgAbortHostZoneSunset(creator string, chainId string) *MsgAbortHostZoneSunset {
+	return &MsgAbortHostZoneSunset{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgAbortHostZoneSunset) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAbortHostZoneSunset) Type() string {
+	return TypeMsgAbortHostZoneSunset
+}
+
+func (msg *MsgAbortHostZoneSunset) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAbortHostZoneSunset) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAbortHostZoneSunset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelRedemption = "cancel_redemption"
+
+var _ sdk.Msg = &MsgCancelRedemption{}
+
+func NewMsgCancelRedemption(creator string, hostZone string, epochNumber uint64) *MsgCancelRedemption {
+	return &MsgCancelRedemption{
+		Creator:     creator,
+		HostZone:    hostZone,
+		EpochNumber: epochNumber,
+	}
+}
+
+func (msg *MsgCancelRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRedemption) Type() string {
+	return TypeMsgCancelRedemption
+}
+
+func (msg *MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// validate host zone is not empty
+	if msg.HostZone == "" {
+		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/testutil/sample"
+)
+
+func TestMsgCancelRedemption_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelRedemption
+		err  error
+	}{
+		{
+			name: "success",
+			msg: MsgCancelRedemption{
+				Creator:     sample.AccAddress(),
+				HostZone:    "GAIA",
+				EpochNumber: uint64(1),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: MsgCancelRedemption{
+				Creator:     "invalid_address",
+				HostZone:    "GAIA",
+				EpochNumber: uint64(1),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no host zone",
+			msg: MsgCancelRedemption{
+				Creator:     sample.AccAddress(),
+				EpochNumber: uint64(1),
+			},
+			err: ErrRequiredFieldEmpty,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
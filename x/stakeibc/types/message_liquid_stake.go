@@ -70,5 +70,9 @@ func (msg *MsgLiquidStake) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.HostDenom); err != nil {
 		return err
 	}
+	// the minimum amount out is optional, but cannot be negative
+	if !msg.MinStTokenOut.IsNil() && msg.MinStTokenOut.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "min stToken out cannot be negative (%v)", msg.MinStTokenOut)
+	}
 	return nil
 }
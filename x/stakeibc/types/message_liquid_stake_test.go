@@ -51,6 +51,25 @@ func TestMsgLiquidStake_ValidateBasic(t *testing.T) {
 			},
 			err: ErrInvalidAmount,
 		},
+		{
+			name: "valid min stToken out",
+			msg: MsgLiquidStake{
+				Creator:       sample.AccAddress(),
+				Amount:        sdkmath.NewInt(1),
+				HostDenom:     "uatom",
+				MinStTokenOut: sdkmath.NewInt(1),
+			},
+		},
+		{
+			name: "negative min stToken out",
+			msg: MsgLiquidStake{
+				Creator:       sample.AccAddress(),
+				Amount:        sdkmath.NewInt(1),
+				HostDenom:     "uatom",
+				MinStTokenOut: sdkmath.NewInt(-1),
+			},
+			err: ErrInvalidAmount,
+		},
 		{
 			name: "empty host denom",
 			msg: MsgLiquidStake{
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgLSMLiquidStake = "lsm_liquid_stake"
+
+var _ sdk.Msg = &MsgLSMLiquidStake{}
+
+func NewMsgLSMLiquidStake(creator string, amount sdkmath.Int, lsmTokenIbcDenom string) *MsgLSMLiquidStake {
+	return &MsgLSMLiquidStake{
+		Creator:          creator,
+		Amount:           amount,
+		LsmTokenIbcDenom: lsmTokenIbcDenom,
+	}
+}
+
+func (msg *MsgLSMLiquidStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLSMLiquidStake) Type() string {
+	return TypeMsgLSMLiquidStake
+}
+
+func (msg *MsgLSMLiquidStake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLSMLiquidStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLSMLiquidStake) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// validate amount is positive nonzero
+	if msg.Amount.IsNil() || msg.Amount.LTE(sdkmath.ZeroInt()) {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount liquid staked must be positive and nonzero")
+	}
+	// the LSM tokens must have been transferred to stride over IBC
+	if !IsIBCToken(msg.LsmTokenIbcDenom) {
+		return errorsmod.Wrapf(ErrInvalidLSMToken, "LSM token denom must be an IBC denom (%s)", msg.LsmTokenIbcDenom)
+	}
+	if err := sdk.ValidateDenom(msg.LsmTokenIbcDenom); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/testutil/sample"
+)
+
+func TestMsgLSMLiquidStake_ValidateBasic(t *testing.T) {
+	validIbcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	tests := []struct {
+		name string
+		msg  MsgLSMLiquidStake
+		err  error
+	}{
+		{
+			name: "valid inputs",
+			msg: MsgLSMLiquidStake{
+				Creator:          sample.AccAddress(),
+				Amount:           sdkmath.NewInt(1),
+				LsmTokenIbcDenom: validIbcDenom,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: MsgLSMLiquidStake{
+				Creator:          "invalid_address",
+				Amount:           sdkmath.NewInt(1),
+				LsmTokenIbcDenom: validIbcDenom,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero amount",
+			msg: MsgLSMLiquidStake{
+				Creator:          sample.AccAddress(),
+				Amount:           sdkmath.ZeroInt(),
+				LsmTokenIbcDenom: validIbcDenom,
+			},
+			err: ErrInvalidAmount,
+		},
+		{
+			name: "nil amount",
+			msg: MsgLSMLiquidStake{
+				Creator:          sample.AccAddress(),
+				LsmTokenIbcDenom: validIbcDenom,
+			},
+			err: ErrInvalidAmount,
+		},
+		{
+			name: "not an ibc denom",
+			msg: MsgLSMLiquidStake{
+				Creator:          sample.AccAddress(),
+				Amount:           sdkmath.NewInt(1),
+				LsmTokenIbcDenom: "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrt52vv7/1",
+			},
+			err: ErrInvalidLSMToken,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseLSMTokenDenom(t *testing.T) {
+	validatorAddress, recordId, err := ParseLSMTokenDenom("cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrt52vv7/12")
+	require.NoError(t, err)
+	require.Equal(t, "cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrt52vv7", validatorAddress, "validator address")
+	require.Equal(t, uint64(12), recordId, "record id")
+
+	invalidDenoms := []string{
+		"uatom",
+		"cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrt52vv7/",
+		"cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrt52vv7/x",
+		"cosmosvaloper1uk4ze0x4nvh4fk0xm4jdud58eqn4yxhrt52vv7/1/2",
+	}
+	for _, denom := range invalidDenoms {
+		_, _, err := ParseLSMTokenDenom(denom)
+		require.ErrorIs(t, err, ErrInvalidLSMToken, "denom %s", denom)
+	}
+}
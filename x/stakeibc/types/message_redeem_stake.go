@@ -61,5 +61,9 @@ func (msg *MsgRedeemStake) ValidateBasic() error {
 	if msg.HostZone == "" {
 		return errorsmod.Wrapf(ErrRequiredFieldEmpty, "host zone cannot be empty")
 	}
+	// the minimum amount out is optional, but cannot be negative
+	if !msg.MinNativeOut.IsNil() && msg.MinNativeOut.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "min native out cannot be negative (%v)", msg.MinNativeOut)
+	}
 	return nil
 }
@@ -26,6 +26,27 @@ func TestMsgRedeemStake_ValidateBasic(t *testing.T) {
 				Amount:   sdkmath.NewInt(1),
 			},
 		},
+		{
+			name: "valid min native out",
+			msg: MsgRedeemStake{
+				Creator:      sample.AccAddress(),
+				HostZone:     "GAIA",
+				Receiver:     sample.AccAddress(),
+				Amount:       sdkmath.NewInt(1),
+				MinNativeOut: sdkmath.NewInt(1),
+			},
+		},
+		{
+			name: "negative min native out",
+			msg: MsgRedeemStake{
+				Creator:      sample.AccAddress(),
+				HostZone:     "GAIA",
+				Receiver:     sample.AccAddress(),
+				Amount:       sdkmath.NewInt(1),
+				MinNativeOut: sdkmath.NewInt(-1),
+			},
+			err: ErrInvalidAmount,
+		},
 		{
 			name: "invalid creator",
 			msg: MsgRedeemStake{
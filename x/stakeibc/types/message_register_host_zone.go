@@ -120,6 +120,10 @@ func (msg *MsgRegisterHostZone) ValidateBasic() error {
 		msg.MinRedemptionRate.GTE(msg.MaxRedemptionRate) {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min redemption rate should be lower than max redemption rate")
 	}
+	// stToken metadata fields are optional, but must be valid if specified
+	if err := ValidateStTokenMetadataFields(msg.StTokenDisplayName, msg.StTokenSymbol); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,68 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgResolveICARetry = "resolve_ica_retry"
+
+var _ sdk.Msg = &MsgResolveICARetry{}
+
+func NewMsgResolveICARetry(creator string, chainId string, operation ICARetry_Operation, recordId uint64, action ICARetryAction) *MsgResolveICARetry {
+	return &MsgResolveICARetry{
+		Creator:   creator,
+		ChainId:   chainId,
+		Operation: operation,
+		RecordId:  recordId,
+		Action:    action,
+	}
+}
+
+func (msg *MsgResolveICARetry) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResolveICARetry) Type() string {
+	return TypeMsgResolveICARetry
+}
+
+func (msg *MsgResolveICARetry) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResolveICARetry) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResolveICARetry) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	if _, ok := ICARetry_Operation_name[int32(msg.Operation)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid retry operation (%d)", msg.Operation)
+	}
+	if _, ok := ICARetryAction_name[int32(msg.Action)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid retry action (%d)", msg.Action)
+	}
+	// Undelegations and reinvestments are tracked per host zone, so they don't have a record ID
+	if msg.Operation != ICARetry_DELEGATE && msg.RecordId != 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "record id can only be specified for %s retries", ICARetry_DELEGATE.String())
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgResolveICARetry_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgResolveICARetry
+		err  string
+	}{
+		{
+			name: "valid delegate retry",
+			msg: types.MsgResolveICARetry{
+				Creator:   adminAddress,
+				ChainId:   "GAIA",
+				Operation: types.ICARetry_DELEGATE,
+				RecordId:  1,
+				Action:    types.ICARetryAction_FORCE_RETRY,
+			},
+		},
+		{
+			name: "valid undelegate retry",
+			msg: types.MsgResolveICARetry{
+				Creator:   adminAddress,
+				ChainId:   "GAIA",
+				Operation: types.ICARetry_UNDELEGATE,
+				Action:    types.ICARetryAction_DROP_RETRY,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgResolveICARetry{
+				Creator: invalidAddress,
+				ChainId: "GAIA",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgResolveICARetry{
+				Creator: validNonAdminAddress,
+				ChainId: "GAIA",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgResolveICARetry{
+				Creator: adminAddress,
+			},
+			err: "chain id is required",
+		},
+		{
+			name: "invalid operation",
+			msg: types.MsgResolveICARetry{
+				Creator:   adminAddress,
+				ChainId:   "GAIA",
+				Operation: 10,
+			},
+			err: "invalid retry operation (10)",
+		},
+		{
+			name: "invalid action",
+			msg: types.MsgResolveICARetry{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Action:  10,
+			},
+			err: "invalid retry action (10)",
+		},
+		{
+			name: "record id for reinvest retry",
+			msg: types.MsgResolveICARetry{
+				Creator:   adminAddress,
+				ChainId:   "GAIA",
+				Operation: types.ICARetry_REINVEST,
+				RecordId:  1,
+			},
+			err: "record id can only be specified for DELEGATE retries",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgResolveUnconfirmedSlash = "resolve_unconfirmed_slash"
+
+var _ sdk.Msg = &MsgResolveUnconfirmedSlash{}
+
+func NewMsgResolveUnconfirmedSlash(creator string, chainId string, validatorAddress string, action UnconfirmedSlashAction) *MsgResolveUnconfirmedSlash {
+	return &MsgResolveUnconfirmedSlash{
+		Creator:          creator,
+		ChainId:          chainId,
+		ValidatorAddress: validatorAddress,
+		Action:           action,
+	}
+}
+
+func (msg *MsgResolveUnconfirmedSlash) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResolveUnconfirmedSlash) Type() string {
+	return TypeMsgResolveUnconfirmedSlash
+}
+
+func (msg *MsgResolveUnconfirmedSlash) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgResolveUnconfirmedSlash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgResolveUnconfirmedSlash) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	if msg.ValidatorAddress == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "validator address is required")
+	}
+	if _, ok := UnconfirmedSlashAction_name[int32(msg.Action)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unconfirmed slash action (%d)", msg.Action)
+	}
+	return nil
+}
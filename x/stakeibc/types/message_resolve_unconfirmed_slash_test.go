@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgResolveUnconfirmedSlash_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgResolveUnconfirmedSlash
+		err  string
+	}{
+		{
+			name: "valid confirm",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator:          adminAddress,
+				ChainId:          "GAIA",
+				ValidatorAddress: "cosmosvaloper1",
+				Action:           types.UnconfirmedSlashAction_CONFIRM_SLASH,
+			},
+		},
+		{
+			name: "valid dismiss",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator:          adminAddress,
+				ChainId:          "GAIA",
+				ValidatorAddress: "cosmosvaloper1",
+				Action:           types.UnconfirmedSlashAction_DISMISS_SLASH,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator:          invalidAddress,
+				ChainId:          "GAIA",
+				ValidatorAddress: "cosmosvaloper1",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator:          validNonAdminAddress,
+				ChainId:          "GAIA",
+				ValidatorAddress: "cosmosvaloper1",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator:          adminAddress,
+				ValidatorAddress: "cosmosvaloper1",
+			},
+			err: "chain id is required",
+		},
+		{
+			name: "missing validator address",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+			},
+			err: "validator address is required",
+		},
+		{
+			name: "invalid action",
+			msg: types.MsgResolveUnconfirmedSlash{
+				Creator:          adminAddress,
+				ChainId:          "GAIA",
+				ValidatorAddress: "cosmosvaloper1",
+				Action:           10,
+			},
+			err: "invalid unconfirmed slash action (10)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
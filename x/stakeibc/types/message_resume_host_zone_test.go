@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgResumeHostZone_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgResumeHostZone
+		err  string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgResumeHostZone{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgResumeHostZone{
+				Creator: invalidAddress,
+				ChainId: "GAIA",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgResumeHostZone{
+				Creator: validNonAdminAddress,
+				ChainId: "GAIA",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgResumeHostZone{
+				Creator: adminAddress,
+			},
+			err: "chainid is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgSetUnbondingStrategy = "set_unbonding_strategy"
+
+var _ sdk.Msg = &MsgSetUnbondingStrategy{}
+
+func NewMsgSetUnbondingStrategy(creator string, chainId string, strategy UnbondingStrategy) *MsgSetUnbondingStrategy {
+	return &MsgSetUnbondingStrategy{
+		Creator:  creator,
+		ChainId:  chainId,
+		Strategy: strategy,
+	}
+}
+
+func (msg *MsgSetUnbondingStrategy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetUnbondingStrategy) Type() string {
+	return TypeMsgSetUnbondingStrategy
+}
+
+func (msg *MsgSetUnbondingStrategy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetUnbondingStrategy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetUnbondingStrategy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	if _, ok := UnbondingStrategy_name[int32(msg.Strategy)]; !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unbonding strategy (%d)", msg.Strategy)
+	}
+	return nil
+}
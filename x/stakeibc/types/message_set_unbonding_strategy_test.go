@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgSetUnbondingStrategy_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetUnbondingStrategy
+		err  string
+	}{
+		{
+			name: "valid target weight strategy",
+			msg: types.MsgSetUnbondingStrategy{
+				Creator:  adminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.UnbondingStrategy_TARGET_WEIGHT,
+			},
+		},
+		{
+			name: "valid rebalance strategy",
+			msg: types.MsgSetUnbondingStrategy{
+				Creator:  adminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.UnbondingStrategy_REBALANCE,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSetUnbondingStrategy{
+				Creator:  invalidAddress,
+				ChainId:  "GAIA",
+				Strategy: types.UnbondingStrategy_REBALANCE,
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgSetUnbondingStrategy{
+				Creator:  validNonAdminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.UnbondingStrategy_REBALANCE,
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgSetUnbondingStrategy{
+				Creator:  adminAddress,
+				Strategy: types.UnbondingStrategy_REBALANCE,
+			},
+			err: "chain id is required",
+		},
+		{
+			name: "invalid strategy",
+			msg: types.MsgSetUnbondingStrategy{
+				Creator:  adminAddress,
+				ChainId:  "GAIA",
+				Strategy: types.UnbondingStrategy(99),
+			},
+			err: "invalid unbonding strategy (99)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgSetValidatorWeightPolicy = "set_validator_weight_policy"
+
+var _ sdk.Msg = &MsgSetValidatorWeightPolicy{}
+
+func NewMsgSetValidatorWeightPolicy(creator string, chainId string, policy *ValidatorWeightPolicy) *MsgSetValidatorWeightPolicy {
+	return &MsgSetValidatorWeightPolicy{
+		Creator: creator,
+		ChainId: chainId,
+		Policy:  policy,
+	}
+}
+
+func (msg *MsgSetValidatorWeightPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetValidatorWeightPolicy) Type() string {
+	return TypeMsgSetValidatorWeightPolicy
+}
+
+func (msg *MsgSetValidatorWeightPolicy) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetValidatorWeightPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetValidatorWeightPolicy) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	// A nil policy reverts the host zone to manually set weights
+	if msg.Policy == nil {
+		return nil
+	}
+	return msg.Policy.Validate()
+}
@@ -0,0 +1,140 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgSetValidatorWeightPolicy_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	// Helper to create a valid policy with the commission and voting power thresholds modified
+	policy := func(maxCommission, maxVotingPower string) *types.ValidatorWeightPolicy {
+		return &types.ValidatorWeightPolicy{
+			MaxCommissionRate:   sdk.MustNewDecFromStr(maxCommission),
+			MaxVotingPowerShare: sdk.MustNewDecFromStr(maxVotingPower),
+			MaxJailedCount:      2,
+			MaxMissedBlocks:     500,
+			MaxWeightChange:     10,
+		}
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgSetValidatorWeightPolicy
+		err  string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("0.10", "0.05"),
+			},
+		},
+		{
+			name: "valid clear policy",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+			},
+		},
+		{
+			name: "valid boundary thresholds",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("0", "1"),
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: invalidAddress,
+				ChainId: "GAIA",
+				Policy:  policy("0.10", "0.05"),
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: validNonAdminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("0.10", "0.05"),
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				Policy:  policy("0.10", "0.05"),
+			},
+			err: "chain id is required",
+		},
+		{
+			name: "negative commission",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("-0.10", "0.05"),
+			},
+			err: "max commission rate must be between 0 and 1",
+		},
+		{
+			name: "commission greater than one",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("1.01", "0.05"),
+			},
+			err: "max commission rate must be between 0 and 1",
+		},
+		{
+			name: "missing commission",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  &types.ValidatorWeightPolicy{MaxVotingPowerShare: sdk.MustNewDecFromStr("0.05")},
+			},
+			err: "max commission rate must be between 0 and 1",
+		},
+		{
+			name: "zero voting power share",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("0.10", "0"),
+			},
+			err: "max voting power share must be greater than 0 and at most 1",
+		},
+		{
+			name: "voting power share greater than one",
+			msg: types.MsgSetValidatorWeightPolicy{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+				Policy:  policy("0.10", "1.5"),
+			},
+			err: "max voting power share must be greater than 0 and at most 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgSunsetHostZone = "sunset_host_zone"
+
+var _ sdk.Msg = &MsgSunsetHostZone{}
+
+func NewMsgSunsetHostZone(creator string, chainId string) *MsgSunsetHostZone {
+	return &MsgSunsetHostZone{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgSunsetHostZone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSunsetHostZone) Type() string {
+	return TypeMsgSunsetHostZone
+}
+
+func (msg *MsgSunsetHostZone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSunsetHostZone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSunsetHostZone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	if msg.ChainId == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id is required")
+	}
+	return nil
+}
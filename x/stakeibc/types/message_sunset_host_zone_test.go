@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgSunsetHostZone_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	tests := []struct {
+		name string
+		msg  types.MsgSunsetHostZone
+		err  string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgSunsetHostZone{
+				Creator: adminAddress,
+				ChainId: "GAIA",
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgSunsetHostZone{
+				Creator: invalidAddress,
+				ChainId: "GAIA",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgSunsetHostZone{
+				Creator: validNonAdminAddress,
+				ChainId: "GAIA",
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgSunsetHostZone{
+				Creator: adminAddress,
+			},
+			err: "chain id is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
+
+func TestSunsetHostZoneProposal_ValidateBasic(t *testing.T) {
+	proposal := types.SunsetHostZoneProposal{
+		Title:       "Sunset host zone GAIA",
+		Description: "Proposal to wind down the GAIA host zone",
+		ChainId:     "GAIA",
+	}
+	require.NoError(t, proposal.ValidateBasic(), "valid proposal")
+
+	missingChainId := proposal
+	missingChainId.ChainId = ""
+	require.ErrorContains(t, missingChainId.ValidateBasic(), "chainid is required")
+
+	abortProposal := types.AbortHostZoneSunsetProposal{
+		Title:       "Abort host zone GAIA sunset",
+		Description: "Proposal to abort the GAIA host zone sunset",
+		ChainId:     "GAIA",
+	}
+	require.NoError(t, abortProposal.ValidateBasic(), "valid abort proposal")
+
+	abortProposal.ChainId = ""
+	require.ErrorContains(t, abortProposal.ValidateBasic(), "chainid is required")
+}
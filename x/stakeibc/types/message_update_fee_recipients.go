@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Stride-Labs/stride/v9/utils"
+)
+
+const TypeMsgUpdateFeeRecipients = "update_fee_recipients"
+
+var _ sdk.Msg = &MsgUpdateFeeRecipients{}
+
+func NewMsgUpdateFeeRecipients(creator string, feeRecipients []FeeRecipient) *MsgUpdateFeeRecipients {
+	return &MsgUpdateFeeRecipients{
+		Creator:       creator,
+		FeeRecipients: feeRecipients,
+	}
+}
+
+func (msg *MsgUpdateFeeRecipients) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateFeeRecipients) Type() string {
+	return TypeMsgUpdateFeeRecipients
+}
+
+func (msg *MsgUpdateFeeRecipients) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateFeeRecipients) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateFeeRecipients) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := utils.ValidateAdminAddress(msg.Creator); err != nil {
+		return err
+	}
+	return ValidateFeeRecipients(msg.FeeRecipients)
+}
@@ -0,0 +1,169 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgUpdateFeeRecipients_ValidateBasic(t *testing.T) {
+	validNonAdminAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	adminAddress, ok := apptesting.GetAdminAddress()
+	require.True(t, ok)
+
+	validRecipients := []types.FeeRecipient{
+		{Name: "fee_collector", Type: types.FeeRecipientType_MODULE_ACCOUNT, Address: "fee_collector", Weight: 8},
+		{Name: "community_pool", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 1},
+		{Name: "treasury", Type: types.FeeRecipientType_ACCOUNT, Address: validNonAdminAddress, Weight: 1},
+	}
+
+	// Helper to create a valid recipient list with one recipient modified
+	withRecipient := func(index int, recipient types.FeeRecipient) []types.FeeRecipient {
+		recipients := make([]types.FeeRecipient, len(validRecipients))
+		copy(recipients, validRecipients)
+		recipients[index] = recipient
+		return recipients
+	}
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateFeeRecipients
+		err  string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator:       adminAddress,
+				FeeRecipients: validRecipients,
+			},
+		},
+		{
+			name: "valid empty recipients",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator:       invalidAddress,
+				FeeRecipients: validRecipients,
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-admin address",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator:       validNonAdminAddress,
+				FeeRecipients: validRecipients,
+			},
+			err: "invalid address",
+		},
+		{
+			name: "missing name",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(0, types.FeeRecipient{
+					Type: types.FeeRecipientType_MODULE_ACCOUNT, Address: "fee_collector", Weight: 1,
+				}),
+			},
+			err: "fee recipient name is required",
+		},
+		{
+			name: "duplicate name",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(1, types.FeeRecipient{
+					Name: "fee_collector", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 1,
+				}),
+			},
+			err: "duplicate fee recipient name (fee_collector)",
+		},
+		{
+			name: "zero weight",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(1, types.FeeRecipient{
+					Name: "community_pool", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 0,
+				}),
+			},
+			err: "fee recipient community_pool must have a positive weight",
+		},
+		{
+			name: "invalid account address",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(2, types.FeeRecipient{
+					Name: "treasury", Type: types.FeeRecipientType_ACCOUNT, Address: invalidAddress, Weight: 1,
+				}),
+			},
+			err: "invalid address for fee recipient treasury",
+		},
+		{
+			name: "missing module name",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(0, types.FeeRecipient{
+					Name: "fee_collector", Type: types.FeeRecipientType_MODULE_ACCOUNT, Weight: 1,
+				}),
+			},
+			err: "module name is required for fee recipient fee_collector",
+		},
+		{
+			name: "community pool with address",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(1, types.FeeRecipient{
+					Name: "community_pool", Type: types.FeeRecipientType_COMMUNITY_POOL, Address: validNonAdminAddress, Weight: 1,
+				}),
+			},
+			err: "address should not be specified for community pool fee recipient",
+		},
+		{
+			name: "invalid type",
+			msg: types.MsgUpdateFeeRecipients{
+				Creator: adminAddress,
+				FeeRecipients: withRecipient(1, types.FeeRecipient{
+					Name: "community_pool", Type: 10, Weight: 1,
+				}),
+			},
+			err: "invalid type for fee recipient community_pool",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
+
+func TestUpdateFeeRecipientsProposal_ValidateBasic(t *testing.T) {
+	proposal := types.UpdateFeeRecipientsProposal{
+		Title:       "Update fee recipients",
+		Description: "Proposal to send 10% of protocol revenue to the community pool",
+		FeeRecipients: []types.FeeRecipient{
+			{Name: "fee_collector", Type: types.FeeRecipientType_MODULE_ACCOUNT, Address: "fee_collector", Weight: 9},
+			{Name: "community_pool", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 1},
+		},
+	}
+	require.NoError(t, proposal.ValidateBasic(), "valid proposal")
+
+	missingTitle := proposal
+	missingTitle.Title = ""
+	require.ErrorContains(t, missingTitle.ValidateBasic(), "proposal title cannot be blank")
+
+	zeroWeight := proposal
+	zeroWeight.FeeRecipients = []types.FeeRecipient{
+		{Name: "community_pool", Type: types.FeeRecipientType_COMMUNITY_POOL, Weight: 0},
+	}
+	require.ErrorContains(t, zeroWeight.ValidateBasic(), "fee recipient community_pool must have a positive weight")
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const TypeMsgUpdateHostZone = "update_host_zone"
+
+var _ sdk.Msg = &MsgUpdateHostZone{}
+
+func NewMsgUpdateHostZone(creator string, chainId string) *MsgUpdateHostZone {
+	return &MsgUpdateHostZone{
+		Creator: creator,
+		ChainId: chainId,
+	}
+}
+
+func (msg *MsgUpdateHostZone) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateHostZone) Type() string {
+	return TypeMsgUpdateHostZone
+}
+
+func (msg *MsgUpdateHostZone) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateHostZone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateHostZone) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	// The update can only be executed by governance, in which case the message is signed by the gov module account
+	if govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String(); msg.Creator != govAuthority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", govAuthority, msg.Creator)
+	}
+	if len(msg.ChainId) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chainid is required")
+	}
+
+	return ValidateHostZoneUpdate(msg)
+}
+
+// Returns true if any of the host zone's configurable fields are specified in the update
+func (msg *MsgUpdateHostZone) HasUpdates() bool {
+	return msg.StrideCommission != nil ||
+		msg.UnbondingFrequency != 0 ||
+		msg.MinRedemptionRate != nil ||
+		msg.MaxRedemptionRate != nil ||
+		msg.TransferChannelId != "" ||
+		msg.Bech32Prefix != "" ||
+		msg.EpochIntervals != nil ||
+		msg.HasStTokenMetadataUpdate()
+}
+
+// Returns true if any of the stToken's metadata fields are specified in the update
+func (msg *MsgUpdateHostZone) HasStTokenMetadataUpdate() bool {
+	return msg.StTokenDisplayName != "" ||
+		msg.StTokenSymbol != "" ||
+		msg.StTokenExponent != 0
+}
+
+// Validates the fields specified in a host zone update, independently of the host zone's current state
+// This is shared between MsgUpdateHostZone and UpdateHostZoneProposal
+func ValidateHostZoneUpdate(msg *MsgUpdateHostZone) error {
+	// At least one field must be updated
+	if !msg.HasUpdates() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no host zone fields specified for update")
+	}
+
+	// The stride commission is a fraction of rewards and must be between 0 and 1
+	if msg.StrideCommission != nil {
+		if msg.StrideCommission.IsNil() || msg.StrideCommission.IsNegative() || msg.StrideCommission.GT(sdk.OneDec()) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "stride commission must be between 0 and 1, %v provided", msg.StrideCommission)
+		}
+	}
+
+	// If specified, the redemption rate bounds must be positive, and the min must be below the max
+	// (each bound is also checked against the host zone's other bound in the msg server)
+	if msg.MinRedemptionRate != nil && (msg.MinRedemptionRate.IsNil() || !msg.MinRedemptionRate.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min redemption rate must be positive, %v provided", msg.MinRedemptionRate)
+	}
+	if msg.MaxRedemptionRate != nil && (msg.MaxRedemptionRate.IsNil() || !msg.MaxRedemptionRate.IsPositive()) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max redemption rate must be positive, %v provided", msg.MaxRedemptionRate)
+	}
+	if msg.MinRedemptionRate != nil && msg.MaxRedemptionRate != nil && msg.MinRedemptionRate.GTE(*msg.MaxRedemptionRate) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "min redemption rate should be lower than max redemption rate")
+	}
+
+	// transfer channel id must begin with "channel"
+	if msg.TransferChannelId != "" && !strings.HasPrefix(msg.TransferChannelId, "channel") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "transfer channel id must begin with 'channel'")
+	}
+
+	// bech32 prefix cannot contain whitespace
+	if msg.Bech32Prefix != "" && strings.TrimSpace(msg.Bech32Prefix) != msg.Bech32Prefix {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid bech32 prefix (%s)", msg.Bech32Prefix)
+	}
+
+	if err := ValidateStTokenMetadataFields(msg.StTokenDisplayName, msg.StTokenSymbol); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Stride-Labs/stride/v9/app/apptesting"
+	"github.com/Stride-Labs/stride/v9/utils"
+	"github.com/Stride-Labs/stride/v9/x/stakeibc/types"
+)
+
+func TestMsgUpdateHostZone_ValidateBasic(t *testing.T) {
+	validNonGovAddress, invalidAddress := apptesting.GenerateTestAddrs()
+	govAddress := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// The gov module account is also an admin, so pick an admin that isn't the gov address
+	adminAddress := ""
+	for address := range utils.Admins {
+		if address != govAddress {
+			adminAddress = address
+		}
+	}
+	require.NotEmpty(t, adminAddress, "non-governance admin address")
+
+	validCommission := sdk.MustNewDecFromStr("0.05")
+	zeroCommission := sdk.ZeroDec()
+	fullCommission := sdk.OneDec()
+	negativeCommission := sdk.MustNewDecFromStr("-0.05")
+	excessiveCommission := sdk.MustNewDecFromStr("1.05")
+	minRedemptionRate := sdk.MustNewDecFromStr("0.9")
+	maxRedemptionRate := sdk.MustNewDecFromStr("1.5")
+
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateHostZone
+		err  string
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgUpdateHostZone{
+				Creator:          govAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &validCommission,
+			},
+		},
+		{
+			name: "valid zero commission",
+			msg: types.MsgUpdateHostZone{
+				Creator:          govAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &zeroCommission,
+			},
+		},
+		{
+			name: "valid full commission",
+			msg: types.MsgUpdateHostZone{
+				Creator:          govAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &fullCommission,
+			},
+		},
+		{
+			name: "invalid address",
+			msg: types.MsgUpdateHostZone{
+				Creator:          invalidAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &validCommission,
+			},
+			err: "invalid address",
+		},
+		{
+			name: "non-governance address",
+			msg: types.MsgUpdateHostZone{
+				Creator:          validNonGovAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &validCommission,
+			},
+			err: "expected gov account as only signer for proposal message",
+		},
+		{
+			name: "admin address",
+			msg: types.MsgUpdateHostZone{
+				Creator:          adminAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &validCommission,
+			},
+			err: "expected gov account as only signer for proposal message",
+		},
+		{
+			name: "missing chain id",
+			msg: types.MsgUpdateHostZone{
+				Creator:          govAddress,
+				StrideCommission: &validCommission,
+			},
+			err: "chainid is required",
+		},
+		{
+			name: "no fields to update",
+			msg: types.MsgUpdateHostZone{
+				Creator: govAddress,
+				ChainId: "GAIA",
+			},
+			err: "no host zone fields specified for update",
+		},
+		{
+			name: "negative commission",
+			msg: types.MsgUpdateHostZone{
+				Creator:          govAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &negativeCommission,
+			},
+			err: "stride commission must be between 0 and 1",
+		},
+		{
+			name: "commission greater than one",
+			msg: types.MsgUpdateHostZone{
+				Creator:          govAddress,
+				ChainId:          "GAIA",
+				StrideCommission: &excessiveCommission,
+			},
+			err: "stride commission must be between 0 and 1",
+		},
+		{
+			name: "valid config update",
+			msg: types.MsgUpdateHostZone{
+				Creator:            govAddress,
+				ChainId:            "GAIA",
+				UnbondingFrequency: 4,
+				MinRedemptionRate:  &minRedemptionRate,
+				MaxRedemptionRate:  &maxRedemptionRate,
+				TransferChannelId:  "channel-5",
+				Bech32Prefix:       "cosmos",
+			},
+		},
+		{
+			name: "valid epoch intervals update",
+			msg: types.MsgUpdateHostZone{
+				Creator:        govAddress,
+				ChainId:        "GAIA",
+				EpochIntervals: &types.EpochIntervals{DelegateInterval: 2},
+			},
+		},
+		{
+			name: "zero min redemption rate",
+			msg: types.MsgUpdateHostZone{
+				Creator:           govAddress,
+				ChainId:           "GAIA",
+				MinRedemptionRate: &zeroCommission,
+			},
+			err: "min redemption rate must be positive",
+		},
+		{
+			name: "negative max redemption rate",
+			msg: types.MsgUpdateHostZone{
+				Creator:           govAddress,
+				ChainId:           "GAIA",
+				MaxRedemptionRate: &negativeCommission,
+			},
+			err: "max redemption rate must be positive",
+		},
+		{
+			name: "min redemption rate above max",
+			msg: types.MsgUpdateHostZone{
+				Creator:           govAddress,
+				ChainId:           "GAIA",
+				MinRedemptionRate: &maxRedemptionRate,
+				MaxRedemptionRate: &minRedemptionRate,
+			},
+			err: "min redemption rate should be lower than max redemption rate",
+		},
+		{
+			name: "invalid transfer channel",
+			msg: types.MsgUpdateHostZone{
+				Creator:           govAddress,
+				ChainId:           "GAIA",
+				TransferChannelId: "connection-5",
+			},
+			err: "transfer channel id must begin with 'channel'",
+		},
+		{
+			name: "invalid bech32 prefix",
+			msg: types.MsgUpdateHostZone{
+				Creator:      govAddress,
+				ChainId:      "GAIA",
+				Bech32Prefix: " cosmos",
+			},
+			err: "invalid bech32 prefix",
+		},
+		{
+			name: "valid stToken metadata update",
+			msg: types.MsgUpdateHostZone{
+				Creator:            govAddress,
+				ChainId:            "GAIA",
+				StTokenDisplayName: "Stride Staked ATOM",
+				StTokenSymbol:      "stATOM",
+				StTokenExponent:    6,
+			},
+		},
+		{
+			name: "invalid stToken display name",
+			msg: types.MsgUpdateHostZone{
+				Creator:            govAddress,
+				ChainId:            "GAIA",
+				StTokenDisplayName: "Stride Staked ATOM ",
+			},
+			err: "invalid display name",
+		},
+		{
+			name: "invalid stToken symbol",
+			msg: types.MsgUpdateHostZone{
+				Creator:       govAddress,
+				ChainId:       "GAIA",
+				StTokenSymbol: "st ATOM",
+			},
+			err: "invalid symbol",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actualError := tc.msg.ValidateBasic()
+			if tc.err == "" {
+				require.NoError(t, actualError)
+			} else {
+				require.ErrorContains(t, actualError, tc.err)
+			}
+		})
+	}
+}
+
+func TestUpdateHostZoneProposal_ValidateBasic(t *testing.T) {
+	proposalJson := `{
+		"title": "Update host zone GAIA",
+		"description": "Proposal to widen the GAIA redemption rate bounds and unbond every 4 days",
+		"chain_id": "GAIA",
+		"unbonding_frequency": "4",
+		"min_redemption_rate": "0.9",
+		"max_redemption_rate": "1.5",
+		"epoch_intervals": {"redemption_rate_interval": "4"},
+		"deposit": "10000000ustrd"
+	}`
+	var proposal types.UpdateHostZoneProposal
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.NoError(t, cdc.UnmarshalJSON([]byte(proposalJson), &proposal), "unmarshalling proposal json")
+
+	require.NoError(t, proposal.ValidateBasic(), "valid proposal")
+	require.Equal(t, uint64(4), proposal.UnbondingFrequency, "unbonding frequency")
+	require.Equal(t, "0.900000000000000000", proposal.MinRedemptionRate.String(), "min redemption rate")
+	require.Nil(t, proposal.StrideCommission, "unspecified stride commission")
+	require.Equal(t, &types.EpochIntervals{RedemptionRateInterval: 4}, proposal.GetHostZoneUpdate().EpochIntervals, "epoch intervals")
+
+	missingChainId := proposal
+	missingChainId.ChainId = ""
+	require.ErrorContains(t, missingChainId.ValidateBasic(), "chainid is required")
+
+	noUpdates := types.UpdateHostZoneProposal{Title: proposal.Title, Description: proposal.Description, ChainId: "GAIA"}
+	require.ErrorContains(t, noUpdates.ValidateBasic(), "no host zone fields specified for update")
+}
@@ -16,17 +16,24 @@ var (
 	DefaultRewardsInterval        uint64 = 1
 	DefaultRedemptionRateInterval uint64 = 1
 	// you apparently cannot safely encode floats, so we make commission / 100
-	DefaultStrideCommission           uint64 = 10
-	DefaultICATimeoutNanos            uint64 = 600000000000
-	DefaultBufferSize                 uint64 = 5             // 1/5=20% of the epoch
-	DefaultIbcTimeoutBlocks           uint64 = 300           // 300 blocks ~= 30 minutes
-	DefaultFeeTransferTimeoutNanos    uint64 = 1800000000000 // 30 minutes
-	DefaultMinRedemptionRateThreshold uint64 = 90            // divide by 100, so 90 = 0.9
-	DefaultMaxRedemptionRateThreshold uint64 = 150           // divide by 100, so 150 = 1.5
-	DefaultMaxStakeICACallsPerEpoch   uint64 = 100
-	DefaultIBCTransferTimeoutNanos    uint64 = 1800000000000 // 30 minutes
-	DefaultSafetyNumValidators        uint64 = 35
-	DefaultSafetyMaxSlashPercent      uint64 = 10
+	DefaultStrideCommission               uint64 = 10
+	DefaultICATimeoutNanos                uint64 = 600000000000
+	DefaultBufferSize                     uint64 = 5             // 1/5=20% of the epoch
+	DefaultIbcTimeoutBlocks               uint64 = 300           // 300 blocks ~= 30 minutes
+	DefaultFeeTransferTimeoutNanos        uint64 = 1800000000000 // 30 minutes
+	DefaultMinRedemptionRateThreshold     uint64 = 90            // divide by 100, so 90 = 0.9
+	DefaultMaxRedemptionRateThreshold     uint64 = 150           // divide by 100, so 150 = 1.5
+	DefaultMaxStakeICACallsPerEpoch       uint64 = 100
+	DefaultIBCTransferTimeoutNanos        uint64 = 1800000000000 // 30 minutes
+	DefaultSafetyNumValidators            uint64 = 35
+	DefaultSafetyMaxSlashPercent          uint64 = 10
+	DefaultRedemptionRateHistorySize      uint64 = 120 // 120 stride epochs ~= 30 days
+	DefaultRedemptionRateTwapEpochs       uint64 = 0   // 0 = use the spot redemption rate
+	DefaultInstantRedemptionBufferPercent uint64 = 0   // 0 = instant redemptions disabled
+	DefaultInstantRedemptionFee           uint64 = 1   // divide by 100, so 1 = 1%
+	DefaultMaxAutoClaimsPerEpoch          uint64 = 50  // 0 = automatic claims disabled
+	DefaultSafetySlashConfirmationWindow  uint64 = 4   // 4 stride epochs ~= 1 day
+	DefaultSunsetClaimPeriodDays          uint64 = 180
 
 	// KeyDepositInterval is store's key for the DepositInterval option
 	KeyDepositInterval                   = []byte("DepositInterval")
@@ -45,6 +52,13 @@ var (
 	KeyIBCTransferTimeoutNanos           = []byte("IBCTransferTimeoutNanos")
 	KeySafetyNumValidators               = []byte("SafetyNumValidators")
 	KeySafetyMaxSlashPercent             = []byte("SafetyMaxSlashPercent")
+	KeyRedemptionRateHistorySize         = []byte("RedemptionRateHistorySize")
+	KeyRedemptionRateTwapEpochs          = []byte("RedemptionRateTwapEpochs")
+	KeyInstantRedemptionBufferPercent    = []byte("InstantRedemptionBufferPercent")
+	KeyInstantRedemptionFee              = []byte("InstantRedemptionFee")
+	KeyMaxAutoClaimsPerEpoch             = []byte("MaxAutoClaimsPerEpoch")
+	KeySafetySlashConfirmationWindow     = []byte("SafetySlashConfirmationWindow")
+	KeySunsetClaimPeriodDays             = []byte("SunsetClaimPeriodDays")
 	KeyMaxRedemptionRates                = []byte("MaxRedemptionRates")
 	KeyMinRedemptionRates                = []byte("MinRedemptionRates")
 )
@@ -74,6 +88,13 @@ func NewParams(
 	ibcTransferTimeoutNanos uint64,
 	safetyNumValidators uint64,
 	safetyMaxSlashPercent uint64,
+	redemptionRateHistorySize uint64,
+	redemptionRateTwapEpochs uint64,
+	instantRedemptionBufferPercent uint64,
+	instantRedemptionFee uint64,
+	maxAutoClaimsPerEpoch uint64,
+	safetySlashConfirmationWindow uint64,
+	sunsetClaimPeriodDays uint64,
 ) Params {
 	return Params{
 		DepositInterval:                   depositInterval,
@@ -92,6 +113,13 @@ func NewParams(
 		IbcTransferTimeoutNanos:           ibcTransferTimeoutNanos,
 		SafetyNumValidators:               safetyNumValidators,
 		SafetyMaxSlashPercent:             safetyMaxSlashPercent,
+		RedemptionRateHistorySize:         redemptionRateHistorySize,
+		RedemptionRateTwapEpochs:          redemptionRateTwapEpochs,
+		InstantRedemptionBufferPercent:    instantRedemptionBufferPercent,
+		InstantRedemptionFee:              instantRedemptionFee,
+		MaxAutoClaimsPerEpoch:             maxAutoClaimsPerEpoch,
+		SafetySlashConfirmationWindow:     safetySlashConfirmationWindow,
+		SunsetClaimPeriodDays:             sunsetClaimPeriodDays,
 	}
 }
 
@@ -114,6 +142,13 @@ func DefaultParams() Params {
 		DefaultIBCTransferTimeoutNanos,
 		DefaultSafetyNumValidators,
 		DefaultSafetyMaxSlashPercent,
+		DefaultRedemptionRateHistorySize,
+		DefaultRedemptionRateTwapEpochs,
+		DefaultInstantRedemptionBufferPercent,
+		DefaultInstantRedemptionFee,
+		DefaultMaxAutoClaimsPerEpoch,
+		DefaultSafetySlashConfirmationWindow,
+		DefaultSunsetClaimPeriodDays,
 	)
 }
 
@@ -136,6 +171,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyIBCTransferTimeoutNanos, &p.IbcTransferTimeoutNanos, validTimeoutNanos),
 		paramtypes.NewParamSetPair(KeySafetyNumValidators, &p.SafetyNumValidators, isPositive),
 		paramtypes.NewParamSetPair(KeySafetyMaxSlashPercent, &p.SafetyMaxSlashPercent, validSlashPercent),
+		paramtypes.NewParamSetPair(KeyRedemptionRateHistorySize, &p.RedemptionRateHistorySize, isPositive),
+		paramtypes.NewParamSetPair(KeyRedemptionRateTwapEpochs, &p.RedemptionRateTwapEpochs, isUint64),
+		paramtypes.NewParamSetPair(KeyInstantRedemptionBufferPercent, &p.InstantRedemptionBufferPercent, isPercentage),
+		paramtypes.NewParamSetPair(KeyInstantRedemptionFee, &p.InstantRedemptionFee, isPercentage),
+		paramtypes.NewParamSetPair(KeyMaxAutoClaimsPerEpoch, &p.MaxAutoClaimsPerEpoch, isUint64),
+		paramtypes.NewParamSetPair(KeySafetySlashConfirmationWindow, &p.SafetySlashConfirmationWindow, isPositive),
+		paramtypes.NewParamSetPair(KeySunsetClaimPeriodDays, &p.SunsetClaimPeriodDays, isPositive),
 	}
 }
 
@@ -211,6 +253,24 @@ func isPositive(i interface{}) error {
 	return nil
 }
 
+func isPercentage(i interface{}) error {
+	ival, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	if ival > 100 {
+		return fmt.Errorf("parameter must be between 0 and 100: %d", ival)
+	}
+	return nil
+}
+
+func isUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("parameter not accepted: %T", i)
+	}
+	return nil
+}
+
 func isCommission(i interface{}) error {
 	ival, ok := i.(uint64)
 	if !ok {
@@ -273,6 +333,28 @@ func (p Params) Validate() error {
 	if err := validSlashPercent(p.SafetyMaxSlashPercent); err != nil {
 		return err
 	}
+	if err := isPositive(p.RedemptionRateHistorySize); err != nil {
+		return err
+	}
+	if p.RedemptionRateTwapEpochs > p.RedemptionRateHistorySize {
+		return fmt.Errorf("redemption rate twap epochs (%d) cannot exceed the redemption rate history size (%d)",
+			p.RedemptionRateTwapEpochs, p.RedemptionRateHistorySize)
+	}
+	if err := isPercentage(p.InstantRedemptionBufferPercent); err != nil {
+		return err
+	}
+	if err := isPercentage(p.InstantRedemptionFee); err != nil {
+		return err
+	}
+	if err := isUint64(p.MaxAutoClaimsPerEpoch); err != nil {
+		return err
+	}
+	if err := isPositive(p.SafetySlashConfirmationWindow); err != nil {
+		return err
+	}
+	if err := isPositive(p.SunsetClaimPeriodDays); err != nil {
+		return err
+	}
 
 	return nil
 }
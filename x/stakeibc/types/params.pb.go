@@ -24,7 +24,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
-// next id: 18
+// next id: 26
 type Params struct {
 	// define epoch lengths, in stride_epochs
 	RewardsInterval                   uint64 `protobuf:"varint,1,opt,name=rewards_interval,json=rewardsInterval,proto3" json:"rewards_interval,omitempty"`
@@ -43,6 +43,27 @@ type Params struct {
 	IbcTransferTimeoutNanos           uint64 `protobuf:"varint,16,opt,name=ibc_transfer_timeout_nanos,json=ibcTransferTimeoutNanos,proto3" json:"ibc_transfer_timeout_nanos,omitempty"`
 	SafetyNumValidators               uint64 `protobuf:"varint,17,opt,name=safety_num_validators,json=safetyNumValidators,proto3" json:"safety_num_validators,omitempty"`
 	SafetyMaxSlashPercent             uint64 `protobuf:"varint,18,opt,name=safety_max_slash_percent,json=safetyMaxSlashPercent,proto3" json:"safety_max_slash_percent,omitempty"`
+	// number of stride epochs of redemption rate history retained per host zone
+	RedemptionRateHistorySize uint64 `protobuf:"varint,19,opt,name=redemption_rate_history_size,json=redemptionRateHistorySize,proto3" json:"redemption_rate_history_size,omitempty"`
+	// number of stride epochs used to compute the time-weighted redemption rate
+	// for the safety bound checks (0 disables the TWAP and uses the spot rate)
+	RedemptionRateTwapEpochs uint64 `protobuf:"varint,20,opt,name=redemption_rate_twap_epochs,json=redemptionRateTwapEpochs,proto3" json:"redemption_rate_twap_epochs,omitempty"`
+	// target size of each host zone's instant redemption buffer, as a percentage
+	// of the host zone's staked balance (0 disables the buffer)
+	InstantRedemptionBufferPercent uint64 `protobuf:"varint,21,opt,name=instant_redemption_buffer_percent,json=instantRedemptionBufferPercent,proto3" json:"instant_redemption_buffer_percent,omitempty"`
+	// fee charged on instant redemptions, as a percentage of the redeemed amount
+	InstantRedemptionFee uint64 `protobuf:"varint,22,opt,name=instant_redemption_fee,json=instantRedemptionFee,proto3" json:"instant_redemption_fee,omitempty"`
+	// maximum number of claimable redemption records per host zone that are
+	// automatically claimed each day epoch (0 disables automatic claims)
+	MaxAutoClaimsPerEpoch uint64 `protobuf:"varint,23,opt,name=max_auto_claims_per_epoch,json=maxAutoClaimsPerEpoch,proto3" json:"max_auto_claims_per_epoch,omitempty"`
+	// number of stride epochs before a slash is detected during which the
+	// validator must have been jailed or tombstoned on the host for the slash to
+	// be confirmed
+	SafetySlashConfirmationWindow uint64 `protobuf:"varint,24,opt,name=safety_slash_confirmation_window,json=safetySlashConfirmationWindow,proto3" json:"safety_slash_confirmation_window,omitempty"`
+	// number of days after a sunset host zone becomes claimable before any
+	// outstanding stTokens can no longer be redeemed, after which the leftover
+	// funds are swept to the community pool and the host zone is closed
+	SunsetClaimPeriodDays uint64 `protobuf:"varint,25,opt,name=sunset_claim_period_days,json=sunsetClaimPeriodDays,proto3" json:"sunset_claim_period_days,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -189,6 +210,55 @@ func (m *Params) GetSafetyMaxSlashPercent() uint64 {
 	return 0
 }
 
+func (m *Params) GetRedemptionRateHistorySize() uint64 {
+	if m != nil {
+		return m.RedemptionRateHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetRedemptionRateTwapEpochs() uint64 {
+	if m != nil {
+		return m.RedemptionRateTwapEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetInstantRedemptionBufferPercent() uint64 {
+	if m != nil {
+		return m.InstantRedemptionBufferPercent
+	}
+	return 0
+}
+
+func (m *Params) GetInstantRedemptionFee() uint64 {
+	if m != nil {
+		return m.InstantRedemptionFee
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoClaimsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxAutoClaimsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetSafetySlashConfirmationWindow() uint64 {
+	if m != nil {
+		return m.SafetySlashConfirmationWindow
+	}
+	return 0
+}
+
+func (m *Params) GetSunsetClaimPeriodDays() uint64 {
+	if m != nil {
+		return m.SunsetClaimPeriodDays
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "stride.stakeibc.Params")
 }
@@ -196,43 +266,54 @@ func init() {
 func init() { proto.RegisterFile("stride/stakeibc/params.proto", fileDescriptor_5aeaab6a38c2b438) }
 
 var fileDescriptor_5aeaab6a38c2b438 = []byte{
-	// 568 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcd, 0x6e, 0xd3, 0x4e,
-	0x14, 0xc5, 0x93, 0xff, 0x3f, 0x0a, 0xed, 0x14, 0x68, 0x62, 0xbe, 0x46, 0x11, 0xb8, 0x80, 0x84,
-	0x44, 0x29, 0xc4, 0x02, 0x16, 0x7c, 0x74, 0x81, 0xd4, 0x8a, 0x45, 0x81, 0x56, 0x51, 0x12, 0xb1,
-	0x60, 0x33, 0x1a, 0x8f, 0xaf, 0x93, 0x51, 0x6d, 0x8f, 0x35, 0x33, 0x0e, 0x6e, 0x9f, 0x82, 0x25,
-	0x4b, 0x1e, 0x87, 0x65, 0x97, 0x2c, 0x51, 0xf2, 0x06, 0x3c, 0x01, 0xf2, 0x8c, 0xe3, 0xd4, 0xa8,
-	0xb0, 0x8b, 0xce, 0xf9, 0xdd, 0xe3, 0x3b, 0x67, 0x32, 0xe8, 0xb6, 0xd2, 0x92, 0x07, 0xe0, 0x29,
-	0x4d, 0x8f, 0x81, 0xfb, 0xcc, 0x4b, 0xa9, 0xa4, 0xb1, 0xea, 0xa7, 0x52, 0x68, 0xe1, 0x6c, 0x5a,
-	0xb7, 0xbf, 0x74, 0x7b, 0xd7, 0x27, 0x62, 0x22, 0x8c, 0xe7, 0x15, 0xbf, 0x2c, 0x76, 0xff, 0x57,
-	0x1b, 0xb5, 0x07, 0x66, 0xce, 0xd9, 0x46, 0x1d, 0x09, 0x9f, 0xa9, 0x0c, 0x14, 0xe1, 0x89, 0x06,
-	0x39, 0xa3, 0x11, 0x6e, 0xde, 0x6d, 0x3e, 0x6c, 0x0d, 0x37, 0x4b, 0xfd, 0xa0, 0x94, 0x9d, 0x1d,
-	0xd4, 0x0d, 0x20, 0x82, 0x09, 0xd5, 0xb0, 0x62, 0xdb, 0x86, 0xed, 0x2c, 0x8d, 0x0a, 0xde, 0x46,
-	0x9d, 0x00, 0x52, 0xa1, 0xb8, 0x5e, 0xb1, 0xff, 0xd9, 0xdc, 0x52, 0xaf, 0xd0, 0x97, 0x08, 0x4b,
-	0x08, 0x20, 0x4e, 0x35, 0x17, 0x09, 0x91, 0xb5, 0xf8, 0xff, 0xcd, 0xc8, 0xcd, 0x95, 0x3f, 0x3c,
-	0xff, 0x91, 0x1d, 0xd4, 0xb5, 0x07, 0x26, 0x4c, 0xc4, 0x31, 0x57, 0x8a, 0x8b, 0x04, 0xb7, 0xec,
-	0x46, 0xd6, 0xd8, 0xaf, 0xf4, 0x02, 0x96, 0xc0, 0x93, 0x19, 0xa8, 0x73, 0x2b, 0x5d, 0xb2, 0xf0,
-	0xd2, 0xa8, 0x92, 0x1f, 0xa1, 0x2e, 0x67, 0x94, 0x68, 0x1e, 0x83, 0xc8, 0x34, 0x49, 0x68, 0x22,
-	0x14, 0x5e, 0xb7, 0xfb, 0x73, 0x46, 0xc7, 0x56, 0x3f, 0x2a, 0x64, 0x67, 0x0b, 0x6d, 0xf8, 0x59,
-	0x18, 0x82, 0x24, 0x8a, 0x9f, 0x02, 0x46, 0x86, 0x42, 0x56, 0x1a, 0xf1, 0x53, 0x70, 0x1e, 0x23,
-	0x87, 0xfb, 0xac, 0x0a, 0xf3, 0x23, 0xc1, 0x8e, 0x15, 0xde, 0xb0, 0x9f, 0xe6, 0x3e, 0x2b, 0xd3,
-	0xf6, 0x8c, 0xee, 0xec, 0xa2, 0x5e, 0x08, 0x40, 0xb4, 0xa4, 0x89, 0x2a, 0x42, 0xeb, 0x3b, 0x5c,
-	0x36, 0x53, 0xb7, 0x42, 0x80, 0x71, 0x09, 0xd4, 0x76, 0x79, 0x83, 0xee, 0xc4, 0x34, 0x27, 0xe6,
-	0xfe, 0x49, 0x71, 0x02, 0x46, 0xa3, 0x48, 0x91, 0x14, 0x24, 0x81, 0x54, 0xb0, 0x29, 0xbe, 0x62,
-	0xe6, 0x71, 0x4c, 0xf3, 0x51, 0xc1, 0x1c, 0x30, 0xba, 0x5f, 0x10, 0x03, 0x90, 0x6f, 0x0b, 0xdf,
-	0x19, 0xa0, 0x07, 0x01, 0x84, 0x34, 0x8b, 0x34, 0x89, 0x79, 0x42, 0xfe, 0xbc, 0x18, 0x3d, 0x95,
-	0xa0, 0xa6, 0x22, 0x0a, 0xf0, 0x55, 0x13, 0x74, 0xaf, 0x84, 0x0f, 0x79, 0x32, 0xac, 0xdd, 0xd1,
-	0x78, 0x09, 0xd6, 0x12, 0x69, 0xfe, 0x8f, 0xc4, 0xcd, 0x7a, 0x22, 0xcd, 0xff, 0x96, 0xb8, 0x8b,
-	0x7a, 0xa6, 0xcf, 0x8b, 0x1b, 0xea, 0xd8, 0x86, 0x8a, 0x5e, 0x2f, 0x6a, 0xe8, 0x19, 0xba, 0xa1,
-	0x68, 0x08, 0xfa, 0x84, 0x24, 0x59, 0x4c, 0x66, 0x34, 0xe2, 0x01, 0xd5, 0x42, 0x2a, 0xdc, 0x35,
-	0x73, 0xd7, 0xac, 0x79, 0x94, 0xc5, 0x1f, 0x2b, 0xcb, 0x79, 0x81, 0x70, 0x39, 0x63, 0xca, 0x8d,
-	0xa8, 0x9a, 0x16, 0x95, 0x32, 0x48, 0x34, 0x76, 0xcc, 0x58, 0x99, 0x79, 0x48, 0xf3, 0x51, 0xe1,
-	0x0e, 0xac, 0xf9, 0xba, 0xf5, 0xf5, 0xdb, 0x56, 0xe3, 0x5d, 0x6b, 0x6d, 0xad, 0xb3, 0xbe, 0xf7,
-	0xfe, 0xfb, 0xdc, 0x6d, 0x9e, 0xcd, 0xdd, 0xe6, 0xcf, 0xb9, 0xdb, 0xfc, 0xb2, 0x70, 0x1b, 0x67,
-	0x0b, 0xb7, 0xf1, 0x63, 0xe1, 0x36, 0x3e, 0x3d, 0x9d, 0x70, 0x3d, 0xcd, 0xfc, 0x3e, 0x13, 0xb1,
-	0x37, 0x32, 0x7f, 0xdb, 0x27, 0x1f, 0xa8, 0xaf, 0xbc, 0xf2, 0xa9, 0xcf, 0x5e, 0x79, 0xf9, 0xea,
-	0xbd, 0xeb, 0x93, 0x14, 0x94, 0xdf, 0x36, 0x0f, 0xf9, 0xf9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x06, 0x81, 0x52, 0xd7, 0x0f, 0x04, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x4e, 0x34, 0x45,
+	0x14, 0x9e, 0xd1, 0x09, 0xfe, 0x7f, 0xa1, 0x32, 0xd3, 0xdc, 0x0a, 0x84, 0x01, 0x4c, 0x4c, 0x44,
+	0x94, 0x89, 0x97, 0x44, 0x94, 0x18, 0x22, 0xe3, 0x0d, 0x15, 0x32, 0x61, 0x26, 0x9a, 0xb8, 0xa9,
+	0x9c, 0xee, 0x3e, 0x3d, 0x53, 0xa1, 0xbb, 0xaa, 0x53, 0x55, 0x3d, 0x17, 0x9e, 0xc2, 0xa5, 0x4b,
+	0xdf, 0xc0, 0xd7, 0x70, 0xc9, 0xd2, 0xa5, 0x81, 0x17, 0x31, 0x5d, 0xd5, 0x73, 0x69, 0xc0, 0x7f,
+	0xd7, 0x39, 0xdf, 0xa5, 0xcf, 0xf9, 0xce, 0x49, 0x91, 0x1d, 0x6d, 0x14, 0x0f, 0xb1, 0xa5, 0x0d,
+	0xdc, 0x20, 0xf7, 0x83, 0x56, 0x0a, 0x0a, 0x12, 0x7d, 0x9c, 0x2a, 0x69, 0xa4, 0xb7, 0xe2, 0xd0,
+	0xe3, 0x29, 0xba, 0xbd, 0xd6, 0x97, 0x7d, 0x69, 0xb1, 0x56, 0xfe, 0xe5, 0x68, 0xef, 0xfe, 0x45,
+	0xc8, 0x52, 0xc7, 0xea, 0xbc, 0x43, 0x52, 0x57, 0x38, 0x02, 0x15, 0x6a, 0xc6, 0x85, 0x41, 0x35,
+	0x84, 0x98, 0x56, 0xf7, 0xab, 0xef, 0xd7, 0xae, 0x57, 0x8a, 0xfa, 0x45, 0x51, 0xf6, 0x8e, 0x48,
+	0x23, 0xc4, 0x18, 0xfb, 0x60, 0x70, 0xce, 0x5d, 0xb2, 0xdc, 0xfa, 0x14, 0x98, 0x91, 0x0f, 0x49,
+	0x3d, 0xc4, 0x54, 0x6a, 0x6e, 0xe6, 0xdc, 0xd7, 0x9c, 0x6f, 0x51, 0x9f, 0x51, 0x4f, 0x08, 0x55,
+	0x18, 0x62, 0x92, 0x1a, 0x2e, 0x05, 0x53, 0x25, 0xfb, 0xd7, 0xad, 0x64, 0x63, 0x8e, 0x5f, 0x2f,
+	0xfe, 0xe4, 0x88, 0x34, 0xdc, 0xc0, 0x2c, 0x90, 0x49, 0xc2, 0xb5, 0xe6, 0x52, 0xd0, 0x9a, 0xeb,
+	0xc8, 0x01, 0xed, 0x59, 0x3d, 0x27, 0x2b, 0xe4, 0x62, 0x88, 0x7a, 0xa1, 0xa5, 0x37, 0x1c, 0x79,
+	0x0a, 0xcc, 0x9c, 0x3f, 0x20, 0x0d, 0x1e, 0x00, 0x33, 0x3c, 0x41, 0x99, 0x19, 0x26, 0x40, 0x48,
+	0x4d, 0x5f, 0xba, 0xfe, 0x79, 0x00, 0x3d, 0x57, 0xbf, 0xca, 0xcb, 0xde, 0x1e, 0x59, 0xf6, 0xb3,
+	0x28, 0x42, 0xc5, 0x34, 0xbf, 0x45, 0x4a, 0x2c, 0x8b, 0xb8, 0x52, 0x97, 0xdf, 0xa2, 0xf7, 0x21,
+	0xf1, 0xb8, 0x1f, 0xcc, 0xcc, 0xfc, 0x58, 0x06, 0x37, 0x9a, 0x2e, 0xbb, 0x5f, 0x73, 0x3f, 0x28,
+	0xdc, 0xce, 0x6d, 0xdd, 0x3b, 0x25, 0xdb, 0x11, 0x22, 0x33, 0x0a, 0x84, 0xce, 0x4d, 0xcb, 0x3d,
+	0xbc, 0x69, 0x55, 0x9b, 0x11, 0x62, 0xaf, 0x20, 0x94, 0x7a, 0x39, 0x23, 0xbb, 0x09, 0x8c, 0x99,
+	0xdd, 0x3f, 0xcb, 0x27, 0x08, 0x20, 0x8e, 0x35, 0x4b, 0x51, 0x31, 0x4c, 0x65, 0x30, 0xa0, 0x6f,
+	0x59, 0x3d, 0x4d, 0x60, 0xdc, 0xcd, 0x39, 0x17, 0x01, 0xb4, 0x73, 0x46, 0x07, 0xd5, 0xb7, 0x39,
+	0xee, 0x75, 0xc8, 0x7b, 0x21, 0x46, 0x90, 0xc5, 0x86, 0x25, 0x5c, 0xb0, 0xc7, 0x8b, 0x31, 0x03,
+	0x85, 0x7a, 0x20, 0xe3, 0x90, 0xbe, 0x6d, 0x8d, 0x0e, 0x0a, 0xf2, 0x25, 0x17, 0xd7, 0xa5, 0x1d,
+	0xf5, 0xa6, 0xc4, 0x92, 0x23, 0x8c, 0x5f, 0xe1, 0xb8, 0x52, 0x76, 0x84, 0xf1, 0xff, 0x39, 0x9e,
+	0x92, 0x6d, 0x9b, 0xe7, 0xf3, 0x09, 0xd5, 0x5d, 0x42, 0x79, 0xae, 0xcf, 0x25, 0xf4, 0x09, 0x59,
+	0xd7, 0x10, 0xa1, 0x99, 0x30, 0x91, 0x25, 0x6c, 0x08, 0x31, 0x0f, 0xc1, 0x48, 0xa5, 0x69, 0xc3,
+	0xea, 0x56, 0x1d, 0x78, 0x95, 0x25, 0xbf, 0xcc, 0x20, 0xef, 0x73, 0x42, 0x0b, 0x8d, 0x0d, 0x37,
+	0x06, 0x3d, 0xc8, 0x23, 0x0d, 0x50, 0x18, 0xea, 0x59, 0x59, 0xe1, 0x79, 0x09, 0xe3, 0x6e, 0x8e,
+	0x76, 0x1c, 0xe8, 0x9d, 0x91, 0x9d, 0xc7, 0xf3, 0x0e, 0xb8, 0x36, 0x52, 0x4d, 0xdc, 0xad, 0xac,
+	0x5a, 0xf1, 0x56, 0xf9, 0xbc, 0x7f, 0x70, 0x0c, 0x7b, 0x3a, 0x5f, 0x91, 0x77, 0x9e, 0x04, 0x36,
+	0x82, 0xd4, 0x2d, 0x53, 0xd3, 0x35, 0xb7, 0xcd, 0xb2, 0xbe, 0x37, 0x82, 0xd4, 0x2e, 0x53, 0x7b,
+	0x17, 0xe4, 0x80, 0x0b, 0x6d, 0x40, 0x98, 0xc5, 0xdc, 0x8b, 0x6b, 0x9d, 0x4e, 0xb0, 0x6e, 0x4d,
+	0x9a, 0x05, 0x71, 0x1e, 0xfa, 0xb9, 0xa5, 0x4d, 0x47, 0xf9, 0x8c, 0x6c, 0x3c, 0x63, 0x15, 0x21,
+	0xd2, 0x0d, 0xab, 0x5f, 0x7b, 0xa2, 0xff, 0x0e, 0xd1, 0x3b, 0x21, 0x5b, 0x79, 0x64, 0x90, 0x19,
+	0xc9, 0x82, 0x18, 0x78, 0xb2, 0x78, 0x8b, 0x9b, 0x2e, 0xba, 0x04, 0xc6, 0x5f, 0x67, 0x46, 0xb6,
+	0x2d, 0x3c, 0x3b, 0xc4, 0xef, 0xc9, 0x7e, 0x91, 0xb9, 0xcb, 0x3b, 0x90, 0x22, 0xe2, 0x2a, 0x01,
+	0xfb, 0xdb, 0x11, 0x17, 0xa1, 0x1c, 0x51, 0x6a, 0x0d, 0x76, 0x1d, 0xcf, 0x06, 0xdf, 0x5e, 0x60,
+	0xfd, 0x6a, 0x49, 0x76, 0x79, 0x99, 0xd0, 0x68, 0x5c, 0x03, 0xf9, 0xff, 0xb9, 0x0c, 0x59, 0x08,
+	0x13, 0x4d, 0xb7, 0x8a, 0xe5, 0x59, 0xdc, 0x36, 0xd0, 0xb1, 0xe8, 0x37, 0x30, 0xd1, 0x5f, 0xd6,
+	0xfe, 0xf8, 0x73, 0xaf, 0xf2, 0x63, 0xed, 0xc5, 0x8b, 0xfa, 0xcb, 0xf3, 0x9f, 0xfe, 0xbe, 0x6f,
+	0x56, 0xef, 0xee, 0x9b, 0xd5, 0x7f, 0xef, 0x9b, 0xd5, 0xdf, 0x1f, 0x9a, 0x95, 0xbb, 0x87, 0x66,
+	0xe5, 0x9f, 0x87, 0x66, 0xe5, 0xb7, 0x8f, 0xfb, 0xdc, 0x0c, 0x32, 0xff, 0x38, 0x90, 0x49, 0xab,
+	0x6b, 0xdf, 0x9c, 0x8f, 0x7e, 0x06, 0x5f, 0xb7, 0x8a, 0x77, 0x7a, 0xf8, 0x45, 0x6b, 0x3c, 0x7f,
+	0xac, 0xcd, 0x24, 0x45, 0xed, 0x2f, 0xd9, 0x57, 0xf8, 0xd3, 0xff, 0x06, 0x00, 0xa9, 0xe8, 0xa2,
+	0x34, 0xcc, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -255,6 +336,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SunsetClaimPeriodDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SunsetClaimPeriodDays))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.SafetySlashConfirmationWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SafetySlashConfirmationWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxAutoClaimsPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAutoClaimsPerEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.InstantRedemptionFee != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InstantRedemptionFee))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.InstantRedemptionBufferPercent != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.InstantRedemptionBufferPercent))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.RedemptionRateTwapEpochs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedemptionRateTwapEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.RedemptionRateHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RedemptionRateHistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.SafetyMaxSlashPercent != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SafetyMaxSlashPercent))
 		i--
@@ -409,6 +539,27 @@ func (m *Params) Size() (n int) {
 	if m.SafetyMaxSlashPercent != 0 {
 		n += 2 + sovParams(uint64(m.SafetyMaxSlashPercent))
 	}
+	if m.RedemptionRateHistorySize != 0 {
+		n += 2 + sovParams(uint64(m.RedemptionRateHistorySize))
+	}
+	if m.RedemptionRateTwapEpochs != 0 {
+		n += 2 + sovParams(uint64(m.RedemptionRateTwapEpochs))
+	}
+	if m.InstantRedemptionBufferPercent != 0 {
+		n += 2 + sovParams(uint64(m.InstantRedemptionBufferPercent))
+	}
+	if m.InstantRedemptionFee != 0 {
+		n += 2 + sovParams(uint64(m.InstantRedemptionFee))
+	}
+	if m.MaxAutoClaimsPerEpoch != 0 {
+		n += 2 + sovParams(uint64(m.MaxAutoClaimsPerEpoch))
+	}
+	if m.SafetySlashConfirmationWindow != 0 {
+		n += 2 + sovParams(uint64(m.SafetySlashConfirmationWindow))
+	}
+	if m.SunsetClaimPeriodDays != 0 {
+		n += 2 + sovParams(uint64(m.SunsetClaimPeriodDays))
+	}
 	return n
 }
 
@@ -751,6 +902,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateHistorySize", wireType)
+			}
+			m.RedemptionRateHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionRateHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateTwapEpochs", wireType)
+			}
+			m.RedemptionRateTwapEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionRateTwapEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionBufferPercent", wireType)
+			}
+			m.InstantRedemptionBufferPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantRedemptionBufferPercent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantRedemptionFee", wireType)
+			}
+			m.InstantRedemptionFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantRedemptionFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoClaimsPerEpoch", wireType)
+			}
+			m.MaxAutoClaimsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoClaimsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SafetySlashConfirmationWindow", wireType)
+			}
+			m.SafetySlashConfirmationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SafetySlashConfirmationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetClaimPeriodDays", wireType)
+			}
+			m.SunsetClaimPeriodDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SunsetClaimPeriodDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -931,1469 +933,6311 @@ func (m *QueryAddressUnbondingsResponse) GetAddressUnbondings() []AddressUnbondi
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
-	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "stride.stakeibc.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "stride.stakeibc.QueryParamsResponse")
-	proto.RegisterType((*QueryGetValidatorsRequest)(nil), "stride.stakeibc.QueryGetValidatorsRequest")
-	proto.RegisterType((*QueryGetValidatorsResponse)(nil), "stride.stakeibc.QueryGetValidatorsResponse")
-	proto.RegisterType((*QueryGetHostZoneRequest)(nil), "stride.stakeibc.QueryGetHostZoneRequest")
-	proto.RegisterType((*QueryGetHostZoneResponse)(nil), "stride.stakeibc.QueryGetHostZoneResponse")
-	proto.RegisterType((*QueryAllHostZoneRequest)(nil), "stride.stakeibc.QueryAllHostZoneRequest")
-	proto.RegisterType((*QueryAllHostZoneResponse)(nil), "stride.stakeibc.QueryAllHostZoneResponse")
-	proto.RegisterType((*QueryModuleAddressRequest)(nil), "stride.stakeibc.QueryModuleAddressRequest")
-	proto.RegisterType((*QueryModuleAddressResponse)(nil), "stride.stakeibc.QueryModuleAddressResponse")
-	proto.RegisterType((*QueryGetEpochTrackerRequest)(nil), "stride.stakeibc.QueryGetEpochTrackerRequest")
-	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "stride.stakeibc.QueryGetEpochTrackerResponse")
-	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "stride.stakeibc.QueryAllEpochTrackerRequest")
-	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "stride.stakeibc.QueryAllEpochTrackerResponse")
-	proto.RegisterType((*QueryGetNextPacketSequenceRequest)(nil), "stride.stakeibc.QueryGetNextPacketSequenceRequest")
-	proto.RegisterType((*QueryGetNextPacketSequenceResponse)(nil), "stride.stakeibc.QueryGetNextPacketSequenceResponse")
-	proto.RegisterType((*QueryAddressUnbondings)(nil), "stride.stakeibc.QueryAddressUnbondings")
-	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
+type QueryRedemptionRateHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// number of stride epochs to average over (defaults to the full history)
+	TwapEpochs uint64 `protobuf:"varint,2,opt,name=twap_epochs,json=twapEpochs,proto3" json:"twap_epochs,omitempty"`
 }
 
-func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
-
-var fileDescriptor_494b786fe66f2b80 = []byte{
-	// 1157 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0xdd, 0x6e, 0x1b, 0x45,
-	0x1b, 0xc7, 0xb3, 0x4d, 0x9a, 0x8f, 0x27, 0x89, 0xf2, 0x76, 0xde, 0x88, 0x38, 0xdb, 0xc4, 0x21,
-	0xd3, 0xd2, 0x7c, 0x90, 0x7a, 0x89, 0x53, 0x90, 0x12, 0x51, 0x41, 0x22, 0xb5, 0x8d, 0xa1, 0xa0,
-	0xe0, 0x42, 0x85, 0xca, 0x81, 0x35, 0xde, 0x1d, 0xec, 0x55, 0xd7, 0x33, 0xee, 0xee, 0x3a, 0x24,
-	0x44, 0x56, 0x25, 0xae, 0xa0, 0x02, 0x71, 0xc2, 0x59, 0x11, 0x07, 0x1c, 0x73, 0x15, 0x3d, 0xa3,
-	0x12, 0x27, 0x1c, 0x45, 0x28, 0xe1, 0x0a, 0x2a, 0x2e, 0x00, 0xed, 0xcc, 0xec, 0xda, 0xde, 0x0f,
-	0xe3, 0xf4, 0xcc, 0x33, 0xf3, 0x7c, 0xfc, 0xe6, 0x99, 0x67, 0xfe, 0xb3, 0x86, 0xab, 0x9e, 0xef,
-	0xda, 0x16, 0x35, 0x3c, 0x9f, 0x3c, 0xa6, 0x76, 0xd5, 0x34, 0x9e, 0xb4, 0xa8, 0x7b, 0x5c, 0x68,
-	0xba, 0xdc, 0xe7, 0x68, 0x46, 0x2e, 0x16, 0xc2, 0x45, 0x7d, 0xb6, 0xc6, 0x6b, 0x5c, 0xac, 0x19,
-	0xc1, 0x2f, 0x69, 0xa6, 0x2f, 0xd4, 0x38, 0xaf, 0x39, 0xd4, 0x20, 0x4d, 0xdb, 0x20, 0x8c, 0x71,
-	0x9f, 0xf8, 0x36, 0x67, 0x9e, 0x5a, 0x5d, 0x37, 0xb9, 0xd7, 0xe0, 0x9e, 0x51, 0x25, 0x1e, 0x95,
-	0xd1, 0x8d, 0xc3, 0xcd, 0x2a, 0xf5, 0xc9, 0xa6, 0xd1, 0x24, 0x35, 0x9b, 0x09, 0xe3, 0x30, 0x52,
-	0x9c, 0xa6, 0x49, 0x5c, 0xd2, 0x08, 0x23, 0x2d, 0xc5, 0x57, 0x0f, 0x89, 0x63, 0x5b, 0xc4, 0xe7,
-	0x6e, 0x96, 0x41, 0x9d, 0x7b, 0x7e, 0xe5, 0x5b, 0xce, 0xa8, 0x32, 0xb8, 0x16, 0x37, 0xa0, 0x4d,
-	0x6e, 0xd6, 0x2b, 0xbe, 0x4b, 0xcc, 0xc7, 0x34, 0x8c, 0xb2, 0x12, 0x37, 0x22, 0x96, 0xe5, 0x52,
-	0xcf, 0xab, 0xb4, 0x58, 0x95, 0x33, 0xcb, 0x66, 0x35, 0x69, 0x88, 0x9f, 0xc2, 0xea, 0x67, 0xc1,
-	0x7e, 0x4a, 0xcc, 0xa7, 0xae, 0x59, 0x27, 0x36, 0xdb, 0x35, 0x4d, 0xde, 0x62, 0xfe, 0x5d, 0x97,
-	0x37, 0x76, 0xa5, 0x53, 0x99, 0x3e, 0x69, 0x51, 0xcf, 0x47, 0xb3, 0x70, 0x99, 0x7f, 0xc3, 0xa8,
-	0x9b, 0xd3, 0xde, 0xd4, 0x56, 0x27, 0xca, 0x72, 0x80, 0x6e, 0xc3, 0xb4, 0xc9, 0x19, 0xa3, 0x66,
-	0x50, 0x83, 0x8a, 0x6d, 0xe5, 0x2e, 0x05, 0xab, 0x7b, 0xb9, 0x57, 0xa7, 0x4b, 0xb3, 0xc7, 0xa4,
-	0xe1, 0xec, 0xe0, 0x9e, 0x65, 0x5c, 0x9e, 0xea, 0x8c, 0x4b, 0x16, 0x7e, 0xa6, 0xc1, 0xda, 0x00,
-	0x04, 0x5e, 0x93, 0x33, 0x8f, 0x22, 0x13, 0x74, 0x3b, 0xb2, 0xab, 0x10, 0x69, 0x58, 0x51, 0x9b,
-	0x93, 0x5c, 0x7b, 0x6f, 0xbd, 0x3a, 0x5d, 0x5a, 0x96, 0x99, 0xb3, 0x6d, 0x71, 0x39, 0x67, 0xc7,
-	0x13, 0xaa, 0x64, 0x78, 0x16, 0x90, 0x20, 0x3a, 0x10, 0x07, 0xa7, 0x76, 0x8f, 0xef, 0xc3, 0xff,
-	0x7b, 0x66, 0x15, 0xd1, 0xbb, 0x30, 0x2a, 0x0f, 0x58, 0x64, 0x9f, 0x2c, 0xce, 0x15, 0x62, 0x0d,
-	0x57, 0x90, 0x0e, 0x7b, 0x23, 0x2f, 0x4e, 0x97, 0x86, 0xca, 0xca, 0x18, 0xbf, 0x07, 0xf3, 0x22,
-	0xda, 0x3d, 0xea, 0x3f, 0x0c, 0x3b, 0x20, 0x2a, 0xf4, 0x3c, 0x8c, 0x4b, 0x68, 0xdb, 0x52, 0xb5,
-	0x1e, 0x13, 0xe3, 0x92, 0x85, 0xbf, 0x04, 0x3d, 0xcd, 0x4f, 0xc1, 0xec, 0x00, 0x44, 0xfd, 0x14,
-	0x00, 0x0d, 0xaf, 0x4e, 0x16, 0xf5, 0x04, 0x50, 0xe4, 0x58, 0xee, 0xb2, 0xc6, 0xb7, 0x60, 0x2e,
-	0x8c, 0xbc, 0xcf, 0x3d, 0xff, 0x11, 0x67, 0x74, 0x20, 0x9e, 0x5c, 0xd2, 0x4b, 0xd1, 0xbc, 0x0f,
-	0x13, 0x51, 0xf3, 0xaa, 0xea, 0xcc, 0x27, 0x60, 0x42, 0x2f, 0x55, 0x9f, 0xf1, 0xba, 0x1a, 0x63,
-	0xa2, 0x78, 0x76, 0x1d, 0x27, 0xce, 0x73, 0x17, 0xa0, 0x73, 0xed, 0x54, 0xe4, 0x1b, 0x05, 0x79,
-	0x47, 0x0b, 0xc1, 0x1d, 0x2d, 0x48, 0x05, 0x50, 0x77, 0xb4, 0x70, 0x40, 0x6a, 0xa1, 0x6f, 0xb9,
-	0xcb, 0x13, 0x3f, 0xd7, 0x14, 0x7d, 0x4f, 0x8e, 0x74, 0xfa, 0xe1, 0x0b, 0xd1, 0xa3, 0x7b, 0x3d,
-	0x88, 0x97, 0x04, 0xe2, 0xca, 0x7f, 0x22, 0xca, 0xd4, 0x3d, 0x8c, 0x86, 0x6a, 0x94, 0x4f, 0xb8,
-	0xd5, 0x72, 0x68, 0xec, 0x46, 0x22, 0x18, 0x61, 0xa4, 0x41, 0xd5, 0xa1, 0x88, 0xdf, 0xf8, 0x1d,
-	0xd5, 0x21, 0x31, 0x07, 0xb5, 0x2b, 0x04, 0x23, 0xc1, 0x0d, 0x08, 0x3d, 0x82, 0xdf, 0x78, 0x1f,
-	0xae, 0x86, 0x67, 0x78, 0x27, 0xd0, 0x92, 0xcf, 0xa5, 0x94, 0x84, 0x49, 0xd6, 0xe0, 0x7f, 0x52,
-	0x62, 0x6c, 0x8b, 0x32, 0xdf, 0xfe, 0xda, 0x8e, 0x14, 0x60, 0x46, 0xcc, 0x97, 0xa2, 0x69, 0x5c,
-	0x87, 0x85, 0xf4, 0x48, 0x2a, 0xfb, 0x3e, 0x4c, 0xf7, 0xa8, 0x95, 0x3a, 0xbb, 0xc5, 0x44, 0x5d,
-	0xbb, 0xbd, 0x55, 0x6d, 0xa7, 0x68, 0xd7, 0x1c, 0x5e, 0x54, 0xcc, 0xbb, 0x8e, 0x93, 0xc2, 0x1c,
-	0x81, 0x24, 0x96, 0xb3, 0x41, 0x86, 0x5f, 0x0f, 0xe4, 0x2b, 0x58, 0x0e, 0xb7, 0xfc, 0x29, 0x3d,
-	0xf2, 0x0f, 0x82, 0x59, 0xff, 0x41, 0x80, 0xc1, 0xcc, 0xa8, 0x61, 0x17, 0x01, 0xcc, 0x3a, 0x61,
-	0x8c, 0x3a, 0x9d, 0x2b, 0x34, 0xa1, 0x66, 0x4a, 0x16, 0x9a, 0x83, 0xb1, 0x26, 0x77, 0xfd, 0x48,
-	0x3c, 0xcb, 0xa3, 0xc1, 0xb0, 0x64, 0xe1, 0x0f, 0x01, 0xf7, 0x0b, 0xae, 0x36, 0xa3, 0xc3, 0xb8,
-	0xa7, 0xe6, 0x44, 0xec, 0x91, 0x72, 0x34, 0xc6, 0x45, 0x78, 0x43, 0x16, 0x42, 0xf6, 0xc1, 0x17,
-	0xa1, 0xfc, 0x7b, 0x28, 0x07, 0x63, 0x3d, 0xba, 0x59, 0x0e, 0x87, 0xf8, 0x08, 0xf2, 0xe9, 0x3e,
-	0x51, 0xc6, 0x87, 0x80, 0x12, 0x0f, 0x4a, 0xa8, 0x37, 0xcb, 0x89, 0x1a, 0xc6, 0xe3, 0xa8, 0x3a,
-	0x5e, 0x21, 0xf1, 0xf8, 0xc5, 0x7f, 0xa6, 0xe0, 0xb2, 0x48, 0x8d, 0x9e, 0xc2, 0xa8, 0xd4, 0x4d,
-	0x74, 0x2d, 0x11, 0x2f, 0x29, 0xce, 0xfa, 0xf5, 0xfe, 0x46, 0x12, 0x1b, 0xaf, 0x7f, 0xf7, 0xc7,
-	0xdf, 0x3f, 0x5c, 0xba, 0x8e, 0xb0, 0xf1, 0x40, 0x58, 0x3b, 0xa4, 0xea, 0x19, 0xe9, 0xcf, 0x35,
-	0x7a, 0xae, 0x01, 0x74, 0x14, 0x16, 0xad, 0xa7, 0x27, 0x48, 0x93, 0x6f, 0xfd, 0xed, 0x81, 0x6c,
-	0x15, 0xd3, 0x8e, 0x60, 0xba, 0x85, 0x8a, 0x8a, 0xe9, 0xe6, 0xfd, 0x34, 0xa8, 0x8e, 0x4e, 0x1b,
-	0x27, 0xa1, 0x14, 0xb7, 0xd1, 0x4f, 0x1a, 0x8c, 0x87, 0x0a, 0x84, 0x56, 0x33, 0xb3, 0xc6, 0xe4,
-	0x53, 0x5f, 0x1b, 0xc0, 0x52, 0xd1, 0x6d, 0x0b, 0xba, 0x2d, 0xb4, 0xd9, 0x97, 0x2e, 0xd2, 0xc9,
-	0x6e, 0xb8, 0xef, 0x35, 0x98, 0x0c, 0xe3, 0xed, 0x3a, 0x4e, 0x16, 0x5f, 0x52, 0xde, 0xb3, 0xf8,
-	0x52, 0x44, 0x1a, 0x17, 0x04, 0xdf, 0x2a, 0xba, 0x31, 0x18, 0x1f, 0xfa, 0x45, 0x83, 0xe9, 0x1e,
-	0x61, 0xcc, 0x3a, 0xd8, 0x34, 0xb9, 0xcd, 0x3a, 0xd8, 0x54, 0xa5, 0x1d, 0xf0, 0x60, 0x1b, 0xc2,
-	0x37, 0xfc, 0x2a, 0x31, 0x4e, 0x02, 0x09, 0x6f, 0xa3, 0x1f, 0x35, 0x58, 0xe8, 0xf7, 0x3d, 0x84,
-	0xb6, 0xd3, 0x49, 0x06, 0xf8, 0x8a, 0xd3, 0x77, 0x5e, 0xc7, 0x55, 0xdd, 0xfb, 0xdf, 0x34, 0x98,
-	0xea, 0x56, 0x44, 0xb4, 0x91, 0xd9, 0x4a, 0x29, 0xaa, 0xac, 0xdf, 0x1c, 0xd0, 0x5a, 0x55, 0xf0,
-	0x8e, 0xa8, 0xe0, 0x07, 0xe8, 0x76, 0xdf, 0x0a, 0xf6, 0xe8, 0xb8, 0x71, 0x12, 0x7f, 0xaa, 0xda,
-	0xe8, 0x67, 0x0d, 0x66, 0xba, 0xe3, 0x07, 0xcd, 0xb8, 0x91, 0xd9, 0x62, 0x17, 0xe0, 0xce, 0x78,
-	0x5c, 0x70, 0x51, 0x70, 0x6f, 0xa0, 0xf5, 0xc1, 0xb9, 0xd1, 0xef, 0x1a, 0xa0, 0xa4, 0xc4, 0xa3,
-	0x62, 0x66, 0xc5, 0x32, 0x1f, 0x1b, 0x7d, 0xeb, 0x42, 0x3e, 0x8a, 0xf9, 0x40, 0x30, 0x7f, 0x84,
-	0xf6, 0xfb, 0x32, 0x33, 0x7a, 0xe4, 0x57, 0x9a, 0x22, 0x42, 0x25, 0x7c, 0x62, 0xc4, 0x9d, 0x57,
-	0x4f, 0x5b, 0xdb, 0x38, 0x51, 0x0f, 0x59, 0x1b, 0xfd, 0xaa, 0xc1, 0x95, 0xe4, 0xab, 0xb3, 0x92,
-	0x51, 0xca, 0xb8, 0xa1, 0x6e, 0x0c, 0x68, 0x78, 0x41, 0xa9, 0xea, 0x3c, 0x57, 0xc6, 0x89, 0xba,
-	0x74, 0xed, 0xbd, 0x8f, 0x5f, 0x9c, 0xe5, 0xb5, 0x97, 0x67, 0x79, 0xed, 0xaf, 0xb3, 0xbc, 0xf6,
-	0xec, 0x3c, 0x3f, 0xf4, 0xf2, 0x3c, 0x3f, 0xf4, 0xe7, 0x79, 0x7e, 0xe8, 0xd1, 0x66, 0xcd, 0xf6,
-	0xeb, 0xad, 0x6a, 0xc1, 0xe4, 0x8d, 0xb4, 0xb0, 0x87, 0xdb, 0xc6, 0x51, 0x27, 0xb6, 0x7f, 0xdc,
-	0xa4, 0x5e, 0x75, 0x54, 0xfc, 0xb1, 0xda, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x4d, 0xd9,
-	0x03, 0x96, 0x0e, 0x00, 0x00,
+func (m *QueryRedemptionRateHistoryRequest) Reset()         { *m = QueryRedemptionRateHistoryRequest{} }
+func (m *QueryRedemptionRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{20}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a Validator by host zone.
-	Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error)
-	// Queries a HostZone by id.
-	HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error)
-	// Queries a list of HostZone items.
-	HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error)
-	// Queries a list of ModuleAddress items.
-	ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error)
-	// QueryInterchainAccountFromAddress returns the interchain account for given
-	// owner address on a given connection pair
-	InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error)
-	// Queries a EpochTracker by index.
-	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
-	// Queries a list of EpochTracker items.
-	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
-	// Queries the next packet sequence for one for a given channel
-	NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error)
-	// Queries an address's unbondings
-	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
+func (m *QueryRedemptionRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRedemptionRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error) {
-	out := new(QueryGetValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Validators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryRequest) GetTwapEpochs() uint64 {
+	if m != nil {
+		return m.TwapEpochs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error) {
-	out := new(QueryGetHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZone", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryRedemptionRateHistoryResponse struct {
+	RedemptionRateRecords      []RedemptionRateRecord                 `protobuf:"bytes,1,rep,name=redemption_rate_records,json=redemptionRateRecords,proto3" json:"redemption_rate_records"`
+	TimeWeightedRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=time_weighted_redemption_rate,json=timeWeightedRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"time_weighted_redemption_rate"`
 }
 
-func (c *queryClient) HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error) {
-	out := new(QueryAllHostZoneResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionRateHistoryResponse) Reset()         { *m = QueryRedemptionRateHistoryResponse{} }
+func (m *QueryRedemptionRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRedemptionRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{21}
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRateHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error) {
-	out := new(QueryModuleAddressResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ModuleAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionRateHistoryResponse) GetRedemptionRateRecords() []RedemptionRateRecord {
+	if m != nil {
+		return m.RedemptionRateRecords
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error) {
-	out := new(QueryInterchainAccountFromAddressResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InterchainAccountFromAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryFeeRecipientsRequest struct {
 }
 
-func (c *queryClient) EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error) {
-	out := new(QueryGetEpochTrackerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRecipientsRequest) Reset()         { *m = QueryFeeRecipientsRequest{} }
+func (m *QueryFeeRecipientsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientsRequest) ProtoMessage()    {}
+func (*QueryFeeRecipientsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{22}
+}
+func (m *QueryFeeRecipientsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeRecipientsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientsRequest.Merge(m, src)
+}
+func (m *QueryFeeRecipientsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error) {
-	out := new(QueryAllEpochTrackerResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryFeeRecipientsRequest proto.InternalMessageInfo
+
+type QueryFeeRecipientsResponse struct {
+	FeeRecipients []FeeRecipient        `protobuf:"bytes,1,rep,name=fee_recipients,json=feeRecipients,proto3" json:"fee_recipients"`
+	Revenue       []FeeRecipientRevenue `protobuf:"bytes,2,rep,name=revenue,proto3" json:"revenue"`
 }
 
-func (c *queryClient) NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error) {
-	out := new(QueryGetNextPacketSequenceResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextPacketSequence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRecipientsResponse) Reset()         { *m = QueryFeeRecipientsResponse{} }
+func (m *QueryFeeRecipientsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientsResponse) ProtoMessage()    {}
+func (*QueryFeeRecipientsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{23}
+}
+func (m *QueryFeeRecipientsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeRecipientsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientsResponse.Merge(m, src)
+}
+func (m *QueryFeeRecipientsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error) {
-	out := new(QueryAddressUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AddressUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeRecipientsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRecipientsResponse) GetFeeRecipients() []FeeRecipient {
+	if m != nil {
+		return m.FeeRecipients
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a Validator by host zone.
-	Validators(context.Context, *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error)
-	// Queries a HostZone by id.
-	HostZone(context.Context, *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error)
-	// Queries a list of HostZone items.
-	HostZoneAll(context.Context, *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error)
-	// Queries a list of ModuleAddress items.
-	ModuleAddress(context.Context, *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error)
-	// QueryInterchainAccountFromAddress returns the interchain account for given
-	// owner address on a given connection pair
-	InterchainAccountFromAddress(context.Context, *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error)
-	// Queries a EpochTracker by index.
-	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
-	// Queries a list of EpochTracker items.
-	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
-	// Queries the next packet sequence for one for a given channel
-	NextPacketSequence(context.Context, *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error)
-	// Queries an address's unbondings
-	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
+func (m *QueryFeeRecipientsResponse) GetRevenue() []FeeRecipientRevenue {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryUnconfirmedSlashesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+func (m *QueryUnconfirmedSlashesRequest) Reset()         { *m = QueryUnconfirmedSlashesRequest{} }
+func (m *QueryUnconfirmedSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnconfirmedSlashesRequest) ProtoMessage()    {}
+func (*QueryUnconfirmedSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{24}
 }
-func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
+func (m *QueryUnconfirmedSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HostZoneAll(ctx context.Context, req *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAll not implemented")
+func (m *QueryUnconfirmedSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnconfirmedSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ModuleAddress(ctx context.Context, req *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddress not implemented")
+func (m *QueryUnconfirmedSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnconfirmedSlashesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) InterchainAccountFromAddress(ctx context.Context, req *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountFromAddress not implemented")
+func (m *QueryUnconfirmedSlashesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochTracker not implemented")
+func (m *QueryUnconfirmedSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnconfirmedSlashesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
-}
-func (*UnimplementedQueryServer) NextPacketSequence(ctx context.Context, req *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextPacketSequence not implemented")
-}
-func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddressUnbondings not implemented")
+
+var xxx_messageInfo_QueryUnconfirmedSlashesRequest proto.InternalMessageInfo
+
+func (m *QueryUnconfirmedSlashesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryUnconfirmedSlashesResponse struct {
+	UnconfirmedSlashes []UnconfirmedSlash `protobuf:"bytes,1,rep,name=unconfirmed_slashes,json=unconfirmedSlashes,proto3" json:"unconfirmed_slashes"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryUnconfirmedSlashesResponse) Reset()         { *m = QueryUnconfirmedSlashesResponse{} }
+func (m *QueryUnconfirmedSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnconfirmedSlashesResponse) ProtoMessage()    {}
+func (*QueryUnconfirmedSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{25}
+}
+func (m *QueryUnconfirmedSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnconfirmedSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnconfirmedSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUnconfirmedSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnconfirmedSlashesResponse.Merge(m, src)
+}
+func (m *QueryUnconfirmedSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnconfirmedSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnconfirmedSlashesResponse.DiscardUnknown(m)
 }
 
-func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Validators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/Validators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Validators(ctx, req.(*QueryGetValidatorsRequest))
+var xxx_messageInfo_QueryUnconfirmedSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryUnconfirmedSlashesResponse) GetUnconfirmedSlashes() []UnconfirmedSlash {
+	if m != nil {
+		return m.UnconfirmedSlashes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetHostZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostZone(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/HostZone",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostZone(ctx, req.(*QueryGetHostZoneRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryValidatorWeightsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_HostZoneAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllHostZoneRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostZoneAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/HostZoneAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostZoneAll(ctx, req.(*QueryAllHostZoneRequest))
+func (m *QueryValidatorWeightsRequest) Reset()         { *m = QueryValidatorWeightsRequest{} }
+func (m *QueryValidatorWeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorWeightsRequest) ProtoMessage()    {}
+func (*QueryValidatorWeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{26}
+}
+func (m *QueryValidatorWeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorWeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorWeightsRequest.Merge(m, src)
+}
+func (m *QueryValidatorWeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorWeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorWeightsRequest.DiscardUnknown(m)
 }
 
-func _Query_ModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/ModuleAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAddress(ctx, req.(*QueryModuleAddressRequest))
+var xxx_messageInfo_QueryValidatorWeightsRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorWeightsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_InterchainAccountFromAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInterchainAccountFromAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InterchainAccountFromAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/InterchainAccountFromAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InterchainAccountFromAddress(ctx, req.(*QueryInterchainAccountFromAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryValidatorWeightsResponse struct {
+	Policy       *ValidatorWeightPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Explanations []ValidatorWeightExplanation `protobuf:"bytes,2,rep,name=explanations,proto3" json:"explanations"`
 }
 
-func _Query_EpochTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetEpochTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochTracker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/EpochTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochTracker(ctx, req.(*QueryGetEpochTrackerRequest))
+func (m *QueryValidatorWeightsResponse) Reset()         { *m = QueryValidatorWeightsResponse{} }
+func (m *QueryValidatorWeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorWeightsResponse) ProtoMessage()    {}
+func (*QueryValidatorWeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{27}
+}
+func (m *QueryValidatorWeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorWeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorWeightsResponse.Merge(m, src)
+}
+func (m *QueryValidatorWeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorWeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorWeightsResponse.DiscardUnknown(m)
 }
 
-func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllEpochTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/EpochTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochTrackerAll(ctx, req.(*QueryAllEpochTrackerRequest))
+var xxx_messageInfo_QueryValidatorWeightsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorWeightsResponse) GetPolicy() *ValidatorWeightPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_NextPacketSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNextPacketSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NextPacketSequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/NextPacketSequence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NextPacketSequence(ctx, req.(*QueryGetNextPacketSequenceRequest))
+func (m *QueryValidatorWeightsResponse) GetExplanations() []ValidatorWeightExplanation {
+	if m != nil {
+		return m.Explanations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AddressUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddressUnbondings)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AddressUnbondings(ctx, in)
+type QueryRebalancePlanRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the maximum number of redelegations in the plan (0 for no limit)
+	MaxRebalancings uint64 `protobuf:"varint,2,opt,name=max_rebalancings,json=maxRebalancings,proto3" json:"max_rebalancings,omitempty"`
+}
+
+func (m *QueryRebalancePlanRequest) Reset()         { *m = QueryRebalancePlanRequest{} }
+func (m *QueryRebalancePlanRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanRequest) ProtoMessage()    {}
+func (*QueryRebalancePlanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{28}
+}
+func (m *QueryRebalancePlanRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stride.stakeibc.Query/AddressUnbondings",
+}
+func (m *QueryRebalancePlanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanRequest.Merge(m, src)
+}
+func (m *QueryRebalancePlanRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancePlanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRebalancePlanRequest proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AddressUnbondings(ctx, req.(*QueryAddressUnbondings))
+	return ""
+}
+
+func (m *QueryRebalancePlanRequest) GetMaxRebalancings() uint64 {
+	if m != nil {
+		return m.MaxRebalancings
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stride.stakeibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "HostZone",
-			Handler:    _Query_HostZone_Handler,
-		},
-		{
-			MethodName: "HostZoneAll",
-			Handler:    _Query_HostZoneAll_Handler,
-		},
-		{
-			MethodName: "ModuleAddress",
-			Handler:    _Query_ModuleAddress_Handler,
-		},
-		{
-			MethodName: "InterchainAccountFromAddress",
-			Handler:    _Query_InterchainAccountFromAddress_Handler,
-		},
-		{
-			MethodName: "EpochTracker",
-			Handler:    _Query_EpochTracker_Handler,
-		},
-		{
-			MethodName: "EpochTrackerAll",
-			Handler:    _Query_EpochTrackerAll_Handler,
-		},
-		{
-			MethodName: "NextPacketSequence",
-			Handler:    _Query_NextPacketSequence_Handler,
-		},
-		{
-			MethodName: "AddressUnbondings",
-			Handler:    _Query_AddressUnbondings_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stride/stakeibc/query.proto",
+type QueryRebalancePlanResponse struct {
+	Rebalancings         []Rebalancing         `protobuf:"bytes,1,rep,name=rebalancings,proto3" json:"rebalancings"`
+	PendingRedelegations []PendingRedelegation `protobuf:"bytes,2,rep,name=pending_redelegations,json=pendingRedelegations,proto3" json:"pending_redelegations"`
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) Reset()         { *m = QueryRebalancePlanResponse{} }
+func (m *QueryRebalancePlanResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancePlanResponse) ProtoMessage()    {}
+func (*QueryRebalancePlanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{29}
+}
+func (m *QueryRebalancePlanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancePlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancePlanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryInterchainAccountFromAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRebalancePlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancePlanResponse.Merge(m, src)
+}
+func (m *QueryRebalancePlanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancePlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancePlanResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInterchainAccountFromAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRebalancePlanResponse proto.InternalMessageInfo
+
+func (m *QueryRebalancePlanResponse) GetRebalancings() []Rebalancing {
+	if m != nil {
+		return m.Rebalancings
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancePlanResponse) GetPendingRedelegations() []PendingRedelegation {
+	if m != nil {
+		return m.PendingRedelegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySunsetProgressRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterchainAccountAddress) > 0 {
-		i -= len(m.InterchainAccountAddress)
-		copy(dAtA[i:], m.InterchainAccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySunsetProgressRequest) Reset()         { *m = QuerySunsetProgressRequest{} }
+func (m *QuerySunsetProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySunsetProgressRequest) ProtoMessage()    {}
+func (*QuerySunsetProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{30}
+}
+func (m *QuerySunsetProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySunsetProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySunsetProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySunsetProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySunsetProgressRequest.Merge(m, src)
+}
+func (m *QuerySunsetProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySunsetProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySunsetProgressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySunsetProgressRequest proto.InternalMessageInfo
+
+func (m *QuerySunsetProgressRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySunsetProgressResponse struct {
+	Status SunsetStatus `protobuf:"varint,1,opt,name=status,proto3,enum=stride.stakeibc.SunsetStatus" json:"status,omitempty"`
+	// delegations remaining on the host
+	StakedBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=staked_balance,json=stakedBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staked_balance"`
+	// number of validators that still have delegations
+	NumValidatorsWithDelegations uint64 `protobuf:"varint,3,opt,name=num_validators_with_delegations,json=numValidatorsWithDelegations,proto3" json:"num_validators_with_delegations,omitempty"`
+	// native tokens that are unbonding, or have unbonded but not yet been swept
+	// to the redemption account
+	UnbondingBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unbonding_balance,json=unbondingBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonding_balance"`
+	// native tokens in deposit records that have not yet been delegated
+	PendingDepositBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=pending_deposit_balance,json=pendingDepositBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pending_deposit_balance"`
+	// rate at which stTokens are redeemed, set once the sunset is claimable
+	SunsetRedemptionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=sunset_redemption_rate,json=sunsetRedemptionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sunset_redemption_rate"`
+	// native tokens still reserved for sunset redemptions
+	ClaimableBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=claimable_balance,json=claimableBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable_balance"`
+	// stTokens that have not yet been redeemed
+	StTokenSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=st_token_supply,json=stTokenSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st_token_supply"`
+}
+
+func (m *QuerySunsetProgressResponse) Reset()         { *m = QuerySunsetProgressResponse{} }
+func (m *QuerySunsetProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySunsetProgressResponse) ProtoMessage()    {}
+func (*QuerySunsetProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{31}
+}
+func (m *QuerySunsetProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySunsetProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySunsetProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySunsetProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySunsetProgressResponse.Merge(m, src)
+}
+func (m *QuerySunsetProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySunsetProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySunsetProgressResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QuerySunsetProgressResponse proto.InternalMessageInfo
+
+func (m *QuerySunsetProgressResponse) GetStatus() SunsetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SunsetStatus_SUNSET_NONE
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySunsetProgressResponse) GetNumValidatorsWithDelegations() uint64 {
+	if m != nil {
+		return m.NumValidatorsWithDelegations
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNextScheduledRunsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNextScheduledRunsRequest) Reset()         { *m = QueryNextScheduledRunsRequest{} }
+func (m *QueryNextScheduledRunsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextScheduledRunsRequest) ProtoMessage()    {}
+func (*QueryNextScheduledRunsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{32}
+}
+func (m *QueryNextScheduledRunsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextScheduledRunsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextScheduledRunsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryNextScheduledRunsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextScheduledRunsRequest.Merge(m, src)
+}
+func (m *QueryNextScheduledRunsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextScheduledRunsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextScheduledRunsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextScheduledRunsRequest proto.InternalMessageInfo
+
+func (m *QueryNextScheduledRunsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ScheduledRun struct {
+	// name of the task (e.g. deposit, delegate, reinvest, redemption_rate,
+	// unbonding)
+	Task string `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// epoch that the task runs in
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// number of epochs between each run
+	Interval uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// true if the interval is overridden by the host zone
+	Overridden bool `protobuf:"varint,4,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	// epoch number of the next run
+	NextEpochNumber uint64 `protobuf:"varint,5,opt,name=next_epoch_number,json=nextEpochNumber,proto3" json:"next_epoch_number,omitempty"`
+	// estimated start time of the next run's epoch (unix nanoseconds)
+	NextRunTime uint64 `protobuf:"varint,6,opt,name=next_run_time,json=nextRunTime,proto3" json:"next_run_time,omitempty"`
+}
+
+func (m *ScheduledRun) Reset()         { *m = ScheduledRun{} }
+func (m *ScheduledRun) String() string { return proto.CompactTextString(m) }
+func (*ScheduledRun) ProtoMessage()    {}
+func (*ScheduledRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{33}
+}
+func (m *ScheduledRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ScheduledRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScheduledRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledRun.Merge(m, src)
+}
+func (m *ScheduledRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledRun.DiscardUnknown(m)
 }
 
-func (m *QueryGetValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScheduledRun proto.InternalMessageInfo
+
+func (m *ScheduledRun) GetTask() string {
+	if m != nil {
+		return m.Task
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ScheduledRun) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetHostZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledRun) GetInterval() uint64 {
+	if m != nil {
+		return m.Interval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ScheduledRun) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
+	}
+	return false
 }
 
-func (m *QueryGetHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ScheduledRun) GetNextEpochNumber() uint64 {
+	if m != nil {
+		return m.NextEpochNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledRun) GetNextRunTime() uint64 {
+	if m != nil {
+		return m.NextRunTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNextScheduledRunsResponse struct {
+	ScheduledRuns []ScheduledRun `protobuf:"bytes,1,rep,name=scheduled_runs,json=scheduledRuns,proto3" json:"scheduled_runs"`
 }
 
-func (m *QueryGetHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNextScheduledRunsResponse) Reset()         { *m = QueryNextScheduledRunsResponse{} }
+func (m *QueryNextScheduledRunsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextScheduledRunsResponse) ProtoMessage()    {}
+func (*QueryNextScheduledRunsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{34}
+}
+func (m *QueryNextScheduledRunsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextScheduledRunsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextScheduledRunsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryNextScheduledRunsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextScheduledRunsResponse.Merge(m, src)
+}
+func (m *QueryNextScheduledRunsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextScheduledRunsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextScheduledRunsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostZoneRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNextScheduledRunsResponse proto.InternalMessageInfo
+
+func (m *QueryNextScheduledRunsResponse) GetScheduledRuns() []ScheduledRun {
+	if m != nil {
+		return m.ScheduledRuns
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryICARetriesRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryAllHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryICARetriesRequest) Reset()         { *m = QueryICARetriesRequest{} }
+func (m *QueryICARetriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryICARetriesRequest) ProtoMessage()    {}
+func (*QueryICARetriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{35}
+}
+func (m *QueryICARetriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICARetriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICARetriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryICARetriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICARetriesRequest.Merge(m, src)
+}
+func (m *QueryICARetriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICARetriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICARetriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryICARetriesRequest proto.InternalMessageInfo
+
+func (m *QueryICARetriesRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryICARetriesResponse struct {
+	IcaRetries []ICARetry `protobuf:"bytes,1,rep,name=ica_retries,json=icaRetries,proto3" json:"ica_retries"`
 }
 
-func (m *QueryAllHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.HostZone) > 0 {
-		for iNdEx := len(m.HostZone) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryICARetriesResponse) Reset()         { *m = QueryICARetriesResponse{} }
+func (m *QueryICARetriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryICARetriesResponse) ProtoMessage()    {}
+func (*QueryICARetriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{36}
+}
+func (m *QueryICARetriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryICARetriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryICARetriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryICARetriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryICARetriesResponse.Merge(m, src)
+}
+func (m *QueryICARetriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryICARetriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryICARetriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryICARetriesResponse proto.InternalMessageInfo
+
+func (m *QueryICARetriesResponse) GetIcaRetries() []ICARetry {
+	if m != nil {
+		return m.IcaRetries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDirectedDelegationsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDirectedDelegationsRequest) Reset()         { *m = QueryDirectedDelegationsRequest{} }
+func (m *QueryDirectedDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDirectedDelegationsRequest) ProtoMessage()    {}
+func (*QueryDirectedDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{37}
+}
+func (m *QueryDirectedDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDirectedDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDirectedDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDirectedDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDirectedDelegationsRequest.Merge(m, src)
+}
+func (m *QueryDirectedDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDirectedDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDirectedDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDirectedDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryDirectedDelegationsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DirectedDelegation struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// total liquid staked amount directed to the validator
+	DirectedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=directed_amount,json=directedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"directed_amount"`
+	// portion of the directed amount that has not yet been delegated
+	UndelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=undelegated_amount,json=undelegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"undelegated_amount"`
 }
 
-func (m *QueryModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *DirectedDelegation) Reset()         { *m = DirectedDelegation{} }
+func (m *DirectedDelegation) String() string { return proto.CompactTextString(m) }
+func (*DirectedDelegation) ProtoMessage()    {}
+func (*DirectedDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{38}
 }
-
-func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DirectedDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DirectedDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DirectedDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DirectedDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectedDelegation.Merge(m, src)
 }
-
-func (m *QueryGetEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EpochIdentifier) > 0 {
-		i -= len(m.EpochIdentifier)
-		copy(dAtA[i:], m.EpochIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *DirectedDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DirectedDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectedDelegation.DiscardUnknown(m)
 }
 
-func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DirectedDelegation proto.InternalMessageInfo
+
+func (m *DirectedDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDirectedDelegationsResponse struct {
+	DirectedDelegations []DirectedDelegation                   `protobuf:"bytes,1,rep,name=directed_delegations,json=directedDelegations,proto3" json:"directed_delegations"`
+	TotalDirectedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_directed_amount,json=totalDirectedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_directed_amount"`
 }
 
-func (m *QueryGetEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EpochTracker.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDirectedDelegationsResponse) Reset()         { *m = QueryDirectedDelegationsResponse{} }
+func (m *QueryDirectedDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDirectedDelegationsResponse) ProtoMessage()    {}
+func (*QueryDirectedDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_494b786fe66f2b80, []int{39}
+}
+func (m *QueryDirectedDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDirectedDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDirectedDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDirectedDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDirectedDelegationsResponse.Merge(m, src)
+}
+func (m *QueryDirectedDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDirectedDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDirectedDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDirectedDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryDirectedDelegationsResponse) GetDirectedDelegations() []DirectedDelegation {
+	if m != nil {
+		return m.DirectedDelegations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryInterchainAccountFromAddressRequest)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressRequest")
+	proto.RegisterType((*QueryInterchainAccountFromAddressResponse)(nil), "stride.stakeibc.QueryInterchainAccountFromAddressResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stride.stakeibc.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stride.stakeibc.QueryParamsResponse")
+	proto.RegisterType((*QueryGetValidatorsRequest)(nil), "stride.stakeibc.QueryGetValidatorsRequest")
+	proto.RegisterType((*QueryGetValidatorsResponse)(nil), "stride.stakeibc.QueryGetValidatorsResponse")
+	proto.RegisterType((*QueryGetHostZoneRequest)(nil), "stride.stakeibc.QueryGetHostZoneRequest")
+	proto.RegisterType((*QueryGetHostZoneResponse)(nil), "stride.stakeibc.QueryGetHostZoneResponse")
+	proto.RegisterType((*QueryAllHostZoneRequest)(nil), "stride.stakeibc.QueryAllHostZoneRequest")
+	proto.RegisterType((*QueryAllHostZoneResponse)(nil), "stride.stakeibc.QueryAllHostZoneResponse")
+	proto.RegisterType((*QueryModuleAddressRequest)(nil), "stride.stakeibc.QueryModuleAddressRequest")
+	proto.RegisterType((*QueryModuleAddressResponse)(nil), "stride.stakeibc.QueryModuleAddressResponse")
+	proto.RegisterType((*QueryGetEpochTrackerRequest)(nil), "stride.stakeibc.QueryGetEpochTrackerRequest")
+	proto.RegisterType((*QueryGetEpochTrackerResponse)(nil), "stride.stakeibc.QueryGetEpochTrackerResponse")
+	proto.RegisterType((*QueryAllEpochTrackerRequest)(nil), "stride.stakeibc.QueryAllEpochTrackerRequest")
+	proto.RegisterType((*QueryAllEpochTrackerResponse)(nil), "stride.stakeibc.QueryAllEpochTrackerResponse")
+	proto.RegisterType((*QueryGetNextPacketSequenceRequest)(nil), "stride.stakeibc.QueryGetNextPacketSequenceRequest")
+	proto.RegisterType((*QueryGetNextPacketSequenceResponse)(nil), "stride.stakeibc.QueryGetNextPacketSequenceResponse")
+	proto.RegisterType((*QueryAddressUnbondings)(nil), "stride.stakeibc.QueryAddressUnbondings")
+	proto.RegisterType((*QueryAddressUnbondingsResponse)(nil), "stride.stakeibc.QueryAddressUnbondingsResponse")
+	proto.RegisterType((*QueryRedemptionRateHistoryRequest)(nil), "stride.stakeibc.QueryRedemptionRateHistoryRequest")
+	proto.RegisterType((*QueryRedemptionRateHistoryResponse)(nil), "stride.stakeibc.QueryRedemptionRateHistoryResponse")
+	proto.RegisterType((*QueryFeeRecipientsRequest)(nil), "stride.stakeibc.QueryFeeRecipientsRequest")
+	proto.RegisterType((*QueryFeeRecipientsResponse)(nil), "stride.stakeibc.QueryFeeRecipientsResponse")
+	proto.RegisterType((*QueryUnconfirmedSlashesRequest)(nil), "stride.stakeibc.QueryUnconfirmedSlashesRequest")
+	proto.RegisterType((*QueryUnconfirmedSlashesResponse)(nil), "stride.stakeibc.QueryUnconfirmedSlashesResponse")
+	proto.RegisterType((*QueryValidatorWeightsRequest)(nil), "stride.stakeibc.QueryValidatorWeightsRequest")
+	proto.RegisterType((*QueryValidatorWeightsResponse)(nil), "stride.stakeibc.QueryValidatorWeightsResponse")
+	proto.RegisterType((*QueryRebalancePlanRequest)(nil), "stride.stakeibc.QueryRebalancePlanRequest")
+	proto.RegisterType((*QueryRebalancePlanResponse)(nil), "stride.stakeibc.QueryRebalancePlanResponse")
+	proto.RegisterType((*QuerySunsetProgressRequest)(nil), "stride.stakeibc.QuerySunsetProgressRequest")
+	proto.RegisterType((*QuerySunsetProgressResponse)(nil), "stride.stakeibc.QuerySunsetProgressResponse")
+	proto.RegisterType((*QueryNextScheduledRunsRequest)(nil), "stride.stakeibc.QueryNextScheduledRunsRequest")
+	proto.RegisterType((*ScheduledRun)(nil), "stride.stakeibc.ScheduledRun")
+	proto.RegisterType((*QueryNextScheduledRunsResponse)(nil), "stride.stakeibc.QueryNextScheduledRunsResponse")
+	proto.RegisterType((*QueryICARetriesRequest)(nil), "stride.stakeibc.QueryICARetriesRequest")
+	proto.RegisterType((*QueryICARetriesResponse)(nil), "stride.stakeibc.QueryICARetriesResponse")
+	proto.RegisterType((*QueryDirectedDelegationsRequest)(nil), "stride.stakeibc.QueryDirectedDelegationsRequest")
+	proto.RegisterType((*DirectedDelegation)(nil), "stride.stakeibc.DirectedDelegation")
+	proto.RegisterType((*QueryDirectedDelegationsResponse)(nil), "stride.stakeibc.QueryDirectedDelegationsResponse")
 }
 
-func (m *QueryAllEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("stride/stakeibc/query.proto", fileDescriptor_494b786fe66f2b80) }
+
+var fileDescriptor_494b786fe66f2b80 = []byte{
+	// 2404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0x6d, 0x59, 0x56, 0x9e, 0x24, 0xcb, 0x1a, 0xcb, 0x91, 0x4c, 0xdb, 0x52, 0xcc, 0xf8,
+	0x43, 0xb6, 0xec, 0x5d, 0x5b, 0xca, 0x97, 0xfc, 0x19, 0x39, 0xf2, 0x87, 0xd2, 0x34, 0x50, 0x29,
+	0x7f, 0x04, 0x71, 0x0b, 0x62, 0x96, 0x1c, 0xef, 0x12, 0xe2, 0x92, 0x1b, 0x72, 0x56, 0x96, 0x2a,
+	0x08, 0x06, 0x7a, 0xec, 0x29, 0x68, 0xd1, 0x4b, 0xd1, 0x1e, 0x52, 0xf4, 0x90, 0x43, 0x51, 0xa0,
+	0x45, 0x50, 0xa0, 0xb7, 0x02, 0x45, 0x81, 0xf4, 0xd4, 0xa0, 0xbd, 0xb4, 0x3d, 0x18, 0x81, 0x9d,
+	0xbf, 0x20, 0xb7, 0xde, 0x0a, 0xce, 0x3c, 0x72, 0xc9, 0x25, 0xb9, 0xa2, 0x84, 0x9c, 0xb4, 0x9c,
+	0x79, 0x1f, 0xbf, 0x79, 0xef, 0xcd, 0x7b, 0x6f, 0x1e, 0x04, 0xc7, 0x02, 0xee, 0xdb, 0x16, 0xab,
+	0x06, 0x9c, 0xae, 0x32, 0xbb, 0x66, 0x56, 0x3f, 0x69, 0x33, 0x7f, 0xa3, 0xd2, 0xf2, 0x3d, 0xee,
+	0x91, 0x11, 0xb9, 0x59, 0x89, 0x36, 0xd5, 0xb1, 0xba, 0x57, 0xf7, 0xc4, 0x5e, 0x35, 0xfc, 0x25,
+	0xc9, 0xd4, 0xe3, 0x75, 0xcf, 0xab, 0x3b, 0xac, 0x4a, 0x5b, 0x76, 0x95, 0xba, 0xae, 0xc7, 0x29,
+	0xb7, 0x3d, 0x37, 0xc0, 0xdd, 0xf3, 0xa6, 0x17, 0x34, 0xbd, 0xa0, 0x5a, 0xa3, 0x01, 0x93, 0xd2,
+	0xab, 0x6b, 0x97, 0x6b, 0x8c, 0xd3, 0xcb, 0xd5, 0x16, 0xad, 0xdb, 0xae, 0x20, 0x8e, 0x24, 0x75,
+	0xa3, 0x69, 0x51, 0x9f, 0x36, 0x23, 0x49, 0x53, 0xdd, 0xbb, 0x6b, 0xd4, 0xb1, 0x2d, 0xca, 0x3d,
+	0xbf, 0x88, 0xa0, 0xe1, 0x05, 0xdc, 0xf8, 0xb1, 0xe7, 0x32, 0x24, 0x78, 0xbd, 0x9b, 0x80, 0xb5,
+	0x3c, 0xb3, 0x61, 0x70, 0x9f, 0x9a, 0xab, 0x2c, 0x92, 0x72, 0xb6, 0x9b, 0x88, 0x5a, 0x96, 0xcf,
+	0x82, 0xc0, 0x68, 0xbb, 0x35, 0xcf, 0xb5, 0x6c, 0xb7, 0x8e, 0x84, 0xa7, 0xbb, 0x09, 0x7d, 0x66,
+	0xb1, 0x66, 0x2b, 0x3c, 0x8f, 0xe1, 0x53, 0x5e, 0xa8, 0xf4, 0x09, 0x63, 0x86, 0xcf, 0x4c, 0xbb,
+	0x65, 0x33, 0x97, 0x23, 0x51, 0xc6, 0x0f, 0x81, 0x43, 0x83, 0x06, 0x6e, 0x6a, 0x79, 0x8a, 0x1c,
+	0x56, 0x4f, 0x9a, 0x2e, 0x73, 0x76, 0x93, 0x3a, 0x4e, 0x8d, 0x9a, 0xab, 0x85, 0xd6, 0xb3, 0x4d,
+	0x6a, 0xf8, 0x8c, 0x47, 0xde, 0x56, 0x8f, 0x4a, 0x47, 0x19, 0xd2, 0xbf, 0xf2, 0x43, 0x6e, 0x69,
+	0xcf, 0x60, 0xfa, 0x07, 0xa1, 0xe7, 0x96, 0x5c, 0xce, 0x7c, 0xb3, 0x41, 0x6d, 0x77, 0xc1, 0x34,
+	0xbd, 0xb6, 0xcb, 0xef, 0xf8, 0x5e, 0x73, 0x41, 0x9a, 0x47, 0x67, 0x9f, 0xb4, 0x59, 0xc0, 0xc9,
+	0x18, 0xec, 0xf7, 0x9e, 0xba, 0xcc, 0x9f, 0x50, 0x5e, 0x53, 0xa6, 0x5f, 0xd1, 0xe5, 0x07, 0xb9,
+	0x0e, 0xc3, 0xa6, 0xe7, 0xba, 0xcc, 0x14, 0xd6, 0xb1, 0xad, 0x89, 0xbd, 0xe1, 0xee, 0xad, 0x89,
+	0x6f, 0x9f, 0x4f, 0x8d, 0x6d, 0xd0, 0xa6, 0x73, 0x45, 0x4b, 0x6d, 0x6b, 0xfa, 0x50, 0xe7, 0x7b,
+	0xc9, 0xd2, 0x3e, 0x55, 0xe0, 0x5c, 0x09, 0x04, 0x41, 0xcb, 0x73, 0x03, 0x46, 0x4c, 0x50, 0xed,
+	0x98, 0xce, 0xa0, 0x92, 0xd0, 0x40, 0x37, 0x4a, 0x5c, 0xb7, 0x4e, 0x7f, 0xfb, 0x7c, 0xea, 0xa4,
+	0xd4, 0x5c, 0x4c, 0xab, 0xe9, 0x13, 0x76, 0xb7, 0x42, 0x54, 0xa6, 0x8d, 0x01, 0x11, 0x88, 0x96,
+	0x45, 0x88, 0xe2, 0xe9, 0xb5, 0x0f, 0xe0, 0x70, 0x6a, 0x15, 0x11, 0xbd, 0x09, 0xfd, 0x32, 0x94,
+	0x85, 0xf6, 0xc1, 0xd9, 0xf1, 0x4a, 0xd7, 0xd5, 0xaa, 0x48, 0x86, 0x5b, 0x7d, 0x5f, 0x3e, 0x9f,
+	0xda, 0xa3, 0x23, 0xb1, 0xf6, 0x16, 0x1c, 0x15, 0xd2, 0xee, 0x32, 0xfe, 0x30, 0x8a, 0xf5, 0xd8,
+	0xd0, 0x47, 0x61, 0x40, 0x82, 0xb6, 0x2d, 0xb4, 0xf5, 0x01, 0xf1, 0xbd, 0x64, 0x69, 0x1f, 0x81,
+	0x9a, 0xc7, 0x87, 0x60, 0xae, 0x00, 0xc4, 0x37, 0x27, 0x04, 0xb4, 0x6f, 0x7a, 0x70, 0x56, 0xcd,
+	0x00, 0x8a, 0x19, 0xf5, 0x04, 0xb5, 0xf6, 0x06, 0x8c, 0x47, 0x92, 0xef, 0x79, 0x01, 0xff, 0xd8,
+	0x73, 0x59, 0x29, 0x3c, 0x13, 0x59, 0x2e, 0x44, 0x73, 0x0d, 0x5e, 0x89, 0xaf, 0x29, 0x5a, 0xe7,
+	0x68, 0x06, 0x4c, 0xc4, 0x85, 0xf6, 0x19, 0x68, 0xe0, 0xb7, 0x46, 0x11, 0xcf, 0x82, 0xe3, 0x74,
+	0xe3, 0xb9, 0x03, 0xd0, 0x49, 0x30, 0x28, 0xf9, 0x4c, 0x05, 0xe3, 0x3a, 0xcc, 0x46, 0x15, 0x99,
+	0xeb, 0x30, 0x1b, 0x55, 0x96, 0x69, 0x3d, 0xe2, 0xd5, 0x13, 0x9c, 0xda, 0x67, 0x0a, 0x4c, 0x64,
+	0x75, 0xe4, 0xa3, 0xdf, 0xb7, 0x23, 0xf4, 0xe4, 0x6e, 0x0a, 0xe2, 0x5e, 0x01, 0xf1, 0xec, 0xb6,
+	0x10, 0xa5, 0xea, 0x14, 0xc6, 0x2a, 0x06, 0xca, 0xf7, 0x3d, 0xab, 0xed, 0xb0, 0xae, 0x1b, 0x49,
+	0xa0, 0xcf, 0xa5, 0x4d, 0x86, 0x4e, 0x11, 0xbf, 0xb5, 0x4b, 0xa0, 0xe6, 0x31, 0xe0, 0xa9, 0x08,
+	0xf4, 0x85, 0x37, 0x20, 0xe2, 0x08, 0x7f, 0x6b, 0xf7, 0xe0, 0x58, 0xe4, 0xc3, 0xdb, 0x61, 0xd6,
+	0xbc, 0x2f, 0x93, 0x66, 0xa4, 0xe4, 0x1c, 0x1c, 0x92, 0xc9, 0xd4, 0xb6, 0x98, 0xcb, 0xed, 0x27,
+	0x76, 0x9c, 0x01, 0x46, 0xc4, 0xfa, 0x52, 0xbc, 0xac, 0x35, 0xe0, 0x78, 0xbe, 0x24, 0xd4, 0x7e,
+	0x0f, 0x86, 0x53, 0x79, 0x19, 0x7d, 0x77, 0x22, 0x63, 0xd7, 0x24, 0x37, 0xda, 0x76, 0x88, 0x25,
+	0xd6, 0xb4, 0x13, 0x88, 0x79, 0xc1, 0x71, 0x72, 0x30, 0xc7, 0x40, 0x32, 0xdb, 0xc5, 0x40, 0xf6,
+	0xed, 0x0e, 0xc8, 0x63, 0x38, 0x19, 0x1d, 0xf9, 0x43, 0xb6, 0xce, 0x97, 0xc3, 0x55, 0xbe, 0x12,
+	0xc2, 0x70, 0xcd, 0x38, 0x60, 0x4f, 0x00, 0x98, 0x0d, 0xea, 0xba, 0xcc, 0xe9, 0x5c, 0xa1, 0x57,
+	0x70, 0x65, 0xc9, 0x22, 0xe3, 0x70, 0xa0, 0xe5, 0xf9, 0x3c, 0x4e, 0x9e, 0x7a, 0x7f, 0xf8, 0xb9,
+	0x64, 0x69, 0xef, 0x82, 0xd6, 0x4b, 0x38, 0x1e, 0x46, 0x85, 0x81, 0x00, 0xd7, 0x84, 0xec, 0x3e,
+	0x3d, 0xfe, 0xd6, 0x66, 0xe1, 0x55, 0x69, 0x08, 0x19, 0x07, 0x0f, 0xa2, 0x42, 0x17, 0x90, 0x09,
+	0x38, 0x90, 0xca, 0x9b, 0x7a, 0xf4, 0xa9, 0xad, 0xc3, 0x64, 0x3e, 0x4f, 0xac, 0xf1, 0x21, 0x90,
+	0x4c, 0xe9, 0x8c, 0xf2, 0xcd, 0xc9, 0x8c, 0x0d, 0xbb, 0xe5, 0xa0, 0x1d, 0x47, 0x69, 0xb7, 0x7c,
+	0xcd, 0x40, 0x63, 0xea, 0x71, 0xb9, 0xd5, 0x29, 0x67, 0xf7, 0xec, 0x80, 0x7b, 0xfe, 0x46, 0x64,
+	0xcc, 0xe2, 0x6c, 0x44, 0xa6, 0x60, 0x90, 0x3f, 0xa5, 0x2d, 0x43, 0x78, 0x28, 0x10, 0xc6, 0xec,
+	0xd3, 0x21, 0x5c, 0x12, 0x7e, 0x0c, 0xb4, 0x9f, 0xee, 0x05, 0xad, 0x97, 0x86, 0xb8, 0xcc, 0x8c,
+	0x77, 0x55, 0xfc, 0xb0, 0xac, 0x7b, 0xbe, 0x15, 0x1d, 0xf2, 0x74, 0xe6, 0x90, 0x69, 0x81, 0xba,
+	0xa0, 0xc6, 0x83, 0x1e, 0xf1, 0x73, 0xf6, 0x02, 0xf2, 0x0c, 0x4e, 0x70, 0xbb, 0xc9, 0x8c, 0xa7,
+	0xcc, 0xae, 0x37, 0x38, 0xb3, 0x8c, 0x2e, 0x95, 0x58, 0x48, 0xaf, 0x85, 0x32, 0xfe, 0xfb, 0x7c,
+	0xea, 0x4c, 0xdd, 0xe6, 0x8d, 0x76, 0xad, 0x62, 0x7a, 0x4d, 0x2c, 0xe1, 0xf8, 0xe7, 0x62, 0x60,
+	0xad, 0x56, 0xf9, 0x46, 0x8b, 0x05, 0x95, 0x45, 0x66, 0xfe, 0xf3, 0x8b, 0x8b, 0x20, 0xd7, 0xc3,
+	0x2f, 0x5d, 0x0d, 0x55, 0x3c, 0x42, 0x0d, 0x69, 0x8c, 0xda, 0x31, 0x4c, 0x2d, 0x77, 0x18, 0xd3,
+	0xa3, 0xa6, 0x25, 0x2e, 0x77, 0xbf, 0x57, 0x40, 0xcd, 0xdb, 0x45, 0x0b, 0xbd, 0x0f, 0x07, 0x53,
+	0xcd, 0x4e, 0x50, 0x78, 0x83, 0x92, 0xfc, 0x68, 0x90, 0xe1, 0x27, 0x49, 0x99, 0x64, 0x11, 0x0e,
+	0xf8, 0x6c, 0x8d, 0xb9, 0xed, 0xf0, 0xc8, 0xa1, 0x90, 0x53, 0x3d, 0x85, 0xe8, 0x92, 0x16, 0x65,
+	0x45, 0xac, 0xda, 0x55, 0x8c, 0xda, 0x07, 0xae, 0xe9, 0xb9, 0x4f, 0x6c, 0xbf, 0xc9, 0xac, 0x95,
+	0xb0, 0xd3, 0x62, 0x65, 0xca, 0xea, 0x26, 0x4c, 0x15, 0x32, 0xe3, 0x89, 0x3f, 0x82, 0xc3, 0xed,
+	0xce, 0xae, 0x11, 0xc8, 0xed, 0xc2, 0xa0, 0xef, 0x96, 0x84, 0x70, 0x49, 0x3b, 0xa3, 0x41, 0x9b,
+	0xc7, 0x64, 0x15, 0xd7, 0x65, 0xe9, 0xaf, 0x32, 0xb8, 0xff, 0xa4, 0xc0, 0x89, 0x02, 0x5e, 0x84,
+	0x7d, 0x03, 0xfa, 0x5b, 0x9e, 0x63, 0x9b, 0x1b, 0x71, 0x9d, 0x2c, 0x6c, 0x07, 0x24, 0xeb, 0xb2,
+	0xa0, 0xd6, 0x91, 0x8b, 0x3c, 0x80, 0x21, 0xb6, 0xde, 0x72, 0xa8, 0x2c, 0x47, 0x01, 0x7a, 0x68,
+	0x66, 0x3b, 0x29, 0xb7, 0x3b, 0x3c, 0x71, 0xda, 0x4c, 0x88, 0xd1, 0x28, 0xc6, 0x9e, 0xce, 0x6a,
+	0xd4, 0xa1, 0xae, 0xc9, 0x96, 0x1d, 0xea, 0x96, 0xb8, 0xe1, 0xe7, 0xe0, 0x50, 0x93, 0xae, 0x1b,
+	0x3e, 0xb2, 0x89, 0xbc, 0x23, 0xaf, 0xf9, 0x48, 0x93, 0xae, 0xeb, 0x89, 0x65, 0xed, 0x6f, 0x51,
+	0x04, 0x77, 0xe9, 0x40, 0xc3, 0xdc, 0x81, 0xa1, 0x94, 0x14, 0xe9, 0xc8, 0xe3, 0x39, 0x17, 0x3b,
+	0x26, 0x8a, 0x4e, 0x92, 0xe4, 0x23, 0x06, 0x1c, 0x69, 0x31, 0x91, 0xbf, 0x8c, 0x64, 0xf3, 0x1e,
+	0x14, 0xc6, 0xf2, 0xb2, 0xa4, 0xd6, 0x13, 0xc4, 0x28, 0x78, 0xac, 0x95, 0xdd, 0x0a, 0xb4, 0xb7,
+	0xf1, 0x18, 0x2b, 0x6d, 0x37, 0x60, 0x7c, 0xd9, 0xf7, 0xea, 0xc9, 0x16, 0xa0, 0x47, 0x70, 0x7c,
+	0xbd, 0x1f, 0x8e, 0xe5, 0x72, 0x76, 0x5a, 0xd7, 0x80, 0x53, 0xde, 0x96, 0x05, 0xe0, 0x60, 0xce,
+	0xdd, 0x95, 0x8c, 0x2b, 0x82, 0x48, 0x47, 0x62, 0xf2, 0x00, 0x0e, 0x0a, 0x02, 0xcb, 0x40, 0xb3,
+	0x62, 0xa2, 0xaa, 0xec, 0x20, 0x51, 0x2d, 0xb9, 0x5c, 0x1f, 0x96, 0x52, 0x6e, 0x49, 0x21, 0xe4,
+	0x36, 0x4c, 0xb9, 0xed, 0xa6, 0xd1, 0xe9, 0x48, 0x8d, 0xa7, 0x36, 0x6f, 0x18, 0x49, 0x8b, 0xee,
+	0x13, 0x8e, 0x3e, 0xee, 0xb6, 0x9b, 0x9d, 0xde, 0xf7, 0x91, 0xcd, 0x1b, 0x8b, 0x1d, 0x1a, 0xf2,
+	0x18, 0x46, 0xe3, 0x92, 0x14, 0x03, 0xec, 0xdb, 0x15, 0xc0, 0x43, 0xb1, 0xa0, 0x08, 0xe3, 0x13,
+	0x18, 0x8f, 0x7c, 0x6d, 0xb1, 0x96, 0x17, 0xd8, 0x3c, 0x56, 0xb1, 0x7f, 0x57, 0x2a, 0xa2, 0xd0,
+	0x59, 0x94, 0xd2, 0x22, 0x3d, 0x3e, 0xbc, 0x1a, 0x08, 0xd3, 0x67, 0x6a, 0x42, 0xff, 0x77, 0x50,
+	0x13, 0xc6, 0xa4, 0xec, 0x74, 0x35, 0x08, 0x0d, 0x67, 0x3a, 0xd4, 0x6e, 0xd2, 0x9a, 0xc3, 0xe2,
+	0x53, 0x1d, 0xd8, 0x9d, 0xe1, 0x62, 0x41, 0xd1, 0x81, 0x1e, 0xc2, 0x48, 0xc0, 0x0d, 0xee, 0xad,
+	0x32, 0xd7, 0x08, 0xda, 0xad, 0x96, 0xb3, 0x31, 0x31, 0xb0, 0xdb, 0xa0, 0xb9, 0x1f, 0x4a, 0x59,
+	0x11, 0x42, 0xb4, 0x2b, 0x98, 0xfe, 0xc2, 0xee, 0x68, 0xc5, 0x6c, 0xb0, 0xb0, 0xe5, 0xb5, 0xf4,
+	0xb6, 0x5b, 0xe6, 0x7a, 0xfc, 0x47, 0x81, 0xa1, 0x24, 0x4f, 0xd8, 0x1b, 0x73, 0x1a, 0xac, 0x46,
+	0xbd, 0x71, 0xf8, 0x3b, 0xb7, 0xf9, 0xdd, 0x9b, 0xdb, 0xfc, 0x86, 0x6d, 0x98, 0x78, 0x52, 0xae,
+	0x51, 0x07, 0x23, 0x35, 0xfe, 0x26, 0x93, 0x00, 0xde, 0x1a, 0xf3, 0x7d, 0xdb, 0xb2, 0x98, 0x2b,
+	0xc2, 0x71, 0x40, 0x4f, 0xac, 0x90, 0xf3, 0x30, 0xea, 0xb2, 0x75, 0x2e, 0x1b, 0x17, 0xc3, 0x6d,
+	0x37, 0x6b, 0xcc, 0x17, 0x21, 0xd5, 0xa7, 0x8f, 0x84, 0x1b, 0xa2, 0x7d, 0xf9, 0x50, 0x2c, 0x13,
+	0x0d, 0x86, 0x05, 0xad, 0xdf, 0x76, 0x8d, 0xb0, 0xba, 0x8b, 0x98, 0xe8, 0xd3, 0x07, 0xc3, 0x45,
+	0xbd, 0xed, 0xde, 0xb7, 0x9b, 0x4c, 0x73, 0x60, 0xb2, 0xc8, 0x2e, 0x9d, 0x02, 0x1e, 0x44, 0x1b,
+	0xa1, 0xa8, 0xe2, 0x02, 0x9e, 0xe4, 0x8f, 0x0a, 0x78, 0x90, 0x94, 0xa9, 0xcd, 0x61, 0x93, 0xb9,
+	0xf4, 0xde, 0x82, 0xce, 0xb8, 0x6f, 0x97, 0x2a, 0xb9, 0x8f, 0x61, 0x3c, 0xc3, 0x84, 0xd8, 0xde,
+	0x85, 0xc1, 0x68, 0x84, 0x61, 0xb3, 0xa0, 0xf0, 0xf1, 0x85, 0x9c, 0x1b, 0x08, 0x0a, 0x6c, 0x93,
+	0xa2, 0x24, 0xed, 0x1a, 0xd6, 0xf3, 0x45, 0xdb, 0x67, 0x26, 0x67, 0x56, 0x22, 0x43, 0x94, 0x80,
+	0xf6, 0x3f, 0x05, 0x48, 0x96, 0x93, 0xcc, 0xc0, 0x68, 0x9c, 0x9d, 0xd2, 0x33, 0x07, 0xfd, 0x50,
+	0xbc, 0x81, 0xcd, 0x2e, 0x79, 0x04, 0x23, 0x16, 0x8a, 0x30, 0x68, 0x33, 0x1c, 0x2f, 0xec, 0x32,
+	0x4d, 0x1e, 0x8c, 0xc4, 0x2c, 0x08, 0x29, 0xe4, 0x47, 0x40, 0xda, 0x2e, 0x66, 0xc5, 0x8e, 0xec,
+	0x7d, 0xbb, 0x92, 0x3d, 0x9a, 0x90, 0x24, 0xc5, 0x6b, 0xdf, 0x28, 0xf0, 0x5a, 0xb1, 0xe9, 0xd0,
+	0x41, 0x3f, 0x84, 0xb1, 0xf8, 0x70, 0xc9, 0x04, 0x2d, 0x3d, 0xf5, 0x7a, 0xc6, 0x53, 0x59, 0x59,
+	0xe8, 0xb3, 0xc3, 0x56, 0x56, 0x0b, 0xa9, 0xc1, 0x11, 0xee, 0x71, 0xea, 0x18, 0xdf, 0x8d, 0x01,
+	0x0f, 0x0b, 0x61, 0x8b, 0x29, 0x2b, 0xce, 0xfe, 0xf5, 0x28, 0xec, 0x17, 0xc7, 0x24, 0xcf, 0xa0,
+	0x5f, 0x4e, 0x68, 0x48, 0x16, 0x77, 0x76, 0x0c, 0xa4, 0x9e, 0xea, 0x4d, 0x24, 0x0d, 0xa4, 0x9d,
+	0xff, 0xc9, 0xbf, 0xbe, 0xf9, 0xf9, 0xde, 0x53, 0x44, 0xab, 0xae, 0x08, 0x6a, 0x87, 0xd6, 0x82,
+	0x6a, 0xfe, 0x08, 0x94, 0x7c, 0xa6, 0x00, 0x74, 0xea, 0x19, 0x39, 0x9f, 0xaf, 0x20, 0x6f, 0x50,
+	0xa4, 0xce, 0x94, 0xa2, 0x45, 0x4c, 0x57, 0x04, 0xa6, 0x37, 0xc8, 0x2c, 0x62, 0xba, 0xf8, 0x41,
+	0x1e, 0xa8, 0x4e, 0xfd, 0xad, 0x6e, 0x46, 0xf7, 0x63, 0x8b, 0xfc, 0x52, 0x81, 0x81, 0x68, 0xd6,
+	0x41, 0xa6, 0x0b, 0xb5, 0x76, 0x0d, 0x6a, 0xd4, 0x73, 0x25, 0x28, 0x11, 0xdd, 0xbc, 0x40, 0x37,
+	0x47, 0x2e, 0xf7, 0x44, 0x17, 0x4f, 0x64, 0x92, 0xe0, 0x7e, 0xa6, 0xc0, 0x60, 0x24, 0x6f, 0xc1,
+	0x71, 0x8a, 0xf0, 0x65, 0x07, 0x49, 0xea, 0xb9, 0x12, 0x94, 0x88, 0xaf, 0x22, 0xf0, 0x4d, 0x93,
+	0x33, 0xe5, 0xf0, 0x91, 0xdf, 0x2a, 0x30, 0x9c, 0x1a, 0xc1, 0x14, 0x39, 0x36, 0x6f, 0xb0, 0xa3,
+	0xce, 0x94, 0xa2, 0xdd, 0x91, 0x63, 0x9b, 0x82, 0x37, 0xca, 0x5b, 0xd5, 0xcd, 0x70, 0x58, 0xb4,
+	0x45, 0x7e, 0xa1, 0xc0, 0xf1, 0x5e, 0x93, 0x57, 0x32, 0x9f, 0x8f, 0xa4, 0xc4, 0xbc, 0x58, 0xbd,
+	0xb2, 0x1b, 0x56, 0xcc, 0x30, 0x7f, 0x54, 0x60, 0x28, 0x39, 0x7b, 0x21, 0x17, 0x0a, 0x43, 0x29,
+	0x67, 0xfe, 0xa3, 0x5e, 0x2c, 0x49, 0x8d, 0x16, 0xbc, 0x2d, 0x2c, 0x78, 0x93, 0x5c, 0xef, 0x69,
+	0xc1, 0xd4, 0xc4, 0xa8, 0xba, 0xd9, 0xdd, 0x17, 0x6c, 0x91, 0xdf, 0x28, 0x30, 0x92, 0x94, 0x1f,
+	0x06, 0xe3, 0x85, 0xc2, 0x10, 0xdb, 0x01, 0xee, 0x82, 0x31, 0x96, 0x36, 0x2b, 0x70, 0x5f, 0x20,
+	0xe7, 0xcb, 0xe3, 0x26, 0xff, 0x50, 0x80, 0x64, 0x87, 0x49, 0x64, 0xb6, 0xd0, 0x62, 0x85, 0x63,
+	0x2d, 0x75, 0x6e, 0x47, 0x3c, 0x88, 0x79, 0x59, 0x60, 0x7e, 0x9f, 0xdc, 0xeb, 0x89, 0x59, 0x74,
+	0x38, 0x2d, 0x21, 0xc1, 0x88, 0x86, 0x59, 0xd5, 0x4d, 0x1c, 0x99, 0x85, 0xb7, 0xbe, 0xba, 0x89,
+	0x23, 0xb3, 0x2d, 0xf2, 0xb9, 0x02, 0xa3, 0xd9, 0xf9, 0xd6, 0xd9, 0x02, 0x53, 0x76, 0x13, 0xaa,
+	0xd5, 0x92, 0x84, 0x3b, 0x4c, 0x55, 0x9d, 0xc1, 0x58, 0x75, 0x13, 0x2f, 0xdd, 0x16, 0xf9, 0xbb,
+	0x02, 0x47, 0x72, 0x47, 0x4f, 0x45, 0xf6, 0xef, 0x35, 0x09, 0x53, 0xe7, 0x76, 0xc4, 0x83, 0xe8,
+	0xef, 0x0a, 0xf4, 0x0b, 0xe4, 0x66, 0x4f, 0xf4, 0xdd, 0xe3, 0xaf, 0x86, 0x94, 0x92, 0x4c, 0xbb,
+	0xbf, 0x56, 0x60, 0x38, 0x35, 0x1c, 0x2a, 0xca, 0x70, 0x79, 0xf3, 0x25, 0x75, 0xa6, 0x14, 0x2d,
+	0x62, 0x9e, 0x13, 0x98, 0x2f, 0x92, 0x99, 0x9e, 0x98, 0xd3, 0x03, 0x29, 0xf2, 0x07, 0x05, 0x48,
+	0x76, 0x9e, 0x43, 0x0a, 0xdc, 0x5d, 0x38, 0x36, 0x52, 0x2f, 0x95, 0x67, 0x40, 0xb8, 0xef, 0x08,
+	0xb8, 0xb3, 0xe4, 0xd2, 0x36, 0x01, 0x92, 0x99, 0x26, 0x91, 0x2f, 0x14, 0x38, 0xd4, 0x3d, 0xca,
+	0x21, 0x05, 0x49, 0xa1, 0x60, 0x5c, 0xa4, 0x56, 0xca, 0x92, 0x23, 0xda, 0x05, 0x81, 0xf6, 0x2a,
+	0x99, 0x2f, 0xd7, 0x17, 0xe0, 0xbc, 0x32, 0xd5, 0x1e, 0x7c, 0xae, 0xc0, 0x70, 0x6a, 0xca, 0x52,
+	0x14, 0x0a, 0x79, 0xe3, 0x1e, 0x75, 0xa6, 0x14, 0x2d, 0xa2, 0xbd, 0x21, 0xd0, 0xbe, 0x43, 0xde,
+	0xda, 0x26, 0x7c, 0x91, 0xd7, 0x08, 0x67, 0x4e, 0x49, 0xa8, 0xbf, 0x53, 0xe0, 0x60, 0x7a, 0x1e,
+	0x42, 0x0a, 0xf4, 0xe7, 0xce, 0x5b, 0xd4, 0x0b, 0xe5, 0x88, 0x11, 0xed, 0x4d, 0x81, 0x76, 0x9e,
+	0xbc, 0xdd, 0x13, 0x2d, 0xbe, 0xf5, 0x5b, 0xc8, 0x9d, 0x84, 0xfb, 0x67, 0x05, 0x46, 0x33, 0x8f,
+	0x38, 0x52, 0xe0, 0xe2, 0xa2, 0x57, 0xb0, 0x5a, 0x2d, 0x4d, 0x8f, 0xb8, 0xdf, 0x13, 0xb8, 0xaf,
+	0x93, 0xab, 0xdb, 0x27, 0xe9, 0xf4, 0x2b, 0x32, 0x89, 0xfd, 0x57, 0x0a, 0x40, 0xe7, 0x75, 0x57,
+	0x94, 0x90, 0x33, 0x8f, 0x46, 0x75, 0x7a, 0x7b, 0x42, 0x84, 0x79, 0x55, 0xc0, 0x7c, 0x93, 0xcc,
+	0xf5, 0x84, 0x99, 0x78, 0x4b, 0x26, 0xe1, 0xfd, 0x45, 0x81, 0xc3, 0x39, 0x8f, 0x1c, 0x52, 0x70,
+	0xdf, 0x8b, 0x9f, 0x92, 0xea, 0xe5, 0x1d, 0x70, 0x20, 0xf2, 0x45, 0x81, 0xfc, 0x06, 0xb9, 0xd6,
+	0x13, 0x79, 0xde, 0x23, 0x2b, 0x71, 0x84, 0x5b, 0xdf, 0xfb, 0xf2, 0xc5, 0xa4, 0xf2, 0xd5, 0x8b,
+	0x49, 0xe5, 0xeb, 0x17, 0x93, 0xca, 0xa7, 0x2f, 0x27, 0xf7, 0x7c, 0xf5, 0x72, 0x72, 0xcf, 0xbf,
+	0x5f, 0x4e, 0xee, 0xf9, 0xf8, 0x72, 0xe2, 0x71, 0x94, 0xa3, 0x61, 0x6d, 0xbe, 0xba, 0xde, 0x51,
+	0x23, 0xde, 0x4a, 0xb5, 0x7e, 0xf1, 0x1f, 0x01, 0x73, 0xff, 0x1f, 0x00, 0xf8, 0x13, 0x40, 0x56,
+	0x39, 0x22, 0x00, 0x00,
 }
 
-func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a Validator by host zone.
+	Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error)
+	// Queries a HostZone by id.
+	HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error)
+	// Queries a list of HostZone items.
+	HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error)
+	// Queries a list of ModuleAddress items.
+	ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error)
+	// QueryInterchainAccountFromAddress returns the interchain account for given
+	// owner address on a given connection pair
+	InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error)
+	// Queries a EpochTracker by index.
+	EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error)
+	// Queries a list of EpochTracker items.
+	EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error)
+	// Queries the next packet sequence for one for a given channel
+	NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error)
+	// Queries an address's unbondings
+	AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error)
+	// Queries a host zone's redemption rate history and the time-weighted
+	// redemption rate over the last N stride epochs
+	RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error)
+	// Queries the protocol revenue fee recipients and the cumulative revenue
+	// distributed to each
+	FeeRecipients(ctx context.Context, in *QueryFeeRecipientsRequest, opts ...grpc.CallOption) (*QueryFeeRecipientsResponse, error)
+	// Queries validator delegation discrepancies that are awaiting confirmation
+	// or have been quarantined, optionally filtered by host zone
+	UnconfirmedSlashes(ctx context.Context, in *QueryUnconfirmedSlashesRequest, opts ...grpc.CallOption) (*QueryUnconfirmedSlashesResponse, error)
+	// Queries a host zone's validator weight policy and explains how each
+	// validator's weight is derived from it
+	ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error)
+	// Queries the redelegations that would be submitted to rebalance a host
+	// zone's validators, taking into account the redelegations still pending on
+	// the host
+	RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error)
+	// Queries the progress of a host zone's sunset
+	SunsetProgress(ctx context.Context, in *QuerySunsetProgressRequest, opts ...grpc.CallOption) (*QuerySunsetProgressResponse, error)
+	// Queries the next scheduled run of each epochly task for a host zone
+	NextScheduledRuns(ctx context.Context, in *QueryNextScheduledRunsRequest, opts ...grpc.CallOption) (*QueryNextScheduledRunsResponse, error)
+	// Queries a host zone's ICA operations that are queued for a retry
+	// (including those in the dead letter state)
+	ICARetries(ctx context.Context, in *QueryICARetriesRequest, opts ...grpc.CallOption) (*QueryICARetriesResponse, error)
+	// Queries the liquid staked amounts that users directed to each of a host
+	// zone's validators
+	DirectedDelegations(ctx context.Context, in *QueryDirectedDelegationsRequest, opts ...grpc.CallOption) (*QueryDirectedDelegationsResponse, error)
 }
 
-func (m *QueryAllEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EpochTracker) > 0 {
-		for iNdEx := len(m.EpochTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EpochTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetNextPacketSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Validators(ctx context.Context, in *QueryGetValidatorsRequest, opts ...grpc.CallOption) (*QueryGetValidatorsResponse, error) {
+	out := new(QueryGetValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/Validators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetNextPacketSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) HostZone(ctx context.Context, in *QueryGetHostZoneRequest, opts ...grpc.CallOption) (*QueryGetHostZoneResponse, error) {
+	out := new(QueryGetHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZone", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) HostZoneAll(ctx context.Context, in *QueryAllHostZoneRequest, opts ...grpc.CallOption) (*QueryAllHostZoneResponse, error) {
+	out := new(QueryAllHostZoneResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/HostZoneAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ModuleAddress(ctx context.Context, in *QueryModuleAddressRequest, opts ...grpc.CallOption) (*QueryModuleAddressResponse, error) {
+	out := new(QueryModuleAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ModuleAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetNextPacketSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetNextPacketSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) InterchainAccountFromAddress(ctx context.Context, in *QueryInterchainAccountFromAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountFromAddressResponse, error) {
+	out := new(QueryInterchainAccountFromAddressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/InterchainAccountFromAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochTracker(ctx context.Context, in *QueryGetEpochTrackerRequest, opts ...grpc.CallOption) (*QueryGetEpochTrackerResponse, error) {
+	out := new(QueryGetEpochTrackerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTracker", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAddressUnbondings) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAddressUnbondings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EpochTrackerAll(ctx context.Context, in *QueryAllEpochTrackerRequest, opts ...grpc.CallOption) (*QueryAllEpochTrackerResponse, error) {
+	out := new(QueryAllEpochTrackerResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/EpochTrackerAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NextPacketSequence(ctx context.Context, in *QueryGetNextPacketSequenceRequest, opts ...grpc.CallOption) (*QueryGetNextPacketSequenceResponse, error) {
+	out := new(QueryGetNextPacketSequenceResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextPacketSequence", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAddressUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AddressUnbondings) > 0 {
-		for iNdEx := len(m.AddressUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AddressUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) AddressUnbondings(ctx context.Context, in *QueryAddressUnbondings, opts ...grpc.CallOption) (*QueryAddressUnbondingsResponse, error) {
+	out := new(QueryAddressUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/AddressUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RedemptionRateHistory(ctx context.Context, in *QueryRedemptionRateHistoryRequest, opts ...grpc.CallOption) (*QueryRedemptionRateHistoryResponse, error) {
+	out := new(QueryRedemptionRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RedemptionRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InterchainAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) FeeRecipients(ctx context.Context, in *QueryFeeRecipientsRequest, opts ...grpc.CallOption) (*QueryFeeRecipientsResponse, error) {
+	out := new(QueryFeeRecipientsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/FeeRecipients", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) UnconfirmedSlashes(ctx context.Context, in *QueryUnconfirmedSlashesRequest, opts ...grpc.CallOption) (*QueryUnconfirmedSlashesResponse, error) {
+	out := new(QueryUnconfirmedSlashesResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/UnconfirmedSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidatorWeights(ctx context.Context, in *QueryValidatorWeightsRequest, opts ...grpc.CallOption) (*QueryValidatorWeightsResponse, error) {
+	out := new(QueryValidatorWeightsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ValidatorWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RebalancePlan(ctx context.Context, in *QueryRebalancePlanRequest, opts ...grpc.CallOption) (*QueryRebalancePlanResponse, error) {
+	out := new(QueryRebalancePlanResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/RebalancePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) SunsetProgress(ctx context.Context, in *QuerySunsetProgressRequest, opts ...grpc.CallOption) (*QuerySunsetProgressResponse, error) {
+	out := new(QuerySunsetProgressResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/SunsetProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetHostZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) NextScheduledRuns(ctx context.Context, in *QueryNextScheduledRunsRequest, opts ...grpc.CallOption) (*QueryNextScheduledRunsResponse, error) {
+	out := new(QueryNextScheduledRunsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/NextScheduledRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ICARetries(ctx context.Context, in *QueryICARetriesRequest, opts ...grpc.CallOption) (*QueryICARetriesResponse, error) {
+	out := new(QueryICARetriesResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/ICARetries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.HostZone.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAllHostZoneRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) DirectedDelegations(ctx context.Context, in *QueryDirectedDelegationsRequest, opts ...grpc.CallOption) (*QueryDirectedDelegationsResponse, error) {
+	out := new(QueryDirectedDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/stride.stakeibc.Query/DirectedDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllHostZoneResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.HostZone) > 0 {
-		for _, e := range m.HostZone {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a Validator by host zone.
+	Validators(context.Context, *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error)
+	// Queries a HostZone by id.
+	HostZone(context.Context, *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error)
+	// Queries a list of HostZone items.
+	HostZoneAll(context.Context, *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error)
+	// Queries a list of ModuleAddress items.
+	ModuleAddress(context.Context, *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error)
+	// QueryInterchainAccountFromAddress returns the interchain account for given
+	// owner address on a given connection pair
+	InterchainAccountFromAddress(context.Context, *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error)
+	// Queries a EpochTracker by index.
+	EpochTracker(context.Context, *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error)
+	// Queries a list of EpochTracker items.
+	EpochTrackerAll(context.Context, *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error)
+	// Queries the next packet sequence for one for a given channel
+	NextPacketSequence(context.Context, *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error)
+	// Queries an address's unbondings
+	AddressUnbondings(context.Context, *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error)
+	// Queries a host zone's redemption rate history and the time-weighted
+	// redemption rate over the last N stride epochs
+	RedemptionRateHistory(context.Context, *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error)
+	// Queries the protocol revenue fee recipients and the cumulative revenue
+	// distributed to each
+	FeeRecipients(context.Context, *QueryFeeRecipientsRequest) (*QueryFeeRecipientsResponse, error)
+	// Queries validator delegation discrepancies that are awaiting confirmation
+	// or have been quarantined, optionally filtered by host zone
+	UnconfirmedSlashes(context.Context, *QueryUnconfirmedSlashesRequest) (*QueryUnconfirmedSlashesResponse, error)
+	// Queries a host zone's validator weight policy and explains how each
+	// validator's weight is derived from it
+	ValidatorWeights(context.Context, *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error)
+	// Queries the redelegations that would be submitted to rebalance a host
+	// zone's validators, taking into account the redelegations still pending on
+	// the host
+	RebalancePlan(context.Context, *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error)
+	// Queries the progress of a host zone's sunset
+	SunsetProgress(context.Context, *QuerySunsetProgressRequest) (*QuerySunsetProgressResponse, error)
+	// Queries the next scheduled run of each epochly task for a host zone
+	NextScheduledRuns(context.Context, *QueryNextScheduledRunsRequest) (*QueryNextScheduledRunsResponse, error)
+	// Queries a host zone's ICA operations that are queued for a retry
+	// (including those in the dead letter state)
+	ICARetries(context.Context, *QueryICARetriesRequest) (*QueryICARetriesResponse, error)
+	// Queries the liquid staked amounts that users directed to each of a host
+	// zone's validators
+	DirectedDelegations(context.Context, *QueryDirectedDelegationsRequest) (*QueryDirectedDelegationsResponse, error)
 }
 
-func (m *QueryModuleAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Validators(ctx context.Context, req *QueryGetValidatorsRequest) (*QueryGetValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validators not implemented")
+}
+func (*UnimplementedQueryServer) HostZone(ctx context.Context, req *QueryGetHostZoneRequest) (*QueryGetHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZone not implemented")
+}
+func (*UnimplementedQueryServer) HostZoneAll(ctx context.Context, req *QueryAllHostZoneRequest) (*QueryAllHostZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostZoneAll not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAddress(ctx context.Context, req *QueryModuleAddressRequest) (*QueryModuleAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAddress not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountFromAddress(ctx context.Context, req *QueryInterchainAccountFromAddressRequest) (*QueryInterchainAccountFromAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountFromAddress not implemented")
+}
+func (*UnimplementedQueryServer) EpochTracker(ctx context.Context, req *QueryGetEpochTrackerRequest) (*QueryGetEpochTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTracker not implemented")
+}
+func (*UnimplementedQueryServer) EpochTrackerAll(ctx context.Context, req *QueryAllEpochTrackerRequest) (*QueryAllEpochTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) NextPacketSequence(ctx context.Context, req *QueryGetNextPacketSequenceRequest) (*QueryGetNextPacketSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextPacketSequence not implemented")
+}
+func (*UnimplementedQueryServer) AddressUnbondings(ctx context.Context, req *QueryAddressUnbondings) (*QueryAddressUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionRateHistory(ctx context.Context, req *QueryRedemptionRateHistoryRequest) (*QueryRedemptionRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) FeeRecipients(ctx context.Context, req *QueryFeeRecipientsRequest) (*QueryFeeRecipientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipients not implemented")
+}
+func (*UnimplementedQueryServer) UnconfirmedSlashes(ctx context.Context, req *QueryUnconfirmedSlashesRequest) (*QueryUnconfirmedSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnconfirmedSlashes not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorWeights(ctx context.Context, req *QueryValidatorWeightsRequest) (*QueryValidatorWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorWeights not implemented")
+}
+func (*UnimplementedQueryServer) RebalancePlan(ctx context.Context, req *QueryRebalancePlanRequest) (*QueryRebalancePlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalancePlan not implemented")
+}
+func (*UnimplementedQueryServer) SunsetProgress(ctx context.Context, req *QuerySunsetProgressRequest) (*QuerySunsetProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SunsetProgress not implemented")
+}
+func (*UnimplementedQueryServer) NextScheduledRuns(ctx context.Context, req *QueryNextScheduledRunsRequest) (*QueryNextScheduledRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextScheduledRuns not implemented")
+}
+func (*UnimplementedQueryServer) ICARetries(ctx context.Context, req *QueryICARetriesRequest) (*QueryICARetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ICARetries not implemented")
+}
+func (*UnimplementedQueryServer) DirectedDelegations(ctx context.Context, req *QueryDirectedDelegationsRequest) (*QueryDirectedDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DirectedDelegations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryModuleAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Validators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Validators(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/Validators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Validators(ctx, req.(*QueryGetValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetEpochTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_HostZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.EpochIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).HostZone(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZone(ctx, req.(*QueryGetHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetEpochTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_HostZoneAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllHostZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EpochTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).HostZoneAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/HostZoneAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostZoneAll(ctx, req.(*QueryAllHostZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEpochTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ModuleAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/ModuleAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAddress(ctx, req.(*QueryModuleAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllEpochTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_InterchainAccountFromAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountFromAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.EpochTracker) > 0 {
-		for _, e := range m.EpochTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountFromAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/InterchainAccountFromAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountFromAddress(ctx, req.(*QueryInterchainAccountFromAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetEpochTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/EpochTracker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTracker(ctx, req.(*QueryGetEpochTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllEpochTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochTrackerAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/EpochTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochTrackerAll(ctx, req.(*QueryAllEpochTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextPacketSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNextPacketSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextPacketSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/NextPacketSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextPacketSequence(ctx, req.(*QueryGetNextPacketSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressUnbondings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/AddressUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressUnbondings(ctx, req.(*QueryAddressUnbondings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RedemptionRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionRateHistory(ctx, req.(*QueryRedemptionRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/FeeRecipients",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipients(ctx, req.(*QueryFeeRecipientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnconfirmedSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnconfirmedSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnconfirmedSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/UnconfirmedSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnconfirmedSlashes(ctx, req.(*QueryUnconfirmedSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/ValidatorWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorWeights(ctx, req.(*QueryValidatorWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RebalancePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalancePlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RebalancePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/RebalancePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RebalancePlan(ctx, req.(*QueryRebalancePlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SunsetProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySunsetProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SunsetProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/SunsetProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SunsetProgress(ctx, req.(*QuerySunsetProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextScheduledRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextScheduledRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextScheduledRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/NextScheduledRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextScheduledRuns(ctx, req.(*QueryNextScheduledRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ICARetries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryICARetriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ICARetries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/ICARetries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ICARetries(ctx, req.(*QueryICARetriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DirectedDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDirectedDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DirectedDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stride.stakeibc.Query/DirectedDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DirectedDelegations(ctx, req.(*QueryDirectedDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stride.stakeibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "HostZone",
+			Handler:    _Query_HostZone_Handler,
+		},
+		{
+			MethodName: "HostZoneAll",
+			Handler:    _Query_HostZoneAll_Handler,
+		},
+		{
+			MethodName: "ModuleAddress",
+			Handler:    _Query_ModuleAddress_Handler,
+		},
+		{
+			MethodName: "InterchainAccountFromAddress",
+			Handler:    _Query_InterchainAccountFromAddress_Handler,
+		},
+		{
+			MethodName: "EpochTracker",
+			Handler:    _Query_EpochTracker_Handler,
+		},
+		{
+			MethodName: "EpochTrackerAll",
+			Handler:    _Query_EpochTrackerAll_Handler,
+		},
+		{
+			MethodName: "NextPacketSequence",
+			Handler:    _Query_NextPacketSequence_Handler,
+		},
+		{
+			MethodName: "AddressUnbondings",
+			Handler:    _Query_AddressUnbondings_Handler,
+		},
+		{
+			MethodName: "RedemptionRateHistory",
+			Handler:    _Query_RedemptionRateHistory_Handler,
+		},
+		{
+			MethodName: "FeeRecipients",
+			Handler:    _Query_FeeRecipients_Handler,
+		},
+		{
+			MethodName: "UnconfirmedSlashes",
+			Handler:    _Query_UnconfirmedSlashes_Handler,
+		},
+		{
+			MethodName: "ValidatorWeights",
+			Handler:    _Query_ValidatorWeights_Handler,
+		},
+		{
+			MethodName: "RebalancePlan",
+			Handler:    _Query_RebalancePlan_Handler,
+		},
+		{
+			MethodName: "SunsetProgress",
+			Handler:    _Query_SunsetProgress_Handler,
+		},
+		{
+			MethodName: "NextScheduledRuns",
+			Handler:    _Query_NextScheduledRuns_Handler,
+		},
+		{
+			MethodName: "ICARetries",
+			Handler:    _Query_ICARetries_Handler,
+		},
+		{
+			MethodName: "DirectedDelegations",
+			Handler:    _Query_DirectedDelegations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stride/stakeibc/query.proto",
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountFromAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterchainAccountAddress) > 0 {
+		i -= len(m.InterchainAccountAddress)
+		copy(dAtA[i:], m.InterchainAccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostZone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostZoneRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostZoneRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostZoneRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllHostZoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllHostZoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllHostZoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HostZone) > 0 {
+		for iNdEx := len(m.HostZone) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostZone[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochTracker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochTrackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllEpochTrackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllEpochTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllEpochTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochTracker) > 0 {
+		for iNdEx := len(m.EpochTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNextPacketSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNextPacketSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNextPacketSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNextPacketSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNextPacketSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNextPacketSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressUnbondings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUnbondings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUnbondings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AddressUnbondings) > 0 {
+		for iNdEx := len(m.AddressUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TwapEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TimeWeightedRedemptionRate.Size()
+		i -= size
+		if _, err := m.TimeWeightedRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RedemptionRateRecords) > 0 {
+		for iNdEx := len(m.RedemptionRateRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionRateRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeRecipients) > 0 {
+		for iNdEx := len(m.FeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnconfirmedSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnconfirmedSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnconfirmedSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnconfirmedSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnconfirmedSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnconfirmedSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnconfirmedSlashes) > 0 {
+		for iNdEx := len(m.UnconfirmedSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnconfirmedSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Explanations) > 0 {
+		for iNdEx := len(m.Explanations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Explanations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRebalancings != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxRebalancings))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancePlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancePlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancePlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRedelegations) > 0 {
+		for iNdEx := len(m.PendingRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rebalancings) > 0 {
+		for iNdEx := len(m.Rebalancings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rebalancings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySunsetProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySunsetProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySunsetProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySunsetProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySunsetProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySunsetProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.StTokenSupply.Size()
+		i -= size
+		if _, err := m.StTokenSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ClaimableBalance.Size()
+		i -= size
+		if _, err := m.ClaimableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SunsetRedemptionRate.Size()
+		i -= size
+		if _, err := m.SunsetRedemptionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PendingDepositBalance.Size()
+		i -= size
+		if _, err := m.PendingDepositBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.UnbondingBalance.Size()
+		i -= size
+		if _, err := m.UnbondingBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NumValidatorsWithDelegations != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumValidatorsWithDelegations))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StakedBalance.Size()
+		i -= size
+		if _, err := m.StakedBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextScheduledRunsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextScheduledRunsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextScheduledRunsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRunTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRunTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextEpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		i -= len(m.Task)
+		copy(dAtA[i:], m.Task)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Task)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextScheduledRunsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextScheduledRunsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextScheduledRunsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledRuns) > 0 {
+		for iNdEx := len(m.ScheduledRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryICARetriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICARetriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICARetriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryICARetriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryICARetriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryICARetriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IcaRetries) > 0 {
+		for iNdEx := len(m.IcaRetries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaRetries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDirectedDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDirectedDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDirectedDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DirectedDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DirectedDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DirectedDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UndelegatedAmount.Size()
+		i -= size
+		if _, err := m.UndelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DirectedAmount.Size()
+		i -= size
+		if _, err := m.DirectedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDirectedDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDirectedDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDirectedDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalDirectedAmount.Size()
+		i -= size
+		if _, err := m.TotalDirectedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DirectedDelegations) > 0 {
+		for iNdEx := len(m.DirectedDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DirectedDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInterchainAccountFromAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountFromAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InterchainAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostZone.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllHostZoneRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllHostZoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostZone) > 0 {
+		for _, e := range m.HostZone {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetEpochTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllEpochTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllEpochTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochTracker) > 0 {
+		for _, e := range m.EpochTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetNextPacketSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNextPacketSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryAddressUnbondings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AddressUnbondings) > 0 {
+		for _, e := range m.AddressUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TwapEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.TwapEpochs))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RedemptionRateRecords) > 0 {
+		for _, e := range m.RedemptionRateRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TimeWeightedRedemptionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeRecipientsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeRecipientsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeRecipients) > 0 {
+		for _, e := range m.FeeRecipients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnconfirmedSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnconfirmedSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnconfirmedSlashes) > 0 {
+		for _, e := range m.UnconfirmedSlashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Explanations) > 0 {
+		for _, e := range m.Explanations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRebalancePlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxRebalancings != 0 {
+		n += 1 + sovQuery(uint64(m.MaxRebalancings))
+	}
+	return n
+}
+
+func (m *QueryRebalancePlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rebalancings) > 0 {
+		for _, e := range m.Rebalancings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingRedelegations) > 0 {
+		for _, e := range m.PendingRedelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySunsetProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySunsetProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.StakedBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NumValidatorsWithDelegations != 0 {
+		n += 1 + sovQuery(uint64(m.NumValidatorsWithDelegations))
+	}
+	l = m.UnbondingBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingDepositBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SunsetRedemptionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClaimableBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StTokenSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNextScheduledRunsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Task)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.Overridden {
+		n += 2
+	}
+	if m.NextEpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochNumber))
+	}
+	if m.NextRunTime != 0 {
+		n += 1 + sovQuery(uint64(m.NextRunTime))
+	}
+	return n
+}
+
+func (m *QueryNextScheduledRunsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledRuns) > 0 {
+		for _, e := range m.ScheduledRuns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryICARetriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryICARetriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IcaRetries) > 0 {
+		for _, e := range m.IcaRetries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDirectedDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DirectedDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.DirectedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UndelegatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDirectedDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DirectedDelegations) > 0 {
+		for _, e := range m.DirectedDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalDirectedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostZone = append(m.HostZone, HostZone{})
+			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
+			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressUnbondings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressUnbondings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressUnbondings = append(m.AddressUnbondings, AddressUnbonding{})
+			if err := m.AddressUnbondings[len(m.AddressUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapEpochs", wireType)
+			}
+			m.TwapEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRedemptionRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRedemptionRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionRateRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionRateRecords = append(m.RedemptionRateRecords, RedemptionRateRecord{})
+			if err := m.RedemptionRateRecords[len(m.RedemptionRateRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWeightedRedemptionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeWeightedRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRecipientsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRecipientsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRecipientsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRecipientsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRecipientsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRecipientsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipients = append(m.FeeRecipients, FeeRecipient{})
+			if err := m.FeeRecipients[len(m.FeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, FeeRecipientRevenue{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnconfirmedSlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnconfirmedSlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnconfirmedSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryGetNextPacketSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetNextPacketSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
-	}
-	return n
-}
 
-func (m *QueryAddressUnbondings) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAddressUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AddressUnbondings) > 0 {
-		for _, e := range m.AddressUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryUnconfirmedSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnconfirmedSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnconfirmedSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnconfirmedSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnconfirmedSlashes = append(m.UnconfirmedSlashes, UnconfirmedSlash{})
+			if err := m.UnconfirmedSlashes[len(m.UnconfirmedSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorWeightsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2416,15 +7260,15 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorWeightsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2445,20 +7289,106 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.Policy == nil {
+				m.Policy = &ValidatorWeightPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Explanations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2468,23 +7398,25 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Explanations = append(m.Explanations, ValidatorWeightExplanation{})
+			if err := m.Explanations[len(m.Explanations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2507,7 +7439,7 @@ func (m *QueryInterchainAccountFromAddressRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlanRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2530,15 +7462,15 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountFromAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2566,8 +7498,27 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRebalancings", wireType)
+			}
+			m.MaxRebalancings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRebalancings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2589,7 +7540,7 @@ func (m *QueryInterchainAccountFromAddressResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancePlanResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2612,65 +7563,49 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancePlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebalancings", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Rebalancings = append(m.Rebalancings, Rebalancing{})
+			if err := m.Rebalancings[len(m.Rebalancings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRedelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2697,7 +7632,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingRedelegations = append(m.PendingRedelegations, PendingRedelegation{})
+			if err := m.PendingRedelegations[len(m.PendingRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2722,7 +7658,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySunsetProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,10 +7681,10 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySunsetProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySunsetProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2804,7 +7740,7 @@ func (m *QueryGetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySunsetProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,17 +7763,36 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySunsetProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySunsetProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SunsetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2847,79 +7802,116 @@ func (m *QueryGetValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorsWithDelegations", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.NumValidatorsWithDelegations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidatorsWithDelegations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.UnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDepositBalance", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingDepositBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetRedemptionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2947,63 +7939,49 @@ func (m *QueryGetHostZoneRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.SunsetRedemptionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableBalance", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ClaimableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StTokenSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3013,22 +7991,23 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostZone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3053,7 +8032,7 @@ func (m *QueryGetHostZoneResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextScheduledRunsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3076,17 +8055,17 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextScheduledRunsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextScheduledRunsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3096,27 +8075,23 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3139,7 +8114,7 @@ func (m *QueryAllHostZoneRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduledRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3162,17 +8137,17 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllHostZoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostZone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3182,31 +8157,29 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostZone = append(m.HostZone, HostZone{})
-			if err := m.HostZone[len(m.HostZone)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Task = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3216,83 +8189,48 @@ func (m *QueryAllHostZoneResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3302,24 +8240,50 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Overridden = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochNumber", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.NextEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunTime", wireType)
+			}
+			m.NextRunTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3341,7 +8305,7 @@ func (m *QueryModuleAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextScheduledRunsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3364,17 +8328,17 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextScheduledRunsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextScheduledRunsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledRuns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3384,23 +8348,25 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.ScheduledRuns = append(m.ScheduledRuns, ScheduledRun{})
+			if err := m.ScheduledRuns[len(m.ScheduledRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3423,7 +8389,7 @@ func (m *QueryModuleAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryICARetriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3446,15 +8412,15 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryICARetriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryICARetriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3482,7 +8448,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3505,7 +8471,7 @@ func (m *QueryGetEpochTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryICARetriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3528,15 +8494,15 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryICARetriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryICARetriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaRetries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3563,7 +8529,8 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EpochTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IcaRetries = append(m.IcaRetries, ICARetry{})
+			if err := m.IcaRetries[len(m.IcaRetries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3588,57 +8555,7 @@ func (m *QueryGetEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEpochTrackerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDirectedDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3661,17 +8578,17 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDirectedDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEpochTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDirectedDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3681,25 +8598,23 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochTracker = append(m.EpochTracker, EpochTracker{})
-			if err := m.EpochTracker[len(m.EpochTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3722,7 +8637,7 @@ func (m *QueryAllEpochTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
+func (m *DirectedDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3745,15 +8660,15 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectedDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectedDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3781,11 +8696,11 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3813,63 +8728,15 @@ func (m *QueryGetNextPacketSequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.DirectedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextPacketSequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatedAmount", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3879,11 +8746,26 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UndelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3905,7 +8787,7 @@ func (m *QueryGetNextPacketSequenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
+func (m *QueryDirectedDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3928,17 +8810,17 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressUnbondings: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDirectedDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressUnbondings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDirectedDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectedDelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3948,79 +8830,31 @@ func (m *QueryAddressUnbondings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.DirectedDelegations = append(m.DirectedDelegations, DirectedDelegation{})
+			if err := m.DirectedDelegations[len(m.DirectedDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDirectedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4030,23 +8864,23 @@ func (m *QueryAddressUnbondingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressUnbondings = append(m.AddressUnbondings, AddressUnbonding{})
-			if err := m.AddressUnbondings[len(m.AddressUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalDirectedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
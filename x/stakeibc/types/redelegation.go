@@ -0,0 +1,5 @@
+package types
+
+// The maximum number of pending redelegation entries between a source and destination validator
+// on the host, as enforced by the host's staking module (the default MaxEntries staking param)
+const MaxPendingRedelegationEntries = 7
@@ -0,0 +1,519 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/redelegation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// A redelegation from the delegation ICA that is still maturing on the host
+// zone. While it's pending, the destination validator cannot be used as the
+// source of another redelegation, and each source/destination pair is limited
+// to a fixed number of pending entries. Records are keyed by chain ID, source
+// validator, destination validator and completion time
+type PendingRedelegation struct {
+	ChainId      string                                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SrcValidator string                                 `protobuf:"bytes,2,opt,name=src_validator,json=srcValidator,proto3" json:"src_validator,omitempty"`
+	DstValidator string                                 `protobuf:"bytes,3,opt,name=dst_validator,json=dstValidator,proto3" json:"dst_validator,omitempty"`
+	Amount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// the time (unix nano) at which the redelegation matures on the host,
+	// returned in the ICA acknowledgement (0 while the redelegation ICA is still
+	// awaiting its acknowledgement)
+	CompletionTime uint64 `protobuf:"varint,5,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+}
+
+func (m *PendingRedelegation) Reset()         { *m = PendingRedelegation{} }
+func (m *PendingRedelegation) String() string { return proto.CompactTextString(m) }
+func (*PendingRedelegation) ProtoMessage()    {}
+func (*PendingRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fae44a3c542d481, []int{0}
+}
+func (m *PendingRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRedelegation.Merge(m, src)
+}
+func (m *PendingRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRedelegation proto.InternalMessageInfo
+
+func (m *PendingRedelegation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *PendingRedelegation) GetSrcValidator() string {
+	if m != nil {
+		return m.SrcValidator
+	}
+	return ""
+}
+
+func (m *PendingRedelegation) GetDstValidator() string {
+	if m != nil {
+		return m.DstValidator
+	}
+	return ""
+}
+
+func (m *PendingRedelegation) GetCompletionTime() uint64 {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingRedelegation)(nil), "stride.stakeibc.PendingRedelegation")
+}
+
+func init() {
+	proto.RegisterFile("stride/stakeibc/redelegation.proto", fileDescriptor_5fae44a3c542d481)
+}
+
+var fileDescriptor_5fae44a3c542d481 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4f, 0x32, 0x31,
+	0x18, 0xc7, 0xaf, 0xef, 0x8b, 0xa8, 0x8d, 0x4a, 0x72, 0x3a, 0x9c, 0x0e, 0x85, 0x60, 0xa2, 0x2c,
+	0x5c, 0x63, 0x9c, 0x5c, 0x19, 0x4c, 0x88, 0x0e, 0xe6, 0x34, 0x0e, 0x2e, 0xa4, 0xd7, 0x36, 0x47,
+	0x03, 0x6d, 0x49, 0xfb, 0x40, 0xf4, 0x5b, 0xf8, 0xb1, 0x18, 0x19, 0x8d, 0x03, 0x31, 0x30, 0xfa,
+	0x25, 0xcc, 0x15, 0x08, 0x4c, 0x6d, 0x9f, 0xff, 0xaf, 0xed, 0x3f, 0x3f, 0xdc, 0xf4, 0xe0, 0x94,
+	0x90, 0xd4, 0x03, 0x1b, 0x48, 0x95, 0x73, 0xea, 0xa4, 0x90, 0x43, 0x59, 0x30, 0x50, 0xd6, 0xa4,
+	0x23, 0x67, 0xc1, 0xc6, 0xb5, 0x15, 0x93, 0x6e, 0x98, 0x8b, 0xb3, 0xc2, 0x16, 0x36, 0x64, 0xb4,
+	0xdc, 0xad, 0xb0, 0xe6, 0x2f, 0xc2, 0xa7, 0x4f, 0xd2, 0x08, 0x65, 0x8a, 0x6c, 0xe7, 0x91, 0xf8,
+	0x1c, 0x1f, 0xf0, 0x3e, 0x53, 0xa6, 0xa7, 0x44, 0x82, 0x1a, 0xa8, 0x75, 0x98, 0xed, 0x87, 0x73,
+	0x57, 0xc4, 0x97, 0xf8, 0xd8, 0x3b, 0xde, 0x9b, 0xb0, 0xa1, 0x12, 0x0c, 0xac, 0x4b, 0xfe, 0x85,
+	0xfc, 0xc8, 0x3b, 0xfe, 0xba, 0x99, 0x95, 0x90, 0xf0, 0xb0, 0x03, 0xfd, 0x5f, 0x41, 0xc2, 0xc3,
+	0x16, 0xba, 0xc7, 0x55, 0xa6, 0xed, 0xd8, 0x40, 0x52, 0x29, 0xd3, 0x4e, 0x3a, 0x9d, 0xd7, 0xa3,
+	0xef, 0x79, 0xfd, 0xaa, 0x50, 0xd0, 0x1f, 0xe7, 0x29, 0xb7, 0x9a, 0x72, 0xeb, 0xb5, 0xf5, 0xeb,
+	0xa5, 0xed, 0xc5, 0x80, 0xc2, 0xc7, 0x48, 0xfa, 0xb4, 0x6b, 0x20, 0x5b, 0xdf, 0x8e, 0xaf, 0x71,
+	0x8d, 0x5b, 0x3d, 0x1a, 0xca, 0xb2, 0x7a, 0x0f, 0x94, 0x96, 0xc9, 0x5e, 0x03, 0xb5, 0x2a, 0xd9,
+	0xc9, 0x76, 0xfc, 0xa2, 0xb4, 0xec, 0x3c, 0x4c, 0x17, 0x04, 0xcd, 0x16, 0x04, 0xfd, 0x2c, 0x08,
+	0xfa, 0x5c, 0x92, 0x68, 0xb6, 0x24, 0xd1, 0xd7, 0x92, 0x44, 0x6f, 0x37, 0x3b, 0x5f, 0x3e, 0x07,
+	0x73, 0xed, 0x47, 0x96, 0x7b, 0xba, 0x36, 0x3d, 0xb9, 0xa3, 0xef, 0x5b, 0xdd, 0xa1, 0x41, 0x5e,
+	0x0d, 0x06, 0x6f, 0xff, 0x06, 0x00, 0x82, 0xf4, 0xa7, 0x3d, 0x8e, 0x01, 0x00, 0x00,
+}
+
+func (m *PendingRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionTime != 0 {
+		i = encodeVarintRedelegation(dAtA, i, uint64(m.CompletionTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstValidator) > 0 {
+		i -= len(m.DstValidator)
+		copy(dAtA[i:], m.DstValidator)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.DstValidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidator) > 0 {
+		i -= len(m.SrcValidator)
+		copy(dAtA[i:], m.SrcValidator)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.SrcValidator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintRedelegation(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRedelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRedelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = len(m.SrcValidator)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = len(m.DstValidator)
+	if l > 0 {
+		n += 1 + l + sovRedelegation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRedelegation(uint64(l))
+	if m.CompletionTime != 0 {
+		n += 1 + sovRedelegation(uint64(m.CompletionTime))
+	}
+	return n
+}
+
+func sovRedelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRedelegation(x uint64) (n int) {
+	return sovRedelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			m.CompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRedelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRedelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRedelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRedelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRedelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRedelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRedelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRedelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRedelegation = fmt.Errorf("proto: unexpected end of group")
+)
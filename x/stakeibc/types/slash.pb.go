@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stride/stakeibc/slash.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type UnconfirmedSlash_Status int32
+
+const (
+	// a signing info ICQ has been submitted to confirm the slash
+	UnconfirmedSlash_PENDING_CONFIRMATION UnconfirmedSlash_Status = 0
+	// the slash could not be confirmed and the host zone's records were not
+	// updated
+	UnconfirmedSlash_QUARANTINED UnconfirmedSlash_Status = 1
+)
+
+var UnconfirmedSlash_Status_name = map[int32]string{
+	0: "PENDING_CONFIRMATION",
+	1: "QUARANTINED",
+}
+
+var UnconfirmedSlash_Status_value = map[string]int32{
+	"PENDING_CONFIRMATION": 0,
+	"QUARANTINED":          1,
+}
+
+func (x UnconfirmedSlash_Status) String() string {
+	return proto.EnumName(UnconfirmedSlash_Status_name, int32(x))
+}
+
+func (UnconfirmedSlash_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_23f1153cf1d8624d, []int{0, 0}
+}
+
+// A drop in a validator's delegation that has not yet been confirmed as a slash
+// on the host zone. Records are keyed by chain ID and validator address, and
+// are removed once the slash is confirmed against the validator's signing info
+type UnconfirmedSlash struct {
+	ChainId          string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// the validator's delegation in state when the discrepancy was detected
+	DelegationAmt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=delegation_amt,json=delegationAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amt"`
+	// the delegation returned from the delegator shares ICQ
+	QueriedDelegationAmt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=queried_delegation_amt,json=queriedDelegationAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"queried_delegation_amt"`
+	// the stride epoch and block time (unix nano) at which the discrepancy was
+	// detected
+	EpochNumber   uint64                  `protobuf:"varint,5,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	DetectionTime uint64                  `protobuf:"varint,6,opt,name=detection_time,json=detectionTime,proto3" json:"detection_time,omitempty"`
+	Status        UnconfirmedSlash_Status `protobuf:"varint,7,opt,name=status,proto3,enum=stride.stakeibc.UnconfirmedSlash_Status" json:"status,omitempty"`
+}
+
+func (m *UnconfirmedSlash) Reset()         { *m = UnconfirmedSlash{} }
+func (m *UnconfirmedSlash) String() string { return proto.CompactTextString(m) }
+func (*UnconfirmedSlash) ProtoMessage()    {}
+func (*UnconfirmedSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23f1153cf1d8624d, []int{0}
+}
+func (m *UnconfirmedSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnconfirmedSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnconfirmedSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnconfirmedSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnconfirmedSlash.Merge(m, src)
+}
+func (m *UnconfirmedSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnconfirmedSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnconfirmedSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnconfirmedSlash proto.InternalMessageInfo
+
+func (m *UnconfirmedSlash) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *UnconfirmedSlash) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *UnconfirmedSlash) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *UnconfirmedSlash) GetDetectionTime() uint64 {
+	if m != nil {
+		return m.DetectionTime
+	}
+	return 0
+}
+
+func (m *UnconfirmedSlash) GetStatus() UnconfirmedSlash_Status {
+	if m != nil {
+		return m.Status
+	}
+	return UnconfirmedSlash_PENDING_CONFIRMATION
+}
+
+func init() {
+	proto.RegisterEnum("stride.stakeibc.UnconfirmedSlash_Status", UnconfirmedSlash_Status_name, UnconfirmedSlash_Status_value)
+	proto.RegisterType((*UnconfirmedSlash)(nil), "stride.stakeibc.UnconfirmedSlash")
+}
+
+func init() { proto.RegisterFile("stride/stakeibc/slash.proto", fileDescriptor_23f1153cf1d8624d) }
+
+var fileDescriptor_23f1153cf1d8624d = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x8d, 0xd9, 0xc8, 0xc0, 0x63, 0x5b, 0xb0, 0x2a, 0x14, 0x40, 0xca, 0xca, 0x24, 0x50, 0x24,
+	0x34, 0x47, 0xb0, 0x13, 0x37, 0x32, 0x3a, 0x50, 0x04, 0x64, 0x90, 0xb6, 0x17, 0x2e, 0x91, 0x13,
+	0x9b, 0xc4, 0x5a, 0x1d, 0x97, 0xd8, 0x99, 0xe0, 0x5f, 0xf0, 0xb3, 0x76, 0xdc, 0x71, 0xe2, 0x30,
+	0xa1, 0xf6, 0x8f, 0xa0, 0x38, 0x61, 0x83, 0x1e, 0x77, 0xb2, 0xfd, 0xde, 0xf3, 0xfb, 0xec, 0xef,
+	0x7b, 0xf0, 0xb1, 0xd2, 0x35, 0xa7, 0x2c, 0x50, 0x9a, 0x9c, 0x30, 0x9e, 0xe5, 0x81, 0x9a, 0x11,
+	0x55, 0xe2, 0x79, 0x2d, 0xb5, 0x44, 0x3b, 0x1d, 0x89, 0xff, 0x92, 0x8f, 0x06, 0x85, 0x2c, 0xa4,
+	0xe1, 0x82, 0x76, 0xd7, 0xc9, 0xf6, 0x2e, 0xd6, 0xa0, 0x33, 0xad, 0x72, 0x59, 0x7d, 0xe5, 0xb5,
+	0x60, 0x74, 0xdc, 0x3a, 0xa0, 0x87, 0xf0, 0x4e, 0x5e, 0x12, 0x5e, 0xa5, 0x9c, 0xba, 0x60, 0x08,
+	0xfc, 0xbb, 0xc9, 0x86, 0x39, 0x47, 0x14, 0x3d, 0x87, 0xf7, 0x4f, 0xc9, 0x8c, 0x53, 0xa2, 0x65,
+	0x9d, 0x12, 0x4a, 0x6b, 0xa6, 0x94, 0x7b, 0xcb, 0x68, 0x9c, 0x2b, 0x22, 0xec, 0x70, 0x34, 0x85,
+	0xdb, 0x94, 0xcd, 0x58, 0x41, 0x34, 0x97, 0x55, 0x4a, 0x84, 0x76, 0xd7, 0x5a, 0xe5, 0x21, 0x3e,
+	0xbb, 0xdc, 0xb5, 0x7e, 0x5d, 0xee, 0x3e, 0x2b, 0xb8, 0x2e, 0x9b, 0x0c, 0xe7, 0x52, 0x04, 0xb9,
+	0x54, 0x42, 0xaa, 0x7e, 0xd9, 0x57, 0xf4, 0x24, 0xd0, 0x3f, 0xe6, 0x4c, 0xe1, 0xa8, 0xd2, 0xc9,
+	0xd6, 0xb5, 0x4b, 0x28, 0x34, 0xa2, 0xf0, 0xc1, 0xb7, 0x86, 0xd5, 0x9c, 0xd1, 0x74, 0xc5, 0x7e,
+	0xfd, 0x46, 0xf6, 0x83, 0xde, 0x6d, 0xf4, 0x5f, 0x95, 0x27, 0xf0, 0x1e, 0x9b, 0xcb, 0xbc, 0x4c,
+	0xab, 0x46, 0x64, 0xac, 0x76, 0x6f, 0x0f, 0x81, 0xbf, 0x9e, 0x6c, 0x1a, 0x2c, 0x36, 0x10, 0x7a,
+	0xda, 0xfe, 0x4f, 0xb3, 0xdc, 0xd4, 0xd7, 0x5c, 0x30, 0xd7, 0x36, 0xa2, 0xad, 0x2b, 0x74, 0xc2,
+	0x05, 0x43, 0xaf, 0xa1, 0xad, 0x34, 0xd1, 0x8d, 0x72, 0x37, 0x86, 0xc0, 0xdf, 0x7e, 0xe9, 0xe3,
+	0x95, 0xd9, 0xe0, 0xd5, 0x09, 0xe0, 0xb1, 0xd1, 0x27, 0xfd, 0xbd, 0xbd, 0x03, 0x68, 0x77, 0x08,
+	0x72, 0xe1, 0xe0, 0xd3, 0x51, 0x3c, 0x8a, 0xe2, 0x77, 0xe9, 0x9b, 0xe3, 0xf8, 0x6d, 0x94, 0x7c,
+	0x0c, 0x27, 0xd1, 0x71, 0xec, 0x58, 0x68, 0x07, 0x6e, 0x7e, 0x9e, 0x86, 0x49, 0x18, 0x4f, 0xa2,
+	0xf8, 0x68, 0xe4, 0x80, 0xc3, 0xf7, 0x67, 0x0b, 0x0f, 0x9c, 0x2f, 0x3c, 0xf0, 0x7b, 0xe1, 0x81,
+	0x9f, 0x4b, 0xcf, 0x3a, 0x5f, 0x7a, 0xd6, 0xc5, 0xd2, 0xb3, 0xbe, 0xbc, 0xf8, 0xa7, 0x31, 0x63,
+	0xf3, 0x94, 0xfd, 0x0f, 0x24, 0x53, 0x41, 0x9f, 0xa7, 0xd3, 0x57, 0xc1, 0xf7, 0xeb, 0x50, 0x99,
+	0x3e, 0x65, 0xb6, 0x89, 0xcb, 0xc1, 0x9f, 0x01, 0x00, 0x12, 0xc8, 0x6b, 0x02, 0x74, 0x02, 0x00,
+	0x00,
+}
+
+func (m *UnconfirmedSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnconfirmedSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnconfirmedSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DetectionTime != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.DetectionTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintSlash(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.QueriedDelegationAmt.Size()
+		i -= size
+		if _, err := m.QueriedDelegationAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DelegationAmt.Size()
+		i -= size
+		if _, err := m.DelegationAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintSlash(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnconfirmedSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlash(uint64(l))
+	}
+	l = m.DelegationAmt.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	l = m.QueriedDelegationAmt.Size()
+	n += 1 + l + sovSlash(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovSlash(uint64(m.EpochNumber))
+	}
+	if m.DetectionTime != 0 {
+		n += 1 + sovSlash(uint64(m.DetectionTime))
+	}
+	if m.Status != 0 {
+		n += 1 + sovSlash(uint64(m.Status))
+	}
+	return n
+}
+
+func sovSlash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlash(x uint64) (n int) {
+	return sovSlash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnconfirmedSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnconfirmedSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnconfirmedSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueriedDelegationAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueriedDelegationAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectionTime", wireType)
+			}
+			m.DetectionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= UnconfirmedSlash_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlash = fmt.Errorf("proto: unexpected end of group")
+)
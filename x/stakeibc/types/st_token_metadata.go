@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Returns the default display name, symbol and exponent of a host zone's stToken, derived from the host denom
+// Host denoms with a micro ("u") or atto ("a") prefix are assumed to have 6 or 18 decimals respectively
+// e.g. uatom -> ("Stride Staked ATOM", "stATOM", 6)
+func GetDefaultStTokenMetadataFields(hostDenom string) (displayName string, symbol string, exponent uint32) {
+	unit := hostDenom
+	switch {
+	case strings.HasPrefix(hostDenom, "u") && len(hostDenom) > 1:
+		unit, exponent = hostDenom[1:], 6
+	case strings.HasPrefix(hostDenom, "a") && len(hostDenom) > 1:
+		unit, exponent = hostDenom[1:], 18
+	}
+	unit = strings.ToUpper(unit)
+
+	return fmt.Sprintf("Stride Staked %s", unit), "st" + unit, exponent
+}
+
+// Builds the bank metadata of a host zone's stToken
+// The stToken is the base denom, and the display unit is the lowercased symbol at the given exponent
+// e.g. stuatom with a display unit of statom (exponent 6)
+// If the exponent is zero, the base denom is also used as the display unit
+func NewStTokenMetadata(hostDenom, displayName, symbol string, exponent uint32) banktypes.Metadata {
+	stDenom := StAssetDenomFromHostZoneDenom(hostDenom)
+
+	denomUnits := []*banktypes.DenomUnit{{Denom: stDenom, Exponent: 0}}
+	display := stDenom
+	if exponent > 0 {
+		display = strings.ToLower(symbol)
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("Stride liquid staked %s", hostDenom),
+		DenomUnits:  denomUnits,
+		Base:        stDenom,
+		Display:     display,
+		Name:        displayName,
+		Symbol:      symbol,
+	}
+}
+
+// Returns the display name, symbol and exponent of a stToken's bank metadata (the inverse of NewStTokenMetadata)
+func GetStTokenMetadataFields(metadata banktypes.Metadata) (displayName string, symbol string, exponent uint32) {
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Denom == metadata.Display {
+			exponent = denomUnit.Exponent
+		}
+	}
+	return metadata.Name, metadata.Symbol, exponent
+}
+
+// Validates the stToken metadata fields specified in a message (empty fields are left unchanged or defaulted)
+// The symbol is also used (lowercased) as the denom of the display unit, so it must be a valid denom
+func ValidateStTokenMetadataFields(displayName, symbol string) error {
+	if displayName != "" && strings.TrimSpace(displayName) != displayName {
+		return errorsmod.Wrapf(ErrInvalidStTokenMetadata, "invalid display name (%s)", displayName)
+	}
+	if symbol != "" {
+		if err := sdk.ValidateDenom(strings.ToLower(symbol)); err != nil {
+			return errorsmod.Wrapf(ErrInvalidStTokenMetadata, "invalid symbol (%s): %s", symbol, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package types
+
+// The maximum number of validators that are fully unbonded in each day epoch while a host zone is
+// being sunset (this keeps each undelegation ICA tx small)
+const SunsetUnbondingBatchSize = 10
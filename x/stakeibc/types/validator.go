@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Returns false if the validator has been jailed or tombstoned on the host zone,
+// in which case it should not receive any new delegations
+func (v Validator) IsActive() bool {
+	return !v.Jailed && !v.Tombstoned
+}
+
+// Returns true if the validator has a non-zero weight, or had a non-zero weight before it was deactivated
+// (in which case the weight is restored if it's unjailed), so that it counts towards the max number of validators
+func (v Validator) HasWeight() bool {
+	return v.Weight > 0 || v.DeactivatedWeight > 0
+}
+
+// Returns the validator's weight for delegation targets, which is zero if the validator is not active
+func (v Validator) GetActiveWeight() uint64 {
+	if !v.IsActive() {
+		return 0
+	}
+	return v.Weight
+}
+
+// Returns the liquid staked amount directed to the validator (zero if it was never set)
+func (v Validator) GetDirectedDelegationAmt() sdkmath.Int {
+	if v.DirectedDelegationAmt.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return v.DirectedDelegationAmt
+}
+
+// Returns the portion of the directed amount that has not yet been delegated (zero if it was never set)
+func (v Validator) GetUndelegatedDirectedAmt() sdkmath.Int {
+	if v.UndelegatedDirectedAmt.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return v.UndelegatedDirectedAmt
+}
+
+// Converts the bond status of a validator queried from the host zone
+func BondStatusFromHost(status stakingtypes.BondStatus) Validator_BondStatus {
+	switch status {
+	case stakingtypes.Bonded:
+		return Validator_BONDED
+	case stakingtypes.Unbonding:
+		return Validator_UNBONDING
+	default:
+		return Validator_UNBONDED
+	}
+}
+
+// Confirms the commission and voting power thresholds of a weight policy are valid percentages
+func (p ValidatorWeightPolicy) Validate() error {
+	if p.MaxCommissionRate.IsNil() || p.MaxCommissionRate.IsNegative() || p.MaxCommissionRate.GT(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidValidatorWeightPolicy, "max commission rate must be between 0 and 1")
+	}
+	if p.MaxVotingPowerShare.IsNil() || !p.MaxVotingPowerShare.IsPositive() || p.MaxVotingPowerShare.GT(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidValidatorWeightPolicy, "max voting power share must be greater than 0 and at most 1")
+	}
+	return nil
+}
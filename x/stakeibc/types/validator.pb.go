@@ -25,6 +25,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// mirrors the bond status of the validator on the host zone
+type Validator_BondStatus int32
+
+const (
+	Validator_BONDED    Validator_BondStatus = 0
+	Validator_UNBONDING Validator_BondStatus = 1
+	Validator_UNBONDED  Validator_BondStatus = 2
+)
+
+var Validator_BondStatus_name = map[int32]string{
+	0: "BONDED",
+	1: "UNBONDING",
+	2: "UNBONDED",
+}
+
+var Validator_BondStatus_value = map[string]int32{
+	"BONDED":    0,
+	"UNBONDING": 1,
+	"UNBONDED":  2,
+}
+
+func (x Validator_BondStatus) String() string {
+	return proto.EnumName(Validator_BondStatus_name, int32(x))
+}
+
+func (Validator_BondStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{1, 0}
+}
+
 type ValidatorExchangeRate struct {
 	InternalTokensToSharesRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=internal_tokens_to_shares_rate,json=internalTokensToSharesRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"internal_tokens_to_shares_rate"`
 	EpochNumber                uint64                                 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
@@ -76,6 +105,33 @@ type Validator struct {
 	DelegationAmt        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=delegation_amt,json=delegationAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegation_amt"`
 	Weight               uint64                                 `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
 	InternalExchangeRate *ValidatorExchangeRate                 `protobuf:"bytes,7,opt,name=internal_exchange_rate,json=internalExchangeRate,proto3" json:"internal_exchange_rate,omitempty"`
+	// the validator's consensus address on the host zone, populated from the
+	// validator ICQ and used to query the validator's slashing signing info
+	ConsensusAddress string `protobuf:"bytes,8,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// the validator's bond status and jailed flag on the host, populated from
+	// the validator ICQ
+	Status Validator_BondStatus `protobuf:"varint,9,opt,name=status,proto3,enum=stride.stakeibc.Validator_BondStatus" json:"status,omitempty"`
+	Jailed bool                 `protobuf:"varint,10,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// set when the validator's signing info on the host shows it was tombstoned
+	Tombstoned bool `protobuf:"varint,11,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// the validator's commission rate and tokens on the host, populated from the
+	// validator ICQ (the commission rate is unset until the first query returns)
+	CommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
+	Tokens         github_com_cosmos_cosmos_sdk_types.Int  `protobuf:"bytes,13,opt,name=tokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tokens"`
+	// number of times the validator has been observed being jailed
+	JailedCount uint64 `protobuf:"varint,14,opt,name=jailed_count,json=jailedCount,proto3" json:"jailed_count,omitempty"`
+	// the validator's missed blocks in the host's signing window, populated from
+	// the signing info ICQ
+	MissedBlocksCounter uint64 `protobuf:"varint,15,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// total liquid staked amount that users directed to this validator, which is
+	// added on top of the validator's weight-based target delegation
+	DirectedDelegationAmt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=directed_delegation_amt,json=directedDelegationAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"directed_delegation_amt"`
+	// portion of the directed amount that has not yet been delegated, which is
+	// delegated to this validator ahead of the weight-based split
+	UndelegatedDirectedAmt github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=undelegated_directed_amt,json=undelegatedDirectedAmt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"undelegated_directed_amt"`
+	// the validator's weight before it was jailed or tombstoned (its weight is
+	// set to zero while it's inactive, and restored if it's unjailed)
+	DeactivatedWeight uint64 `protobuf:"varint,18,opt,name=deactivated_weight,json=deactivatedWeight,proto3" json:"deactivated_weight,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -139,43 +195,304 @@ func (m *Validator) GetInternalExchangeRate() *ValidatorExchangeRate {
 	return nil
 }
 
+func (m *Validator) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *Validator) GetStatus() Validator_BondStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Validator_BONDED
+}
+
+func (m *Validator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *Validator) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *Validator) GetJailedCount() uint64 {
+	if m != nil {
+		return m.JailedCount
+	}
+	return 0
+}
+
+func (m *Validator) GetMissedBlocksCounter() uint64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *Validator) GetDeactivatedWeight() uint64 {
+	if m != nil {
+		return m.DeactivatedWeight
+	}
+	return 0
+}
+
+// Policy for automatically deriving a host zone's validator weights from the
+// queried validator data. Weights are recomputed each day epoch
+type ValidatorWeightPolicy struct {
+	// validators with a commission rate above this are excluded
+	MaxCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate"`
+	// validators with a share of the host's total bonded tokens above this are
+	// excluded
+	MaxVotingPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_voting_power_share,json=maxVotingPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_voting_power_share"`
+	// validators that have been jailed more times than this are excluded
+	MaxJailedCount uint64 `protobuf:"varint,3,opt,name=max_jailed_count,json=maxJailedCount,proto3" json:"max_jailed_count,omitempty"`
+	// validators that have missed more blocks than this in the host's signing
+	// window are excluded
+	MaxMissedBlocks uint64 `protobuf:"varint,4,opt,name=max_missed_blocks,json=maxMissedBlocks,proto3" json:"max_missed_blocks,omitempty"`
+	// maximum change in a validator's weight per day epoch (0 for unbounded),
+	// excluded validators are always set to zero immediately
+	MaxWeightChange uint64 `protobuf:"varint,5,opt,name=max_weight_change,json=maxWeightChange,proto3" json:"max_weight_change,omitempty"`
+}
+
+func (m *ValidatorWeightPolicy) Reset()         { *m = ValidatorWeightPolicy{} }
+func (m *ValidatorWeightPolicy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeightPolicy) ProtoMessage()    {}
+func (*ValidatorWeightPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{2}
+}
+func (m *ValidatorWeightPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeightPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeightPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWeightPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeightPolicy.Merge(m, src)
+}
+func (m *ValidatorWeightPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeightPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeightPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeightPolicy proto.InternalMessageInfo
+
+func (m *ValidatorWeightPolicy) GetMaxJailedCount() uint64 {
+	if m != nil {
+		return m.MaxJailedCount
+	}
+	return 0
+}
+
+func (m *ValidatorWeightPolicy) GetMaxMissedBlocks() uint64 {
+	if m != nil {
+		return m.MaxMissedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorWeightPolicy) GetMaxWeightChange() uint64 {
+	if m != nil {
+		return m.MaxWeightChange
+	}
+	return 0
+}
+
+// Breakdown of how a validator's weight was derived from the weight policy
+type ValidatorWeightExplanation struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	CurrentWeight    uint64 `protobuf:"varint,2,opt,name=current_weight,json=currentWeight,proto3" json:"current_weight,omitempty"`
+	// the weight the validator is converging towards
+	TargetWeight uint64 `protobuf:"varint,3,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`
+	// the weight that will be applied at the next day epoch, after bounding
+	NextWeight uint64 `protobuf:"varint,4,opt,name=next_weight,json=nextWeight,proto3" json:"next_weight,omitempty"`
+	// unset if the validator's commission has not yet been queried
+	CommissionRate      *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate,omitempty"`
+	VotingPowerShare    github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=voting_power_share,json=votingPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power_share"`
+	JailedCount         uint64                                  `protobuf:"varint,7,opt,name=jailed_count,json=jailedCount,proto3" json:"jailed_count,omitempty"`
+	MissedBlocksCounter uint64                                  `protobuf:"varint,8,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	Excluded            bool                                    `protobuf:"varint,9,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	Reason              string                                  `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ValidatorWeightExplanation) Reset()         { *m = ValidatorWeightExplanation{} }
+func (m *ValidatorWeightExplanation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorWeightExplanation) ProtoMessage()    {}
+func (*ValidatorWeightExplanation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d2f32e16bd6ab8f, []int{3}
+}
+func (m *ValidatorWeightExplanation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorWeightExplanation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorWeightExplanation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorWeightExplanation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorWeightExplanation.Merge(m, src)
+}
+func (m *ValidatorWeightExplanation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorWeightExplanation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorWeightExplanation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorWeightExplanation proto.InternalMessageInfo
+
+func (m *ValidatorWeightExplanation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorWeightExplanation) GetCurrentWeight() uint64 {
+	if m != nil {
+		return m.CurrentWeight
+	}
+	return 0
+}
+
+func (m *ValidatorWeightExplanation) GetTargetWeight() uint64 {
+	if m != nil {
+		return m.TargetWeight
+	}
+	return 0
+}
+
+func (m *ValidatorWeightExplanation) GetNextWeight() uint64 {
+	if m != nil {
+		return m.NextWeight
+	}
+	return 0
+}
+
+func (m *ValidatorWeightExplanation) GetJailedCount() uint64 {
+	if m != nil {
+		return m.JailedCount
+	}
+	return 0
+}
+
+func (m *ValidatorWeightExplanation) GetMissedBlocksCounter() uint64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *ValidatorWeightExplanation) GetExcluded() bool {
+	if m != nil {
+		return m.Excluded
+	}
+	return false
+}
+
+func (m *ValidatorWeightExplanation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("stride.stakeibc.Validator_BondStatus", Validator_BondStatus_name, Validator_BondStatus_value)
 	proto.RegisterType((*ValidatorExchangeRate)(nil), "stride.stakeibc.ValidatorExchangeRate")
 	proto.RegisterType((*Validator)(nil), "stride.stakeibc.Validator")
+	proto.RegisterType((*ValidatorWeightPolicy)(nil), "stride.stakeibc.ValidatorWeightPolicy")
+	proto.RegisterType((*ValidatorWeightExplanation)(nil), "stride.stakeibc.ValidatorWeightExplanation")
 }
 
 func init() { proto.RegisterFile("stride/stakeibc/validator.proto", fileDescriptor_5d2f32e16bd6ab8f) }
 
 var fileDescriptor_5d2f32e16bd6ab8f = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbf, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0xcb, 0x35, 0x6d, 0x5c, 0x7e, 0x54, 0x56, 0xa8, 0x8e, 0x0c, 0x97, 0xd0, 0xa1, 0xca,
-	0x92, 0x3b, 0x51, 0x26, 0x24, 0x96, 0x46, 0x65, 0xa0, 0x20, 0x86, 0x4b, 0x61, 0x40, 0x48, 0x27,
-	0xdf, 0xdd, 0xd3, 0x9d, 0x95, 0x9c, 0x1d, 0xd9, 0xaf, 0xa5, 0x6c, 0xfc, 0x09, 0xfc, 0x21, 0x8c,
-	0x5d, 0xd9, 0x3b, 0x56, 0x9d, 0x10, 0x43, 0x85, 0x92, 0x7f, 0x04, 0xe1, 0xf3, 0xb5, 0x11, 0x62,
-	0xe9, 0x64, 0xfb, 0xf3, 0xe7, 0xf7, 0x7d, 0x9f, 0xdf, 0xa3, 0x7d, 0x83, 0x5a, 0xe4, 0x10, 0x19,
-	0xe4, 0x53, 0x10, 0x69, 0x16, 0x9d, 0xf2, 0x99, 0xc8, 0x39, 0x2a, 0x1d, 0xce, 0xb5, 0x42, 0xc5,
-	0x1e, 0xd5, 0x84, 0xb0, 0x21, 0xf4, 0x9e, 0x64, 0xca, 0x54, 0xca, 0x24, 0xf6, 0x3a, 0xaa, 0x0f,
-	0x35, 0xb7, 0xd7, 0x2d, 0x54, 0xa1, 0x6a, 0xfc, 0xef, 0xae, 0x46, 0x77, 0x7f, 0x10, 0xfa, 0xf8,
-	0x43, 0x53, 0xf5, 0xd5, 0x59, 0x56, 0x72, 0x59, 0x40, 0xcc, 0x11, 0xd8, 0x57, 0x42, 0x03, 0x21,
-	0x11, 0xb4, 0xe4, 0xb3, 0x04, 0xd5, 0x14, 0xa4, 0x49, 0x50, 0x25, 0xa6, 0xe4, 0x1a, 0x4c, 0xa2,
-	0x39, 0x82, 0x4f, 0x06, 0x64, 0xd8, 0x19, 0xbf, 0xbc, 0xb8, 0xee, 0xb7, 0x7e, 0x5d, 0xf7, 0xf7,
-	0x0a, 0x81, 0xe5, 0x49, 0x1a, 0x66, 0xaa, 0x72, 0xca, 0x6e, 0x19, 0x99, 0x7c, 0x1a, 0xe1, 0x97,
-	0x39, 0x98, 0xf0, 0x10, 0xb2, 0xab, 0xf3, 0x11, 0x75, 0xc6, 0x0e, 0x21, 0x8b, 0x7b, 0x8d, 0xc6,
-	0xb1, 0x95, 0x38, 0x56, 0x13, 0x2b, 0x60, 0x2d, 0x3c, 0xa5, 0xf7, 0x61, 0xae, 0xb2, 0x32, 0x91,
-	0x27, 0x55, 0x0a, 0xda, 0x5f, 0x1b, 0x90, 0xa1, 0x17, 0x6f, 0x59, 0xec, 0x9d, 0x85, 0x76, 0xbf,
-	0xaf, 0xd1, 0xce, 0x8d, 0x7f, 0xc6, 0xa8, 0x27, 0x79, 0xe5, 0x8c, 0xc5, 0x76, 0xcf, 0xf6, 0xe9,
-	0x06, 0xcf, 0x73, 0x0d, 0xc6, 0xd8, 0xf7, 0x9d, 0xb1, 0x7f, 0x75, 0x3e, 0xea, 0x3a, 0x07, 0x07,
-	0xf5, 0xcd, 0x04, 0xb5, 0x90, 0x45, 0xdc, 0x10, 0xd9, 0x7b, 0xfa, 0x30, 0x87, 0x19, 0x14, 0x1c,
-	0x85, 0x92, 0x09, 0xaf, 0xd0, 0x5f, 0xb7, 0x4f, 0xc3, 0x3b, 0x44, 0x7d, 0x2d, 0x31, 0x7e, 0x70,
-	0x5b, 0xe5, 0xa0, 0x42, 0xb6, 0x43, 0xdb, 0x9f, 0x41, 0x14, 0x25, 0xfa, 0x6d, 0x9b, 0xc4, 0x9d,
-	0xd8, 0x27, 0xba, 0x73, 0xf3, 0xd3, 0xe0, 0x7a, 0x50, 0xff, 0xf0, 0xc6, 0x80, 0x0c, 0xb7, 0xf6,
-	0xf7, 0xc2, 0x7f, 0xfa, 0x1c, 0xfe, 0xb7, 0x65, 0x71, 0xb7, 0xa9, 0xb2, 0x8a, 0x1e, 0x79, 0x9b,
-	0xf7, 0xb6, 0xbd, 0x23, 0x6f, 0xd3, 0xdb, 0x5e, 0x1f, 0xbf, 0xb9, 0x58, 0x04, 0xe4, 0x72, 0x11,
-	0x90, 0xdf, 0x8b, 0x80, 0x7c, 0x5b, 0x06, 0xad, 0xcb, 0x65, 0xd0, 0xfa, 0xb9, 0x0c, 0x5a, 0x1f,
-	0x9f, 0xad, 0x44, 0x9a, 0x58, 0xb5, 0xd1, 0x5b, 0x9e, 0x9a, 0xc8, 0x8d, 0xe0, 0xe9, 0x8b, 0xe8,
-	0xec, 0x76, 0x0e, 0x6d, 0xc2, 0xb4, 0x6d, 0x47, 0xe8, 0xf9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x0b, 0x6d, 0xbf, 0x65, 0xa7, 0x02, 0x00, 0x00,
+	// 940 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xa6, 0x8e, 0x6b, 0xbf, 0xc4, 0xce, 0x66, 0x9a, 0x84, 0xc5, 0x07, 0xc7, 0x18, 0xb5,
+	0xb2, 0x40, 0xb6, 0x45, 0x10, 0x07, 0x24, 0x38, 0xd4, 0x71, 0x40, 0x0d, 0x10, 0xaa, 0x4d, 0x5b,
+	0x24, 0x84, 0xb4, 0x1a, 0xef, 0x0e, 0xeb, 0x6d, 0x76, 0x67, 0xcc, 0xce, 0xd8, 0xdd, 0xde, 0xf8,
+	0x0b, 0x10, 0x07, 0xfe, 0x94, 0x5e, 0xb9, 0xf7, 0x58, 0xf5, 0x84, 0x38, 0x54, 0x28, 0xf9, 0x2f,
+	0x38, 0xa1, 0xf9, 0xb1, 0x1b, 0xdb, 0x4a, 0x0f, 0x8d, 0xc2, 0xc9, 0x9e, 0xf7, 0xbe, 0x7d, 0xdf,
+	0x7b, 0x6f, 0x3e, 0x7d, 0xbb, 0xb0, 0xcf, 0x45, 0x1a, 0x05, 0x64, 0xc0, 0x05, 0x3e, 0x23, 0xd1,
+	0xd8, 0x1f, 0xcc, 0x71, 0x1c, 0x05, 0x58, 0xb0, 0xb4, 0x3f, 0x4d, 0x99, 0x60, 0x68, 0x4b, 0x03,
+	0xfa, 0x39, 0xa0, 0xf9, 0xbe, 0xcf, 0x78, 0xc2, 0xb8, 0xa7, 0xd2, 0x03, 0x7d, 0xd0, 0xd8, 0xe6,
+	0x4e, 0xc8, 0x42, 0xa6, 0xe3, 0xf2, 0x9f, 0x8e, 0x76, 0xfe, 0xb4, 0x60, 0xf7, 0x49, 0x5e, 0xf5,
+	0x28, 0xf3, 0x27, 0x98, 0x86, 0xc4, 0xc5, 0x82, 0xa0, 0x5f, 0x2d, 0x68, 0x45, 0x54, 0x90, 0x94,
+	0xe2, 0xd8, 0x13, 0xec, 0x8c, 0x50, 0xee, 0x09, 0xe6, 0xf1, 0x09, 0x4e, 0x09, 0xf7, 0x52, 0x2c,
+	0x88, 0x63, 0xb5, 0xad, 0x6e, 0x6d, 0xf8, 0xc5, 0xcb, 0x37, 0xfb, 0xa5, 0xbf, 0xdf, 0xec, 0xdf,
+	0x0b, 0x23, 0x31, 0x99, 0x8d, 0xfb, 0x3e, 0x4b, 0x0c, 0xb3, 0xf9, 0xe9, 0xf1, 0xe0, 0x6c, 0x20,
+	0x9e, 0x4f, 0x09, 0xef, 0x8f, 0x88, 0xff, 0xfa, 0x45, 0x0f, 0x4c, 0x63, 0x23, 0xe2, 0xbb, 0xcd,
+	0x9c, 0xe3, 0x91, 0xa2, 0x78, 0xc4, 0x4e, 0x15, 0x81, 0x6a, 0xe1, 0x03, 0xd8, 0x24, 0x53, 0xe6,
+	0x4f, 0x3c, 0x3a, 0x4b, 0xc6, 0x24, 0x75, 0xd6, 0xda, 0x56, 0xb7, 0xec, 0x6e, 0xa8, 0xd8, 0x89,
+	0x0a, 0x75, 0x7e, 0xab, 0x42, 0xad, 0xe8, 0x1f, 0x21, 0x28, 0x53, 0x9c, 0x98, 0xc6, 0x5c, 0xf5,
+	0x1f, 0x1d, 0xc0, 0x6d, 0x1c, 0x04, 0x29, 0xe1, 0x5c, 0x3d, 0x5f, 0x1b, 0x3a, 0xaf, 0x5f, 0xf4,
+	0x76, 0x4c, 0x07, 0xf7, 0x75, 0xe6, 0x54, 0xa4, 0x11, 0x0d, 0xdd, 0x1c, 0x88, 0x1e, 0x43, 0x23,
+	0x20, 0x31, 0x09, 0xb1, 0x88, 0x18, 0xf5, 0x70, 0x22, 0x9c, 0x75, 0xf5, 0x68, 0xff, 0x1d, 0x46,
+	0x7d, 0x40, 0x85, 0x5b, 0xbf, 0xac, 0x72, 0x3f, 0x11, 0x68, 0x0f, 0x2a, 0xcf, 0x48, 0x14, 0x4e,
+	0x84, 0x53, 0x51, 0x93, 0x98, 0x13, 0xfa, 0x09, 0xf6, 0x8a, 0x4d, 0x13, 0x73, 0x07, 0x7a, 0xc3,
+	0xb7, 0xdb, 0x56, 0x77, 0xe3, 0xe0, 0x5e, 0x7f, 0xe5, 0x9e, 0xfb, 0x57, 0x5e, 0x99, 0xbb, 0x93,
+	0x57, 0x59, 0xba, 0xc8, 0x8f, 0x61, 0xdb, 0x67, 0x94, 0x13, 0xca, 0x67, 0xdc, 0xcb, 0x57, 0x51,
+	0x55, 0x1b, 0xb2, 0x8b, 0x84, 0x59, 0x04, 0xfa, 0x12, 0x2a, 0x5c, 0x60, 0x31, 0xe3, 0x4e, 0xad,
+	0x6d, 0x75, 0x1b, 0x07, 0x77, 0xdf, 0x4e, 0xdd, 0x1f, 0x32, 0x1a, 0x9c, 0x2a, 0xb0, 0x6b, 0x1e,
+	0x92, 0x13, 0x3e, 0xc5, 0x51, 0x4c, 0x02, 0x07, 0xda, 0x56, 0xb7, 0xea, 0x9a, 0x13, 0x6a, 0x01,
+	0x08, 0x96, 0x8c, 0xb9, 0x60, 0x94, 0x04, 0xce, 0x86, 0xca, 0x2d, 0x44, 0x10, 0x81, 0x2d, 0x9f,
+	0x25, 0x49, 0xc4, 0xb9, 0x5c, 0xb8, 0x1a, 0x7d, 0xb3, 0x10, 0x97, 0x75, 0x6d, 0x71, 0x35, 0x2e,
+	0x8b, 0xaa, 0x55, 0x7c, 0x05, 0x15, 0xad, 0x64, 0xa7, 0x7e, 0xad, 0xfb, 0x34, 0x4f, 0x4b, 0x61,
+	0xea, 0xc1, 0x3c, 0x9f, 0xcd, 0xa8, 0x70, 0x1a, 0x5a, 0x98, 0x3a, 0x76, 0x28, 0x43, 0xe8, 0x00,
+	0x76, 0x25, 0x33, 0x09, 0xbc, 0x71, 0xcc, 0xfc, 0x33, 0xae, 0x91, 0x24, 0x75, 0xb6, 0x14, 0xf6,
+	0x8e, 0x4e, 0x0e, 0x55, 0xee, 0x50, 0xa7, 0xd0, 0xcf, 0xf0, 0x5e, 0x10, 0xa5, 0xc4, 0x17, 0x24,
+	0xf0, 0x56, 0xf4, 0x67, 0x5f, 0xab, 0xdf, 0xdd, 0xbc, 0xdc, 0x68, 0x49, 0x87, 0x13, 0x70, 0x66,
+	0xd4, 0x10, 0x48, 0xaa, 0x9c, 0x53, 0x12, 0x6d, 0x5f, 0x8b, 0x68, 0x6f, 0xa1, 0xde, 0xc8, 0x94,
+	0x93, 0x4c, 0x3d, 0x40, 0x01, 0xc1, 0xbe, 0x88, 0xe6, 0x8a, 0xc9, 0xa8, 0x1f, 0xa9, 0x15, 0x6c,
+	0x2f, 0x64, 0x7e, 0x50, 0x89, 0xce, 0x67, 0x00, 0x97, 0xa2, 0x42, 0x00, 0x95, 0xe1, 0xf7, 0x27,
+	0xa3, 0xa3, 0x91, 0x5d, 0x42, 0x75, 0xa8, 0x3d, 0x3e, 0x91, 0xa7, 0x07, 0x27, 0x5f, 0xdb, 0x16,
+	0xda, 0x84, 0xaa, 0x3e, 0x1e, 0x8d, 0xec, 0xb5, 0xe3, 0x72, 0xf5, 0x96, 0x5d, 0x3e, 0x2e, 0x57,
+	0xcb, 0xf6, 0x7a, 0xe7, 0xdf, 0xb5, 0x05, 0x43, 0xd3, 0x65, 0x1f, 0xb2, 0x38, 0xf2, 0x9f, 0xa3,
+	0x18, 0xee, 0x24, 0x38, 0xf3, 0x56, 0x75, 0x76, 0x13, 0x26, 0xb6, 0x9d, 0xe0, 0xec, 0x70, 0x59,
+	0x6a, 0xbf, 0xc0, 0x9e, 0x64, 0x9b, 0x33, 0x11, 0xd1, 0xd0, 0x9b, 0xb2, 0x67, 0x24, 0xd5, 0xde,
+	0xe9, 0xac, 0xdd, 0x00, 0xa1, 0x9c, 0xe4, 0x89, 0x2a, 0xfd, 0x50, 0x56, 0x56, 0x9e, 0x89, 0xba,
+	0x60, 0x4b, 0xca, 0x25, 0x65, 0xde, 0x52, 0xab, 0x6e, 0x24, 0x38, 0x3b, 0x5e, 0x10, 0xe7, 0x47,
+	0x20, 0x3b, 0xf6, 0x96, 0x04, 0xea, 0x94, 0x15, 0x74, 0x2b, 0xc1, 0xd9, 0x77, 0x0b, 0xda, 0xcc,
+	0xb1, 0xfa, 0xea, 0x3c, 0xed, 0x2b, 0xce, 0x7a, 0x81, 0xd5, 0x2b, 0x3e, 0x54, 0xe1, 0xce, 0x1f,
+	0x65, 0x68, 0xae, 0x2c, 0xff, 0x28, 0x9b, 0xc6, 0x98, 0x2a, 0xe9, 0x49, 0x27, 0x2a, 0xde, 0x60,
+	0x85, 0x13, 0x69, 0xaf, 0xb6, 0x8b, 0x44, 0xee, 0x44, 0x77, 0xa1, 0xe1, 0xcf, 0xd2, 0x94, 0x50,
+	0x91, 0xcb, 0x46, 0xdb, 0x7f, 0xdd, 0x44, 0x75, 0x79, 0xf4, 0x21, 0xd4, 0x05, 0x4e, 0x43, 0x52,
+	0xa0, 0xf4, 0xc4, 0x9b, 0x3a, 0x68, 0x40, 0xfb, 0xb0, 0x41, 0x49, 0x56, 0x40, 0xf4, 0xa4, 0x20,
+	0x43, 0x06, 0x70, 0x85, 0xff, 0xac, 0xff, 0x0f, 0xfe, 0xf3, 0x14, 0xd0, 0x15, 0x82, 0xa8, 0xdc,
+	0x80, 0x20, 0xec, 0xf9, 0xaa, 0x1a, 0x56, 0x3d, 0xea, 0xf6, 0x3b, 0x78, 0x54, 0xf5, 0xed, 0x1e,
+	0xd5, 0x84, 0x2a, 0xc9, 0xfc, 0x78, 0x16, 0x90, 0x40, 0xbd, 0x22, 0xaa, 0x6e, 0x71, 0x96, 0xee,
+	0x9f, 0x12, 0xcc, 0x19, 0x55, 0xee, 0x5f, 0x73, 0xcd, 0x69, 0xf8, 0xcd, 0xcb, 0xf3, 0x96, 0xf5,
+	0xea, 0xbc, 0x65, 0xfd, 0x73, 0xde, 0xb2, 0x7e, 0xbf, 0x68, 0x95, 0x5e, 0x5d, 0xb4, 0x4a, 0x7f,
+	0x5d, 0xb4, 0x4a, 0x3f, 0x7e, 0xb2, 0x30, 0xec, 0xa9, 0x7a, 0xd1, 0xf4, 0xbe, 0xc5, 0x63, 0x3e,
+	0x30, 0x1f, 0x3e, 0xf3, 0xcf, 0x07, 0xd9, 0xe5, 0xd7, 0x8f, 0x9a, 0x7d, 0x5c, 0x51, 0x1f, 0x2e,
+	0x9f, 0xfe, 0x37, 0x00, 0x64, 0xd5, 0x65, 0xed, 0x1d, 0x09, 0x00, 0x00,
 }
 
 func (m *ValidatorExchangeRate) Marshal() (dAtA []byte, err error) {
@@ -236,6 +553,101 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeactivatedWeight != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.DeactivatedWeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.UndelegatedDirectedAmt.Size()
+		i -= size
+		if _, err := m.UndelegatedDirectedAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.DirectedDelegationAmt.Size()
+		i -= size
+		if _, err := m.DirectedDelegationAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.JailedCount != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.JailedCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Status != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.InternalExchangeRate != nil {
 		{
 			size, err := m.InternalExchangeRate.MarshalToSizedBuffer(dAtA[:i])
@@ -280,64 +692,903 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovValidator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorWeightPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorExchangeRate) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ValidatorWeightPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeightPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWeightChange != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.MaxWeightChange))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxMissedBlocks != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.MaxMissedBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxJailedCount != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.MaxJailedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxVotingPowerShare.Size()
+		i -= size
+		if _, err := m.MaxVotingPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxCommissionRate.Size()
+		i -= size
+		if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorWeightExplanation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorWeightExplanation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorWeightExplanation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.InternalTokensToSharesRate.Size()
-	n += 1 + l + sovValidator(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Excluded {
+		i--
+		if m.Excluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.JailedCount != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.JailedCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.VotingPowerShare.Size()
+		i -= size
+		if _, err := m.VotingPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidator(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.CommissionRate != nil {
+		{
+			size := m.CommissionRate.Size()
+			i -= size
+			if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintValidator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NextWeight != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.NextWeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetWeight != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.TargetWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentWeight != 0 {
+		i = encodeVarintValidator(dAtA, i, uint64(m.CurrentWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintValidator(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InternalTokensToSharesRate.Size()
+	n += 1 + l + sovValidator(uint64(l))
 	if m.EpochNumber != 0 {
 		n += 1 + sovValidator(uint64(m.EpochNumber))
 	}
-	return n
-}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = m.DelegationAmt.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.Weight != 0 {
+		n += 1 + sovValidator(uint64(m.Weight))
+	}
+	if m.InternalExchangeRate != nil {
+		l = m.InternalExchangeRate.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovValidator(uint64(m.Status))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.JailedCount != 0 {
+		n += 1 + sovValidator(uint64(m.JailedCount))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovValidator(uint64(m.MissedBlocksCounter))
+	}
+	l = m.DirectedDelegationAmt.Size()
+	n += 2 + l + sovValidator(uint64(l))
+	l = m.UndelegatedDirectedAmt.Size()
+	n += 2 + l + sovValidator(uint64(l))
+	if m.DeactivatedWeight != 0 {
+		n += 2 + sovValidator(uint64(m.DeactivatedWeight))
+	}
+	return n
+}
+
+func (m *ValidatorWeightPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxCommissionRate.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	l = m.MaxVotingPowerShare.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.MaxJailedCount != 0 {
+		n += 1 + sovValidator(uint64(m.MaxJailedCount))
+	}
+	if m.MaxMissedBlocks != 0 {
+		n += 1 + sovValidator(uint64(m.MaxMissedBlocks))
+	}
+	if m.MaxWeightChange != 0 {
+		n += 1 + sovValidator(uint64(m.MaxWeightChange))
+	}
+	return n
+}
+
+func (m *ValidatorWeightExplanation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	if m.CurrentWeight != 0 {
+		n += 1 + sovValidator(uint64(m.CurrentWeight))
+	}
+	if m.TargetWeight != 0 {
+		n += 1 + sovValidator(uint64(m.TargetWeight))
+	}
+	if m.NextWeight != 0 {
+		n += 1 + sovValidator(uint64(m.NextWeight))
+	}
+	if m.CommissionRate != nil {
+		l = m.CommissionRate.Size()
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	l = m.VotingPowerShare.Size()
+	n += 1 + l + sovValidator(uint64(l))
+	if m.JailedCount != 0 {
+		n += 1 + sovValidator(uint64(m.JailedCount))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovValidator(uint64(m.MissedBlocksCounter))
+	}
+	if m.Excluded {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovValidator(uint64(l))
+	}
+	return n
+}
+
+func sovValidator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidator(x uint64) (n int) {
+	return sovValidator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalTokensToSharesRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InternalTokensToSharesRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegationAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalExchangeRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalExchangeRate == nil {
+				m.InternalExchangeRate = &ValidatorExchangeRate{}
+			}
+			if err := m.InternalExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Validator_BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedCount", wireType)
+			}
+			m.JailedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DirectedDelegationAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DirectedDelegationAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegatedDirectedAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UndelegatedDirectedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedWeight", wireType)
+			}
+			m.DeactivatedWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivatedWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovValidator(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovValidator(uint64(l))
-	}
-	l = m.DelegationAmt.Size()
-	n += 1 + l + sovValidator(uint64(l))
-	if m.Weight != 0 {
-		n += 1 + sovValidator(uint64(m.Weight))
-	}
-	if m.InternalExchangeRate != nil {
-		l = m.InternalExchangeRate.Size()
-		n += 1 + l + sovValidator(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovValidator(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozValidator(x uint64) (n int) {
-	return sovValidator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
+func (m *ValidatorWeightPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -360,15 +1611,15 @@ func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorExchangeRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorWeightPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorWeightPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalTokensToSharesRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -396,15 +1647,49 @@ func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InternalTokensToSharesRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVotingPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJailedCount", wireType)
 			}
-			m.EpochNumber = 0
+			m.MaxJailedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidator
@@ -414,7 +1699,45 @@ func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= uint64(b&0x7F) << shift
+				m.MaxJailedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBlocks", wireType)
+			}
+			m.MaxMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWeightChange", wireType)
+			}
+			m.MaxWeightChange = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWeightChange |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -440,7 +1763,7 @@ func (m *ValidatorExchangeRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *ValidatorWeightExplanation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -463,15 +1786,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorWeightExplanation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorWeightExplanation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -499,11 +1822,68 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeight", wireType)
+			}
+			m.CurrentWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			m.TargetWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWeight", wireType)
+			}
+			m.NextWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -531,11 +1911,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -563,15 +1947,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegationAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedCount", wireType)
 			}
-			m.Weight = 0
+			m.JailedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidator
@@ -581,16 +1965,55 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint64(b&0x7F) << shift
+				m.JailedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Excluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Excluded = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowValidator
@@ -600,27 +2023,23 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthValidator
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthValidator
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalExchangeRate == nil {
-				m.InternalExchangeRate = &ValidatorExchangeRate{}
-			}
-			if err := m.InternalExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex